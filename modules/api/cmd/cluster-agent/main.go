@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// The cluster agent connects a cluster which cannot be reached from the outside to the
+// Kubermatic API. It opens an outbound websocket to the API and proxies the requests the API
+// sends through it to the API server of the cluster. The requests are sent with the credentials
+// of the agent, so its service account needs the permissions the dashboard should have.
+package main
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"flag"
+	"fmt"
+	"io"
+	"math"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"os"
+	"strings"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/clusteragent"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	ctrlruntimeconfig "sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/manager/signals"
+)
+
+const (
+	connectPath = "/api/v2/clusteragent/connect"
+	// stableConnectionDuration is how long a connection has to last for the reconnect backoff to be reset.
+	stableConnectionDuration = time.Minute
+)
+
+func main() {
+	var (
+		apiURL       string
+		token        string
+		name         string
+		caBundleFile string
+	)
+
+	logOpts := kubermaticlog.NewDefaultOptions()
+	logOpts.AddFlags(flag.CommandLine)
+	flag.StringVar(&apiURL, "api-url", "", "The URL of the Kubermatic API, e.g. https://kkp.example.com")
+	flag.StringVar(&token, "token", os.Getenv("CLUSTER_AGENT_TOKEN"), "The cluster agent token of the project the cluster is registered with, defaults to $CLUSTER_AGENT_TOKEN")
+	flag.StringVar(&name, "name", "", "The name of the cluster in the dashboard, it is only used when the cluster is registered for the first time")
+	flag.StringVar(&caBundleFile, "ca-bundle", "", "The path to the CA bundle used to verify the certificate of the API, the system roots are used if empty")
+	flag.Parse()
+
+	log := kubermaticlog.New(logOpts.Debug, logOpts.Format).Sugar()
+	if apiURL == "" || token == "" {
+		log.Fatal("-api-url and -token are required")
+	}
+
+	ctx := signals.SetupSignalHandler()
+
+	cfg, err := ctrlruntimeconfig.GetConfig()
+	if err != nil {
+		log.Fatalw("Failed to get the config of the cluster", zap.Error(err))
+	}
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		log.Fatalw("Failed to create the client of the cluster", zap.Error(err))
+	}
+	// the UID of kube-system identifies the cluster over agent restarts and reinstalls
+	kubeSystem, err := client.CoreV1().Namespaces().Get(ctx, metav1.NamespaceSystem, metav1.GetOptions{})
+	if err != nil {
+		log.Fatalw("Failed to get the kube-system namespace", zap.Error(err))
+	}
+
+	proxy, err := newAPIServerProxy(cfg)
+	if err != nil {
+		log.Fatalw("Failed to create the API server proxy", zap.Error(err))
+	}
+
+	connectURL, err := getConnectURL(apiURL)
+	if err != nil {
+		log.Fatalw("Invalid API URL", zap.Error(err))
+	}
+
+	dialer, err := newDialer(caBundleFile)
+	if err != nil {
+		log.Fatalw("Failed to create the API dialer", zap.Error(err))
+	}
+
+	header := http.Header{}
+	header.Set("Authorization", "Bearer "+token)
+	header.Set(clusteragent.ClusterIDHeader, string(kubeSystem.UID))
+	if name != "" {
+		header.Set(clusteragent.ClusterNameHeader, name)
+	}
+
+	log = log.With("api", connectURL, "cluster", kubeSystem.UID)
+	backoff := newBackoff()
+	for {
+		start := time.Now()
+		err := connect(ctx, log, dialer, connectURL, header, proxy)
+		if ctx.Err() != nil {
+			return
+		}
+
+		if time.Since(start) > stableConnectionDuration {
+			backoff = newBackoff()
+		}
+		delay := backoff.Step()
+		log.Infow("Connection to the API lost, reconnecting", "delay", delay, zap.Error(err))
+
+		select {
+		case <-ctx.Done():
+			return
+		case <-time.After(delay):
+		}
+	}
+}
+
+// connect opens the tunnel to the API and serves it until the connection is closed.
+func connect(ctx context.Context, log *zap.SugaredLogger, dialer *websocket.Dialer, connectURL string, header http.Header, proxy http.Handler) error {
+	ws, resp, err := dialer.DialContext(ctx, connectURL, header)
+	if err != nil {
+		if resp != nil {
+			body, _ := io.ReadAll(io.LimitReader(resp.Body, 4096))
+			resp.Body.Close()
+			return fmt.Errorf("%w: %s: %s", err, resp.Status, strings.TrimSpace(string(body)))
+		}
+		return err
+	}
+
+	conn := clusteragent.NewWebsocketConn(ws)
+	done := make(chan struct{})
+	defer close(done)
+	go func() {
+		select {
+		case <-ctx.Done():
+			conn.Close()
+		case <-done:
+		}
+	}()
+
+	log.Info("Connected to the API")
+	return clusteragent.ServeAgent(conn, proxy)
+}
+
+// newAPIServerProxy returns a proxy to the API server of the cluster which authenticates the
+// requests with the credentials of the agent.
+func newAPIServerProxy(cfg *rest.Config) (http.Handler, error) {
+	target, err := url.Parse(cfg.Host)
+	if err != nil {
+		return nil, fmt.Errorf("invalid API server address %q: %w", cfg.Host, err)
+	}
+	transport, err := rest.TransportFor(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return &httputil.ReverseProxy{
+		Rewrite: func(r *httputil.ProxyRequest) {
+			r.SetURL(target)
+		},
+		Transport: transport,
+		// watches and log streams have to be forwarded immediately
+		FlushInterval: -1,
+	}, nil
+}
+
+func getConnectURL(apiURL string) (string, error) {
+	u, err := url.Parse(strings.TrimSuffix(apiURL, "/"))
+	if err != nil {
+		return "", err
+	}
+
+	switch u.Scheme {
+	case "https":
+		u.Scheme = "wss"
+	case "http":
+		u.Scheme = "ws"
+	default:
+		return "", fmt.Errorf("unsupported scheme %q", u.Scheme)
+	}
+	u.Path += connectPath
+
+	return u.String(), nil
+}
+
+func newDialer(caBundleFile string) (*websocket.Dialer, error) {
+	dialer := &websocket.Dialer{
+		Proxy:            http.ProxyFromEnvironment,
+		HandshakeTimeout: 30 * time.Second,
+		ReadBufferSize:   32 * 1024,
+		WriteBufferSize:  32 * 1024,
+	}
+
+	if caBundleFile != "" {
+		caBundle, err := os.ReadFile(caBundleFile)
+		if err != nil {
+			return nil, err
+		}
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(caBundle) {
+			return nil, fmt.Errorf("no certificates found in %s", caBundleFile)
+		}
+		dialer.TLSClientConfig = &tls.Config{RootCAs: pool, MinVersion: tls.VersionTLS12}
+	}
+
+	return dialer, nil
+}
+
+func newBackoff() wait.Backoff {
+	return wait.Backoff{
+		Duration: time.Second,
+		Factor:   2,
+		Jitter:   0.1,
+		Steps:    math.MaxInt32,
+		Cap:      time.Minute,
+	}
+}
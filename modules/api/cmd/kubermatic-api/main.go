@@ -61,10 +61,13 @@ import (
 	velerov1 "github.com/vmware-tanzu/velero/pkg/apis/velero/v1"
 	"go.uber.org/zap"
 
+	"k8c.io/dashboard/v2/pkg/clusteragent"
 	"k8c.io/dashboard/v2/pkg/handler"
 	"k8c.io/dashboard/v2/pkg/handler/auth"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	v2 "k8c.io/dashboard/v2/pkg/handler/v2"
+	kubernetespkg "k8c.io/dashboard/v2/pkg/kubernetes"
 	"k8c.io/dashboard/v2/pkg/provider"
 	auth2 "k8c.io/dashboard/v2/pkg/provider/auth"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
@@ -283,6 +286,7 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 			}
 		}
 	}()
+	go handlercommon.RunMachineDeploymentScalingSchedules(ctx, seedsGetter, clusterProviderGetter, log)
 
 	sshKeyProvider := kubernetesprovider.NewSSHKeyProvider(defaultImpersonationClient.CreateImpersonatedClient, client)
 	privilegedSSHKeyProvider, err := kubernetesprovider.NewPrivilegedSSHKeyProvider(client)
@@ -294,6 +298,14 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	addonConfigProvider := kubernetesprovider.NewAddonConfigProvider(client)
 	adminProvider := kubernetesprovider.NewAdminProvider(client)
 	resourceQuotaProvider := resourceQuotaProviderFactory(defaultImpersonationClient.CreateImpersonatedClient, client)
+	resourceQuotaUsageHistoryProvider := resourceQuotaUsageHistoryProviderFactory(client, options)
+	if resourceQuotaUsageHistoryProvider != nil {
+		go resourceQuotaUsageHistoryProvider.Run(ctx, log)
+	}
+	resourceQuotaNotificationProvider := resourceQuotaNotificationProviderFactory(client, options)
+	if resourceQuotaNotificationProvider != nil {
+		go resourceQuotaNotificationProvider.Run(ctx, log)
+	}
 	groupProjectBindingProvider := groupProjectBindingFactory(defaultImpersonationClient.CreateImpersonatedClient, client)
 
 	serviceAccountTokenProvider, err := kubernetesprovider.NewServiceAccountTokenProvider(defaultImpersonationClient.CreateImpersonatedClient, client)
@@ -322,6 +334,24 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	if err != nil {
 		return providers{}, fmt.Errorf("failed to create external cluster provider: %w", err)
 	}
+	go handlercommon.RunExternalClusterInventory(ctx, mgr.GetClient(), externalClusterProvider, log)
+	clusterAgentTokenProvider := kubernetesprovider.NewClusterAgentTokenProvider(client)
+	clusteragent.ConfigureRelay(kubeMasterClient.CoordinationV1().Leases(options.namespace), options.clusterAgentRelayAdvertiseAddress, []byte(options.serviceAccountSigningKey), log)
+	go func() {
+		if err := http.ListenAndServe(options.clusterAgentRelayAddress, clusteragent.RelayHandler()); err != nil {
+			log.Fatalw("failed to start the cluster agent relay", zap.Error(err))
+		}
+	}()
+	go func() {
+		if err := kubernetespkg.RunWithLeaderElection(ctx, kubeMasterClient.CoordinationV1(), options.namespace, clusteragent.LeaseName, log, clusteragent.Lead); err != nil {
+			log.Fatalw("failed to elect the replica serving cluster agents", zap.Error(err))
+		}
+	}()
+	sessionProvider := kubernetesprovider.NewSessionProvider(client, mgr.GetAPIReader(), []byte(options.serviceAccountSigningKey))
+	go handlercommon.RunSessionCleanup(ctx, sessionProvider, log)
+	deviceAuthorizationProvider := kubernetesprovider.NewDeviceAuthorizationProvider(client, mgr.GetAPIReader())
+	go handlercommon.RunDeviceAuthorizationCleanup(ctx, deviceAuthorizationProvider, log)
+	personalAccessTokenProvider := kubernetesprovider.NewPersonalAccessTokenProvider(client, mgr.GetAPIReader())
 
 	defaultConstraintProvider, err := kubernetesprovider.NewDefaultConstraintProvider(defaultImpersonationClient.CreateImpersonatedClient, mgr.GetClient(), options.namespace)
 	if err != nil {
@@ -342,6 +372,18 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	if err != nil {
 		return providers{}, fmt.Errorf("failed to create allowed registry provider: %w", err)
 	}
+	backgroundOperationProvider := kubernetesprovider.NewBackgroundOperationProvider(ctx, client, mgr.GetAPIReader(), log)
+	backgroundOperationProvider.RegisterResumer(handlercommon.ClusterUpgradeOperationKind, handlercommon.ClusterUpgradeResumer(seedsGetter, clusterProviderGetter))
+	backgroundOperationProvider.RegisterResumer(handlercommon.MachineNodeDrainOperationKind, handlercommon.MachineNodeDrainResumer(seedsGetter, clusterProviderGetter))
+	backgroundOperationProvider.RegisterResumer(handlercommon.MachineDeploymentRolloutOperationKind, handlercommon.MachineDeploymentRolloutResumer(seedsGetter, clusterProviderGetter))
+	backgroundOperationProvider.RegisterResumer(handlercommon.ExternalClusterNodeDrainOperationKind, handlercommon.ExternalClusterNodeDrainResumer(externalClusterProvider, externalClusterProvider))
+	backgroundOperationProvider.RegisterResumer(handlercommon.ClusterReadinessCheckOperationKind, handlercommon.ClusterReadinessCheckResumer(seedsGetter, clusterProviderGetter, privilegedAllowedRegistryProvider))
+	backgroundOperationProvider.RegisterFailureHandler(handlercommon.ClusterUpgradeOperationKind, handlercommon.ClusterUpgradeFailureHandler(seedsGetter, clusterProviderGetter))
+	backgroundOperationProvider.RegisterFailureHandler(handlercommon.MachineNodeDrainOperationKind, handlercommon.MachineNodeDrainFailureHandler(seedsGetter, clusterProviderGetter))
+	backgroundOperationProvider.RegisterFailureHandler(handlercommon.MachineDeploymentRolloutOperationKind, handlercommon.MachineDeploymentRolloutFailureHandler(seedsGetter, clusterProviderGetter))
+	backgroundOperationProvider.RegisterFailureHandler(handlercommon.ExternalClusterNodeDrainOperationKind, handlercommon.ExternalClusterNodeDrainFailureHandler(externalClusterProvider, externalClusterProvider))
+	backgroundOperationProvider.RegisterFailureHandler(handlercommon.ClusterReadinessCheckOperationKind, handlercommon.ClusterReadinessCheckFailureHandler(seedsGetter, clusterProviderGetter))
+	go backgroundOperationProvider.Run()
 
 	constraintProviderGetter := kubernetesprovider.ConstraintProviderFactory(mgr.GetRESTMapper(), seedKubeconfigGetter)
 
@@ -375,6 +417,16 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 	seedProvider := kubernetesprovider.NewSeedProvider(mgr.GetClient())
 
 	applicationDefinitionProvider := kubernetesprovider.NewApplicationDefinitionProvider(client)
+	applicationCatalogSourceProvider := kubernetesprovider.NewApplicationCatalogSourceProvider(client)
+	go func() {
+		if err := kubernetespkg.RunWithLeaderElection(ctx, kubeMasterClient.CoordinationV1(), options.namespace, handlercommon.ApplicationCatalogSyncLeaseName, log, func(ctx context.Context) {
+			handlercommon.RunApplicationCatalogSync(ctx, applicationCatalogSourceProvider, applicationDefinitionProvider, log)
+		}); err != nil {
+			log.Fatalw("failed to elect the replica syncing application catalogs", zap.Error(err))
+		}
+	}()
+	applicationRolloutProvider := kubernetesprovider.NewApplicationRolloutProvider(client)
+	go handlercommon.RunApplicationRollouts(ctx, applicationRolloutProvider, seedsGetter, clusterProviderGetter, log)
 
 	privilegedOperatingSystemProfileProviderGetter := kubernetesprovider.PrivilegedOperatingSystemProfileProviderFactory(mgr.GetRESTMapper(), seedKubeconfigGetter)
 
@@ -383,6 +435,15 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		log.Fatalw("failed to create an openid authenticator", zap.Any("issuer", options.oidcURL), zap.Any("oidcClientID", options.oidcAuthenticatorClientID), zap.Error(err))
 	}
 
+	var additionalOIDCIssuerVerifiers []authtypes.OIDCIssuerVerifier
+	for _, issuer := range options.additionalOIDCIssuers {
+		issuerVerifier, err := createOIDCClients(issuer.IssuerConfiguration(options.oidcIssuerConfiguration.SecureCookie, options.oidcIssuerCookieSecureMode), options.oidcIssuerRedirectURI, options.caBundle)
+		if err != nil {
+			log.Fatalw("failed to create an openid authenticator", zap.String("issuer", issuer.URL), zap.String("oidcClientID", issuer.ClientID), zap.Error(err))
+		}
+		additionalOIDCIssuerVerifiers = append(additionalOIDCIssuerVerifiers, issuerVerifier)
+	}
+
 	oidcIssuerVerifierProviderGetter := auth2.OIDCIssuerVerifierProviderFactory(
 		oidcIssuerVerifier,
 		options.oidcIssuerRedirectURI,
@@ -476,12 +537,22 @@ func createInitProviders(ctx context.Context, options serverRunOptions, masterCf
 		privilegedMLAAdminSettingProviderGetter:        privilegedMLAAdminSettingProviderGetter,
 		seedProvider:                                   seedProvider,
 		resourceQuotaProvider:                          resourceQuotaProvider,
+		resourceQuotaUsageHistoryProvider:              resourceQuotaUsageHistoryProvider,
+		clusterAgentTokenProvider:                      clusterAgentTokenProvider,
+		sessionProvider:                                sessionProvider,
+		deviceAuthorizationProvider:                    deviceAuthorizationProvider,
+		personalAccessTokenProvider:                    personalAccessTokenProvider,
+		resourceQuotaNotificationProvider:              resourceQuotaNotificationProvider,
 		groupProjectBindingProvider:                    groupProjectBindingProvider,
 		privilegedIPAMPoolProviderGetter:               privilegedIPAMPoolProviderGetter,
 		applicationDefinitionProvider:                  applicationDefinitionProvider,
+		applicationCatalogSourceProvider:               applicationCatalogSourceProvider,
+		applicationRolloutProvider:                     applicationRolloutProvider,
+		backgroundOperationProvider:                    backgroundOperationProvider,
 		privilegedOperatingSystemProfileProviderGetter: privilegedOperatingSystemProfileProviderGetter,
 		oidcIssuerVerifierProviderGetter:               oidcIssuerVerifierProviderGetter,
 		oidcIssuerVerifier:                             oidcIssuerVerifier,
+		additionalOIDCIssuerVerifiers:                  additionalOIDCIssuerVerifiers,
 	}, nil
 }
 
@@ -498,21 +569,34 @@ func createOIDCClients(oidcIssuerConfiguration *authtypes.OIDCConfiguration, oid
 }
 
 func createAuthClients(options serverRunOptions, prov providers) (authtypes.TokenVerifier, authtypes.TokenExtractor, error) {
-	oidcExtractorVerifier, err := auth.NewOpenIDClient(
-		options.oidcAuthenticatorConfiguration,
-		"",
-		auth.NewCombinedExtractor(
-			auth.NewHeaderBearerTokenExtractor("Authorization"),
-			auth.NewCookieHeaderBearerTokenExtractor("token"),
-			auth.NewQueryParamBearerTokenExtractor("token"),
-			auth.NewCookieHeaderBearerMultiTokenExtractor("token"),
-		),
-		options.caBundle.CertPool(),
-	)
-
+	newAuthenticator := func(config *authtypes.OIDCConfiguration) (authtypes.OIDCIssuerVerifier, error) {
+		return auth.NewOpenIDClient(
+			config,
+			"",
+			auth.NewCombinedExtractor(
+				auth.NewHeaderBearerTokenExtractor("Authorization"),
+				auth.NewCookieHeaderBearerTokenExtractor("token"),
+				auth.NewQueryParamBearerTokenExtractor("token"),
+				auth.NewCookieHeaderBearerMultiTokenExtractor("token"),
+			),
+			options.caBundle.CertPool(),
+		)
+	}
+
+	oidcExtractorVerifier, err := newAuthenticator(options.oidcAuthenticatorConfiguration)
 	if err != nil {
 		return nil, nil, fmt.Errorf("failed to create OIDC Authenticator: %w", err)
 	}
+	verifiers := []authtypes.TokenVerifier{oidcExtractorVerifier}
+
+	// the tokens of all issuers are extracted the same way, they only need their own verifiers
+	for _, issuer := range options.additionalOIDCIssuers {
+		issuerVerifier, err := newAuthenticator(issuer.AuthenticatorConfiguration())
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to create OIDC Authenticator for issuer %s: %w", issuer.Name, err)
+		}
+		verifiers = append(verifiers, issuerVerifier)
+	}
 
 	jwtExtractorVerifier := auth.NewServiceAccountAuthClient(
 		auth.NewHeaderBearerTokenExtractor("Authorization"),
@@ -520,8 +604,14 @@ func createAuthClients(options serverRunOptions, prov providers) (authtypes.Toke
 		prov.privilegedServiceAccountTokenProvider,
 	)
 
-	tokenVerifiers := auth.NewTokenVerifierPlugins([]authtypes.TokenVerifier{oidcExtractorVerifier, jwtExtractorVerifier})
-	tokenExtractors := auth.NewTokenExtractorPlugins([]authtypes.TokenExtractor{oidcExtractorVerifier, jwtExtractorVerifier})
+	// the dashboard sends the session cookie, the tokens of its login are only kept server-side
+	sessionExtractor := auth.NewSessionTokenExtractor(prov.sessionProvider, options.trustedProxies)
+
+	// personal access tokens are sent as bearer tokens, which the OIDC extractor already extracts
+	patVerifier := auth.NewPersonalAccessTokenVerifier(prov.personalAccessTokenProvider, prov.settingsProvider)
+
+	tokenVerifiers := auth.NewTokenVerifierPlugins(append(verifiers, jwtExtractorVerifier, patVerifier))
+	tokenExtractors := auth.NewTokenExtractorPlugins([]authtypes.TokenExtractor{oidcExtractorVerifier, jwtExtractorVerifier, sessionExtractor})
 	return tokenVerifiers, tokenExtractors, nil
 }
 
@@ -604,15 +694,27 @@ func createAPIHandler(
 		PrivilegedMLAAdminSettingProviderGetter:        prov.privilegedMLAAdminSettingProviderGetter,
 		SeedProvider:                                   prov.seedProvider,
 		ResourceQuotaProvider:                          prov.resourceQuotaProvider,
+		ResourceQuotaUsageHistoryProvider:              prov.resourceQuotaUsageHistoryProvider,
+		ClusterAgentTokenProvider:                      prov.clusterAgentTokenProvider,
+		SessionProvider:                                prov.sessionProvider,
+		DeviceAuthorizationProvider:                    prov.deviceAuthorizationProvider,
+		PersonalAccessTokenProvider:                    prov.personalAccessTokenProvider,
+		ResourceQuotaNotificationProvider:              prov.resourceQuotaNotificationProvider,
 		GroupProjectBindingProvider:                    prov.groupProjectBindingProvider,
 		PrivilegedIPAMPoolProviderGetter:               prov.privilegedIPAMPoolProviderGetter,
 		ApplicationDefinitionProvider:                  prov.applicationDefinitionProvider,
+		ApplicationCatalogSourceProvider:               prov.applicationCatalogSourceProvider,
+		ApplicationRolloutProvider:                     prov.applicationRolloutProvider,
+		BackgroundOperationProvider:                    prov.backgroundOperationProvider,
 		PrivilegedOperatingSystemProfileProviderGetter: prov.privilegedOperatingSystemProfileProviderGetter,
 		OIDCIssuerVerifierProviderGetter:               prov.oidcIssuerVerifierProviderGetter,
 		OIDCIssuerVerifier:                             prov.oidcIssuerVerifier,
+		AdditionalOIDCIssuerVerifiers:                  prov.additionalOIDCIssuerVerifiers,
+		TrustedProxies:                                 options.trustedProxies,
 		Versions:                                       options.versions,
 		CABundle:                                       options.caBundle.CertPool(),
 		Features:                                       options.featureGates,
+		PriceCatalog:                                   options.priceCatalog,
 	}
 
 	r := handler.NewRouting(routingParams, mgr.GetClient())
@@ -21,11 +21,15 @@ import (
 	"flag"
 	"fmt"
 	"os"
+	"strings"
 
 	"github.com/gorilla/securecookie"
 	"go.uber.org/zap"
 	"gopkg.in/yaml.v3"
 
+	"k8c.io/dashboard/v2/pkg/clusteragent"
+	"k8c.io/dashboard/v2/pkg/handler/auth"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
@@ -52,6 +56,12 @@ type serverRunOptions struct {
 	caBundle                       *certificates.CABundle
 	oidcIssuerConfiguration        *authtypes.OIDCConfiguration
 	oidcAuthenticatorConfiguration *authtypes.OIDCConfiguration
+	additionalOIDCIssuers          []auth.OIDCIssuer
+	trustedProxies                 auth.TrustedProxies
+
+	// the replica holding the cluster agent tunnels relays them to the other replicas
+	clusterAgentRelayAddress          string
+	clusterAgentRelayAdvertiseAddress string
 
 	// for development purposes, a local configuration file
 	// can be used to provide the KubermaticConfiguration
@@ -73,6 +83,9 @@ type serverRunOptions struct {
 
 	featureGates features.FeatureGate
 	versions     kubermatic.Versions
+
+	// optional price sheet used for cost estimates
+	priceCatalog pricing.Catalog
 }
 
 func newServerRunOptions() (serverRunOptions, error) {
@@ -81,6 +94,9 @@ func newServerRunOptions() (serverRunOptions, error) {
 		rawExposeStrategy string
 		caBundleFile      string
 		configFile        string
+		priceCatalogFile  string
+		oidcIssuersFile   string
+		trustedProxies    string
 	)
 
 	s.log = kubermaticlog.NewDefaultOptions()
@@ -88,6 +104,8 @@ func newServerRunOptions() (serverRunOptions, error) {
 
 	flag.StringVar(&s.listenAddress, "address", ":8080", "The address to listen on")
 	flag.StringVar(&s.internalAddr, "internal-address", "127.0.0.1:8085", "The address on which the internal handler should be exposed")
+	flag.StringVar(&s.clusterAgentRelayAddress, "cluster-agent-relay-address", ":8086", "The address on which the replica holding the cluster agent tunnels relays them to the other replicas, which authenticate with the service-account-signing-key")
+	flag.StringVar(&s.clusterAgentRelayAdvertiseAddress, "cluster-agent-relay-advertise-address", "", "The address the other replicas reach the cluster agent relay of this replica at. Defaults to the IP the hostname resolves to and the port of the cluster-agent-relay-address")
 	flag.StringVar(&s.prometheusURL, "prometheus-url", "http://prometheus.monitoring.svc.local:web", "The URL on which this API can talk to Prometheus")
 	flag.StringVar(&s.overwriteRegistry, "overwrite-registry", "", "registry to use for all images")
 	flag.StringVar(&s.workerName, "worker-name", "", "Create clusters only processed by worker-name cluster controller")
@@ -101,6 +119,7 @@ func newServerRunOptions() (serverRunOptions, error) {
 	flag.StringVar(&s.oidcIssuerRedirectURI, "oidc-issuer-redirect-uri", "", "Callback URL for OpenID responses.")
 	flag.StringVar(&s.oidcIssuerCookieHashKey, "oidc-issuer-cookie-hash-key", "", "Hash key authenticates the cookie value using HMAC. It is recommended to use a key with 32 or 64 bytes.")
 	flag.BoolVar(&s.oidcIssuerCookieSecureMode, "oidc-issuer-cookie-secure-mode", true, "When true cookie received only with HTTPS. Set false for local deployment with HTTP")
+	flag.StringVar(&oidcIssuersFile, "oidc-issuers-file", "", "Path to a YAML file with OIDC issuers users can log in with in addition to the --oidc-url issuer, each with its own client, claim mapping and allowed email domains")
 	flag.BoolVar(&s.oidcIssuerOfflineAccessAsScope, "oidc-issuer-offline-access-as-scope", true, "Set it to false if OIDC provider requires to set \"access_type=offline\" query param when accessing the refresh token")
 	flag.Var(&s.featureGates, "feature-gates", "A set of key=value pairs that describe feature gates for various features.")
 	flag.StringVar(&s.domain, "domain", "localhost", "A domain name on which the server is deployed")
@@ -108,6 +127,8 @@ func newServerRunOptions() (serverRunOptions, error) {
 	flag.StringVar(&rawExposeStrategy, "expose-strategy", "NodePort", "The strategy to expose the controlplane with, either \"NodePort\" which creates NodePorts with a \"nodeport-proxy.k8s.io/expose: true\" annotation or \"LoadBalancer\", which creates a LoadBalancer")
 	flag.StringVar(&s.namespace, "namespace", "kubermatic", "The namespace kubermatic runs in, uses to determine where to look for datacenter custom resources")
 	flag.StringVar(&configFile, "kubermatic-configuration-file", "", "(for development only) path to a KubermaticConfiguration YAML file")
+	flag.StringVar(&trustedProxies, "trusted-proxies", "", "Comma-separated CIDRs of the proxies in front of the API, like the ingress controller. Their X-Forwarded-For headers are used to determine the client IPs recorded in the login sessions, the IP of the connection is used otherwise")
+	flag.StringVar(&priceCatalogFile, "price-catalog-file", "", "Path to a JSON or YAML price sheet used to estimate the cost of machine deployments, clusters and projects")
 	addFlags(flag.CommandLine)
 	flag.Parse()
 
@@ -124,6 +145,36 @@ func newServerRunOptions() (serverRunOptions, error) {
 		}
 	}
 
+	if priceCatalogFile != "" {
+		var err error
+		if s.priceCatalog, err = pricing.NewCatalogFromFile(priceCatalogFile); err != nil {
+			return s, fmt.Errorf("invalid price catalog: %w", err)
+		}
+	}
+
+	if oidcIssuersFile != "" {
+		var err error
+		if s.additionalOIDCIssuers, err = auth.NewOIDCIssuersFromFile(oidcIssuersFile); err != nil {
+			return s, fmt.Errorf("invalid OIDC issuers: %w", err)
+		}
+		for _, issuer := range s.additionalOIDCIssuers {
+			if issuer.URL == s.oidcURL {
+				return s, fmt.Errorf("invalid OIDC issuers: issuer %s uses the --oidc-url", issuer.Name)
+			}
+		}
+	}
+
+	var err error
+	if s.trustedProxies, err = auth.ParseTrustedProxies(strings.Split(trustedProxies, ",")); err != nil {
+		return s, fmt.Errorf("invalid --trusted-proxies: %w", err)
+	}
+
+	if s.clusterAgentRelayAdvertiseAddress == "" {
+		if s.clusterAgentRelayAdvertiseAddress, err = clusteragent.DefaultRelayAddress(s.clusterAgentRelayAddress); err != nil {
+			return s, fmt.Errorf("failed to determine the cluster agent relay address, set --cluster-agent-relay-advertise-address: %w", err)
+		}
+	}
+
 	if len(caBundleFile) == 0 {
 		return s, errors.New("no -ca-bundle configured")
 	}
@@ -211,12 +262,22 @@ type providers struct {
 	privilegedMLAAdminSettingProviderGetter        provider.PrivilegedMLAAdminSettingProviderGetter
 	seedProvider                                   provider.SeedProvider
 	resourceQuotaProvider                          provider.ResourceQuotaProvider
+	resourceQuotaUsageHistoryProvider              provider.ResourceQuotaUsageHistoryProvider
+	clusterAgentTokenProvider                      provider.ClusterAgentTokenProvider
+	sessionProvider                                provider.SessionProvider
+	deviceAuthorizationProvider                    provider.DeviceAuthorizationProvider
+	personalAccessTokenProvider                    provider.PersonalAccessTokenProvider
+	resourceQuotaNotificationProvider              provider.ResourceQuotaNotificationProvider
 	groupProjectBindingProvider                    provider.GroupProjectBindingProvider
 	privilegedIPAMPoolProviderGetter               provider.PrivilegedIPAMPoolProviderGetter
 	applicationDefinitionProvider                  provider.ApplicationDefinitionProvider
+	applicationCatalogSourceProvider               provider.ApplicationCatalogSourceProvider
+	applicationRolloutProvider                     provider.ApplicationRolloutProvider
+	backgroundOperationProvider                    provider.BackgroundOperationProvider
 	privilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter
 	oidcIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
 	oidcIssuerVerifier                             authtypes.OIDCIssuerVerifier
+	additionalOIDCIssuerVerifiers                  []authtypes.OIDCIssuerVerifier
 	policyTemplateProvider                         provider.PolicyTemplateProvider
 }
 
@@ -44,6 +44,14 @@ func resourceQuotaProviderFactory(_ kubernetes.ImpersonationClient, _ ctrlruntim
 	return nil
 }
 
+func resourceQuotaUsageHistoryProviderFactory(_ ctrlruntimeclient.Client, _ serverRunOptions) provider.ResourceQuotaUsageHistoryProvider {
+	return nil
+}
+
+func resourceQuotaNotificationProviderFactory(_ ctrlruntimeclient.Client, _ serverRunOptions) provider.ResourceQuotaNotificationProvider {
+	return nil
+}
+
 func groupProjectBindingFactory(_ kubernetes.ImpersonationClient, _ ctrlruntimeclient.Client) provider.GroupProjectBindingProvider {
 	return nil
 }
@@ -45,6 +45,14 @@ func resourceQuotaProviderFactory(createMasterImpersonatedClient kubernetes.Impe
 	return eeapi.ResourceQuotaProviderFactory(createMasterImpersonatedClient, privilegedClient)
 }
 
+func resourceQuotaUsageHistoryProviderFactory(client ctrlruntimeclient.Client, opt serverRunOptions) provider.ResourceQuotaUsageHistoryProvider {
+	return eeapi.ResourceQuotaUsageHistoryProviderFactory(client, opt.namespace)
+}
+
+func resourceQuotaNotificationProviderFactory(client ctrlruntimeclient.Client, opt serverRunOptions) provider.ResourceQuotaNotificationProvider {
+	return eeapi.ResourceQuotaNotificationProviderFactory(client, opt.namespace)
+}
+
 func groupProjectBindingFactory(createMasterImpersonatedClient kubernetes.ImpersonationClient, privilegedClient ctrlruntimeclient.Client) provider.GroupProjectBindingProvider {
 	return eeapi.GroupProjectBindingProviderFactory(createMasterImpersonatedClient, privilegedClient)
 }
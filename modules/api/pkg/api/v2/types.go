@@ -227,6 +227,55 @@ type AlertmanagerSpec struct {
 	Config []byte `json:"config"`
 }
 
+// AlertmanagerRouteTestBody is the request body for testing the routing tree of an Alertmanager configuration
+// swagger:model AlertmanagerRouteTestBody
+type AlertmanagerRouteTestBody struct {
+	// Labels of the alert that is routed
+	Labels map[string]string `json:"labels"`
+	// Config is the alertmanager configuration in YAML to test, the current configuration of the cluster is used
+	// if it is empty
+	Config []byte `json:"config,omitempty"`
+}
+
+// AlertmanagerRouteTestResult lists the routes an alert with the given labels is sent to
+// swagger:model AlertmanagerRouteTestResult
+type AlertmanagerRouteTestResult struct {
+	// Receivers are the names of the receivers that get notified, in the order of the routes
+	Receivers []string `json:"receivers"`
+	// Routes are the matching routes of the routing tree
+	Routes []AlertmanagerMatchedRoute `json:"routes"`
+}
+
+// AlertmanagerMatchedRoute is a route of the routing tree that matched an alert
+// swagger:model AlertmanagerMatchedRoute
+type AlertmanagerMatchedRoute struct {
+	// Path of the route in the configuration, e.g. route.routes[0]
+	Path     string   `json:"path"`
+	Receiver string   `json:"receiver"`
+	GroupBy  []string `json:"groupBy,omitempty"`
+}
+
+// AlertmanagerTestAlertBody is the request body for sending a test alert
+// swagger:model AlertmanagerTestAlertBody
+type AlertmanagerTestAlertBody struct {
+	// Labels of the test alert, the alertname defaults to DashboardTestAlert
+	Labels map[string]string `json:"labels,omitempty"`
+	// Annotations of the test alert, the summary defaults to a note who sent the alert
+	Annotations map[string]string `json:"annotations,omitempty"`
+}
+
+// AlertmanagerTestAlert is a synthetic alert that was sent to the Alertmanager of a cluster
+// swagger:model AlertmanagerTestAlert
+type AlertmanagerTestAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations"`
+	StartsAt    apiv1.Time        `json:"startsAt"`
+	// EndsAt is the time the alert resolves on its own
+	EndsAt apiv1.Time `json:"endsAt"`
+	// Receivers are the names of the receivers the alert is routed to by the current configuration
+	Receivers []string `json:"receivers"`
+}
+
 // SeedSettings represents settings for a Seed cluster
 // swagger:model SeedSettings
 type SeedSettings struct {
@@ -264,6 +313,8 @@ type ClusterTemplate struct {
 	Cluster        *ClusterTemplateInfo           `json:"cluster,omitempty"`
 	NodeDeployment *ClusterTemplateNodeDeployment `json:"nodeDeployment,omitempty"`
 	Applications   []apiv1.Application            `json:"applications,omitempty"`
+	// CostEstimate is only set when a price catalog is configured.
+	CostEstimate *CostEstimate `json:"costEstimate,omitempty"`
 }
 
 // ClusterTemplateInfo represents a ClusterTemplateInfo object.
@@ -570,6 +621,8 @@ type ExternalClusterStatus struct {
 	State         ExternalClusterState `json:"state"`
 	StatusMessage string               `json:"statusMessage,omitempty"`
 	AKS           *AKSClusterStatus    `json:"aks,omitempty"`
+	// Inventory is only collected for clusters imported from a kubeconfig.
+	Inventory *ExternalClusterInventory `json:"inventory,omitempty"`
 }
 
 // ExternalClusterSpec defines the external cluster specification.
@@ -1941,6 +1994,86 @@ type ResourceQuotaUpdateCalculation struct {
 	Message string `json:"message"`
 }
 
+// swagger:model ResourceQuotaUsageHistory
+type ResourceQuotaUsageHistory struct {
+	ResourceQuota ResourceQuota `json:"resourceQuota"`
+	// Snapshots holds the periodically recorded global usage, oldest first.
+	Snapshots []ResourceQuotaUsageSnapshot `json:"snapshots"`
+	Forecast  ResourceQuotaForecast        `json:"forecast"`
+}
+
+// swagger:model ResourceQuotaUsageSnapshot
+type ResourceQuotaUsageSnapshot struct {
+	Timestamp apiv1.Time `json:"timestamp"`
+	Usage     Quota      `json:"usage"`
+}
+
+// ResourceQuotaForecast holds a linear forecast of the usage for every resource that is limited by the quota.
+// swagger:model ResourceQuotaForecast
+type ResourceQuotaForecast struct {
+	CPU     *ResourceUsageForecast `json:"cpu,omitempty"`
+	Memory  *ResourceUsageForecast `json:"memory,omitempty"`
+	Storage *ResourceUsageForecast `json:"storage,omitempty"`
+}
+
+// swagger:model ResourceUsageForecast
+type ResourceUsageForecast struct {
+	// DailyGrowth is the average growth of the usage per day, in the unit of the quota.
+	DailyGrowth float64 `json:"dailyGrowth"`
+	// ExhaustionTime is the estimated time at which the usage reaches the quota. It is not set if the usage does not grow.
+	ExhaustionTime *apiv1.Time `json:"exhaustionTime,omitempty"`
+}
+
+const (
+	// ResourceQuotaWebhookReceiver posts a generic JSON payload to the receiver URL.
+	ResourceQuotaWebhookReceiver = "webhook"
+	// ResourceQuotaSlackReceiver posts a Slack compatible message to the receiver URL.
+	ResourceQuotaSlackReceiver = "slack"
+	// ResourceQuotaEmailReceiver sends an email through the SMTP relay of the receiver.
+	ResourceQuotaEmailReceiver = "email"
+)
+
+// ResourceQuotaNotificationConfig defines when and where notifications about the usage of a resource quota are sent.
+// swagger:model ResourceQuotaNotificationConfig
+type ResourceQuotaNotificationConfig struct {
+	// Thresholds are percentages of the quota, e.g. 80 and 95. A notification is sent whenever the usage of a
+	// resource crosses one of them.
+	Thresholds []int                               `json:"thresholds"`
+	Receivers  []ResourceQuotaNotificationReceiver `json:"receivers"`
+}
+
+// swagger:model ResourceQuotaNotificationReceiver
+type ResourceQuotaNotificationReceiver struct {
+	Name string `json:"name"`
+	// Type is one of "webhook", "slack" or "email".
+	Type string `json:"type"`
+	// URL is the endpoint of webhook and slack receivers.
+	URL string `json:"url,omitempty"`
+	// SMTPAddress is the host:port of the SMTP relay used by email receivers.
+	SMTPAddress string `json:"smtpAddress,omitempty"`
+	// From is the sender address of email receivers.
+	From string `json:"from,omitempty"`
+	// To are the recipient addresses of email receivers.
+	To []string `json:"to,omitempty"`
+}
+
+// ResourceQuotaNotificationDelivery records a single notification sent to a receiver. Failed notifications are
+// retried with an exponential backoff and only recorded once they succeeded or failed their last attempt.
+// swagger:model ResourceQuotaNotificationDelivery
+type ResourceQuotaNotificationDelivery struct {
+	Timestamp apiv1.Time `json:"timestamp"`
+	Receiver  string     `json:"receiver"`
+	// Resource is the quota resource whose usage crossed the threshold, one of "cpu", "memory" or "storage".
+	Resource  string `json:"resource"`
+	Threshold int    `json:"threshold"`
+	// Attempts is the number of delivery attempts, including retries.
+	Attempts int `json:"attempts"`
+	// Success is false for notifications which could not be delivered within all attempts.
+	Success bool `json:"success"`
+	// Error is the error of the last attempt.
+	Error string `json:"error,omitempty"`
+}
+
 // swagger:model GroupProjectBinding
 type GroupProjectBinding struct {
 	Name      string `json:"name"`
@@ -2077,6 +2210,252 @@ type ApplicationInstallationCondition struct {
 	Message string `json:"message,omitempty"`
 }
 
+// ApplicationInstallationPreviewBody is the change of an ApplicationInstallation to preview.
+// swagger:model ApplicationInstallationPreviewBody
+type ApplicationInstallationPreviewBody struct {
+	// Version of the application to preview. Defaults to the installed version.
+	Version string `json:"version,omitempty"`
+	// ValuesBlock to preview. Defaults to the values of the installation.
+	ValuesBlock *string `json:"valuesBlock,omitempty"`
+}
+
+// ApplicationInstallationPreview is what changing an ApplicationInstallation would change in the user cluster.
+// swagger:model ApplicationInstallationPreview
+type ApplicationInstallationPreview struct {
+	CurrentVersion      string `json:"currentVersion"`
+	Version             string `json:"version"`
+	CurrentChartVersion string `json:"currentChartVersion,omitempty"`
+	ChartVersion        string `json:"chartVersion"`
+	// ValuesDiff is a unified diff between the values of the installation and the previewed values.
+	ValuesDiff string `json:"valuesDiff,omitempty"`
+	// SchemaErrors are the violations of the values.schema.json of the chart by the previewed values. The
+	// application controller fails to install values which violate the schema.
+	SchemaErrors []string `json:"schemaErrors,omitempty"`
+	// Changes are the objects which would be created, updated or deleted. The current objects are the ones of the
+	// deployed release, hooks are not part of the preview.
+	Changes []ApplicationInstallationObjectChange `json:"changes"`
+}
+
+// ApplicationInstallationObjectAction is what changing an ApplicationInstallation would do to an object.
+type ApplicationInstallationObjectAction string
+
+const (
+	ApplicationInstallationObjectCreated ApplicationInstallationObjectAction = "Created"
+	ApplicationInstallationObjectUpdated ApplicationInstallationObjectAction = "Updated"
+	ApplicationInstallationObjectDeleted ApplicationInstallationObjectAction = "Deleted"
+)
+
+// ApplicationInstallationObjectChange is the change of a single object of an ApplicationInstallation.
+// swagger:model ApplicationInstallationObjectChange
+type ApplicationInstallationObjectChange struct {
+	APIVersion string                              `json:"apiVersion"`
+	Kind       string                              `json:"kind"`
+	Namespace  string                              `json:"namespace,omitempty"`
+	Name       string                              `json:"name"`
+	Action     ApplicationInstallationObjectAction `json:"action"`
+	// Diff is a unified diff between the current and the previewed object.
+	Diff string `json:"diff"`
+}
+
+// ApplicationInstallationRevision is a revision of the Helm release of an ApplicationInstallation.
+// swagger:model ApplicationInstallationRevision
+type ApplicationInstallationRevision struct {
+	Revision int `json:"revision"`
+	// Status of the revision, e.g. deployed, superseded or failed.
+	Status      string `json:"status"`
+	Description string `json:"description,omitempty"`
+	// swagger:strfmt date-time
+	Deployed     apiv1.Time `json:"deployed,omitempty"`
+	ChartName    string     `json:"chartName"`
+	ChartVersion string     `json:"chartVersion"`
+	AppVersion   string     `json:"appVersion,omitempty"`
+	// ApplicationVersion is the version of the ApplicationDefinition which installs the chart version of the
+	// revision. It is empty if the ApplicationDefinition does not offer the chart version anymore, such revisions
+	// cannot be rolled back to.
+	ApplicationVersion string `json:"applicationVersion,omitempty"`
+	// ValuesBlock are the values the revision was installed with.
+	ValuesBlock string `json:"valuesBlock,omitempty"`
+}
+
+// ApplicationInstallationRollbackBody is the revision to roll an ApplicationInstallation back to.
+// swagger:model ApplicationInstallationRollbackBody
+type ApplicationInstallationRollbackBody struct {
+	Revision int `json:"revision"`
+}
+
+// ApplicationRolloutPhase is the overall state of an ApplicationRollout.
+type ApplicationRolloutPhase string
+
+const (
+	// ApplicationRolloutProgressing installs or upgrades the application on the selected clusters.
+	ApplicationRolloutProgressing ApplicationRolloutPhase = "Progressing"
+	// ApplicationRolloutSucceeded means that the application is ready on all selected clusters. Clusters which
+	// match the selector later are still picked up.
+	ApplicationRolloutSucceeded ApplicationRolloutPhase = "Succeeded"
+	// ApplicationRolloutFailed means that the application failed on a cluster. No further clusters are started
+	// until the failed ones are retried or the spec is changed.
+	ApplicationRolloutFailed ApplicationRolloutPhase = "Failed"
+)
+
+// ApplicationRolloutClusterPhase is the state of an ApplicationRollout on a single cluster.
+type ApplicationRolloutClusterPhase string
+
+const (
+	// ApplicationRolloutClusterPending waits for a free slot of the rollout.
+	ApplicationRolloutClusterPending     ApplicationRolloutClusterPhase = "Pending"
+	ApplicationRolloutClusterProgressing ApplicationRolloutClusterPhase = "Progressing"
+	ApplicationRolloutClusterSucceeded   ApplicationRolloutClusterPhase = "Succeeded"
+	ApplicationRolloutClusterFailed      ApplicationRolloutClusterPhase = "Failed"
+	// ApplicationRolloutClusterConflict means that the cluster has an ApplicationInstallation of the same name which
+	// was not created by the rollout. It is left untouched and does not stop the rollout.
+	ApplicationRolloutClusterConflict ApplicationRolloutClusterPhase = "Conflict"
+)
+
+// ApplicationRollout installs or upgrades an application on all clusters of a project which match a label selector,
+// a few clusters at a time.
+// swagger:model ApplicationRollout
+type ApplicationRollout struct {
+	Name              string     `json:"name"`
+	ProjectID         string     `json:"projectID"`
+	CreationTimestamp apiv1.Time `json:"creationTimestamp"`
+	// Generation is incremented with every change of the spec, which rolls the application out to all selected
+	// clusters again.
+	Generation int64                    `json:"generation"`
+	Spec       ApplicationRolloutSpec   `json:"spec"`
+	Status     ApplicationRolloutStatus `json:"status"`
+
+	// ResourceVersion guards the status updates against concurrent API replicas.
+	ResourceVersion string `json:"-"`
+}
+
+// ApplicationRolloutSpec defines the application of an ApplicationRollout and the clusters it is rolled out to.
+// swagger:model ApplicationRolloutSpec
+type ApplicationRolloutSpec struct {
+	// ClusterSelector selects the clusters of the project by their labels. An empty selector selects all clusters.
+	ClusterSelector metav1.LabelSelector `json:"clusterSelector"`
+
+	// Namespace of the ApplicationInstallation in the clusters, which the application is installed into. It is
+	// created if it does not exist.
+	Namespace string `json:"namespace"`
+
+	// ApplicationInstallationName is the name of the ApplicationInstallation in the clusters. Defaults to the name
+	// of the rollout.
+	ApplicationInstallationName string `json:"applicationInstallationName,omitempty"`
+
+	ApplicationRef apiv1.ApplicationRef `json:"applicationRef"`
+
+	// ValuesBlock specifies values overrides that are passed to helm templating.
+	ValuesBlock string `json:"valuesBlock,omitempty"`
+
+	// MaxConcurrent is the number of clusters the application is installed or upgraded on at the same time.
+	// Defaults to 1.
+	MaxConcurrent int `json:"maxConcurrent,omitempty"`
+
+	// TimeoutSeconds is how long the application may take to become ready on a cluster before the cluster is
+	// considered failed. Defaults to 600.
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// ApplicationRolloutStatus is the progress of an ApplicationRollout.
+// swagger:model ApplicationRolloutStatus
+type ApplicationRolloutStatus struct {
+	Phase ApplicationRolloutPhase `json:"phase,omitempty"`
+	// Message explains why the rollout failed or on how many clusters it conflicts with existing installations.
+	Message           string      `json:"message,omitempty"`
+	LastReconcileTime *apiv1.Time `json:"lastReconcileTime,omitempty"`
+	// Clusters are the clusters which currently match the selector. Clusters which stop matching are dropped, the
+	// application stays installed on them.
+	Clusters []ApplicationRolloutClusterStatus `json:"clusters,omitempty"`
+}
+
+// ApplicationRolloutClusterStatus is the state of an ApplicationRollout on a single cluster.
+// swagger:model ApplicationRolloutClusterStatus
+type ApplicationRolloutClusterStatus struct {
+	ClusterID   string                         `json:"clusterID"`
+	ClusterName string                         `json:"clusterName"`
+	Phase       ApplicationRolloutClusterPhase `json:"phase"`
+	// ObservedGeneration is the generation of the rollout the phase refers to.
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+	// Message explains why the application failed or conflicts on the cluster.
+	Message        string      `json:"message,omitempty"`
+	StartTime      *apiv1.Time `json:"startTime,omitempty"`
+	CompletionTime *apiv1.Time `json:"completionTime,omitempty"`
+}
+
+// ApplicationRolloutBody is the object representing the POST/PUT payload of an ApplicationRollout.
+// swagger:model ApplicationRolloutBody
+type ApplicationRolloutBody struct {
+	Name string                 `json:"name"`
+	Spec ApplicationRolloutSpec `json:"spec"`
+}
+
+// AddonRevision is a revision of the variables of an addon.
+// swagger:model AddonRevision
+type AddonRevision struct {
+	Revision int `json:"revision"`
+	// swagger:strfmt date-time
+	CreationTimestamp apiv1.Time `json:"creationTimestamp,omitempty"`
+	// Author is the email of the user who changed the variables, it is empty for the variables the addon was
+	// installed with.
+	Author      string                 `json:"author,omitempty"`
+	Description string                 `json:"description,omitempty"`
+	Variables   map[string]interface{} `json:"variables,omitempty"`
+}
+
+// AddonRevertBody is the revision to revert the variables of an addon to.
+// swagger:model AddonRevertBody
+type AddonRevertBody struct {
+	Revision int `json:"revision"`
+}
+
+// AddonDrift reports the objects of an addon that were changed or deleted in the user cluster since the addon
+// controller applied them.
+// swagger:model AddonDrift
+type AddonDrift struct {
+	// Drifted is set if objects were changed or deleted, objects whose drift is unknown are not counted.
+	Drifted bool `json:"drifted"`
+	// Objects are the drifted and missing objects and the ones whose drift is unknown.
+	Objects []AddonDriftObject `json:"objects"`
+	// Errors are the resource types that could not be checked, e.g. because the user may not list them.
+	Errors []string `json:"errors,omitempty"`
+}
+
+// AddonDriftObjectStatus is the state of an object of an addon compared to its applied manifest.
+type AddonDriftObjectStatus string
+
+const (
+	// AddonDriftObjectDrifted objects differ from their applied manifest.
+	AddonDriftObjectDrifted AddonDriftObjectStatus = "Drifted"
+	// AddonDriftObjectMissing objects were found by an earlier drift check but are deleted now.
+	AddonDriftObjectMissing AddonDriftObjectStatus = "Missing"
+	// AddonDriftObjectUnknown objects were not applied with kubectl, so there is no manifest to compare them with.
+	AddonDriftObjectUnknown AddonDriftObjectStatus = "Unknown"
+)
+
+// AddonDriftObject is an object of an addon whose live state differs from the applied manifest, which is missing,
+// or which cannot be compared.
+// swagger:model AddonDriftObject
+type AddonDriftObject struct {
+	Status     AddonDriftObjectStatus `json:"status"`
+	APIVersion string                 `json:"apiVersion"`
+	Kind       string                 `json:"kind"`
+	Namespace  string                 `json:"namespace,omitempty"`
+	Name       string                 `json:"name"`
+	// Fields are the drifted fields of drifted objects.
+	Fields []AddonDriftField `json:"fields,omitempty"`
+}
+
+// AddonDriftField is a field of an object whose live value differs from the applied one.
+// swagger:model AddonDriftField
+type AddonDriftField struct {
+	// Path of the field, e.g. spec.template.spec.containers[0].image.
+	Path string `json:"path"`
+	// Applied is the value of the applied manifest.
+	Applied interface{} `json:"applied,omitempty"`
+	// Live is the value in the user cluster, it is empty if the field was removed.
+	Live interface{} `json:"live,omitempty"`
+}
+
 // swagger:model IPAMPool
 type IPAMPool struct {
 	Name        string                                `json:"name"`
@@ -2189,6 +2568,101 @@ type ApplicationDefinitionBody struct {
 	Spec        *appskubermaticv1.ApplicationDefinitionSpec
 }
 
+// ApplicationCatalogSourceType is the kind of a catalog source.
+type ApplicationCatalogSourceType string
+
+const (
+	// ApplicationCatalogSourceTypeHelm is a Helm chart repository which serves an index.yaml.
+	ApplicationCatalogSourceTypeHelm ApplicationCatalogSourceType = "helm"
+	// ApplicationCatalogSourceTypeOCI is an OCI registry which stores Helm charts.
+	ApplicationCatalogSourceTypeOCI ApplicationCatalogSourceType = "oci"
+)
+
+// ApplicationCatalogSource is a Helm chart repository or OCI registry whose charts are synced into
+// ApplicationDefinitions.
+// swagger:model ApplicationCatalogSource
+type ApplicationCatalogSource struct {
+	Name   string                         `json:"name"`
+	Spec   ApplicationCatalogSourceSpec   `json:"spec"`
+	Status ApplicationCatalogSourceStatus `json:"status"`
+}
+
+// ApplicationCatalogSourceSpec defines which charts of a catalog source are synced and how.
+type ApplicationCatalogSourceSpec struct {
+	// Type is either "helm" or "oci".
+	Type ApplicationCatalogSourceType `json:"type"`
+
+	// URL of the Helm chart repository, e.g. https://charts.example.com, or of the OCI registry including the
+	// path of the charts, e.g. oci://registry.example.com/charts.
+	URL string `json:"url"`
+
+	// Charts lists the charts of an OCI registry to sync, as registries cannot list their charts. It is ignored for
+	// Helm chart repositories.
+	Charts []string `json:"charts,omitempty"`
+
+	// Include lists glob patterns of the chart names to sync, e.g. "cert-*". All charts are synced if it is empty.
+	Include []string `json:"include,omitempty"`
+
+	// Exclude lists glob patterns of the chart names not to sync. It takes precedence over Include.
+	Exclude []string `json:"exclude,omitempty"`
+
+	// VersionConstraint is a semantic version constraint the synced chart versions satisfy, e.g. ">=1.0.0".
+	// Pre-releases are only synced if the constraint names one.
+	VersionConstraint string `json:"versionConstraint,omitempty"`
+
+	// MaxVersions is the number of most recent versions synced per chart, 5 if unset.
+	MaxVersions int `json:"maxVersions,omitempty"`
+
+	// DefaultValuesBlock is set as the default values of the ApplicationDefinitions synced from the source.
+	DefaultValuesBlock string `json:"defaultValuesBlock,omitempty"`
+
+	// Interval between two syncs of the source, e.g. 30m. Defaults to 1h.
+	Interval string `json:"interval,omitempty"`
+
+	// Insecure disables the certificate validation of the source.
+	Insecure bool `json:"insecure,omitempty"`
+
+	// PlainHTTP connects to an OCI registry without TLS.
+	PlainHTTP bool `json:"plainHTTP,omitempty"`
+
+	// Username for the basic authentication at the source. The password is never returned.
+	Username string `json:"username,omitempty"`
+}
+
+// ApplicationCatalogSourceStatus is the result of the last sync of a catalog source.
+type ApplicationCatalogSourceStatus struct {
+	LastSyncTime           *apiv1.Time `json:"lastSyncTime,omitempty"`
+	LastSuccessfulSyncTime *apiv1.Time `json:"lastSuccessfulSyncTime,omitempty"`
+
+	// Error is the reason the last sync failed, empty if it succeeded.
+	Error string `json:"error,omitempty"`
+
+	// Applications are the charts matched by the last successful sync.
+	Applications []ApplicationCatalogSourceApplication `json:"applications,omitempty"`
+}
+
+// ApplicationCatalogSourceApplication is a chart of a catalog source and the ApplicationDefinition it is synced into.
+type ApplicationCatalogSourceApplication struct {
+	Chart                 string   `json:"chart"`
+	ApplicationDefinition string   `json:"applicationDefinition"`
+	Versions              []string `json:"versions,omitempty"`
+
+	// Error is the reason the chart was not synced, e.g. an ApplicationDefinition of the same name which was not
+	// created by the source.
+	Error string `json:"error,omitempty"`
+}
+
+// ApplicationCatalogSourceBody is the object representing the POST/PUT payload of an ApplicationCatalogSource.
+// swagger:model ApplicationCatalogSourceBody
+type ApplicationCatalogSourceBody struct {
+	Name string                       `json:"name"`
+	Spec ApplicationCatalogSourceSpec `json:"spec"`
+
+	// Password for the basic authentication at the source. An update without a password keeps the stored
+	// password as long as the username does not change.
+	Password string `json:"password,omitempty"`
+}
+
 type DatacentersByProvider = map[string]ClustersByDatacenter
 type ClustersByDatacenter = map[string]int
 
@@ -2227,6 +2701,13 @@ type GlobalSettings struct {
 	DisplayTermsOfService bool `json:"displayTermsOfService"`
 	// EnableDashboard enables the link to the Kubernetes dashboard for a user cluster.
 	EnableDashboard bool `json:"enableDashboard"`
+	// DashboardProxyTarget is the web UI served by the dashboard proxy of user clusters that do not select one on
+	// their own, defaults to the Kubernetes Dashboard.
+	DashboardProxyTarget DashboardProxyTarget `json:"dashboardProxyTarget,omitempty"`
+	// PersonalAccessTokens controls the personal access tokens users create for automation.
+	PersonalAccessTokens *PersonalAccessTokenSettings `json:"personalAccessTokens,omitempty"`
+	// StepUpAuthentication requires a recent login for destructive requests like the deletion of clusters.
+	StepUpAuthentication *StepUpAuthenticationSettings `json:"stepUpAuthentication,omitempty"`
 
 	// EnableWebTerminal enables the Web Terminal feature for the user clusters.
 	EnableWebTerminal bool `json:"enableWebTerminal,omitempty"`
@@ -2307,6 +2788,34 @@ type GlobalSettings struct {
 	ClusterBackupOptions *kubermaticv1.ClusterBackupOptions `json:"clusterBackupOptions,omitempty"`
 }
 
+// DashboardProxyTarget is a web UI of user clusters that is served by the dashboard proxy.
+type DashboardProxyTarget string
+
+const (
+	// DashboardProxyTargetKubernetesDashboard is the Kubernetes Dashboard that KKP deploys to the control plane of
+	// every user cluster.
+	DashboardProxyTargetKubernetesDashboard DashboardProxyTarget = "kubernetes-dashboard"
+	// DashboardProxyTargetHeadlamp is Headlamp, installed to the headlamp namespace of the user cluster, e.g. with
+	// its Helm chart.
+	DashboardProxyTargetHeadlamp DashboardProxyTarget = "headlamp"
+)
+
+// PersonalAccessTokenSettings controls the personal access tokens of the users.
+type PersonalAccessTokenSettings struct {
+	// Disabled rejects all personal access tokens and prevents the creation of new ones.
+	Disabled bool `json:"disabled,omitempty"`
+	// MaxLifetime caps the lifetime of personal access tokens, e.g. 720h. Tokens must expire within it once set.
+	MaxLifetime string `json:"maxLifetime,omitempty"`
+}
+
+// StepUpAuthenticationSettings controls the recent login required for destructive requests.
+type StepUpAuthenticationSettings struct {
+	// MaxAge is the duration, e.g. 15m, within which the user must have logged in to delete clusters, projects
+	// and seeds. Tokens which do not tell when the user logged in, like service account tokens, cannot be used
+	// for these requests then.
+	MaxAge string `json:"maxAge,omitempty"`
+}
+
 // ApplicationSettings defines common settings for applications
 // swagger:model ApplicationSettings
 type ApplicationSettings struct {
@@ -2420,3 +2929,537 @@ type BackupStorageLocationBucketObject struct {
 // BackupStorageLocationBucketObjectList represents an array of Backup Storage Location Bucket Objects.
 // swagger:model BackupStorageLocationBucketObjectList
 type BackupStorageLocationBucketObjectList []BackupStorageLocationBucketObject
+
+// UpgradeBlockerType describes what prevents a cluster upgrade step from being applied.
+type UpgradeBlockerType string
+
+const (
+	// UpgradeBlockerNoUpdatePath is reported when no configured update leads to the next hop.
+	UpgradeBlockerNoUpdatePath UpgradeBlockerType = "NoUpdatePath"
+	// UpgradeBlockerProviderIncompatibility is reported when the version is incompatible with the cluster provider.
+	UpgradeBlockerProviderIncompatibility UpgradeBlockerType = "ProviderIncompatibility"
+	// UpgradeBlockerKubeletVersionSkew is reported when a machine deployment kubelet is too old for the version.
+	UpgradeBlockerKubeletVersionSkew UpgradeBlockerType = "KubeletVersionSkew"
+	// UpgradeBlockerAddon is reported when an installed addon does not support the version.
+	UpgradeBlockerAddon UpgradeBlockerType = "Addon"
+	// UpgradeBlockerApplication is reported when an installed application does not support the version.
+	UpgradeBlockerApplication UpgradeBlockerType = "Application"
+)
+
+// ClusterUpgradePlan is the ordered list of control plane upgrades needed to move a cluster to a target version.
+// swagger:model ClusterUpgradePlan
+type ClusterUpgradePlan struct {
+	CurrentVersion ksemver.Semver `json:"currentVersion"`
+	TargetVersion  ksemver.Semver `json:"targetVersion"`
+	// Steps are the minor version hops, in the order they have to be applied.
+	Steps []ClusterUpgradePlanStep `json:"steps"`
+	// Blocked is true if at least one step has blockers.
+	Blocked bool `json:"blocked"`
+}
+
+// ClusterUpgradePlanStep is a single control plane upgrade of a ClusterUpgradePlan.
+// swagger:model ClusterUpgradePlanStep
+type ClusterUpgradePlanStep struct {
+	Version  ksemver.Semver          `json:"version"`
+	Blockers []ClusterUpgradeBlocker `json:"blockers,omitempty"`
+}
+
+// ClusterUpgradeBlocker describes why a ClusterUpgradePlanStep cannot be applied.
+// swagger:model ClusterUpgradeBlocker
+type ClusterUpgradeBlocker struct {
+	Type UpgradeBlockerType `json:"type"`
+	// Name of the resource causing the blocker, e.g. the machine deployment or addon name.
+	Name    string `json:"name,omitempty"`
+	Message string `json:"message"`
+}
+
+// ClusterUpgradeOperationPhase is the overall state of a ClusterUpgradeOperation.
+type ClusterUpgradeOperationPhase string
+
+const (
+	ClusterUpgradeOperationRunning   ClusterUpgradeOperationPhase = "Running"
+	ClusterUpgradeOperationPaused    ClusterUpgradeOperationPhase = "Paused"
+	ClusterUpgradeOperationCompleted ClusterUpgradeOperationPhase = "Completed"
+	ClusterUpgradeOperationAborted   ClusterUpgradeOperationPhase = "Aborted"
+	ClusterUpgradeOperationFailed    ClusterUpgradeOperationPhase = "Failed"
+)
+
+// ClusterUpgradeOperationStep is the part of the upgrade flow a ClusterUpgradeOperation is working on.
+type ClusterUpgradeOperationStep string
+
+const (
+	ClusterUpgradeStepControlPlane       ClusterUpgradeOperationStep = "ControlPlane"
+	ClusterUpgradeStepControlPlaneHealth ClusterUpgradeOperationStep = "ControlPlaneHealth"
+	ClusterUpgradeStepMachineDeployments ClusterUpgradeOperationStep = "MachineDeployments"
+)
+
+// ClusterUpgradeOperation is a server-side upgrade of the control plane followed by all machine deployments.
+// swagger:model ClusterUpgradeOperation
+type ClusterUpgradeOperation struct {
+	Spec   ClusterUpgradeOperationSpec   `json:"spec"`
+	Status ClusterUpgradeOperationStatus `json:"status"`
+}
+
+// ClusterUpgradeOperationSpec configures a ClusterUpgradeOperation.
+// swagger:model ClusterUpgradeOperationSpec
+type ClusterUpgradeOperationSpec struct {
+	// Version is the Kubernetes version the control plane and all machine deployments are upgraded to.
+	Version ksemver.Semver `json:"version"`
+	// MachineDeploymentOrder lists machine deployments that are upgraded first, in this order.
+	// All remaining machine deployments follow in alphabetical order.
+	MachineDeploymentOrder []string `json:"machineDeploymentOrder,omitempty"`
+	// MaxUnavailable is set as the rolling update max unavailable of every upgraded machine deployment.
+	// Defaults to 1.
+	MaxUnavailable int32 `json:"maxUnavailable,omitempty"`
+}
+
+// ClusterUpgradeOperationStatus is the progress of a ClusterUpgradeOperation.
+// swagger:model ClusterUpgradeOperationStatus
+type ClusterUpgradeOperationStatus struct {
+	Phase ClusterUpgradeOperationPhase `json:"phase"`
+	Step  ClusterUpgradeOperationStep  `json:"step"`
+	// CurrentMachineDeployment is the machine deployment that is rolling out right now.
+	CurrentMachineDeployment string `json:"currentMachineDeployment,omitempty"`
+	// UpgradedMachineDeployments are the machine deployments that finished their rollout.
+	UpgradedMachineDeployments []string `json:"upgradedMachineDeployments,omitempty"`
+	// Message explains why the operation was paused or failed.
+	Message       string     `json:"message,omitempty"`
+	StartTime     apiv1.Time `json:"startTime"`
+	StepStartTime apiv1.Time `json:"stepStartTime"`
+}
+
+// ClusterReadinessCheckType is one of the checks of a ClusterReadinessCheckRun.
+type ClusterReadinessCheckType string
+
+const (
+	ClusterReadinessCheckDNS              ClusterReadinessCheckType = "DNS"
+	ClusterReadinessCheckPodNetwork       ClusterReadinessCheckType = "PodNetwork"
+	ClusterReadinessCheckServiceNetwork   ClusterReadinessCheckType = "ServiceNetwork"
+	ClusterReadinessCheckPersistentVolume ClusterReadinessCheckType = "PersistentVolume"
+	ClusterReadinessCheckLoadBalancer     ClusterReadinessCheckType = "LoadBalancer"
+	ClusterReadinessCheckImagePull        ClusterReadinessCheckType = "ImagePull"
+)
+
+// AllClusterReadinessChecks are the checks of a run in the order they are executed.
+var AllClusterReadinessChecks = []ClusterReadinessCheckType{
+	ClusterReadinessCheckPodNetwork,
+	ClusterReadinessCheckServiceNetwork,
+	ClusterReadinessCheckDNS,
+	ClusterReadinessCheckPersistentVolume,
+	ClusterReadinessCheckLoadBalancer,
+	ClusterReadinessCheckImagePull,
+}
+
+// ClusterReadinessCheckPhase is the state of a ClusterReadinessCheckRun or of a single check.
+type ClusterReadinessCheckPhase string
+
+const (
+	ClusterReadinessCheckPending ClusterReadinessCheckPhase = "Pending"
+	ClusterReadinessCheckRunning ClusterReadinessCheckPhase = "Running"
+	ClusterReadinessCheckPassed  ClusterReadinessCheckPhase = "Passed"
+	ClusterReadinessCheckFailed  ClusterReadinessCheckPhase = "Failed"
+	// ClusterReadinessCheckSkipped is used for checks that do not apply to the cluster, e.g. load
+	// balancers on a provider without a load balancer integration.
+	ClusterReadinessCheckSkipped ClusterReadinessCheckPhase = "Skipped"
+)
+
+// ClusterReadinessCheckRun is an on-demand run of end-to-end checks against a user cluster.
+// swagger:model ClusterReadinessCheckRun
+type ClusterReadinessCheckRun struct {
+	ID     string                         `json:"id"`
+	Spec   ClusterReadinessCheckRunSpec   `json:"spec"`
+	Status ClusterReadinessCheckRunStatus `json:"status"`
+}
+
+// ClusterReadinessCheckRunSpec configures a ClusterReadinessCheckRun.
+// swagger:model ClusterReadinessCheckRunSpec
+type ClusterReadinessCheckRunSpec struct {
+	// Checks to run, all checks are run if empty. The LoadBalancer check is only skipped on providers without a
+	// load balancer integration if it was not listed explicitly.
+	Checks []ClusterReadinessCheckType `json:"checks,omitempty"`
+	// Image of the workloads the checks deploy, it has to contain the agnhost binary of the Kubernetes e2e tests.
+	// Defaults to registry.k8s.io/e2e-test-images/agnhost.
+	Image string `json:"image,omitempty"`
+	// Images whose pull is tested by the ImagePull check. Defaults to Image.
+	Images []string `json:"images,omitempty"`
+}
+
+// ClusterReadinessCheckRunStatus is the outcome of a ClusterReadinessCheckRun.
+// swagger:model ClusterReadinessCheckRunStatus
+type ClusterReadinessCheckRunStatus struct {
+	// Phase is Running until all checks completed, then Passed if no check failed and Failed otherwise.
+	Phase          ClusterReadinessCheckPhase    `json:"phase"`
+	Message        string                        `json:"message,omitempty"`
+	StartTime      apiv1.Time                    `json:"startTime"`
+	CompletionTime *apiv1.Time                   `json:"completionTime,omitempty"`
+	Results        []ClusterReadinessCheckResult `json:"results"`
+}
+
+// ClusterReadinessCheckResult is the outcome of a single check of a ClusterReadinessCheckRun.
+// swagger:model ClusterReadinessCheckResult
+type ClusterReadinessCheckResult struct {
+	Check     ClusterReadinessCheckType  `json:"check"`
+	Phase     ClusterReadinessCheckPhase `json:"phase"`
+	Message   string                     `json:"message,omitempty"`
+	StartTime *apiv1.Time                `json:"startTime,omitempty"`
+	// DurationMilliseconds is how long the check took, it is set once the check completed.
+	DurationMilliseconds int64 `json:"durationMilliseconds,omitempty"`
+}
+
+// ClusterAPIResource is a type of resource served by the API server of a user cluster.
+// swagger:model ClusterAPIResource
+type ClusterAPIResource struct {
+	// Group is empty for the core group.
+	Group   string `json:"group,omitempty"`
+	Version string `json:"version"`
+	Kind    string `json:"kind"`
+	// Name is the plural name of the resource, e.g. deployments.
+	Name       string   `json:"name"`
+	Namespaced bool     `json:"namespaced"`
+	ShortNames []string `json:"shortNames,omitempty"`
+	Verbs      []string `json:"verbs"`
+}
+
+// ClusterResource is an arbitrary object of a user cluster as returned by its API server.
+// swagger:model ClusterResource
+type ClusterResource map[string]interface{}
+
+// ClusterResourceList is a page of objects of a user cluster.
+// swagger:model ClusterResourceList
+type ClusterResourceList struct {
+	Items []ClusterResource `json:"items"`
+	// Continue is the token of the next page, it is empty on the last page.
+	Continue string `json:"continue,omitempty"`
+	// RemainingItemCount is the number of objects after this page, if the API server knows it.
+	RemainingItemCount *int64 `json:"remainingItemCount,omitempty"`
+}
+
+// ManifestApplySpec configures the server-side apply of a manifest to a user cluster.
+// swagger:model ManifestApplySpec
+type ManifestApplySpec struct {
+	// Manifest contains the objects to apply as multi-document YAML or JSON.
+	Manifest string `json:"manifest"`
+	// Namespace is set on namespaced objects without a namespace. Defaults to "default".
+	Namespace string `json:"namespace,omitempty"`
+	// FieldManager owns the applied fields. Defaults to "kubermatic-dashboard".
+	FieldManager string `json:"fieldManager,omitempty"`
+	// Force takes over fields owned by other field managers instead of failing with a conflict.
+	Force bool `json:"force,omitempty"`
+	// DryRun only validates the objects with the API server and returns the changes that would be made.
+	DryRun bool `json:"dryRun,omitempty"`
+	// PruneSelector is a label selector. Objects that match it but are not part of the manifest are deleted.
+	// Only kinds contained in the manifest are pruned, namespaced objects only in the namespaces of the manifest.
+	PruneSelector string `json:"pruneSelector,omitempty"`
+}
+
+// ManifestObjectAction is what applying a manifest did to an object.
+type ManifestObjectAction string
+
+const (
+	ManifestObjectCreated    ManifestObjectAction = "Created"
+	ManifestObjectConfigured ManifestObjectAction = "Configured"
+	ManifestObjectUnchanged  ManifestObjectAction = "Unchanged"
+	ManifestObjectPruned     ManifestObjectAction = "Pruned"
+	ManifestObjectFailed     ManifestObjectAction = "Failed"
+	// ManifestObjectSkipped is reported by dry-runs for objects of CRDs which are part of the manifest but not
+	// established in the cluster yet, the API server cannot validate them before the CRD is applied.
+	ManifestObjectSkipped ManifestObjectAction = "Skipped"
+)
+
+// ManifestApplyResult is the outcome of applying a manifest.
+// swagger:model ManifestApplyResult
+type ManifestApplyResult struct {
+	DryRun  bool                   `json:"dryRun"`
+	Objects []ManifestObjectResult `json:"objects"`
+	// PruneSkipped explains why no objects were pruned although a prune selector was given.
+	PruneSkipped string `json:"pruneSkipped,omitempty"`
+}
+
+// ManifestObjectResult is the outcome of applying a single object of a manifest.
+// swagger:model ManifestObjectResult
+type ManifestObjectResult struct {
+	APIVersion string               `json:"apiVersion"`
+	Kind       string               `json:"kind"`
+	Namespace  string               `json:"namespace,omitempty"`
+	Name       string               `json:"name,omitempty"`
+	Action     ManifestObjectAction `json:"action"`
+	// Message is the error of failed objects or the reason skipped objects were not applied.
+	Message string `json:"message,omitempty"`
+	// Diff is a unified diff between the live and the applied object, it is only returned for dry-runs.
+	Diff string `json:"diff,omitempty"`
+}
+
+// CostEstimate is the estimated monthly cost of a set of node deployments.
+// swagger:model CostEstimate
+type CostEstimate struct {
+	// Currency is the ISO 4217 currency code of all prices in the estimate.
+	Currency string `json:"currency"`
+	// MonthlyCost is the sum of the monthly cost of all priced items.
+	MonthlyCost float64            `json:"monthlyCost"`
+	Items       []CostEstimateItem `json:"items"`
+	// Unpriced lists the clusters and seeds whose node deployments could not be estimated, for example because
+	// they were not reachable. Their cost is not included in MonthlyCost.
+	Unpriced []string `json:"unpriced,omitempty"`
+}
+
+// CostEstimateItem is the estimated monthly cost of a single node deployment.
+// swagger:model CostEstimateItem
+type CostEstimateItem struct {
+	Name      string `json:"name,omitempty"`
+	ClusterID string `json:"clusterID,omitempty"`
+	Provider  string `json:"provider"`
+	Region    string `json:"region,omitempty"`
+	// Size is the instance type or, for resource based providers, the CPU and memory of a node.
+	Size     string `json:"size"`
+	Replicas int32  `json:"replicas"`
+	// HourlyPricePerNode is the hourly price of a single node.
+	HourlyPricePerNode float64 `json:"hourlyPricePerNode"`
+	MonthlyCost        float64 `json:"monthlyCost"`
+	// Unpriced is set when the price catalog has no price for the node size.
+	Unpriced bool   `json:"unpriced,omitempty"`
+	Message  string `json:"message,omitempty"`
+}
+
+// NodeDrainOptions configures how the pods of a node are evicted.
+// swagger:model NodeDrainOptions
+type NodeDrainOptions struct {
+	// TimeoutSeconds is how long the drain may take before it fails. Defaults to 600.
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+	// GracePeriodSeconds overrides the termination grace period of the evicted pods. The pods' own grace period is
+	// used if it is not set.
+	GracePeriodSeconds *int64 `json:"gracePeriodSeconds,omitempty"`
+	// IgnoreDaemonSets skips pods managed by a DaemonSet instead of failing the drain.
+	IgnoreDaemonSets bool `json:"ignoreDaemonSets,omitempty"`
+	// DeleteEmptyDirData evicts pods with emptyDir volumes, whose data is lost, instead of failing the drain.
+	DeleteEmptyDirData bool `json:"deleteEmptyDirData,omitempty"`
+}
+
+// NodeDrainPhase is the overall state of a NodeDrainOperation.
+type NodeDrainPhase string
+
+const (
+	NodeDrainRunning   NodeDrainPhase = "Running"
+	NodeDrainCompleted NodeDrainPhase = "Completed"
+	NodeDrainFailed    NodeDrainPhase = "Failed"
+)
+
+// NodeDrainOperation is a server-side drain of a node, which cordons the node and evicts its pods while respecting
+// their PodDisruptionBudgets.
+// swagger:model NodeDrainOperation
+type NodeDrainOperation struct {
+	Node   string           `json:"node"`
+	Spec   NodeDrainOptions `json:"spec"`
+	Status NodeDrainStatus  `json:"status"`
+}
+
+// NodeDrainStatus is the progress of a NodeDrainOperation.
+// swagger:model NodeDrainStatus
+type NodeDrainStatus struct {
+	Phase NodeDrainPhase `json:"phase"`
+	// EvictedPods is the number of pods that were evicted so far.
+	EvictedPods int `json:"evictedPods"`
+	// RemainingPods are the pods that still have to leave the node, as namespace/name.
+	RemainingPods []string `json:"remainingPods,omitempty"`
+	// Message explains why the drain is waiting or why it failed.
+	Message        string      `json:"message,omitempty"`
+	StartTime      apiv1.Time  `json:"startTime"`
+	CompletionTime *apiv1.Time `json:"completionTime,omitempty"`
+}
+
+// ControlPlaneLogComponent is a control plane component of a cluster whose logs can be streamed from the seed.
+// swagger:model ControlPlaneLogComponent
+type ControlPlaneLogComponent struct {
+	Name string `json:"name"`
+	// Pods are the pods of the component, most recently created first.
+	Pods       []string `json:"pods"`
+	Containers []string `json:"containers"`
+}
+
+// MachineDeploymentScalingPolicy scales a machine deployment on a schedule, for example to zero replicas overnight.
+// swagger:model MachineDeploymentScalingPolicy
+type MachineDeploymentScalingPolicy struct {
+	// TimeZone is the IANA time zone the schedules are evaluated in, defaults to UTC.
+	TimeZone string                         `json:"timeZone,omitempty"`
+	Rules    []MachineDeploymentScalingRule `json:"rules"`
+}
+
+// MachineDeploymentScalingRule sets the replicas of a machine deployment whenever its schedule fires.
+// swagger:model MachineDeploymentScalingRule
+type MachineDeploymentScalingRule struct {
+	Name string `json:"name"`
+	// Schedule is a standard cron expression, e.g. "0 19 * * 1-5" for every weekday at 19:00.
+	Schedule string `json:"schedule"`
+	Replicas int32  `json:"replicas"`
+}
+
+// MachineDeploymentScalingAction is a change of the replicas of a machine deployment by its scaling policy.
+// swagger:model MachineDeploymentScalingAction
+type MachineDeploymentScalingAction struct {
+	Timestamp apiv1.Time `json:"timestamp"`
+	Rule      string     `json:"rule"`
+	// ScheduleTime is the time the schedule of the rule fired at.
+	ScheduleTime apiv1.Time `json:"scheduleTime"`
+	FromReplicas int32      `json:"fromReplicas"`
+	ToReplicas   int32      `json:"toReplicas"`
+}
+
+// MachineDeploymentRolloutStrategy is how a MachineDeploymentRollout brings up the new node spec.
+type MachineDeploymentRolloutStrategy string
+
+const (
+	// MachineDeploymentRolloutCanary starts the new machine deployment with a few canary replicas and scales it up on promotion.
+	MachineDeploymentRolloutCanary MachineDeploymentRolloutStrategy = "Canary"
+	// MachineDeploymentRolloutBlueGreen starts the new machine deployment with the full replica count of the original.
+	MachineDeploymentRolloutBlueGreen MachineDeploymentRolloutStrategy = "BlueGreen"
+)
+
+// MachineDeploymentRolloutPhase is the overall state of a MachineDeploymentRollout.
+type MachineDeploymentRolloutPhase string
+
+const (
+	// MachineDeploymentRolloutProgressing waits for the new machine deployment to become available.
+	MachineDeploymentRolloutProgressing MachineDeploymentRolloutPhase = "Progressing"
+	// MachineDeploymentRolloutReady waits for the rollout to be promoted or rolled back.
+	MachineDeploymentRolloutReady MachineDeploymentRolloutPhase = "Ready"
+	// MachineDeploymentRolloutPromoting scales the new machine deployment up and then the original one down.
+	MachineDeploymentRolloutPromoting  MachineDeploymentRolloutPhase = "Promoting"
+	MachineDeploymentRolloutCompleted  MachineDeploymentRolloutPhase = "Completed"
+	MachineDeploymentRolloutRolledBack MachineDeploymentRolloutPhase = "RolledBack"
+	MachineDeploymentRolloutFailed     MachineDeploymentRolloutPhase = "Failed"
+)
+
+// MachineDeploymentRollout replaces the node spec of a machine deployment by a new machine deployment, which is
+// promoted once it proved healthy or rolled back without touching the original machines.
+// swagger:model MachineDeploymentRollout
+type MachineDeploymentRollout struct {
+	// MachineDeployment is the name of the original machine deployment.
+	MachineDeployment string                         `json:"machineDeployment"`
+	Spec              MachineDeploymentRolloutSpec   `json:"spec"`
+	Status            MachineDeploymentRolloutStatus `json:"status"`
+}
+
+// MachineDeploymentRolloutSpec configures a MachineDeploymentRollout.
+// swagger:model MachineDeploymentRolloutSpec
+type MachineDeploymentRolloutSpec struct {
+	// Strategy defaults to Canary.
+	Strategy MachineDeploymentRolloutStrategy `json:"strategy,omitempty"`
+	// Template is the new node spec, e.g. with a different image or flavor.
+	Template apiv1.NodeSpec `json:"template"`
+	// CanaryReplicas is the number of replicas the new machine deployment starts with for the Canary strategy.
+	// Defaults to 1.
+	CanaryReplicas int32 `json:"canaryReplicas,omitempty"`
+	// TimeoutSeconds is how long the new machine deployment may take to become available, both initially and after
+	// it was scaled up on promotion, before the rollout fails. Defaults to 1800.
+	TimeoutSeconds int64 `json:"timeoutSeconds,omitempty"`
+}
+
+// MachineDeploymentRolloutStatus is the progress of a MachineDeploymentRollout.
+// swagger:model MachineDeploymentRolloutStatus
+type MachineDeploymentRolloutStatus struct {
+	Phase MachineDeploymentRolloutPhase `json:"phase"`
+	// NewMachineDeployment is the name of the machine deployment with the new node spec.
+	NewMachineDeployment string `json:"newMachineDeployment,omitempty"`
+	// OriginalReplicas is the replica count of the original machine deployment when the rollout started, which the
+	// new machine deployment is scaled to on promotion.
+	OriginalReplicas int32 `json:"originalReplicas"`
+	// Message explains why the rollout failed.
+	Message        string      `json:"message,omitempty"`
+	StartTime      apiv1.Time  `json:"startTime"`
+	PhaseStartTime apiv1.Time  `json:"phaseStartTime"`
+	CompletionTime *apiv1.Time `json:"completionTime,omitempty"`
+}
+
+// ExternalClusterInventory describes a cluster that was imported from a kubeconfig, regardless of its distribution.
+// It is collected periodically by the API.
+// swagger:model ExternalClusterInventory
+type ExternalClusterInventory struct {
+	// Distribution is the detected Kubernetes distribution, e.g. k3s, rke2, openshift, kind or kubernetes.
+	Distribution string `json:"distribution"`
+	// Version is the version reported by the API server, including distribution suffixes like "+k3s1".
+	Version string `json:"version,omitempty"`
+	// NodeOperatingSystems are the distinct operating system images of the nodes.
+	NodeOperatingSystems []string `json:"nodeOperatingSystems,omitempty"`
+	// ContainerRuntimes are the distinct container runtime versions of the nodes.
+	ContainerRuntimes []string `json:"containerRuntimes,omitempty"`
+	// CNI is the detected network plugin, it is empty if the plugin is not known.
+	CNI string `json:"cni,omitempty"`
+	// ControlPlaneComponents are the readiness checks reported by the API server.
+	ControlPlaneComponents    []ExternalClusterComponentHealth `json:"controlPlaneComponents,omitempty"`
+	CustomResourceDefinitions []string                         `json:"customResourceDefinitions,omitempty"`
+	StorageClasses            []ExternalClusterStorageClass    `json:"storageClasses,omitempty"`
+	Reachability              ExternalClusterReachability      `json:"reachability"`
+	// CollectionTime is when the inventory was collected the last time the cluster was reachable.
+	CollectionTime *apiv1.Time `json:"collectionTime,omitempty"`
+}
+
+// ExternalClusterComponentHealth is a readiness check of the API server of an imported cluster, e.g. etcd.
+// swagger:model ExternalClusterComponentHealth
+type ExternalClusterComponentHealth struct {
+	Name    string `json:"name"`
+	Healthy bool   `json:"healthy"`
+	Message string `json:"message,omitempty"`
+}
+
+// ExternalClusterStorageClass is a storage class of an imported cluster.
+// swagger:model ExternalClusterStorageClass
+type ExternalClusterStorageClass struct {
+	Name        string `json:"name"`
+	Provisioner string `json:"provisioner"`
+	Default     bool   `json:"default,omitempty"`
+}
+
+// ExternalClusterReachability is the result of the last reachability check of an imported cluster.
+// swagger:model ExternalClusterReachability
+type ExternalClusterReachability struct {
+	Reachable         bool        `json:"reachable"`
+	LastCheckTime     apiv1.Time  `json:"lastCheckTime"`
+	LastReachableTime *apiv1.Time `json:"lastReachableTime,omitempty"`
+	// LatencyMilliseconds is how long the API server took to answer the version request.
+	LatencyMilliseconds int64 `json:"latencyMilliseconds,omitempty"`
+	// Message explains why the cluster is not reachable.
+	Message string `json:"message,omitempty"`
+}
+
+// ClusterAgentToken is a token cluster agents use to register the cluster they run in with a project.
+// swagger:model ClusterAgentToken
+type ClusterAgentToken struct {
+	ID                string      `json:"id"`
+	Name              string      `json:"name"`
+	CreationTimestamp apiv1.Time  `json:"creationTimestamp"`
+	Expiry            *apiv1.Time `json:"expiry,omitempty"`
+	// Token is only returned when the token is created, it cannot be retrieved afterwards.
+	Token string `json:"token,omitempty"`
+}
+
+// Session is a login session of the dashboard.
+// swagger:model Session
+type Session struct {
+	ID string `json:"id"`
+	// Device describes the browser and operating system the session was last used with.
+	Device    string `json:"device,omitempty"`
+	UserAgent string `json:"userAgent,omitempty"`
+	IP        string `json:"ip,omitempty"`
+	// Issuer is the name of the OIDC issuer the user logged in with.
+	Issuer            string     `json:"issuer,omitempty"`
+	CreationTimestamp apiv1.Time `json:"creationTimestamp"`
+	LastSeen          apiv1.Time `json:"lastSeen"`
+	Expiry            apiv1.Time `json:"expiry"`
+	// Current is set for the session the request was sent with.
+	Current bool `json:"current"`
+}
+
+// PersonalAccessToken is a token users create to act with their own identity in automation.
+// swagger:model PersonalAccessToken
+type PersonalAccessToken struct {
+	ID   string `json:"id"`
+	Name string `json:"name"`
+	// ReadOnly tokens can only be used for requests which do not change anything and do not hand out credentials,
+	// such as kubeconfigs.
+	ReadOnly          bool        `json:"readOnly"`
+	CreationTimestamp apiv1.Time  `json:"creationTimestamp"`
+	LastUsed          *apiv1.Time `json:"lastUsed,omitempty"`
+	// Expiry is empty for tokens which do not expire.
+	Expiry *apiv1.Time `json:"expiry,omitempty"`
+	// Token is only returned when the token is created, it cannot be retrieved afterwards.
+	Token string `json:"token,omitempty"`
+}
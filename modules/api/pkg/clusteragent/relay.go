@@ -0,0 +1,286 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragent
+
+import (
+	"bufio"
+	"context"
+	"crypto/hmac"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"net/url"
+	"os"
+	"strconv"
+	"strings"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	coordinationv1client "k8s.io/client-go/kubernetes/typed/coordination/v1"
+)
+
+const (
+	// RelayAddressAnnotation holds the address of the relay of the leader on the tunnel lease.
+	RelayAddressAnnotation = "dashboard.k8c.io/cluster-agent-relay"
+
+	relayTokenHeader = "X-Cluster-Agent-Relay-Token"
+	// relayTokenValidity bounds how long a captured relay token can be replayed.
+	relayTokenValidity = time.Minute
+	// relayHandshakeTimeout bounds the time until the relay of the leader answers a connection.
+	relayHandshakeTimeout = 10 * time.Second
+)
+
+// relayConfig is set by ConfigureRelay.
+type relayConfig struct {
+	leases  coordinationv1client.LeaseInterface
+	address string
+	key     []byte
+	log     *zap.SugaredLogger
+}
+
+var relay atomic.Pointer[relayConfig]
+
+// ConfigureRelay lets the API replicas reach the tunnels of the leader. The leader records the given
+// address of its relay, see RelayHandler, on the tunnel lease in the given lease client. The other
+// replicas connect to the relay for clusters they hold no tunnel of and authenticate with the key.
+func ConfigureRelay(leases coordinationv1client.LeaseInterface, advertiseAddress string, key []byte, log *zap.SugaredLogger) {
+	relay.Store(&relayConfig{
+		leases:  leases,
+		address: advertiseAddress,
+		key:     key,
+		log:     log,
+	})
+}
+
+// DefaultRelayAddress returns the address the relay listening on the given address is reached at by the
+// other replicas. Relays listening on all interfaces are reached at the IP the hostname of the pod
+// resolves to.
+func DefaultRelayAddress(listenAddress string) (string, error) {
+	host, port, err := net.SplitHostPort(listenAddress)
+	if err != nil {
+		return "", err
+	}
+	if ip := net.ParseIP(host); host != "" && (ip == nil || !ip.IsUnspecified()) {
+		return listenAddress, nil
+	}
+
+	hostname, err := os.Hostname()
+	if err != nil {
+		return "", fmt.Errorf("failed to get hostname: %w", err)
+	}
+	ips, err := net.LookupIP(hostname)
+	if err != nil {
+		return "", fmt.Errorf("failed to resolve hostname %s: %w", hostname, err)
+	}
+	for _, ip := range ips {
+		if !ip.IsLoopback() {
+			return net.JoinHostPort(ip.String(), port), nil
+		}
+	}
+	return "", fmt.Errorf("hostname %s does not resolve to a non-loopback address", hostname)
+}
+
+// recordRelayAddress stores the relay address of this replica on the tunnel lease until it succeeded
+// or ctx is done.
+func recordRelayAddress(ctx context.Context) {
+	config := relay.Load()
+	if config == nil {
+		return
+	}
+
+	patch, err := json.Marshal(map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"annotations": map[string]string{RelayAddressAnnotation: config.address},
+		},
+	})
+	if err != nil {
+		config.log.Errorw("Failed to encode the relay address", zap.Error(err))
+		return
+	}
+
+	_ = wait.PollUntilContextCancel(ctx, 5*time.Second, true, func(ctx context.Context) (bool, error) {
+		if _, err := config.leases.Patch(ctx, LeaseName, types.MergePatchType, patch, metav1.PatchOptions{}); err != nil {
+			config.log.Warnw("Failed to record the relay address on the tunnel lease", zap.Error(err))
+			return false, nil
+		}
+		return true, nil
+	})
+}
+
+// RelayHandler serves the tunnels of this replica to the other replicas. They send a CONNECT request
+// for the cluster, the connection is then spliced onto a stream of the tunnel.
+func RelayHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.Method != http.MethodConnect {
+			http.Error(w, "only CONNECT requests are relayed", http.StatusMethodNotAllowed)
+			return
+		}
+		clusterName := r.Host
+		config := relay.Load()
+		if config == nil || !validRelayToken(config.key, clusterName, r.Header.Get(relayTokenHeader), time.Now()) {
+			http.Error(w, "invalid relay token", http.StatusUnauthorized)
+			return
+		}
+
+		session := getSession(clusterName)
+		if session == nil || session.IsClosed() {
+			http.Error(w, fmt.Sprintf("%v: %s", ErrNotConnected, clusterName), http.StatusBadGateway)
+			return
+		}
+		stream, err := session.Open()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to open tunnel stream: %v", err), http.StatusBadGateway)
+			return
+		}
+
+		hijacker, ok := w.(http.Hijacker)
+		if !ok {
+			_ = stream.Close()
+			http.Error(w, "the connection cannot be relayed", http.StatusInternalServerError)
+			return
+		}
+		conn, buffered, err := hijacker.Hijack()
+		if err != nil {
+			_ = stream.Close()
+			return
+		}
+		if _, err := conn.Write([]byte("HTTP/1.1 200 OK\r\n\r\n")); err != nil {
+			_ = conn.Close()
+			_ = stream.Close()
+			return
+		}
+		splice(&bufferedConn{Conn: conn, reader: buffered.Reader}, stream)
+	})
+}
+
+// dialRelay opens a connection to the API server of the given cluster through the relay of the leader.
+func dialRelay(ctx context.Context, clusterName string) (net.Conn, error) {
+	config := relay.Load()
+	if config == nil {
+		return nil, fmt.Errorf("%w: %s", ErrNotConnected, clusterName)
+	}
+	lease, err := config.leases.Get(ctx, LeaseName, metav1.GetOptions{})
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the tunnel lease: %w", err)
+	}
+	// this replica is the leader or the leader has not recorded its relay yet
+	address := lease.Annotations[RelayAddressAnnotation]
+	if address == "" || address == config.address {
+		return nil, fmt.Errorf("%w: %s", ErrNotConnected, clusterName)
+	}
+
+	dialer := &net.Dialer{Timeout: relayHandshakeTimeout}
+	conn, err := dialer.DialContext(ctx, "tcp", address)
+	if err != nil {
+		return nil, fmt.Errorf("failed to connect to the relay at %s: %w", address, err)
+	}
+	if err := conn.SetDeadline(time.Now().Add(relayHandshakeTimeout)); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+
+	req := &http.Request{
+		Method: http.MethodConnect,
+		URL:    &url.URL{Host: clusterName},
+		Host:   clusterName,
+		Header: http.Header{relayTokenHeader: {newRelayToken(config.key, clusterName, time.Now())}},
+	}
+	if err := req.Write(conn); err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to send relay request: %w", err)
+	}
+	reader := bufio.NewReader(conn)
+	resp, err := http.ReadResponse(reader, req)
+	if err != nil {
+		_ = conn.Close()
+		return nil, fmt.Errorf("failed to read relay response: %w", err)
+	}
+	_ = resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		_ = conn.Close()
+		return nil, fmt.Errorf("%w: %s: the relay answered %s", ErrNotConnected, clusterName, resp.Status)
+	}
+
+	if err := conn.SetDeadline(time.Time{}); err != nil {
+		_ = conn.Close()
+		return nil, err
+	}
+	return &bufferedConn{Conn: conn, reader: reader}, nil
+}
+
+// newRelayToken returns a token for relaying connections to the given cluster, it is valid for
+// relayTokenValidity around the given time.
+func newRelayToken(key []byte, clusterName string, now time.Time) string {
+	timestamp := strconv.FormatInt(now.Unix(), 10)
+	return timestamp + ":" + relayTokenSignature(key, clusterName, timestamp)
+}
+
+func validRelayToken(key []byte, clusterName, token string, now time.Time) bool {
+	timestamp, signature, ok := strings.Cut(token, ":")
+	if !ok {
+		return false
+	}
+	seconds, err := strconv.ParseInt(timestamp, 10, 64)
+	if err != nil {
+		return false
+	}
+	if age := now.Sub(time.Unix(seconds, 0)); age > relayTokenValidity || age < -relayTokenValidity {
+		return false
+	}
+	return hmac.Equal([]byte(signature), []byte(relayTokenSignature(key, clusterName, timestamp)))
+}
+
+func relayTokenSignature(key []byte, clusterName, timestamp string) string {
+	mac := hmac.New(sha256.New, key)
+	mac.Write([]byte(clusterName + "\n" + timestamp))
+	return hex.EncodeToString(mac.Sum(nil))
+}
+
+// splice copies between both connections until one of them is done, both are closed afterwards.
+func splice(a, b io.ReadWriteCloser) {
+	done := make(chan struct{}, 2)
+	copyAndSignal := func(dst io.Writer, src io.Reader) {
+		_, _ = io.Copy(dst, src)
+		done <- struct{}{}
+	}
+	go copyAndSignal(a, b)
+	go copyAndSignal(b, a)
+	<-done
+	_ = a.Close()
+	_ = b.Close()
+	<-done
+}
+
+// bufferedConn is a connection whose reads start with the bytes already buffered while reading the
+// relay handshake.
+type bufferedConn struct {
+	net.Conn
+	reader *bufio.Reader
+}
+
+func (c *bufferedConn) Read(p []byte) (int, error) {
+	return c.reader.Read(p)
+}
@@ -0,0 +1,111 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragent
+
+import (
+	"context"
+	"encoding/json"
+	"net"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	coordinationv1 "k8s.io/api/coordination/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/kubernetes/fake"
+	"k8s.io/client-go/rest"
+)
+
+// TestRelay is not parallel as it configures the relay of the package.
+func TestRelay(t *testing.T) {
+	const clusterName = "agent-relay-test"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	defer relay.Store(nil)
+
+	// the leader holds the tunnel of the cluster
+	apiConn, agentConn := net.Pipe()
+	go func() {
+		_ = ServeAgent(agentConn, http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			_ = json.NewEncoder(w).Encode(version.Info{GitVersion: "v1.30.2"})
+		}))
+	}()
+	go func() {
+		_ = Serve(ctx, clusterName, apiConn)
+	}()
+	require.NoError(t, wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		return IsConnected(clusterName), nil
+	}))
+
+	leader := httptest.NewServer(RelayHandler())
+	defer leader.Close()
+	leases := fake.NewSimpleClientset(&coordinationv1.Lease{
+		ObjectMeta: metav1.ObjectMeta{
+			Namespace:   "kubermatic",
+			Name:        LeaseName,
+			Annotations: map[string]string{RelayAddressAnnotation: strings.TrimPrefix(leader.URL, "http://")},
+		},
+	}).CoordinationV1().Leases("kubermatic")
+	ConfigureRelay(leases, "192.0.2.10:8086", []byte("relay-key"), zap.NewNop().Sugar())
+
+	// another replica reaches the cluster through the relay of the leader
+	config := &rest.Config{
+		Host: "http://" + clusterName,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			return dialRelay(ctx, clusterName)
+		},
+	}
+	info, err := kubernetes.NewForConfigOrDie(config).Discovery().ServerVersion()
+	require.NoError(t, err)
+	require.Equal(t, "v1.30.2", info.GitVersion)
+
+	// clusters without a tunnel at the leader are not connected
+	_, err = dialRelay(ctx, "agent-unknown")
+	require.ErrorIs(t, err, ErrNotConnected)
+
+	// requests without a valid token are refused
+	req, err := http.NewRequest(http.MethodConnect, leader.URL, nil)
+	require.NoError(t, err)
+	req.Host = clusterName
+	req.Header.Set(relayTokenHeader, newRelayToken([]byte("another-key"), clusterName, time.Now()))
+	resp, err := http.DefaultClient.Do(req)
+	require.NoError(t, err)
+	require.NoError(t, resp.Body.Close())
+	require.Equal(t, http.StatusUnauthorized, resp.StatusCode)
+}
+
+func TestRelayToken(t *testing.T) {
+	t.Parallel()
+
+	key := []byte("relay-key")
+	now := time.Now()
+	token := newRelayToken(key, "agent-a", now)
+
+	require.True(t, validRelayToken(key, "agent-a", token, now.Add(30*time.Second)))
+	require.False(t, validRelayToken(key, "agent-b", token, now))
+	require.False(t, validRelayToken([]byte("another-key"), "agent-a", token, now))
+	require.False(t, validRelayToken(key, "agent-a", token, now.Add(2*relayTokenValidity)))
+	require.False(t, validRelayToken(key, "agent-a", "garbage", now))
+}
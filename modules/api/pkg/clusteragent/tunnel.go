@@ -0,0 +1,264 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusteragent implements the reverse tunnel between the API and the agents running in
+// external clusters which cannot be reached from the outside. The agent opens a websocket to the
+// API, the connection is multiplexed with yamux and every stream opened by the API is proxied by
+// the agent to the API server of its cluster.
+//
+// The tunnels are held in memory, so only one API replica may accept agent connections. The
+// replicas elect it with a lease, see Lead, the others refuse the agents which then retry until
+// they reach the leader. The leader records the address of its relay on the lease, the other
+// replicas reach the clusters through it, see ConfigureRelay.
+package clusteragent
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"errors"
+	"fmt"
+	"io"
+	"net"
+	"net/http"
+	"sync"
+	"sync/atomic"
+
+	"github.com/gorilla/websocket"
+	"github.com/hashicorp/yamux"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/client-go/rest"
+)
+
+const (
+	// ConnectivityAnnotation marks external clusters which are reached through a cluster agent
+	// instead of a stored kubeconfig.
+	ConnectivityAnnotation = "dashboard.k8c.io/connectivity"
+	// ConnectivityAgent is the value of the ConnectivityAnnotation for agent connected clusters.
+	ConnectivityAgent = "agent"
+	// AgentIDLabel holds the ID the agent reported for its cluster.
+	AgentIDLabel = "dashboard.k8c.io/cluster-agent-id"
+	// AgentTokenLabel holds the ID of the registration token the cluster was registered with. Only
+	// agents presenting this token are served the cluster.
+	AgentTokenLabel = "dashboard.k8c.io/cluster-agent-token"
+
+	// ClusterIDHeader is sent by the agent on connect and identifies its cluster. The agent uses
+	// the UID of the kube-system namespace, which is stable over restarts and reinstalls.
+	ClusterIDHeader = "X-Cluster-Agent-ID"
+	// ClusterNameHeader is sent by the agent on connect and is used as the human readable name
+	// of the cluster when it registers for the first time.
+	ClusterNameHeader = "X-Cluster-Agent-Name"
+)
+
+// ErrNotConnected is returned when there is no tunnel to the agent of a cluster.
+var ErrNotConnected = errors.New("cluster agent is not connected")
+
+var (
+	sessionsLock sync.RWMutex
+	sessions     = map[string]*yamux.Session{}
+	leading      atomic.Bool
+)
+
+// LeaseName is the name of the lease the API replicas elect the one accepting agents with.
+const LeaseName = "dashboard-cluster-agent-tunnels"
+
+// Lead makes this API replica accept agent connections and relay them to the other replicas
+// until ctx is done. All tunnels are closed afterwards, so the agents reconnect to the new leader.
+func Lead(ctx context.Context) {
+	leading.Store(true)
+	go recordRelayAddress(ctx)
+	<-ctx.Done()
+	leading.Store(false)
+
+	sessionsLock.Lock()
+	closing := sessions
+	sessions = map[string]*yamux.Session{}
+	sessionsLock.Unlock()
+
+	for _, session := range closing {
+		_ = session.Close()
+	}
+}
+
+// IsLeading returns true if this API replica accepts agent connections.
+func IsLeading() bool {
+	return leading.Load()
+}
+
+// UsesAgent returns true if the given external cluster is reached through a cluster agent.
+func UsesAgent(cluster *kubermaticv1.ExternalCluster) bool {
+	return cluster.Annotations[ConnectivityAnnotation] == ConnectivityAgent
+}
+
+// ClusterName returns the name of the external cluster an agent registers for. It is derived
+// from the project and the agent ID so that reconnects of the same agent end up at the same
+// cluster.
+func ClusterName(projectID, agentID string) string {
+	sum := sha256.Sum256([]byte(projectID + "/" + agentID))
+	return "agent-" + hex.EncodeToString(sum[:])[:10]
+}
+
+// Register stores the tunnel of the given cluster, replacing and closing the previous one if
+// the agent reconnected before the old connection was detected as dead.
+func Register(clusterName string, session *yamux.Session) {
+	sessionsLock.Lock()
+	previous := sessions[clusterName]
+	sessions[clusterName] = session
+	sessionsLock.Unlock()
+
+	if previous != nil && previous != session {
+		_ = previous.Close()
+	}
+}
+
+// Unregister removes the tunnel of the given cluster, unless it was already replaced by a
+// newer connection of the agent.
+func Unregister(clusterName string, session *yamux.Session) {
+	sessionsLock.Lock()
+	defer sessionsLock.Unlock()
+
+	if sessions[clusterName] == session {
+		delete(sessions, clusterName)
+	}
+}
+
+// Disconnect closes the tunnel of the given cluster, if this API replica holds one.
+func Disconnect(clusterName string) {
+	sessionsLock.Lock()
+	session := sessions[clusterName]
+	delete(sessions, clusterName)
+	sessionsLock.Unlock()
+
+	if session != nil {
+		_ = session.Close()
+	}
+}
+
+// IsConnected returns true if the agent of the given cluster holds an open tunnel to this API
+// replica.
+func IsConnected(clusterName string) bool {
+	session := getSession(clusterName)
+	return session != nil && !session.IsClosed()
+}
+
+func getSession(clusterName string) *yamux.Session {
+	sessionsLock.RLock()
+	defer sessionsLock.RUnlock()
+
+	return sessions[clusterName]
+}
+
+// RestConfig returns a config whose requests are sent through the tunnel of the given cluster.
+// The tunnel is looked up on every dial, so clients created from the config keep working after
+// the agent reconnected. Replicas which do not hold the tunnel go through the relay of the leader.
+// Authentication against the API server is done by the agent.
+func RestConfig(clusterName string) *rest.Config {
+	return &rest.Config{
+		Host: "http://" + clusterName,
+		Dial: func(ctx context.Context, _, _ string) (net.Conn, error) {
+			session := getSession(clusterName)
+			if session == nil || session.IsClosed() {
+				return dialRelay(ctx, clusterName)
+			}
+			return session.Open()
+		},
+	}
+}
+
+// Serve multiplexes the given agent connection, registers the tunnel and blocks until the
+// connection is closed.
+func Serve(ctx context.Context, clusterName string, conn io.ReadWriteCloser) error {
+	session, err := yamux.Client(conn, yamux.DefaultConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create tunnel session: %w", err)
+	}
+
+	Register(clusterName, session)
+	defer Unregister(clusterName, session)
+
+	select {
+	case <-ctx.Done():
+		return session.Close()
+	case <-session.CloseChan():
+		return nil
+	}
+}
+
+// ServeAgent is the agent side of the tunnel. It multiplexes the connection to the API and
+// serves every stream opened by the API with the given handler until the connection is closed.
+func ServeAgent(conn io.ReadWriteCloser, handler http.Handler) error {
+	session, err := yamux.Server(conn, yamux.DefaultConfig())
+	if err != nil {
+		return fmt.Errorf("failed to create tunnel session: %w", err)
+	}
+	defer session.Close()
+
+	return http.Serve(session, handler)
+}
+
+// websocketConn adapts a websocket to the byte stream expected by yamux by sending every write
+// as a binary message.
+type websocketConn struct {
+	ws        *websocket.Conn
+	reader    io.Reader
+	writeLock sync.Mutex
+}
+
+// NewWebsocketConn returns a byte stream on top of the given websocket.
+func NewWebsocketConn(ws *websocket.Conn) io.ReadWriteCloser {
+	return &websocketConn{ws: ws}
+}
+
+func (c *websocketConn) Read(p []byte) (int, error) {
+	for {
+		if c.reader == nil {
+			messageType, reader, err := c.ws.NextReader()
+			if err != nil {
+				return 0, err
+			}
+			if messageType != websocket.BinaryMessage {
+				continue
+			}
+			c.reader = reader
+		}
+
+		n, err := c.reader.Read(p)
+		if errors.Is(err, io.EOF) {
+			c.reader = nil
+			if n == 0 {
+				continue
+			}
+			err = nil
+		}
+		return n, err
+	}
+}
+
+func (c *websocketConn) Write(p []byte) (int, error) {
+	c.writeLock.Lock()
+	defer c.writeLock.Unlock()
+
+	if err := c.ws.WriteMessage(websocket.BinaryMessage, p); err != nil {
+		return 0, err
+	}
+	return len(p), nil
+}
+
+func (c *websocketConn) Close() error {
+	return c.ws.Close()
+}
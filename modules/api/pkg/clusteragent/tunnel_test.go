@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragent
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/gorilla/websocket"
+	"github.com/stretchr/testify/require"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/apimachinery/pkg/version"
+	"k8s.io/client-go/kubernetes"
+)
+
+func TestTunnel(t *testing.T) {
+	t.Parallel()
+
+	const clusterName = "agent-tunnel-test"
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+
+	// the API side accepts the agent connection and registers the tunnel
+	upgrader := websocket.Upgrader{}
+	api := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			return
+		}
+		_ = Serve(ctx, clusterName, NewWebsocketConn(ws))
+	}))
+	defer api.Close()
+
+	_, err := kubernetes.NewForConfigOrDie(RestConfig(clusterName)).Discovery().ServerVersion()
+	require.ErrorIs(t, err, ErrNotConnected)
+
+	// the agent side serves the API server of its cluster
+	apiServer := http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path != "/version" {
+			http.NotFound(w, r)
+			return
+		}
+		_ = json.NewEncoder(w).Encode(version.Info{GitVersion: "v1.30.2"})
+	})
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(api.URL, "http"), nil)
+	require.NoError(t, err)
+	go func() {
+		_ = ServeAgent(NewWebsocketConn(ws), apiServer)
+	}()
+
+	require.NoError(t, wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		return IsConnected(clusterName), nil
+	}))
+
+	info, err := kubernetes.NewForConfigOrDie(RestConfig(clusterName)).Discovery().ServerVersion()
+	require.NoError(t, err)
+	require.Equal(t, "v1.30.2", info.GitVersion)
+
+	// the tunnel is removed once the agent disconnects
+	require.NoError(t, ws.Close())
+	require.NoError(t, wait.PollUntilContextTimeout(ctx, 10*time.Millisecond, 5*time.Second, true, func(context.Context) (bool, error) {
+		return !IsConnected(clusterName), nil
+	}))
+}
+
+func TestClusterName(t *testing.T) {
+	t.Parallel()
+
+	name := ClusterName("project-a", "0a6f7ad2-4b8e-4b63-9a4e-6f0c1a2b3c4d")
+	require.Equal(t, name, ClusterName("project-a", "0a6f7ad2-4b8e-4b63-9a4e-6f0c1a2b3c4d"))
+	require.NotEqual(t, name, ClusterName("project-b", "0a6f7ad2-4b8e-4b63-9a4e-6f0c1a2b3c4d"))
+	require.Len(t, name, len("agent-")+10)
+}
@@ -50,6 +50,14 @@ func ResourceQuotaProviderFactory(createMasterImpersonatedClient kubernetes.Impe
 	return resourcequotas.NewResourceQuotaProvider(createMasterImpersonatedClient, privilegedClient)
 }
 
+func ResourceQuotaUsageHistoryProviderFactory(privilegedClient ctrlruntimeclient.Client, namespace string) provider.ResourceQuotaUsageHistoryProvider {
+	return resourcequotas.NewResourceQuotaUsageHistoryProvider(privilegedClient, namespace)
+}
+
+func ResourceQuotaNotificationProviderFactory(privilegedClient ctrlruntimeclient.Client, namespace string) provider.ResourceQuotaNotificationProvider {
+	return resourcequotas.NewResourceQuotaNotifier(privilegedClient, namespace)
+}
+
 func GroupProjectBindingProviderFactory(createMasterImpersonatedClient kubernetes.ImpersonationClient, privilegedClient ctrlruntimeclient.Client) provider.GroupProjectBindingProvider {
 	return groupprojectbinding.NewGroupProjectBindingProvider(createMasterImpersonatedClient, privilegedClient)
 }
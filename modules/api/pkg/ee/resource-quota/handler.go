@@ -58,7 +58,7 @@ const (
 	UnitGibi = "Gi"
 )
 
-// swagger:parameters getResourceQuota deleteResourceQuota
+// swagger:parameters getResourceQuota deleteResourceQuota getResourceQuotaNotifications listResourceQuotaNotificationDeliveries
 type getResourceQuota struct {
 	// in: path
 	// required: true
@@ -136,6 +136,17 @@ type putResourceQuota struct {
 	Body apiv2.Quota
 }
 
+// swagger:parameters putResourceQuotaNotifications
+type putResourceQuotaNotifications struct {
+	// in: path
+	// required: true
+	Name string `json:"quota_name"`
+
+	// in: body
+	// required: true
+	Body apiv2.ResourceQuotaNotificationConfig
+}
+
 func (m createResourceQuota) Validate() error {
 	if m.Body.SubjectName == "" {
 		return utilerrors.NewBadRequest("subject's name cannot be empty")
@@ -205,6 +216,21 @@ func DecodePutResourceQuotaReq(r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func DecodePutResourceQuotaNotificationsReq(r *http.Request) (interface{}, error) {
+	var req putResourceQuotaNotifications
+
+	req.Name = mux.Vars(r)["quota_name"]
+	if req.Name == "" {
+		return nil, utilerrors.NewBadRequest("`quota_name` cannot be empty")
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("%v", err)
+	}
+
+	return req, nil
+}
+
 func DecodeCalculateProjectResourceQuotaUpdateReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req calculateProjectResourceQuotaUpdate
 
@@ -263,6 +289,39 @@ func GetResourceQuotaForProject(ctx context.Context, request interface{}, projec
 	return convertToAPIStruct(projectResourceQuota, projectName), nil
 }
 
+func GetResourceQuotaUsageHistoryForProject(ctx context.Context, request interface{}, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	quotaProvider provider.ResourceQuotaProvider, historyProvider provider.ResourceQuotaUsageHistoryProvider) (*apiv2.ResourceQuotaUsageHistory, error) {
+	projectResourceQuota, projectName, err := getProjectResourceQuota(ctx, request, projectProvider, privilegedProjectProvider, userInfoGetter, quotaProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	if projectResourceQuota == nil {
+		// ResourceQuota not found. Return an empty response.
+		return nil, nil
+	}
+
+	snapshots, err := historyProvider.ListUnsecured(ctx, projectResourceQuota.Name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	history := &apiv2.ResourceQuotaUsageHistory{
+		ResourceQuota: *convertToAPIStruct(projectResourceQuota, projectName),
+		Snapshots:     make([]apiv2.ResourceQuotaUsageSnapshot, 0, len(snapshots)),
+	}
+	for _, snapshot := range snapshots {
+		history.Snapshots = append(history.Snapshots, apiv2.ResourceQuotaUsageSnapshot{
+			Timestamp: apiv1.NewTime(snapshot.Timestamp),
+			Usage:     apiv2.ConvertToAPIQuota(snapshot.Usage),
+		})
+	}
+	history.Forecast = forecastQuotaUsage(history.ResourceQuota.Quota, history.Snapshots)
+
+	return history, nil
+}
+
 func accumulateQuotas(rqList *kubermaticv1.ResourceQuotaList) *apiv2.ResourceQuota {
 	rdAvailable := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
 	rdUsed := kubermaticv1.NewResourceDetails(resource.Quantity{}, resource.Quantity{}, resource.Quantity{})
@@ -831,3 +890,66 @@ func DeleteResourceQuota(ctx context.Context, request interface{}, provider prov
 	}
 	return nil
 }
+
+func GetResourceQuotaNotifications(ctx context.Context, request interface{}, quotaProvider provider.ResourceQuotaProvider,
+	notificationProvider provider.ResourceQuotaNotificationProvider) (*apiv2.ResourceQuotaNotificationConfig, error) {
+	req, ok := request.(getResourceQuota)
+	if !ok {
+		return nil, utilerrors.NewBadRequest("invalid request")
+	}
+
+	if _, err := quotaProvider.GetUnsecured(ctx, req.Name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, utilerrors.NewNotFound("ResourceQuota", req.Name)
+		}
+		return nil, err
+	}
+
+	config, err := notificationProvider.GetConfigUnsecured(ctx, req.Name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return config, nil
+}
+
+func PutResourceQuotaNotifications(ctx context.Context, request interface{}, notificationProvider provider.ResourceQuotaNotificationProvider) error {
+	req, ok := request.(putResourceQuotaNotifications)
+	if !ok {
+		return utilerrors.NewBadRequest("invalid request")
+	}
+
+	if err := ValidateNotificationConfig(req.Body); err != nil {
+		return err
+	}
+
+	if err := notificationProvider.UpdateConfigUnsecured(ctx, req.Name, &req.Body); err != nil {
+		if apierrors.IsNotFound(err) {
+			return utilerrors.NewNotFound("ResourceQuota", req.Name)
+		}
+		return err
+	}
+	return nil
+}
+
+func ListResourceQuotaNotificationDeliveries(ctx context.Context, request interface{}, quotaProvider provider.ResourceQuotaProvider,
+	notificationProvider provider.ResourceQuotaNotificationProvider) ([]apiv2.ResourceQuotaNotificationDelivery, error) {
+	req, ok := request.(getResourceQuota)
+	if !ok {
+		return nil, utilerrors.NewBadRequest("invalid request")
+	}
+
+	if _, err := quotaProvider.GetUnsecured(ctx, req.Name); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, utilerrors.NewNotFound("ResourceQuota", req.Name)
+		}
+		return nil, err
+	}
+
+	deliveries, err := notificationProvider.ListDeliveriesUnsecured(ctx, req.Name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return deliveries, nil
+}
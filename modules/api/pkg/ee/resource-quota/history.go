@@ -0,0 +1,264 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"math"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultUsageRecordInterval is the interval in which the usage of all resource quotas is recorded.
+	DefaultUsageRecordInterval = time.Hour
+	// DefaultUsageRetention is the time after which recorded usage snapshots are pruned.
+	DefaultUsageRetention = 30 * 24 * time.Hour
+
+	usageHistoryConfigMapPrefix = "resource-quota-usage-"
+	usageHistoryKey             = "snapshots"
+	usageHistoryQuotaLabelKey   = "kubermatic.k8c.io/resource-quota"
+)
+
+// ResourceQuotaUsageHistoryProvider records the global usage of all resource quotas in one
+// ConfigMap per quota and gives access to the recorded snapshots.
+type ResourceQuotaUsageHistoryProvider struct {
+	privilegedClient ctrlruntimeclient.Client
+	namespace        string
+	interval         time.Duration
+	retention        time.Duration
+}
+
+var _ provider.ResourceQuotaUsageHistoryProvider = &ResourceQuotaUsageHistoryProvider{}
+
+func NewResourceQuotaUsageHistoryProvider(privilegedClient ctrlruntimeclient.Client, namespace string) *ResourceQuotaUsageHistoryProvider {
+	return &ResourceQuotaUsageHistoryProvider{
+		privilegedClient: privilegedClient,
+		namespace:        namespace,
+		interval:         DefaultUsageRecordInterval,
+		retention:        DefaultUsageRetention,
+	}
+}
+
+// Run records the usage of all resource quotas periodically until the context is cancelled.
+func (p *ResourceQuotaUsageHistoryProvider) Run(ctx context.Context, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := p.RecordUnsecured(ctx, time.Now()); err != nil {
+			log.Errorw("failed to record resource quota usage", zap.Error(err))
+		}
+	}, p.interval)
+}
+
+// RecordUnsecured appends a snapshot of the current global usage to the history of every resource quota.
+//
+// Note that this function:
+// is unsafe in a sense that it uses privileged account to update the resource
+func (p *ResourceQuotaUsageHistoryProvider) RecordUnsecured(ctx context.Context, now time.Time) error {
+	resourceQuotas := &kubermaticv1.ResourceQuotaList{}
+	if err := p.privilegedClient.List(ctx, resourceQuotas); err != nil {
+		return fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	var errs []error
+	for i := range resourceQuotas.Items {
+		if err := p.recordUsage(ctx, &resourceQuotas.Items[i], now); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", resourceQuotas.Items[i].Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to record usage of %d resource quotas: %v", len(errs), errs)
+	}
+
+	return nil
+}
+
+func (p *ResourceQuotaUsageHistoryProvider) ListUnsecured(ctx context.Context, quotaName string) ([]provider.ResourceQuotaUsageSnapshot, error) {
+	snapshots, _, err := p.getHistory(ctx, quotaName)
+	return snapshots, err
+}
+
+func (p *ResourceQuotaUsageHistoryProvider) recordUsage(ctx context.Context, resourceQuota *kubermaticv1.ResourceQuota, now time.Time) error {
+	snapshots, cm, err := p.getHistory(ctx, resourceQuota.Name)
+	if err != nil {
+		return err
+	}
+
+	// Every API replica runs the recorder, only the first one within an interval records the usage.
+	if len(snapshots) > 0 && now.Sub(snapshots[len(snapshots)-1].Timestamp) < p.interval/2 {
+		return nil
+	}
+
+	snapshots = append(snapshots, provider.ResourceQuotaUsageSnapshot{
+		Timestamp: now.UTC(),
+		Usage:     *resourceQuota.Status.GlobalUsage.DeepCopy(),
+	})
+
+	cutoff := now.Add(-p.retention)
+	for len(snapshots) > 0 && snapshots[0].Timestamp.Before(cutoff) {
+		snapshots = snapshots[1:]
+	}
+
+	data, err := json.Marshal(snapshots)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		cm = &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      usageHistoryConfigMapPrefix + resourceQuota.Name,
+				Namespace: p.namespace,
+				Labels: map[string]string{
+					usageHistoryQuotaLabelKey: resourceQuota.Name,
+				},
+				OwnerReferences: []metav1.OwnerReference{
+					*metav1.NewControllerRef(resourceQuota, kubermaticv1.SchemeGroupVersion.WithKind(kubermaticv1.ResourceQuotaKindName)),
+				},
+			},
+			Data: map[string]string{usageHistoryKey: string(data)},
+		}
+		return p.privilegedClient.Create(ctx, cm)
+	}
+
+	cm.Data = map[string]string{usageHistoryKey: string(data)}
+	if err := p.privilegedClient.Update(ctx, cm); err != nil && !apierrors.IsConflict(err) {
+		return err
+	}
+
+	return nil
+}
+
+// getHistory returns the recorded snapshots of a resource quota together with the ConfigMap
+// holding them. The ConfigMap is nil if no usage has been recorded yet.
+func (p *ResourceQuotaUsageHistoryProvider) getHistory(ctx context.Context, quotaName string) ([]provider.ResourceQuotaUsageSnapshot, *corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := p.privilegedClient.Get(ctx, types.NamespacedName{Namespace: p.namespace, Name: usageHistoryConfigMapPrefix + quotaName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return []provider.ResourceQuotaUsageSnapshot{}, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	snapshots := []provider.ResourceQuotaUsageSnapshot{}
+	if raw := cm.Data[usageHistoryKey]; raw != "" {
+		if err := json.Unmarshal([]byte(raw), &snapshots); err != nil {
+			return nil, nil, fmt.Errorf("failed to decode usage history: %w", err)
+		}
+	}
+
+	return snapshots, cm, nil
+}
+
+// forecastQuotaUsage fits a linear trend through the recorded usage of every resource that is
+// limited by the quota and extrapolates when the usage will reach the quota.
+func forecastQuotaUsage(quota apiv2.Quota, snapshots []apiv2.ResourceQuotaUsageSnapshot) apiv2.ResourceQuotaForecast {
+	forecast := apiv2.ResourceQuotaForecast{}
+
+	if quota.CPU != nil {
+		forecast.CPU = forecastResourceUsage(float64(*quota.CPU), snapshots, func(q apiv2.Quota) *float64 {
+			if q.CPU == nil {
+				return nil
+			}
+			cpu := float64(*q.CPU)
+			return &cpu
+		})
+	}
+	if quota.Memory != nil {
+		forecast.Memory = forecastResourceUsage(*quota.Memory, snapshots, func(q apiv2.Quota) *float64 { return q.Memory })
+	}
+	if quota.Storage != nil {
+		forecast.Storage = forecastResourceUsage(*quota.Storage, snapshots, func(q apiv2.Quota) *float64 { return q.Storage })
+	}
+
+	return forecast
+}
+
+// forecastResourceUsage returns nil if there are not enough snapshots to compute a trend.
+func forecastResourceUsage(limit float64, snapshots []apiv2.ResourceQuotaUsageSnapshot, usageOf func(apiv2.Quota) *float64) *apiv2.ResourceUsageForecast {
+	if len(snapshots) < 2 {
+		return nil
+	}
+
+	// least squares fit of usage over days since the first snapshot
+	start := snapshots[0].Timestamp.Time
+	var n, sumX, sumY, sumXY, sumXX float64
+	for _, snapshot := range snapshots {
+		var y float64
+		if usage := usageOf(snapshot.Usage); usage != nil {
+			y = *usage
+		}
+		x := snapshot.Timestamp.Sub(start).Hours() / 24
+
+		n++
+		sumX += x
+		sumY += y
+		sumXY += x * y
+		sumXX += x * x
+	}
+
+	denominator := n*sumXX - sumX*sumX
+	if denominator == 0 {
+		return nil
+	}
+	slope := (n*sumXY - sumX*sumY) / denominator
+
+	result := &apiv2.ResourceUsageForecast{
+		DailyGrowth: math.Round(slope*100) / 100,
+	}
+
+	last := snapshots[len(snapshots)-1]
+	var current float64
+	if usage := usageOf(last.Usage); usage != nil {
+		current = *usage
+	}
+
+	switch {
+	case current >= limit:
+		exhaustion := apiv1.NewTime(last.Timestamp.Time)
+		result.ExhaustionTime = &exhaustion
+	case slope > 0:
+		days := (limit - current) / slope
+		exhaustion := apiv1.NewTime(last.Timestamp.Add(time.Duration(days * 24 * float64(time.Hour))))
+		result.ExhaustionTime = &exhaustion
+	}
+
+	return result
+}
@@ -0,0 +1,154 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestForecastQuotaUsage(t *testing.T) {
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	snapshot := func(day int, cpu int64, memory float64) apiv2.ResourceQuotaUsageSnapshot {
+		return apiv2.ResourceQuotaUsageSnapshot{
+			Timestamp: apiv1.NewTime(start.AddDate(0, 0, day)),
+			Usage:     apiv2.Quota{CPU: ptr.To(cpu), Memory: ptr.To(memory)},
+		}
+	}
+
+	testCases := []struct {
+		name      string
+		quota     apiv2.Quota
+		snapshots []apiv2.ResourceQuotaUsageSnapshot
+		expected  apiv2.ResourceQuotaForecast
+	}{
+		{
+			name:      "a single snapshot does not give a trend",
+			quota:     apiv2.Quota{CPU: ptr.To[int64](10)},
+			snapshots: []apiv2.ResourceQuotaUsageSnapshot{snapshot(0, 2, 0)},
+			expected:  apiv2.ResourceQuotaForecast{},
+		},
+		{
+			name:  "growing usage is extrapolated to the limit",
+			quota: apiv2.Quota{CPU: ptr.To[int64](10), Memory: ptr.To(100.0)},
+			snapshots: []apiv2.ResourceQuotaUsageSnapshot{
+				snapshot(0, 2, 50),
+				snapshot(1, 4, 50),
+				snapshot(2, 6, 50),
+			},
+			expected: apiv2.ResourceQuotaForecast{
+				CPU:    &apiv2.ResourceUsageForecast{DailyGrowth: 2, ExhaustionTime: ptr.To(apiv1.NewTime(start.AddDate(0, 0, 4)))},
+				Memory: &apiv2.ResourceUsageForecast{DailyGrowth: 0},
+			},
+		},
+		{
+			name:  "exhausted quota reports the last snapshot",
+			quota: apiv2.Quota{CPU: ptr.To[int64](4)},
+			snapshots: []apiv2.ResourceQuotaUsageSnapshot{
+				snapshot(0, 6, 0),
+				snapshot(1, 5, 0),
+			},
+			expected: apiv2.ResourceQuotaForecast{
+				CPU: &apiv2.ResourceUsageForecast{DailyGrowth: -1, ExhaustionTime: ptr.To(apiv1.NewTime(start.AddDate(0, 0, 1)))},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			forecast := forecastQuotaUsage(tc.quota, tc.snapshots)
+			assertForecast(t, "cpu", tc.expected.CPU, forecast.CPU)
+			assertForecast(t, "memory", tc.expected.Memory, forecast.Memory)
+			assertForecast(t, "storage", tc.expected.Storage, forecast.Storage)
+		})
+	}
+}
+
+func assertForecast(t *testing.T, resourceName string, expected, actual *apiv2.ResourceUsageForecast) {
+	t.Helper()
+
+	if expected == nil || actual == nil {
+		if expected != actual {
+			t.Fatalf("%s: expected forecast %v, got %v", resourceName, expected, actual)
+		}
+		return
+	}
+	if expected.DailyGrowth != actual.DailyGrowth {
+		t.Fatalf("%s: expected daily growth %v, got %v", resourceName, expected.DailyGrowth, actual.DailyGrowth)
+	}
+	if (expected.ExhaustionTime == nil) != (actual.ExhaustionTime == nil) ||
+		expected.ExhaustionTime != nil && !expected.ExhaustionTime.Equal(actual.ExhaustionTime) {
+		t.Fatalf("%s: expected exhaustion time %v, got %v", resourceName, expected.ExhaustionTime, actual.ExhaustionTime)
+	}
+}
+
+func TestRecordUnsecured(t *testing.T) {
+	quota := &kubermaticv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "project-my-project"},
+		Status: kubermaticv1.ResourceQuotaStatus{
+			GlobalUsage: kubermaticv1.ResourceDetails{CPU: ptr.To(resource.MustParse("2"))},
+		},
+	}
+
+	historyProvider := NewResourceQuotaUsageHistoryProvider(fake.NewClientBuilder().WithObjects(quota).Build(), "kubermatic")
+	historyProvider.retention = 48 * time.Hour
+
+	ctx := context.Background()
+	start := time.Date(2026, 1, 1, 0, 0, 0, 0, time.UTC)
+	for _, offset := range []time.Duration{0, 10 * time.Minute, time.Hour, 24 * time.Hour, 50 * time.Hour} {
+		if err := historyProvider.RecordUnsecured(ctx, start.Add(offset)); err != nil {
+			t.Fatalf("failed to record usage: %v", err)
+		}
+	}
+
+	snapshots, err := historyProvider.ListUnsecured(ctx, quota.Name)
+	if err != nil {
+		t.Fatalf("failed to list usage history: %v", err)
+	}
+
+	// the snapshot after 10 minutes is skipped, the first two are pruned by the retention
+	expected := []time.Time{start.Add(24 * time.Hour), start.Add(50 * time.Hour)}
+	if len(snapshots) != len(expected) {
+		t.Fatalf("expected %d snapshots, got %d", len(expected), len(snapshots))
+	}
+	for i, snapshot := range snapshots {
+		if !snapshot.Timestamp.Equal(expected[i]) {
+			t.Errorf("expected snapshot %d at %v, got %v", i, expected[i], snapshot.Timestamp)
+		}
+		if snapshot.Usage.CPU == nil || snapshot.Usage.CPU.Cmp(resource.MustParse("2")) != 0 {
+			t.Errorf("expected snapshot %d to record 2 CPUs, got %v", i, snapshot.Usage.CPU)
+		}
+	}
+}
@@ -0,0 +1,620 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+	"net/smtp"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// DefaultNotificationCheckInterval is the interval in which the usage of all resource quotas is
+	// compared against their notification thresholds.
+	DefaultNotificationCheckInterval = time.Minute
+
+	notificationSecretPrefix = "resource-quota-notifications-"
+	notificationConfigKey    = "config"
+	notificationStateKey     = "state"
+	notificationDeliveryKey  = "deliveries"
+	notificationPendingKey   = "pending"
+
+	// maxNotificationDeliveries is the number of deliveries kept in the delivery log of a quota.
+	maxNotificationDeliveries = 100
+)
+
+// defaultNotificationBackoff retries a failed delivery four times, waiting 1m, 2m, 4m and 8m. The retries
+// are done by the following checks, so the delays are rounded up to the check interval.
+var defaultNotificationBackoff = wait.Backoff{
+	Duration: time.Minute,
+	Factor:   2,
+	Steps:    5,
+	Cap:      30 * time.Minute,
+}
+
+// ResourceQuotaNotifier sends notifications when the usage of a resource quota crosses one of its
+// thresholds. The notification config, the notified thresholds, the pending deliveries and the delivery
+// log of a quota are kept in one Secret per quota, as webhook URLs usually carry credentials. Keeping the
+// pending deliveries there lets every delivery back off on its own, without blocking the checks, and
+// survive restarts of the API.
+type ResourceQuotaNotifier struct {
+	privilegedClient ctrlruntimeclient.Client
+	namespace        string
+	interval         time.Duration
+	backoff          wait.Backoff
+	httpClient       *http.Client
+	sendMail         func(addr string, a smtp.Auth, from string, to []string, msg []byte) error
+}
+
+var _ provider.ResourceQuotaNotificationProvider = &ResourceQuotaNotifier{}
+
+// notificationState holds the highest threshold a notification has been sent for, per resource.
+type notificationState struct {
+	Notified map[string]int `json:"notified,omitempty"`
+}
+
+// pendingDelivery is a notification which has not been delivered to a receiver yet.
+type pendingDelivery struct {
+	Receiver    string              `json:"receiver"`
+	Payload     notificationPayload `json:"payload"`
+	Attempts    int                 `json:"attempts,omitempty"`
+	NextAttempt time.Time           `json:"nextAttempt"`
+}
+
+func (d pendingDelivery) key() string {
+	return fmt.Sprintf("%s/%s/%d/%d", d.Receiver, d.Payload.Resource, d.Payload.Threshold, d.Payload.Timestamp.UnixNano())
+}
+
+// deliveryResult is the outcome of a single delivery attempt.
+type deliveryResult struct {
+	key string
+	err error
+}
+
+// notificationPayload is the body posted to generic webhook receivers.
+type notificationPayload struct {
+	Quota       string    `json:"quota"`
+	SubjectKind string    `json:"subjectKind"`
+	SubjectName string    `json:"subjectName"`
+	Resource    string    `json:"resource"`
+	Threshold   int       `json:"threshold"`
+	Percentage  float64   `json:"percentage"`
+	Usage       string    `json:"usage"`
+	Limit       string    `json:"limit"`
+	Timestamp   time.Time `json:"timestamp"`
+}
+
+func (p notificationPayload) message() string {
+	return fmt.Sprintf("Resource quota %s of %s %s: %s usage is at %.2f%% (%s of %s), crossing the %d%% threshold.",
+		p.Quota, p.SubjectKind, p.SubjectName, p.Resource, p.Percentage, p.Usage, p.Limit, p.Threshold)
+}
+
+func NewResourceQuotaNotifier(privilegedClient ctrlruntimeclient.Client, namespace string) *ResourceQuotaNotifier {
+	return &ResourceQuotaNotifier{
+		privilegedClient: privilegedClient,
+		namespace:        namespace,
+		interval:         DefaultNotificationCheckInterval,
+		backoff:          defaultNotificationBackoff,
+		httpClient:       &http.Client{Timeout: 10 * time.Second},
+		sendMail:         smtp.SendMail,
+	}
+}
+
+// Run checks the usage of all resource quotas periodically until the context is cancelled.
+func (n *ResourceQuotaNotifier) Run(ctx context.Context, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := n.NotifyUnsecured(ctx, time.Now()); err != nil {
+			log.Errorw("failed to send resource quota notifications", zap.Error(err))
+		}
+	}, n.interval)
+}
+
+// NotifyUnsecured sends notifications for every resource quota whose usage crossed a threshold
+// since the last check.
+//
+// Note that this function:
+// is unsafe in a sense that it uses privileged account to update the resource
+func (n *ResourceQuotaNotifier) NotifyUnsecured(ctx context.Context, now time.Time) error {
+	resourceQuotas := &kubermaticv1.ResourceQuotaList{}
+	if err := n.privilegedClient.List(ctx, resourceQuotas); err != nil {
+		return fmt.Errorf("failed to list resource quotas: %w", err)
+	}
+
+	var errs []error
+	for i := range resourceQuotas.Items {
+		if err := n.notify(ctx, &resourceQuotas.Items[i], now); err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", resourceQuotas.Items[i].Name, err))
+		}
+	}
+
+	if len(errs) > 0 {
+		return fmt.Errorf("failed to notify about %d resource quotas: %v", len(errs), errs)
+	}
+
+	return nil
+}
+
+func (n *ResourceQuotaNotifier) GetConfigUnsecured(ctx context.Context, quotaName string) (*apiv2.ResourceQuotaNotificationConfig, error) {
+	secret, err := n.getSecret(ctx, quotaName)
+	if err != nil {
+		return nil, err
+	}
+
+	config := &apiv2.ResourceQuotaNotificationConfig{
+		Thresholds: []int{},
+		Receivers:  []apiv2.ResourceQuotaNotificationReceiver{},
+	}
+	if secret == nil {
+		return config, nil
+	}
+	if err := decodeSecretKey(secret, notificationConfigKey, config); err != nil {
+		return nil, err
+	}
+
+	return config, nil
+}
+
+func (n *ResourceQuotaNotifier) UpdateConfigUnsecured(ctx context.Context, quotaName string, config *apiv2.ResourceQuotaNotificationConfig) error {
+	resourceQuota := &kubermaticv1.ResourceQuota{}
+	if err := n.privilegedClient.Get(ctx, types.NamespacedName{Name: quotaName}, resourceQuota); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(config)
+	if err != nil {
+		return err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := n.getSecret(ctx, quotaName)
+		if err != nil {
+			return err
+		}
+
+		if secret == nil {
+			secret = &corev1.Secret{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      notificationSecretPrefix + quotaName,
+					Namespace: n.namespace,
+					Labels: map[string]string{
+						usageHistoryQuotaLabelKey: quotaName,
+					},
+					OwnerReferences: []metav1.OwnerReference{
+						*metav1.NewControllerRef(resourceQuota, kubermaticv1.SchemeGroupVersion.WithKind(kubermaticv1.ResourceQuotaKindName)),
+					},
+				},
+				Data: map[string][]byte{notificationConfigKey: data},
+			}
+			return n.privilegedClient.Create(ctx, secret)
+		}
+
+		if secret.Data == nil {
+			secret.Data = map[string][]byte{}
+		}
+		secret.Data[notificationConfigKey] = data
+		return n.privilegedClient.Update(ctx, secret)
+	})
+}
+
+func (n *ResourceQuotaNotifier) ListDeliveriesUnsecured(ctx context.Context, quotaName string) ([]apiv2.ResourceQuotaNotificationDelivery, error) {
+	secret, err := n.getSecret(ctx, quotaName)
+	if err != nil {
+		return nil, err
+	}
+
+	deliveries := []apiv2.ResourceQuotaNotificationDelivery{}
+	if secret == nil {
+		return deliveries, nil
+	}
+	if err := decodeSecretKey(secret, notificationDeliveryKey, &deliveries); err != nil {
+		return nil, err
+	}
+
+	return deliveries, nil
+}
+
+func (n *ResourceQuotaNotifier) notify(ctx context.Context, resourceQuota *kubermaticv1.ResourceQuota, now time.Time) error {
+	secret, err := n.getSecret(ctx, resourceQuota.Name)
+	if err != nil || secret == nil {
+		return err
+	}
+
+	config := apiv2.ResourceQuotaNotificationConfig{}
+	if err := decodeSecretKey(secret, notificationConfigKey, &config); err != nil {
+		return err
+	}
+	state := notificationState{}
+	if err := decodeSecretKey(secret, notificationStateKey, &state); err != nil {
+		return err
+	}
+	if state.Notified == nil {
+		state.Notified = map[string]int{}
+	}
+	var pending []pendingDelivery
+	if err := decodeSecretKey(secret, notificationPendingKey, &pending); err != nil {
+		return err
+	}
+
+	changed := false
+	for _, usage := range quotaUsages(resourceQuota) {
+		crossed := crossedThreshold(config.Thresholds, usage.percentage)
+		previous := state.Notified[usage.resource]
+		if crossed == previous {
+			continue
+		}
+
+		// Going below a threshold re-arms it, so that crossing it again sends another notification.
+		changed = true
+		if crossed == 0 {
+			delete(state.Notified, usage.resource)
+		} else {
+			state.Notified[usage.resource] = crossed
+		}
+
+		if crossed > previous {
+			payload := notificationPayload{
+				Quota:       resourceQuota.Name,
+				SubjectKind: resourceQuota.Spec.Subject.Kind,
+				SubjectName: resourceQuota.Spec.Subject.Name,
+				Resource:    usage.resource,
+				Threshold:   crossed,
+				Percentage:  math.Round(usage.percentage*100) / 100,
+				Usage:       usage.usage.String(),
+				Limit:       usage.limit.String(),
+				Timestamp:   now.UTC(),
+			}
+			for _, receiver := range config.Receivers {
+				pending = append(pending, pendingDelivery{Receiver: receiver.Name, Payload: payload, NextAttempt: now})
+			}
+		}
+	}
+
+	// The due deliveries are scheduled for their next attempt before they are sent, so that a delivery
+	// interrupted by a restart is retried as well.
+	var due []pendingDelivery
+	for i := range pending {
+		if pending[i].NextAttempt.After(now) {
+			continue
+		}
+		pending[i].Attempts++
+		pending[i].NextAttempt = now.Add(n.retryDelay(pending[i].Attempts))
+		due = append(due, pending[i])
+	}
+
+	if !changed && len(due) == 0 {
+		return nil
+	}
+
+	// Every API replica runs the notifier. Storing the new state and the scheduled deliveries first claims
+	// them, replicas which lose the update conflict leave them to the winner.
+	if err := encodeSecretKey(secret, notificationStateKey, state); err != nil {
+		return err
+	}
+	if err := encodeSecretKey(secret, notificationPendingKey, pending); err != nil {
+		return err
+	}
+	if err := n.privilegedClient.Update(ctx, secret); err != nil {
+		if apierrors.IsConflict(err) {
+			return nil
+		}
+		return err
+	}
+
+	receivers := map[string]apiv2.ResourceQuotaNotificationReceiver{}
+	for _, receiver := range config.Receivers {
+		receivers[receiver.Name] = receiver
+	}
+
+	results := make([]deliveryResult, len(due))
+	var wg sync.WaitGroup
+	for i, delivery := range due {
+		wg.Add(1)
+		go func() {
+			defer wg.Done()
+			results[i] = deliveryResult{key: delivery.key()}
+			receiver, ok := receivers[delivery.Receiver]
+			if !ok {
+				results[i].err = errors.New("the receiver has been removed")
+				return
+			}
+			results[i].err = n.deliver(ctx, receiver, delivery.Payload)
+		}()
+	}
+	wg.Wait()
+
+	return n.recordResults(ctx, resourceQuota.Name, results, now)
+}
+
+// retryDelay returns how long to wait after the given number of failed attempts.
+func (n *ResourceQuotaNotifier) retryDelay(attempts int) time.Duration {
+	delay := n.backoff.Duration
+	for i := 1; i < attempts; i++ {
+		delay = time.Duration(float64(delay) * n.backoff.Factor)
+		if n.backoff.Cap > 0 && delay > n.backoff.Cap {
+			return n.backoff.Cap
+		}
+	}
+	return delay
+}
+
+// recordResults removes the delivered notifications and the ones which failed their last attempt from the
+// pending deliveries, and adds both to the delivery log. Failed notifications with attempts left stay
+// pending until their next attempt.
+func (n *ResourceQuotaNotifier) recordResults(ctx context.Context, quotaName string, results []deliveryResult, now time.Time) error {
+	if len(results) == 0 {
+		return nil
+	}
+
+	errs := map[string]error{}
+	for _, result := range results {
+		errs[result.key] = result.err
+	}
+
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := n.getSecret(ctx, quotaName)
+		if err != nil || secret == nil {
+			return err
+		}
+
+		var pending []pendingDelivery
+		if err := decodeSecretKey(secret, notificationPendingKey, &pending); err != nil {
+			return err
+		}
+		log := []apiv2.ResourceQuotaNotificationDelivery{}
+		if err := decodeSecretKey(secret, notificationDeliveryKey, &log); err != nil {
+			return err
+		}
+
+		remaining := make([]pendingDelivery, 0, len(pending))
+		for _, delivery := range pending {
+			err, attempted := errs[delivery.key()]
+			if !attempted || (err != nil && delivery.Attempts < n.backoff.Steps) {
+				remaining = append(remaining, delivery)
+				continue
+			}
+
+			record := apiv2.ResourceQuotaNotificationDelivery{
+				Timestamp: apiv1.NewTime(now),
+				Receiver:  delivery.Receiver,
+				Resource:  delivery.Payload.Resource,
+				Threshold: delivery.Payload.Threshold,
+				Attempts:  delivery.Attempts,
+				Success:   err == nil,
+			}
+			if err != nil {
+				record.Error = err.Error()
+			}
+			log = append(log, record)
+		}
+		if len(log) > maxNotificationDeliveries {
+			log = log[len(log)-maxNotificationDeliveries:]
+		}
+
+		if err := encodeSecretKey(secret, notificationPendingKey, remaining); err != nil {
+			return err
+		}
+		if err := encodeSecretKey(secret, notificationDeliveryKey, log); err != nil {
+			return err
+		}
+
+		return n.privilegedClient.Update(ctx, secret)
+	})
+}
+
+func (n *ResourceQuotaNotifier) deliver(ctx context.Context, receiver apiv2.ResourceQuotaNotificationReceiver, payload notificationPayload) error {
+	switch receiver.Type {
+	case apiv2.ResourceQuotaWebhookReceiver:
+		return n.post(ctx, receiver.URL, payload)
+	case apiv2.ResourceQuotaSlackReceiver:
+		return n.post(ctx, receiver.URL, map[string]string{"text": payload.message()})
+	case apiv2.ResourceQuotaEmailReceiver:
+		msg := fmt.Sprintf("From: %s\r\nTo: %s\r\nSubject: Resource quota %s reached %d%% of its %s limit\r\n\r\n%s\r\n",
+			receiver.From, strings.Join(receiver.To, ", "), payload.Quota, payload.Threshold, payload.Resource, payload.message())
+		return n.sendMail(receiver.SMTPAddress, nil, receiver.From, receiver.To, []byte(msg))
+	default:
+		return fmt.Errorf("unknown receiver type %q", receiver.Type)
+	}
+}
+
+func (n *ResourceQuotaNotifier) post(ctx context.Context, endpoint string, body interface{}) error {
+	data, err := json.Marshal(body)
+	if err != nil {
+		return err
+	}
+
+	req, err := http.NewRequestWithContext(ctx, http.MethodPost, endpoint, bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Content-Type", "application/json")
+
+	resp, err := n.httpClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		return fmt.Errorf("receiver responded with status %d", resp.StatusCode)
+	}
+
+	return nil
+}
+
+// getSecret returns the notification Secret of a resource quota, or nil if none exists.
+func (n *ResourceQuotaNotifier) getSecret(ctx context.Context, quotaName string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := n.privilegedClient.Get(ctx, types.NamespacedName{Namespace: n.namespace, Name: notificationSecretPrefix + quotaName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+
+	return secret, nil
+}
+
+func encodeSecretKey(secret *corev1.Secret, key string, v interface{}) error {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return fmt.Errorf("failed to encode %s: %w", key, err)
+	}
+	secret.Data[key] = data
+
+	return nil
+}
+
+func decodeSecretKey(secret *corev1.Secret, key string, v interface{}) error {
+	raw, ok := secret.Data[key]
+	if !ok || len(raw) == 0 {
+		return nil
+	}
+
+	if err := json.Unmarshal(raw, v); err != nil {
+		return fmt.Errorf("failed to decode %s: %w", key, err)
+	}
+
+	return nil
+}
+
+type quotaUsage struct {
+	resource   string
+	usage      resource.Quantity
+	limit      resource.Quantity
+	percentage float64
+}
+
+// quotaUsages returns the usage of every resource that is limited by the quota, as a percentage of the limit.
+func quotaUsages(resourceQuota *kubermaticv1.ResourceQuota) []quotaUsage {
+	var usages []quotaUsage
+
+	add := func(name string, limit, usage *resource.Quantity) {
+		if limit == nil || limit.IsZero() {
+			return
+		}
+
+		used := resource.Quantity{}
+		if usage != nil {
+			used = *usage
+		}
+
+		usages = append(usages, quotaUsage{
+			resource:   name,
+			usage:      used,
+			limit:      *limit,
+			percentage: used.AsApproximateFloat64() / limit.AsApproximateFloat64() * 100,
+		})
+	}
+
+	add("cpu", resourceQuota.Spec.Quota.CPU, resourceQuota.Status.GlobalUsage.CPU)
+	add("memory", resourceQuota.Spec.Quota.Memory, resourceQuota.Status.GlobalUsage.Memory)
+	add("storage", resourceQuota.Spec.Quota.Storage, resourceQuota.Status.GlobalUsage.Storage)
+
+	return usages
+}
+
+// crossedThreshold returns the highest threshold the percentage has reached, or 0 if none.
+func crossedThreshold(thresholds []int, percentage float64) int {
+	crossed := 0
+	for _, threshold := range thresholds {
+		if float64(threshold) <= percentage && threshold > crossed {
+			crossed = threshold
+		}
+	}
+
+	return crossed
+}
+
+// ValidateNotificationConfig checks the thresholds and receivers of a notification config.
+func ValidateNotificationConfig(config apiv2.ResourceQuotaNotificationConfig) error {
+	thresholds := sets.New[int]()
+	for _, threshold := range config.Thresholds {
+		if threshold < 1 || threshold > 100 {
+			return utilerrors.NewBadRequest("threshold %d must be between 1 and 100", threshold)
+		}
+		if thresholds.Has(threshold) {
+			return utilerrors.NewBadRequest("duplicate threshold %d", threshold)
+		}
+		thresholds.Insert(threshold)
+	}
+
+	names := sets.New[string]()
+	for _, receiver := range config.Receivers {
+		if receiver.Name == "" {
+			return utilerrors.NewBadRequest("receiver name cannot be empty")
+		}
+		if names.Has(receiver.Name) {
+			return utilerrors.NewBadRequest("duplicate receiver %q", receiver.Name)
+		}
+		names.Insert(receiver.Name)
+
+		switch receiver.Type {
+		case apiv2.ResourceQuotaWebhookReceiver, apiv2.ResourceQuotaSlackReceiver:
+			u, err := url.Parse(receiver.URL)
+			if err != nil || (u.Scheme != "http" && u.Scheme != "https") || u.Host == "" {
+				return utilerrors.NewBadRequest("receiver %q requires a valid http(s) URL", receiver.Name)
+			}
+		case apiv2.ResourceQuotaEmailReceiver:
+			if receiver.SMTPAddress == "" || receiver.From == "" || len(receiver.To) == 0 {
+				return utilerrors.NewBadRequest("receiver %q requires an SMTP address, a sender and at least one recipient", receiver.Name)
+			}
+		default:
+			return utilerrors.NewBadRequest("receiver %q has unknown type %q, must be one of webhook, slack or email", receiver.Name, receiver.Type)
+		}
+	}
+
+	return nil
+}
@@ -0,0 +1,246 @@
+//go:build ee
+
+/*
+                  Kubermatic Enterprise Read-Only License
+                         Version 1.0 ("KERO-1.0”)
+                     Copyright © 2026 Kubermatic GmbH
+
+   1.	You may only view, read and display for studying purposes the source
+      code of the software licensed under this license, and, to the extent
+      explicitly provided under this license, the binary code.
+   2.	Any use of the software which exceeds the foregoing right, including,
+      without limitation, its execution, compilation, copying, modification
+      and distribution, is expressly prohibited.
+   3.	THE SOFTWARE IS PROVIDED “AS IS”, WITHOUT WARRANTY OF ANY KIND,
+      EXPRESS OR IMPLIED, INCLUDING BUT NOT LIMITED TO THE WARRANTIES OF
+      MERCHANTABILITY, FITNESS FOR A PARTICULAR PURPOSE AND NONINFRINGEMENT.
+      IN NO EVENT SHALL THE AUTHORS OR COPYRIGHT HOLDERS BE LIABLE FOR ANY
+      CLAIM, DAMAGES OR OTHER LIABILITY, WHETHER IN AN ACTION OF CONTRACT,
+      TORT OR OTHERWISE, ARISING FROM, OUT OF OR IN CONNECTION WITH THE
+      SOFTWARE OR THE USE OR OTHER DEALINGS IN THE SOFTWARE.
+
+   END OF TERMS AND CONDITIONS
+*/
+
+package resourcequota
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+	"net/http/httptest"
+	"net/smtp"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// receiverStub records the bodies posted to it, after failing the given number of requests.
+type receiverStub struct {
+	lock     sync.Mutex
+	failures int
+	bodies   []map[string]interface{}
+}
+
+func (s *receiverStub) ServeHTTP(w http.ResponseWriter, r *http.Request) {
+	s.lock.Lock()
+	defer s.lock.Unlock()
+
+	if s.failures > 0 {
+		s.failures--
+		w.WriteHeader(http.StatusServiceUnavailable)
+		return
+	}
+
+	body := map[string]interface{}{}
+	_ = json.NewDecoder(r.Body).Decode(&body)
+	s.bodies = append(s.bodies, body)
+}
+
+func TestNotifyUnsecured(t *testing.T) {
+	quota := &kubermaticv1.ResourceQuota{
+		ObjectMeta: metav1.ObjectMeta{Name: "project-my-project"},
+		Spec: kubermaticv1.ResourceQuotaSpec{
+			Subject: kubermaticv1.Subject{Name: "my-project", Kind: kubermaticv1.ProjectSubjectKind},
+			Quota:   kubermaticv1.ResourceDetails{CPU: ptr.To(resource.MustParse("10"))},
+		},
+		Status: kubermaticv1.ResourceQuotaStatus{
+			GlobalUsage: kubermaticv1.ResourceDetails{CPU: ptr.To(resource.MustParse("8"))},
+		},
+	}
+
+	webhook := &receiverStub{failures: 1}
+	webhookServer := httptest.NewServer(webhook)
+	defer webhookServer.Close()
+
+	slack := &receiverStub{}
+	slackServer := httptest.NewServer(slack)
+	defer slackServer.Close()
+
+	down := &receiverStub{failures: 100}
+	downServer := httptest.NewServer(down)
+	defer downServer.Close()
+
+	var (
+		mailLock sync.Mutex
+		mails    []string
+	)
+	client := fake.NewClientBuilder().WithObjects(quota).Build()
+	notifier := NewResourceQuotaNotifier(client, "kubermatic")
+	notifier.backoff = wait.Backoff{Duration: time.Minute, Factor: 2, Steps: 3}
+	notifier.sendMail = func(addr string, _ smtp.Auth, from string, to []string, msg []byte) error {
+		mailLock.Lock()
+		defer mailLock.Unlock()
+		mails = append(mails, string(msg))
+		return nil
+	}
+
+	ctx := context.Background()
+	config := &apiv2.ResourceQuotaNotificationConfig{
+		Thresholds: []int{80, 95},
+		Receivers: []apiv2.ResourceQuotaNotificationReceiver{
+			{Name: "generic", Type: apiv2.ResourceQuotaWebhookReceiver, URL: webhookServer.URL},
+			{Name: "chat", Type: apiv2.ResourceQuotaSlackReceiver, URL: slackServer.URL},
+			{Name: "ops", Type: apiv2.ResourceQuotaEmailReceiver, SMTPAddress: "localhost:25", From: "kkp@example.com", To: []string{"ops@example.com"}},
+			{Name: "down", Type: apiv2.ResourceQuotaWebhookReceiver, URL: downServer.URL},
+		},
+	}
+	if err := notifier.UpdateConfigUnsecured(ctx, quota.Name, config); err != nil {
+		t.Fatalf("failed to set notification config: %v", err)
+	}
+
+	setUsage := func(cpu string) {
+		current := &kubermaticv1.ResourceQuota{}
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(quota), current); err != nil {
+			t.Fatalf("failed to get resource quota: %v", err)
+		}
+		current.Status.GlobalUsage.CPU = ptr.To(resource.MustParse(cpu))
+		if err := client.Status().Update(ctx, current); err != nil {
+			t.Fatalf("failed to update resource quota: %v", err)
+		}
+	}
+
+	notify := func(now time.Time) {
+		if err := notifier.NotifyUnsecured(ctx, now); err != nil {
+			t.Fatalf("failed to notify: %v", err)
+		}
+	}
+
+	// 80% is crossed, the webhook only succeeds on its second attempt a minute later
+	start := time.Now()
+	notify(start)
+	// nothing changed and no retry is due, nothing is sent
+	notify(start)
+	notify(start.Add(time.Minute))
+	// dropping below 80% re-arms the threshold, crossing 95% sends another notification
+	setUsage("5")
+	notify(start.Add(2 * time.Minute))
+	setUsage("10")
+	// the third attempt of the receiver which is down is its last one
+	notify(start.Add(3 * time.Minute))
+
+	if len(webhook.bodies) != 2 {
+		t.Fatalf("expected 2 webhook notifications, got %d", len(webhook.bodies))
+	}
+	if webhook.bodies[0]["threshold"] != float64(80) || webhook.bodies[1]["threshold"] != float64(95) {
+		t.Errorf("expected notifications for 80%% and 95%%, got %v", webhook.bodies)
+	}
+	if webhook.bodies[0]["resource"] != "cpu" || webhook.bodies[0]["subjectName"] != "my-project" {
+		t.Errorf("unexpected webhook payload %v", webhook.bodies[0])
+	}
+
+	if len(slack.bodies) != 2 {
+		t.Fatalf("expected 2 slack notifications, got %d", len(slack.bodies))
+	}
+	if text, _ := slack.bodies[0]["text"].(string); !strings.Contains(text, "crossing the 80% threshold") {
+		t.Errorf("unexpected slack message %q", text)
+	}
+
+	if len(mails) != 2 || !strings.Contains(mails[1], "Subject: Resource quota project-my-project reached 95% of its cpu limit") {
+		t.Errorf("unexpected mails %v", mails)
+	}
+
+	deliveries, err := notifier.ListDeliveriesUnsecured(ctx, quota.Name)
+	if err != nil {
+		t.Fatalf("failed to list deliveries: %v", err)
+	}
+	// the second notification of the receiver which is down is still pending
+	if len(deliveries) != 7 {
+		t.Fatalf("expected 7 deliveries, got %d: %+v", len(deliveries), deliveries)
+	}
+	for _, delivery := range deliveries {
+		switch {
+		case delivery.Receiver == "generic" && delivery.Threshold == 80:
+			if delivery.Attempts != 2 || !delivery.Success {
+				t.Errorf("expected the first webhook delivery to succeed after 2 attempts, got %+v", delivery)
+			}
+		case delivery.Receiver == "down":
+			if delivery.Threshold != 80 || delivery.Attempts != 3 || delivery.Success || !strings.Contains(delivery.Error, "status 503") {
+				t.Errorf("expected the delivery to the receiver which is down to fail after 3 attempts, got %+v", delivery)
+			}
+		}
+	}
+}
+
+func TestValidateNotificationConfig(t *testing.T) {
+	testCases := []struct {
+		name        string
+		config      apiv2.ResourceQuotaNotificationConfig
+		expectedErr string
+	}{
+		{
+			name: "valid config",
+			config: apiv2.ResourceQuotaNotificationConfig{
+				Thresholds: []int{80, 95},
+				Receivers: []apiv2.ResourceQuotaNotificationReceiver{
+					{Name: "chat", Type: apiv2.ResourceQuotaSlackReceiver, URL: "https://hooks.slack.com/services/T/B/X"},
+				},
+			},
+		},
+		{
+			name:        "threshold out of range",
+			config:      apiv2.ResourceQuotaNotificationConfig{Thresholds: []int{120}},
+			expectedErr: "threshold 120 must be between 1 and 100",
+		},
+		{
+			name: "webhook without URL",
+			config: apiv2.ResourceQuotaNotificationConfig{
+				Receivers: []apiv2.ResourceQuotaNotificationReceiver{{Name: "generic", Type: apiv2.ResourceQuotaWebhookReceiver}},
+			},
+			expectedErr: `receiver "generic" requires a valid http(s) URL`,
+		},
+		{
+			name: "email without recipients",
+			config: apiv2.ResourceQuotaNotificationConfig{
+				Receivers: []apiv2.ResourceQuotaNotificationReceiver{{Name: "ops", Type: apiv2.ResourceQuotaEmailReceiver, SMTPAddress: "localhost:25", From: "kkp@example.com"}},
+			},
+			expectedErr: `receiver "ops" requires an SMTP address, a sender and at least one recipient`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := ValidateNotificationConfig(tc.config)
+			if tc.expectedErr == "" {
+				if err != nil {
+					t.Fatalf("expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedErr) {
+				t.Fatalf("expected error %q, got %v", tc.expectedErr, err)
+			}
+		})
+	}
+}
@@ -0,0 +1,161 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"fmt"
+	"os"
+	"strings"
+
+	"github.com/gorilla/securecookie"
+
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/yaml"
+)
+
+// DefaultOIDCIssuerName selects the issuer configured with --oidc-url in the login flow.
+const DefaultOIDCIssuerName = "default"
+
+// OIDCIssuers is the format of the --oidc-issuers-file, which configures issuers in addition to the one configured
+// with --oidc-url.
+type OIDCIssuers struct {
+	Issuers []OIDCIssuer `json:"issuers"`
+}
+
+// OIDCIssuer is an additional issuer for the dashboard login.
+type OIDCIssuer struct {
+	// Name selects the issuer in the login flow, /api/v2/auth/login?issuer=<name>.
+	Name string `json:"name"`
+	// DisplayName is shown on the login page, defaults to the name.
+	DisplayName string `json:"displayName,omitempty"`
+	URL         string `json:"url"`
+	// ClientID and ClientSecret are the credentials of the dashboard login.
+	ClientID     string `json:"clientID"`
+	ClientSecret string `json:"clientSecret"`
+	// AuthenticatorClientID is the audience of the tokens accepted by the API, defaults to the client ID.
+	AuthenticatorClientID string `json:"authenticatorClientID,omitempty"`
+	SkipTLSVerify         bool   `json:"skipTLSVerify,omitempty"`
+	// OfflineAccessAsScope requests refresh tokens with the offline_access scope instead of access_type=offline,
+	// defaults to true.
+	OfflineAccessAsScope *bool `json:"offlineAccessAsScope,omitempty"`
+	// EmailClaim and GroupsClaim are the claims holding the email and the groups of the user, they default to
+	// "email" and "groups".
+	EmailClaim  string `json:"emailClaim,omitempty"`
+	GroupsClaim string `json:"groupsClaim,omitempty"`
+	// GroupMapping renames groups of the issuer to KKP groups, groups that are not mapped get the groups prefix.
+	GroupMapping map[string]string `json:"groupMapping,omitempty"`
+	GroupsPrefix string            `json:"groupsPrefix,omitempty"`
+	// AllowedEmailDomains restricts the users of the issuer to these email domains. Users are identified by their
+	// email across all issuers, so at least one domain is required and the issuer must verify the emails.
+	AllowedEmailDomains []string `json:"allowedEmailDomains"`
+}
+
+// NewOIDCIssuersFromFile reads and validates an --oidc-issuers-file.
+func NewOIDCIssuersFromFile(filename string) ([]OIDCIssuer, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	issuers := OIDCIssuers{}
+	if err := yaml.UnmarshalStrict(data, &issuers); err != nil {
+		return nil, fmt.Errorf("failed to parse OIDC issuers: %w", err)
+	}
+
+	if err := validateOIDCIssuers(issuers.Issuers); err != nil {
+		return nil, err
+	}
+	return issuers.Issuers, nil
+}
+
+func validateOIDCIssuers(issuers []OIDCIssuer) error {
+	names := sets.New(DefaultOIDCIssuerName)
+	urls := sets.New[string]()
+	for i, issuer := range issuers {
+		if errs := validation.IsDNS1123Label(issuer.Name); len(errs) > 0 {
+			return fmt.Errorf("issuer %d: invalid name %q: %v", i, issuer.Name, errs)
+		}
+		if names.Has(issuer.Name) {
+			return fmt.Errorf("issuer %d: the name %q is already used", i, issuer.Name)
+		}
+		names.Insert(issuer.Name)
+
+		if issuer.URL == "" {
+			return fmt.Errorf("issuer %s: the URL is required", issuer.Name)
+		}
+		// tokens are verified by the issuer of their iss claim, which must be unambiguous
+		if urls.Has(issuer.URL) {
+			return fmt.Errorf("issuer %s: the URL %s is already used by another issuer", issuer.Name, issuer.URL)
+		}
+		urls.Insert(issuer.URL)
+
+		if issuer.ClientID == "" {
+			return fmt.Errorf("issuer %s: the client ID is required", issuer.Name)
+		}
+		if len(issuer.AllowedEmailDomains) == 0 {
+			return fmt.Errorf("issuer %s: at least one allowed email domain is required", issuer.Name)
+		}
+		for _, domain := range issuer.AllowedEmailDomains {
+			if errs := validation.IsDNS1123Subdomain(strings.ToLower(domain)); len(errs) > 0 {
+				return fmt.Errorf("issuer %s: invalid allowed email domain %q: %v", issuer.Name, domain, errs)
+			}
+		}
+	}
+	return nil
+}
+
+// IssuerConfiguration returns the configuration of the login flow of the issuer. The cookies are shared by all
+// issuers.
+func (i OIDCIssuer) IssuerConfiguration(secureCookie *securecookie.SecureCookie, cookieSecureMode bool) *authtypes.OIDCConfiguration {
+	config := i.authenticatorConfiguration(i.ClientID)
+	config.ClientSecret = i.ClientSecret
+	config.SecureCookie = secureCookie
+	config.CookieSecureMode = cookieSecureMode
+	config.OfflineAccessAsScope = i.OfflineAccessAsScope == nil || *i.OfflineAccessAsScope
+	return config
+}
+
+// AuthenticatorConfiguration returns the configuration of the verification of the tokens sent to the API.
+func (i OIDCIssuer) AuthenticatorConfiguration() *authtypes.OIDCConfiguration {
+	clientID := i.AuthenticatorClientID
+	if clientID == "" {
+		clientID = i.ClientID
+	}
+	return i.authenticatorConfiguration(clientID)
+}
+
+func (i OIDCIssuer) authenticatorConfiguration(clientID string) *authtypes.OIDCConfiguration {
+	displayName := i.DisplayName
+	if displayName == "" {
+		displayName = i.Name
+	}
+	return &authtypes.OIDCConfiguration{
+		URL:                 i.URL,
+		ClientID:            clientID,
+		SkipTLSVerify:       i.SkipTLSVerify,
+		Name:                i.Name,
+		DisplayName:         displayName,
+		EmailClaim:          i.EmailClaim,
+		GroupsClaim:         i.GroupsClaim,
+		GroupMapping:        i.GroupMapping,
+		GroupsPrefix:        i.GroupsPrefix,
+		AllowedEmailDomains: i.AllowedEmailDomains,
+	}
+}
@@ -42,6 +42,7 @@ type OpenIDClient struct {
 	redirectURI    string
 	verifier       *oidc.IDTokenVerifier
 	provider       *oidc.Provider
+	issuer         string
 	httpClient     *http.Client
 }
 
@@ -82,6 +83,7 @@ func NewOpenIDClient(
 		redirectURI:    redirectURI,
 		verifier:       p.Verifier(&oidc.Config{ClientID: oidcConfig.ClientID}),
 		provider:       p,
+		issuer:         oidcConfig.URL,
 		httpClient:     client,
 		oidcConfig:     oidcConfig,
 	}, nil
@@ -117,16 +119,18 @@ func (o *OpenIDClient) Verify(ctx context.Context, token string) (authtypes.Toke
 	if rawName, found := claims["name"]; found {
 		oidcClaims.Name = rawName.(string)
 	}
-	if rawEmail, found := claims["email"]; found {
-		oidcClaims.Email = rawEmail.(string)
+	if rawEmail, found := claims[o.emailClaim()]; found {
+		oidcClaims.Email, _ = rawEmail.(string)
 	}
 	if rawSub, found := claims["sub"]; found {
 		oidcClaims.Subject = rawSub.(string)
 	}
-	if rawGroups, found := claims["groups"]; found {
-		for _, rawGroup := range rawGroups.([]interface{}) {
-			if group, ok := rawGroup.(string); ok {
-				oidcClaims.Groups = append(oidcClaims.Groups, group)
+	if rawGroups, found := claims[o.groupsClaim()]; found {
+		if rawGroups, ok := rawGroups.([]interface{}); ok {
+			for _, rawGroup := range rawGroups {
+				if group, ok := rawGroup.(string); ok {
+					oidcClaims.Groups = append(oidcClaims.Groups, o.mapGroup(group))
+				}
 			}
 		}
 	}
@@ -141,10 +145,85 @@ func (o *OpenIDClient) Verify(ctx context.Context, token string) (authtypes.Toke
 		nsecs := int64((exp - float64(secs)) * 1e9)
 		oidcClaims.Expiry = apiv1.NewTime(time.Unix(secs, nsecs))
 	}
+	if rawAuthTime, found := claims["auth_time"]; found {
+		if authTime, ok := rawAuthTime.(float64); ok {
+			oidcClaims.AuthTime = apiv1.NewTime(time.Unix(int64(authTime), 0))
+		}
+	}
+
+	if err := o.validateEmail(claims, oidcClaims.Email); err != nil {
+		return authtypes.TokenClaims{}, err
+	}
 
 	return oidcClaims, nil
 }
 
+// Issuer returns the identifier of the issuer, which is the iss claim of its tokens. The discovery made sure that
+// the issuer identifies itself with the configured URL.
+func (o *OpenIDClient) Issuer() string {
+	return o.issuer
+}
+
+func (o *OpenIDClient) emailClaim() string {
+	if o.oidcConfig.EmailClaim != "" {
+		return o.oidcConfig.EmailClaim
+	}
+	return "email"
+}
+
+func (o *OpenIDClient) groupsClaim() string {
+	if o.oidcConfig.GroupsClaim != "" {
+		return o.oidcConfig.GroupsClaim
+	}
+	return "groups"
+}
+
+// mapGroup maps a group of the issuer to a KKP group. Prefixing the groups keeps groups of the same name in
+// different identity providers apart.
+func (o *OpenIDClient) mapGroup(group string) string {
+	if mapped, ok := o.oidcConfig.GroupMapping[group]; ok {
+		return mapped
+	}
+	return o.oidcConfig.GroupsPrefix + group
+}
+
+// validateEmail verifies that the issuer may authenticate the user with the given email. Users are identified by
+// their email across all issuers, so additional issuers must have verified the email and may only authenticate
+// users of their allowed domains. The issuer configured with --oidc-url is trusted for all domains.
+func (o *OpenIDClient) validateEmail(claims map[string]interface{}, email string) error {
+	if o.oidcConfig.Name == "" {
+		if o.isEmailDomainAllowed(email) {
+			return nil
+		}
+		return fmt.Errorf("the email domain of %q is not allowed for the issuer %s", email, o.issuer)
+	}
+
+	if verified, _ := claims["email_verified"].(bool); !verified {
+		return fmt.Errorf("the email %q is not verified by the issuer %s", email, o.issuer)
+	}
+	// the domains are required for additional issuers, an empty list must not allow all of them
+	if len(o.oidcConfig.AllowedEmailDomains) == 0 || !o.isEmailDomainAllowed(email) {
+		return fmt.Errorf("the email domain of %q is not allowed for the issuer %s", email, o.issuer)
+	}
+	return nil
+}
+
+func (o *OpenIDClient) isEmailDomainAllowed(email string) bool {
+	if len(o.oidcConfig.AllowedEmailDomains) == 0 {
+		return true
+	}
+	_, domain, found := strings.Cut(email, "@")
+	if !found {
+		return false
+	}
+	for _, allowed := range o.oidcConfig.AllowedEmailDomains {
+		if strings.EqualFold(domain, allowed) {
+			return true
+		}
+	}
+	return false
+}
+
 // AuthCodeURL returns a URL to OpenID provider's consent page
 // that asks for permissions for the required scopes explicitly.
 //
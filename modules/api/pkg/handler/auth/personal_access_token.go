@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"crypto/subtle"
+	"errors"
+	"fmt"
+	"strconv"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/provider"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/log"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+const (
+	// PersonalAccessTokensDisabledAnnotation disables the personal access tokens when set to true on the global settings.
+	PersonalAccessTokensDisabledAnnotation = "dashboard.k8c.io/personal-access-tokens-disabled"
+	// PersonalAccessTokenMaxLifetimeAnnotation caps the lifetime of personal access tokens when set on the global
+	// settings, the value is a duration like 720h.
+	PersonalAccessTokenMaxLifetimeAnnotation = "dashboard.k8c.io/personal-access-token-max-lifetime"
+
+	// personalAccessTokenLastUsedInterval throttles the updates of the last used time of a token.
+	personalAccessTokenLastUsedInterval = time.Minute
+)
+
+// PersonalAccessTokensDisabled tells whether the admins disabled the personal access tokens.
+func PersonalAccessTokensDisabled(settings *kubermaticv1.KubermaticSetting) bool {
+	disabled, _ := strconv.ParseBool(settings.Annotations[PersonalAccessTokensDisabledAnnotation])
+	return disabled
+}
+
+// PersonalAccessTokenMaxLifetime returns the maximum lifetime of personal access tokens, zero if it is not capped.
+func PersonalAccessTokenMaxLifetime(settings *kubermaticv1.KubermaticSetting) (time.Duration, error) {
+	value := settings.Annotations[PersonalAccessTokenMaxLifetimeAnnotation]
+	if value == "" {
+		return 0, nil
+	}
+	maxLifetime, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid maximum lifetime of personal access tokens %q: %w", value, err)
+	}
+	if maxLifetime <= 0 {
+		return 0, fmt.Errorf("invalid maximum lifetime of personal access tokens %q: must be positive", value)
+	}
+	return maxLifetime, nil
+}
+
+// PersonalAccessTokenVerifier implements TokenVerifier interface for the personal access tokens of users.
+type PersonalAccessTokenVerifier struct {
+	tokenProvider    provider.PersonalAccessTokenProvider
+	settingsProvider provider.SettingsProvider
+}
+
+var _ authtypes.TokenVerifier = &PersonalAccessTokenVerifier{}
+
+// NewPersonalAccessTokenVerifier returns a verifier of personal access tokens.
+func NewPersonalAccessTokenVerifier(tokenProvider provider.PersonalAccessTokenProvider, settingsProvider provider.SettingsProvider) *PersonalAccessTokenVerifier {
+	return &PersonalAccessTokenVerifier{tokenProvider: tokenProvider, settingsProvider: settingsProvider}
+}
+
+// Verify checks the secret of the token and returns the identity of the user who created it. Tokens become
+// invalid when they expire, are revoked, are older than the maximum lifetime or the admins disable the feature.
+func (v *PersonalAccessTokenVerifier) Verify(ctx context.Context, token string) (authtypes.TokenClaims, error) {
+	id, secret, ok := provider.ParsePersonalAccessTokenValue(token)
+	if !ok {
+		return authtypes.TokenClaims{}, errors.New("pat: not a personal access token")
+	}
+
+	settings, err := v.settingsProvider.GetGlobalSettings(ctx)
+	if err != nil {
+		return authtypes.TokenClaims{}, fmt.Errorf("pat: failed to get the global settings: %w", err)
+	}
+	if PersonalAccessTokensDisabled(settings) {
+		return authtypes.TokenClaims{}, &TokenExpiredError{msg: "pat: personal access tokens are disabled"}
+	}
+
+	tokenExpiredMsg := fmt.Sprintf("pat: the token %s has expired or has been revoked", id)
+	stored, err := v.tokenProvider.Get(ctx, id)
+	if apierrors.IsNotFound(err) {
+		return authtypes.TokenClaims{}, &TokenExpiredError{msg: tokenExpiredMsg}
+	}
+	if err != nil {
+		return authtypes.TokenClaims{}, fmt.Errorf("pat: failed to get the token %s: %w", id, err)
+	}
+	if subtle.ConstantTimeCompare([]byte(provider.PersonalAccessTokenSecretHash(secret)), []byte(stored.SecretHash)) != 1 {
+		return authtypes.TokenClaims{}, &TokenExpiredError{msg: tokenExpiredMsg}
+	}
+
+	// tokens created before the admins lowered the maximum lifetime expire early
+	expiry := stored.Expiry
+	maxLifetime, err := PersonalAccessTokenMaxLifetime(settings)
+	if err != nil {
+		return authtypes.TokenClaims{}, fmt.Errorf("pat: %w", err)
+	}
+	if maxLifetime > 0 {
+		if maxExpiry := stored.CreationTimestamp.Add(maxLifetime); expiry.IsZero() || maxExpiry.Before(expiry) {
+			expiry = maxExpiry
+		}
+	}
+	now := time.Now()
+	if !expiry.IsZero() && !expiry.After(now) {
+		return authtypes.TokenClaims{}, &TokenExpiredError{msg: tokenExpiredMsg}
+	}
+
+	// Throttle the last used update not to pressure the token store too much.
+	if now.Sub(stored.LastUsed) >= personalAccessTokenLastUsedInterval {
+		if err := v.tokenProvider.UpdateLastUsed(ctx, id, now); err != nil {
+			log.Logger.Debugw("Failed to update the last used time of the personal access token", "token", id, "error", err)
+		}
+	}
+
+	claims := authtypes.TokenClaims{
+		Name:     id,
+		Email:    stored.UserEmail,
+		Subject:  stored.UserEmail,
+		ReadOnly: stored.ReadOnly,
+	}
+	if !expiry.IsZero() {
+		claims.Expiry = apiv1.NewTime(expiry)
+	}
+	return claims, nil
+}
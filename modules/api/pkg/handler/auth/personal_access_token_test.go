@@ -0,0 +1,144 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/provider/memory"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPersonalAccessTokenVerifier(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tokenProvider := memory.NewPersonalAccessTokenProvider()
+	createToken := func(id string, token provider.PersonalAccessToken) string {
+		token.ID = id
+		token.UserEmail = "john@acme.com"
+		token.SecretHash = provider.PersonalAccessTokenSecretHash("secret-" + id)
+		if err := tokenProvider.Create(ctx, &token); err != nil {
+			t.Fatal(err)
+		}
+		return provider.NewPersonalAccessTokenValue(id, "secret-"+id)
+	}
+	fullAccess := createToken("full", provider.PersonalAccessToken{})
+	readOnly := createToken("readonly", provider.PersonalAccessToken{ReadOnly: true, Expiry: time.Now().Add(time.Hour)})
+	old := createToken("old", provider.PersonalAccessToken{CreationTimestamp: time.Now().Add(-48 * time.Hour)})
+
+	settingsFor := func(annotations map[string]string) provider.SettingsProvider {
+		return kubernetesprovider.NewSettingsProvider(fake.NewClientBuilder().WithObjects(&kubermaticv1.KubermaticSetting{
+			ObjectMeta: metav1.ObjectMeta{Name: kubermaticv1.GlobalSettingsName, Annotations: annotations},
+		}).Build())
+	}
+
+	testCases := []struct {
+		name             string
+		token            string
+		annotations      map[string]string
+		expectedReadOnly bool
+		expectedExpired  bool
+		expectedError    bool
+	}{
+		{
+			name:  "full access token",
+			token: fullAccess,
+		},
+		{
+			name:             "read-only token",
+			token:            readOnly,
+			expectedReadOnly: true,
+		},
+		{
+			name:          "other tokens are left to the other plugins",
+			token:         "header.payload.signature",
+			expectedError: true,
+		},
+		{
+			name:            "wrong secret",
+			token:           provider.NewPersonalAccessTokenValue("full", "guessed"),
+			expectedExpired: true,
+		},
+		{
+			name:            "unknown token",
+			token:           provider.NewPersonalAccessTokenValue("unknown", "secret-unknown"),
+			expectedExpired: true,
+		},
+		{
+			name:            "disabled by the admins",
+			token:           fullAccess,
+			annotations:     map[string]string{PersonalAccessTokensDisabledAnnotation: "true"},
+			expectedExpired: true,
+		},
+		{
+			name:            "older than the maximum lifetime",
+			token:           old,
+			annotations:     map[string]string{PersonalAccessTokenMaxLifetimeAnnotation: "24h"},
+			expectedExpired: true,
+		},
+		{
+			name:        "within the maximum lifetime",
+			token:       fullAccess,
+			annotations: map[string]string{PersonalAccessTokenMaxLifetimeAnnotation: "24h"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			verifier := NewPersonalAccessTokenVerifier(tokenProvider, settingsFor(tc.annotations))
+			claims, err := verifier.Verify(ctx, tc.token)
+
+			var expired *TokenExpiredError
+			if isExpired := errors.As(err, &expired); isExpired != tc.expectedExpired {
+				t.Fatalf("expected expired error %v, got %v", tc.expectedExpired, err)
+			}
+			if tc.expectedExpired || tc.expectedError {
+				if err == nil {
+					t.Fatal("expected an error")
+				}
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+			if claims.Email != "john@acme.com" || claims.Subject != "john@acme.com" {
+				t.Errorf("expected the identity of the user, got %+v", claims)
+			}
+			if claims.ReadOnly != tc.expectedReadOnly {
+				t.Errorf("expected read-only %v, got %v", tc.expectedReadOnly, claims.ReadOnly)
+			}
+		})
+	}
+
+	// using a token records when it was last used
+	token, err := tokenProvider.Get(ctx, "full")
+	if err != nil {
+		t.Fatal(err)
+	}
+	if time.Since(token.LastUsed) > time.Minute {
+		t.Errorf("expected the last used time to be updated, got %v", token.LastUsed)
+	}
+}
@@ -18,8 +18,11 @@ package auth
 
 import (
 	"context"
+	"encoding/base64"
+	"encoding/json"
 	"errors"
 	"net/http"
+	"strings"
 
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
@@ -39,6 +42,7 @@ func NewTokenVerifierPlugins(plugins []authtypes.TokenVerifier) *TokenVerifierPl
 }
 
 // Verify calls all registered plugins to check the given token.
+// Tokens of an issuer with a dedicated plugin are only checked by the plugins of that issuer.
 // This method stops when a token has been validated and doesn't try remaining plugins.
 // If all plugins were checked an error is returned.
 func (p *TokenVerifierPlugins) Verify(ctx context.Context, token string) (authtypes.TokenClaims, error) {
@@ -46,7 +50,7 @@ func (p *TokenVerifierPlugins) Verify(ctx context.Context, token string) (authty
 		return authtypes.TokenClaims{}, errors.New("cannot validate the token - no plugins registered")
 	}
 	var errList []error
-	for _, plugin := range p.plugins {
+	for _, plugin := range p.pluginsFor(token) {
 		claims, err := plugin.Verify(ctx, token)
 		if err == nil {
 			return claims, nil
@@ -62,6 +66,45 @@ func (p *TokenVerifierPlugins) Verify(ctx context.Context, token string) (authty
 	return authtypes.TokenClaims{}, utilerrors.NewAggregate(errList)
 }
 
+// pluginsFor returns the plugins of the issuer of the token, or all plugins if no plugin is dedicated to the issuer.
+func (p *TokenVerifierPlugins) pluginsFor(token string) []authtypes.TokenVerifier {
+	issuer := unverifiedTokenIssuer(token)
+	if issuer == "" {
+		return p.plugins
+	}
+
+	var plugins []authtypes.TokenVerifier
+	for _, plugin := range p.plugins {
+		if issuerPlugin, ok := plugin.(authtypes.IssuerTokenVerifier); ok && issuerPlugin.Issuer() == issuer {
+			plugins = append(plugins, plugin)
+		}
+	}
+	if len(plugins) == 0 {
+		return p.plugins
+	}
+	return plugins
+}
+
+// unverifiedTokenIssuer returns the iss claim of a JWT without verifying the token. It is only used to select the
+// verifier, which then verifies the token.
+func unverifiedTokenIssuer(token string) string {
+	parts := strings.Split(token, ".")
+	if len(parts) != 3 {
+		return ""
+	}
+	payload, err := base64.RawURLEncoding.DecodeString(strings.TrimRight(parts[1], "="))
+	if err != nil {
+		return ""
+	}
+	claims := struct {
+		Issuer string `json:"iss"`
+	}{}
+	if err := json.Unmarshal(payload, &claims); err != nil {
+		return ""
+	}
+	return claims.Issuer
+}
+
 var _ authtypes.TokenExtractor = &TokenExtractorPlugins{}
 
 // TokenExtractorPlugins implements TokenExtractor
@@ -0,0 +1,218 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"testing"
+
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+)
+
+type fakeIssuerVerifier struct {
+	issuer string
+	calls  int
+}
+
+func (f *fakeIssuerVerifier) Verify(_ context.Context, _ string) (authtypes.TokenClaims, error) {
+	f.calls++
+	return authtypes.TokenClaims{Email: "user@" + f.issuer}, nil
+}
+
+func (f *fakeIssuerVerifier) Issuer() string {
+	return f.issuer
+}
+
+func tokenOfIssuer(issuer string) string {
+	payload := base64.RawURLEncoding.EncodeToString(fmt.Appendf(nil, `{"iss":%q}`, issuer))
+	return "header." + payload + ".signature"
+}
+
+func TestTokenVerifierPluginsRouteByIssuer(t *testing.T) {
+	testCases := []struct {
+		name          string
+		token         string
+		expectedEmail string
+	}{
+		{
+			name:          "token of the second issuer is only verified by its plugin",
+			token:         tokenOfIssuer("second.example.com"),
+			expectedEmail: "user@second.example.com",
+		},
+		{
+			name:          "token of an unknown issuer falls back to all plugins",
+			token:         tokenOfIssuer("unknown.example.com"),
+			expectedEmail: "user@first.example.com",
+		},
+		{
+			name:          "opaque token falls back to all plugins",
+			token:         "not-a-jwt",
+			expectedEmail: "user@first.example.com",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			plugins := NewTokenVerifierPlugins([]authtypes.TokenVerifier{
+				&fakeIssuerVerifier{issuer: "first.example.com"},
+				&fakeIssuerVerifier{issuer: "second.example.com"},
+			})
+
+			claims, err := plugins.Verify(context.Background(), tc.token)
+			if err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			if claims.Email != tc.expectedEmail {
+				t.Errorf("expected email %q, got %q", tc.expectedEmail, claims.Email)
+			}
+		})
+	}
+}
+
+func TestValidateOIDCIssuers(t *testing.T) {
+	partner := OIDCIssuer{Name: "partner", URL: "https://partner.example.com", ClientID: "dashboard", AllowedEmailDomains: []string{"partner.example.com"}}
+
+	testCases := []struct {
+		name        string
+		issuers     []OIDCIssuer
+		expectError bool
+	}{
+		{
+			name:    "valid issuers",
+			issuers: []OIDCIssuer{partner, {Name: "other", URL: "https://other.example.com", ClientID: "dashboard", AllowedEmailDomains: []string{"other.example.com"}}},
+		},
+		{
+			name:        "reserved default name",
+			issuers:     []OIDCIssuer{{Name: DefaultOIDCIssuerName, URL: "https://other.example.com", ClientID: "dashboard"}},
+			expectError: true,
+		},
+		{
+			name:        "invalid name",
+			issuers:     []OIDCIssuer{{Name: "Partner Org", URL: "https://other.example.com", ClientID: "dashboard"}},
+			expectError: true,
+		},
+		{
+			name:        "duplicate name",
+			issuers:     []OIDCIssuer{partner, {Name: "partner", URL: "https://other.example.com", ClientID: "dashboard"}},
+			expectError: true,
+		},
+		{
+			name:        "duplicate URL",
+			issuers:     []OIDCIssuer{partner, {Name: "other", URL: partner.URL, ClientID: "dashboard"}},
+			expectError: true,
+		},
+		{
+			name:        "missing client ID",
+			issuers:     []OIDCIssuer{{Name: "other", URL: "https://other.example.com", AllowedEmailDomains: []string{"other.example.com"}}},
+			expectError: true,
+		},
+		{
+			name:        "missing allowed email domains",
+			issuers:     []OIDCIssuer{{Name: "other", URL: "https://other.example.com", ClientID: "dashboard"}},
+			expectError: true,
+		},
+		{
+			name:        "invalid allowed email domain",
+			issuers:     []OIDCIssuer{{Name: "other", URL: "https://other.example.com", ClientID: "dashboard", AllowedEmailDomains: []string{"@other"}}},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := validateOIDCIssuers(tc.issuers)
+			if tc.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
+
+func TestOpenIDClientValidateEmail(t *testing.T) {
+	primary := &OpenIDClient{issuer: "https://primary.example.com", oidcConfig: &authtypes.OIDCConfiguration{}}
+	partner := &OpenIDClient{issuer: "https://partner.example.com", oidcConfig: &authtypes.OIDCConfiguration{
+		Name:                "partner",
+		AllowedEmailDomains: []string{"partner.example.com"},
+	}}
+	unrestricted := &OpenIDClient{issuer: "https://other.example.com", oidcConfig: &authtypes.OIDCConfiguration{Name: "other"}}
+
+	testCases := []struct {
+		name        string
+		client      *OpenIDClient
+		claims      map[string]interface{}
+		email       string
+		expectError bool
+	}{
+		{
+			name:   "primary issuer allows all domains",
+			client: primary,
+			claims: map[string]interface{}{},
+			email:  "user@example.com",
+		},
+		{
+			name:   "verified email of an allowed domain",
+			client: partner,
+			claims: map[string]interface{}{"email_verified": true},
+			email:  "user@Partner.example.com",
+		},
+		{
+			name:        "email of another domain",
+			client:      partner,
+			claims:      map[string]interface{}{"email_verified": true},
+			email:       "admin@example.com",
+			expectError: true,
+		},
+		{
+			name:        "unverified email",
+			client:      partner,
+			claims:      map[string]interface{}{"email_verified": false},
+			email:       "user@partner.example.com",
+			expectError: true,
+		},
+		{
+			name:        "missing email_verified claim",
+			client:      partner,
+			claims:      map[string]interface{}{},
+			email:       "user@partner.example.com",
+			expectError: true,
+		},
+		{
+			name:        "additional issuer without allowed domains",
+			client:      unrestricted,
+			claims:      map[string]interface{}{"email_verified": true},
+			email:       "user@example.com",
+			expectError: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			err := tc.client.validateEmail(tc.claims, tc.email)
+			if tc.expectError && err == nil {
+				t.Fatal("expected an error, got none")
+			}
+			if !tc.expectError && err != nil {
+				t.Fatalf("unexpected error: %v", err)
+			}
+		})
+	}
+}
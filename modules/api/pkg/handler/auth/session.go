@@ -0,0 +1,147 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"fmt"
+	"net"
+	"net/http"
+	"strings"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/kubermatic/v2/pkg/log"
+)
+
+const (
+	// SessionCookieName is the name of the cookie which holds the key of the login session.
+	SessionCookieName = "session"
+
+	// sessionLastSeenInterval throttles the updates of the last seen time of a session.
+	sessionLastSeenInterval = time.Minute
+)
+
+// NewSessionTokenExtractor returns a token extractor which returns the ID token of the login session
+// in the session cookie. Using a session updates its last seen time, device and IP.
+func NewSessionTokenExtractor(sessionProvider provider.SessionProvider, trustedProxies TrustedProxies) authtypes.TokenExtractor {
+	return sessionTokenExtractor{sessionProvider: sessionProvider, trustedProxies: trustedProxies}
+}
+
+type sessionTokenExtractor struct {
+	sessionProvider provider.SessionProvider
+	trustedProxies  TrustedProxies
+}
+
+// Extract looks up the session of the session cookie.
+func (e sessionTokenExtractor) Extract(r *http.Request) (string, error) {
+	cookie, err := r.Cookie(SessionCookieName)
+	if err != nil || cookie.Value == "" {
+		return "", fmt.Errorf("haven't found a session cookie %s", SessionCookieName)
+	}
+
+	session, err := e.sessionProvider.Get(r.Context(), cookie.Value)
+	if err != nil {
+		return "", fmt.Errorf("failed to get the session: %w", err)
+	}
+	if session.IDToken == "" {
+		return "", fmt.Errorf("the session %s has no token", session.ID)
+	}
+
+	// Throttle the last seen update not to pressure the session store too much.
+	if now := time.Now(); now.Sub(session.LastSeen) >= sessionLastSeenInterval {
+		session.LastSeen = now
+		session.UserAgent = r.UserAgent()
+		session.IP = e.trustedProxies.ClientIP(r)
+		if err := e.sessionProvider.Update(r.Context(), cookie.Value, session); err != nil {
+			log.Logger.Debugw("Failed to update the last seen time of the session", "session", session.ID, "error", err)
+		}
+	}
+
+	return session.IDToken, nil
+}
+
+// TrustedProxies are the networks of the proxies in front of the API, like the ingress controller.
+// Only their X-Forwarded-For headers are trusted, anybody else can send arbitrary addresses.
+type TrustedProxies []*net.IPNet
+
+// ParseTrustedProxies parses the given CIDRs. Single IPs are accepted as well.
+func ParseTrustedProxies(cidrs []string) (TrustedProxies, error) {
+	proxies := TrustedProxies{}
+	for _, cidr := range cidrs {
+		cidr = strings.TrimSpace(cidr)
+		if cidr == "" {
+			continue
+		}
+		if !strings.Contains(cidr, "/") {
+			ip := net.ParseIP(cidr)
+			if ip == nil {
+				return nil, fmt.Errorf("invalid IP %q", cidr)
+			}
+			bits := 8 * net.IPv6len
+			if ip.To4() != nil {
+				bits = 8 * net.IPv4len
+			}
+			proxies = append(proxies, &net.IPNet{IP: ip, Mask: net.CIDRMask(bits, bits)})
+			continue
+		}
+		_, network, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CIDR %q: %w", cidr, err)
+		}
+		proxies = append(proxies, network)
+	}
+	return proxies, nil
+}
+
+// ClientIP returns the IP of the client of the request. The X-Forwarded-For header is only followed
+// while the requests come from trusted proxies, the last untrusted address is the client.
+func (t TrustedProxies) ClientIP(r *http.Request) string {
+	client := r.RemoteAddr
+	if host, _, err := net.SplitHostPort(r.RemoteAddr); err == nil {
+		client = host
+	}
+	if !t.contains(client) {
+		return client
+	}
+
+	forwarded := strings.Split(strings.Join(r.Header.Values("X-Forwarded-For"), ","), ",")
+	for i := len(forwarded) - 1; i >= 0; i-- {
+		address := strings.TrimSpace(forwarded[i])
+		if net.ParseIP(address) == nil {
+			break
+		}
+		client = address
+		if !t.contains(address) {
+			break
+		}
+	}
+	return client
+}
+
+func (t TrustedProxies) contains(address string) bool {
+	ip := net.ParseIP(address)
+	if ip == nil {
+		return false
+	}
+	for _, network := range t {
+		if network.Contains(ip) {
+			return true
+		}
+	}
+	return false
+}
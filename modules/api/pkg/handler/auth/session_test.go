@@ -0,0 +1,90 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package auth
+
+import (
+	"net/http/httptest"
+	"testing"
+)
+
+func TestTrustedProxiesClientIP(t *testing.T) {
+	trusted, err := ParseTrustedProxies([]string{"10.0.0.0/8", "192.0.2.10"})
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+
+	testCases := []struct {
+		name           string
+		trustedProxies TrustedProxies
+		remoteAddr     string
+		forwardedFor   []string
+		expectedIP     string
+	}{
+		{
+			name:         "forwarded header is ignored without trusted proxies",
+			remoteAddr:   "10.1.2.3:4711",
+			forwardedFor: []string{"198.51.100.7"},
+			expectedIP:   "10.1.2.3",
+		},
+		{
+			name:           "forwarded header of an untrusted client is ignored",
+			trustedProxies: trusted,
+			remoteAddr:     "203.0.113.5:4711",
+			forwardedFor:   []string{"198.51.100.7"},
+			expectedIP:     "203.0.113.5",
+		},
+		{
+			name:           "client behind a trusted proxy",
+			trustedProxies: trusted,
+			remoteAddr:     "10.1.2.3:4711",
+			forwardedFor:   []string{"198.51.100.7"},
+			expectedIP:     "198.51.100.7",
+		},
+		{
+			name:           "addresses prepended by the client are skipped",
+			trustedProxies: trusted,
+			remoteAddr:     "10.1.2.3:4711",
+			forwardedFor:   []string{"1.2.3.4, 198.51.100.7", "192.0.2.10"},
+			expectedIP:     "198.51.100.7",
+		},
+		{
+			name:           "invalid forwarded address",
+			trustedProxies: trusted,
+			remoteAddr:     "10.1.2.3:4711",
+			forwardedFor:   []string{"unknown"},
+			expectedIP:     "10.1.2.3",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			req := httptest.NewRequest("GET", "/", nil)
+			req.RemoteAddr = tc.remoteAddr
+			for _, value := range tc.forwardedFor {
+				req.Header.Add("X-Forwarded-For", value)
+			}
+
+			if ip := tc.trustedProxies.ClientIP(req); ip != tc.expectedIP {
+				t.Errorf("expected client IP %q, got %q", tc.expectedIP, ip)
+			}
+		})
+	}
+
+	if _, err := ParseTrustedProxies([]string{"10.0.0.0/33"}); err == nil {
+		t.Error("expected an invalid CIDR to be rejected")
+	}
+}
@@ -17,13 +17,21 @@ limitations under the License.
 package common
 
 import (
+	"bytes"
 	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strconv"
+	"time"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	k8sjson "k8s.io/apimachinery/pkg/util/json"
@@ -33,6 +41,10 @@ import (
 const (
 	addonEnsureLabelKey = "addons.kubermatic.io/ensure"
 	trueFlag            = "true"
+	// addonHistoryAnnotation keeps the revisions of the variables of an addon, oldest first.
+	addonHistoryAnnotation = "dashboard.k8c.io/addon-variables-history"
+	// maxAddonRevisions bounds the history, as all annotations of an object share a size limit of 256 KiB.
+	maxAddonRevisions = 10
 )
 
 func PatchAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, addon apiv1.Addon, projectID, clusterID, addonID string) (interface{}, error) {
@@ -49,7 +61,13 @@ func PatchAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGet
 	if err != nil {
 		return nil, common.KubernetesErrorToHTTPError(err)
 	}
-	apiAddon.Spec.Variables = rawVars
+	author, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := setAddonVariables(apiAddon, rawVars, author.Email, "", time.Now()); err != nil {
+		return nil, err
+	}
 
 	if apiAddon.Labels == nil {
 		apiAddon.Labels = map[string]string{}
@@ -166,6 +184,74 @@ func DeleteAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGe
 	return nil, common.KubernetesErrorToHTTPError(deleteAddon(ctx, userInfoGetter, cluster, projectID, addonID))
 }
 
+// ListAddonRevisionsEndpoint returns the revisions of the variables of an addon, newest first.
+func ListAddonRevisionsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, addonID string) (interface{}, error) {
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addon, err := getAddon(ctx, userInfoGetter, cluster, projectID, addonID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	revisions, err := addonRevisions(addon)
+	if err != nil {
+		return nil, err
+	}
+	slices.Reverse(revisions)
+	return revisions, nil
+}
+
+// RevertAddonEndpoint sets the variables of an addon to the ones of an earlier revision, which is recorded as a new
+// revision.
+func RevertAddonEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, body apiv2.AddonRevertBody, projectID, clusterID, addonID string) (interface{}, error) {
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addon, err := getAddon(ctx, userInfoGetter, cluster, projectID, addonID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	revisions, err := addonRevisions(addon)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(revisions, func(r apiv2.AddonRevision) bool {
+		return r.Revision == body.Revision
+	})
+	if i < 0 {
+		return nil, utilerrors.NewNotFound("revision", strconv.Itoa(body.Revision))
+	}
+
+	rawVars, err := convertExternalVariablesToInternal(revisions[i].Variables)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	author, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if err := setAddonVariables(addon, rawVars, author.Email, fmt.Sprintf("Reverted to revision %d", body.Revision), time.Now()); err != nil {
+		return nil, err
+	}
+
+	addon, err = updateAddon(ctx, userInfoGetter, cluster, addon, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	result, err := convertInternalAddonToExternal(addon)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return result, nil
+}
+
 func GetAddonConfigEndpoint(ctx context.Context, addonConfigProvider provider.AddonConfigProvider, addonID string) (interface{}, error) {
 	addon, err := addonConfigProvider.Get(ctx, addonID)
 	if err != nil {
@@ -269,6 +355,98 @@ func listAddons(ctx context.Context, userInfoGetter provider.UserInfoGetter, clu
 	return addonProvider.List(ctx, userInfo, cluster)
 }
 
+// addonRevisions returns the revisions of the variables of an addon, oldest first. Addons whose variables were not
+// changed through the API yet have a single revision with the variables they were installed with. Variables that
+// were changed on the Addon directly are returned as an additional revision without author.
+func addonRevisions(addon *kubermaticv1.Addon) ([]apiv2.AddonRevision, error) {
+	current, err := decodeAddonVariables(addon.Spec.Variables)
+	if err != nil {
+		return nil, err
+	}
+
+	var revisions []apiv2.AddonRevision
+	if history, ok := addon.Annotations[addonHistoryAnnotation]; ok {
+		if err := json.Unmarshal([]byte(history), &revisions); err != nil {
+			return nil, fmt.Errorf("failed to decode the variables history of addon %s: %w", addon.Name, err)
+		}
+	}
+	if len(revisions) == 0 {
+		return []apiv2.AddonRevision{{
+			Revision:          1,
+			CreationTimestamp: apiv1.NewTime(addon.CreationTimestamp.Time),
+			Variables:         current,
+		}}, nil
+	}
+
+	if last := revisions[len(revisions)-1]; !equalAddonVariables(last.Variables, current) {
+		revisions = append(revisions, apiv2.AddonRevision{
+			Revision:    last.Revision + 1,
+			Description: "Changed outside of the dashboard",
+			Variables:   current,
+		})
+	}
+	return revisions, nil
+}
+
+// setAddonVariables sets the variables of an addon and records them as a new revision if they changed.
+func setAddonVariables(addon *kubermaticv1.Addon, variables *runtime.RawExtension, author, description string, now time.Time) error {
+	revisions, err := addonRevisions(addon)
+	if err != nil {
+		return err
+	}
+	decoded, err := decodeAddonVariables(variables)
+	if err != nil {
+		return err
+	}
+
+	addon.Spec.Variables = variables
+	last := revisions[len(revisions)-1]
+	if equalAddonVariables(last.Variables, decoded) {
+		return nil
+	}
+
+	revisions = append(revisions, apiv2.AddonRevision{
+		Revision:          last.Revision + 1,
+		CreationTimestamp: apiv1.NewTime(now),
+		Author:            author,
+		Description:       description,
+		Variables:         decoded,
+	})
+	if len(revisions) > maxAddonRevisions {
+		revisions = revisions[len(revisions)-maxAddonRevisions:]
+	}
+	history, err := json.Marshal(revisions)
+	if err != nil {
+		return err
+	}
+	if addon.Annotations == nil {
+		addon.Annotations = map[string]string{}
+	}
+	addon.Annotations[addonHistoryAnnotation] = string(history)
+	return nil
+}
+
+func decodeAddonVariables(raw *runtime.RawExtension) (map[string]interface{}, error) {
+	var variables map[string]interface{}
+	if raw != nil && len(raw.Raw) > 0 {
+		if err := k8sjson.Unmarshal(raw.Raw, &variables); err != nil {
+			return nil, err
+		}
+	}
+	return variables, nil
+}
+
+// equalAddonVariables compares the variables in their JSON encoding, as decoded histories hold all numbers as
+// floats.
+func equalAddonVariables(a, b map[string]interface{}) bool {
+	if len(a) == 0 || len(b) == 0 {
+		return len(a) == len(b)
+	}
+	encodedA, errA := json.Marshal(a)
+	encodedB, errB := json.Marshal(b)
+	return errA == nil && errB == nil && bytes.Equal(encodedA, encodedB)
+}
+
 func convertInternalAddonToExternal(internalAddon *kubermaticv1.Addon) (*apiv1.Addon, error) {
 	result := &apiv1.Addon{
 		ObjectMeta: apiv1.ObjectMeta{
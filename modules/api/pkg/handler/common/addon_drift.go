@@ -0,0 +1,341 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/cache"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/discovery"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// addonLabelKey is set by the addon controller on all objects of an addon.
+	addonLabelKey = "kubermatic-addon"
+	// addonDriftRescanInterval is how long the objects found for an addon are remembered. In between, drift checks
+	// only list the resource types of these objects instead of all resource types of the cluster.
+	addonDriftRescanInterval = time.Hour
+)
+
+// addonObjectRef identifies an object of an addon by the version of its API that it was listed with.
+type addonObjectRef struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+// addonInventories holds the objects which were found for an addon, by cluster and addon name. Objects which are not
+// found anymore are reported as missing until the inventory expires, the addon controller recreates them meanwhile.
+var addonInventories = cache.NewExpiring()
+
+// GetAddonDriftEndpoint compares the objects of an addon in the user cluster with the manifests the addon controller
+// applied last. The controller applies the rendered manifests with kubectl, which records them in the
+// last-applied-configuration annotation of every object. Only the fields of the manifests are compared, so fields
+// that are defaulted by the API server or set by controllers are ignored, and so are the contents of secrets.
+// The rendered manifests are not available to the API, so the objects of the addon are found by listing all resource
+// types of the cluster, at most once per addonDriftRescanInterval.
+func GetAddonDriftEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, addonID string) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	addon, err := getAddon(ctx, userInfoGetter, cluster, projectID, addonID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	// the objects are read with the permissions of the user, like all other requests for cluster resources
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	inventoryKey := cluster.Name + "/" + addon.Name
+	if cached, ok := addonInventories.Get(inventoryKey); ok {
+		known := cached.(sets.Set[addonObjectRef])
+		gvks := sets.New[schema.GroupVersionKind]()
+		for ref := range known {
+			gvks.Insert(ref.gvk)
+		}
+		drift, found := detectAddonDrift(ctx, client, gvks.UnsortedList(), known, addon.Spec.Name)
+		addonInventories.Set(inventoryKey, known.Union(found), addonDriftRescanInterval)
+		return drift, nil
+	}
+
+	// see ListClusterAPIResourcesEndpoint, discovery reveals nothing the user could not discover on their own
+	cfg, err := clusterProvider.GetAdminClientConfigForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	resourceLists, err := discoveryClient.ServerPreferredResources()
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	var gvks []schema.GroupVersionKind
+	for _, res := range convertAPIResourceLists(resourceLists) {
+		gvks = append(gvks, schema.GroupVersionKind{Group: res.Group, Version: res.Version, Kind: res.Kind})
+	}
+
+	drift, found := detectAddonDrift(ctx, client, gvks, nil, addon.Spec.Name)
+	// an incomplete inventory would hide objects, the next drift check scans all resource types again
+	if len(drift.Errors) == 0 {
+		addonInventories.Set(inventoryKey, found, addonDriftRescanInterval)
+	}
+	return drift, nil
+}
+
+// detectAddonDrift lists the objects of the addon of the given resource types and returns the drift and the objects
+// which were found. Known objects which were not found are reported as missing. Resource types that fail to be listed
+// are reported as errors, so that a missing permission does not hide the drift of other objects.
+func detectAddonDrift(ctx context.Context, client ctrlruntimeclient.Client, gvks []schema.GroupVersionKind, known sets.Set[addonObjectRef], addonName string) (*apiv2.AddonDrift, sets.Set[addonObjectRef]) {
+	result := &apiv2.AddonDrift{Objects: []apiv2.AddonDriftObject{}}
+	found := sets.New[addonObjectRef]()
+	failed := sets.New[schema.GroupVersionKind]()
+
+	// some objects are served by several groups, e.g. events
+	seen := map[types.UID]bool{}
+	for _, gvk := range gvks {
+		list := &unstructured.UnstructuredList{}
+		list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+		if err := client.List(ctx, list, ctrlruntimeclient.MatchingLabels{addonLabelKey: addonName}); err != nil {
+			if !apierrors.IsNotFound(err) {
+				result.Errors = append(result.Errors, fmt.Sprintf("failed to list %s: %v", gvk.GroupKind(), err))
+				failed.Insert(gvk)
+			}
+			continue
+		}
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			if seen[obj.GetUID()] {
+				continue
+			}
+			seen[obj.GetUID()] = true
+			found.Insert(addonObjectRef{gvk: gvk, namespace: obj.GetNamespace(), name: obj.GetName()})
+
+			drifted, err := diffAddonObject(ctx, client, obj)
+			if err != nil {
+				result.Errors = append(result.Errors, fmt.Sprintf("failed to compare %s %s: %v", gvk.Kind, ctrlruntimeclient.ObjectKeyFromObject(obj), err))
+				continue
+			}
+			if drifted != nil {
+				result.Objects = append(result.Objects, *drifted)
+			}
+		}
+	}
+
+	for ref := range known {
+		if found.Has(ref) || failed.Has(ref.gvk) {
+			continue
+		}
+		result.Objects = append(result.Objects, apiv2.AddonDriftObject{
+			Status:     apiv2.AddonDriftObjectMissing,
+			APIVersion: ref.gvk.GroupVersion().String(),
+			Kind:       ref.gvk.Kind,
+			Namespace:  ref.namespace,
+			Name:       ref.name,
+		})
+	}
+	slices.SortFunc(result.Objects, func(a, b apiv2.AddonDriftObject) int {
+		return strings.Compare(
+			strings.Join([]string{a.APIVersion, a.Kind, a.Namespace, a.Name}, "/"),
+			strings.Join([]string{b.APIVersion, b.Kind, b.Namespace, b.Name}, "/"),
+		)
+	})
+
+	result.Drifted = slices.ContainsFunc(result.Objects, func(obj apiv2.AddonDriftObject) bool {
+		return obj.Status != apiv2.AddonDriftObjectUnknown
+	})
+	return result, found
+}
+
+// diffAddonObject returns the fields of the object that differ from its last applied manifest, nil if there are none.
+// Objects which were not applied with kubectl cannot be compared, their drift is unknown.
+func diffAddonObject(ctx context.Context, client ctrlruntimeclient.Client, obj *unstructured.Unstructured) (*apiv2.AddonDriftObject, error) {
+	lastApplied, ok := obj.GetAnnotations()[corev1.LastAppliedConfigAnnotation]
+	if !ok {
+		return &apiv2.AddonDriftObject{
+			Status:     apiv2.AddonDriftObjectUnknown,
+			APIVersion: obj.GetAPIVersion(),
+			Kind:       obj.GetKind(),
+			Namespace:  obj.GetNamespace(),
+			Name:       obj.GetName(),
+		}, nil
+	}
+	applied := &unstructured.Unstructured{}
+	if err := json.Unmarshal([]byte(lastApplied), &applied.Object); err != nil {
+		return nil, fmt.Errorf("invalid last applied configuration: %w", err)
+	}
+
+	// fields of other versions of the API can only be compared in the applied version
+	live := obj
+	if applied.GetAPIVersion() != obj.GetAPIVersion() {
+		live = &unstructured.Unstructured{}
+		live.SetGroupVersionKind(applied.GroupVersionKind())
+		if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), live); err != nil {
+			return nil, err
+		}
+	}
+
+	unstructured.RemoveNestedField(applied.Object, "status")
+	if applied.GroupVersionKind().GroupKind() == (schema.GroupKind{Kind: "Secret"}) {
+		unstructured.RemoveNestedField(applied.Object, "data")
+		unstructured.RemoveNestedField(applied.Object, "stringData")
+	}
+
+	fields := diffAddonFields("", applied.Object, live.Object)
+	if len(fields) == 0 {
+		return nil, nil
+	}
+	return &apiv2.AddonDriftObject{
+		Status:     apiv2.AddonDriftObjectDrifted,
+		APIVersion: live.GetAPIVersion(),
+		Kind:       live.GetKind(),
+		Namespace:  live.GetNamespace(),
+		Name:       live.GetName(),
+		Fields:     fields,
+	}, nil
+}
+
+// diffAddonFields returns the fields of the applied value that differ in the live value. Lists are compared element
+// by element, as long as their length did not change.
+func diffAddonFields(path string, applied, live interface{}) []apiv2.AddonDriftField {
+	drifted := []apiv2.AddonDriftField{{Path: path, Applied: applied, Live: live}}
+
+	switch applied := applied.(type) {
+	case map[string]interface{}:
+		liveMap, ok := live.(map[string]interface{})
+		if !ok {
+			if live == nil && len(applied) == 0 {
+				return nil
+			}
+			return drifted
+		}
+		keys := make([]string, 0, len(applied))
+		for key := range applied {
+			keys = append(keys, key)
+		}
+		slices.Sort(keys)
+
+		var fields []apiv2.AddonDriftField
+		for _, key := range keys {
+			fields = append(fields, diffAddonFields(addonFieldPath(path, key), applied[key], liveMap[key])...)
+		}
+		return fields
+
+	case []interface{}:
+		liveList, ok := live.([]interface{})
+		if !ok || len(liveList) != len(applied) {
+			if live == nil && len(applied) == 0 {
+				return nil
+			}
+			return drifted
+		}
+
+		var fields []apiv2.AddonDriftField
+		for i := range applied {
+			fields = append(fields, diffAddonFields(fmt.Sprintf("%s[%d]", path, i), applied[i], liveList[i])...)
+		}
+		return fields
+
+	default:
+		if equalAddonFieldValues(path, applied, live) {
+			return nil
+		}
+		return drifted
+	}
+}
+
+// equalAddonFieldValues compares scalar values. Values that are not set in the manifest and zero values that the API
+// server omits are equal, so are numbers of different types and quantities of resource requirements in different
+// notations.
+func equalAddonFieldValues(path string, applied, live interface{}) bool {
+	if applied == nil || live == nil {
+		return applied == nil || isZeroAddonFieldValue(applied)
+	}
+
+	if appliedNumber, ok := addonFieldNumber(applied); ok {
+		liveNumber, ok := addonFieldNumber(live)
+		return ok && appliedNumber == liveNumber
+	}
+
+	appliedString, appliedOK := applied.(string)
+	liveString, liveOK := live.(string)
+	if appliedOK && liveOK && appliedString != liveString && strings.Contains(path, ".resources.") {
+		appliedQuantity, appliedErr := resource.ParseQuantity(appliedString)
+		liveQuantity, liveErr := resource.ParseQuantity(liveString)
+		return appliedErr == nil && liveErr == nil && appliedQuantity.Cmp(liveQuantity) == 0
+	}
+
+	return applied == live
+}
+
+func isZeroAddonFieldValue(value interface{}) bool {
+	if number, ok := addonFieldNumber(value); ok {
+		return number == 0
+	}
+	return value == "" || value == false
+}
+
+func addonFieldNumber(value interface{}) (float64, bool) {
+	switch value := value.(type) {
+	case int64:
+		return float64(value), true
+	case float64:
+		return value, true
+	case int:
+		return float64(value), true
+	default:
+		return 0, false
+	}
+}
+
+// addonFieldPath appends a key to the path of a field, keys like label names are put in brackets.
+func addonFieldPath(path, key string) string {
+	if strings.ContainsAny(key, "./[]") {
+		return path + "[" + key + "]"
+	}
+	if path == "" {
+		return key
+	}
+	return path + "." + key
+}
@@ -0,0 +1,157 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/utils/ptr"
+)
+
+func TestDetectAddonDrift(t *testing.T) {
+	t.Parallel()
+
+	addonMeta := func(name, lastApplied string) metav1.ObjectMeta {
+		return metav1.ObjectMeta{
+			Name:        name,
+			Namespace:   "kube-system",
+			Labels:      map[string]string{addonLabelKey: "dns", "app.kubernetes.io/name": "dns"},
+			Annotations: map[string]string{corev1.LastAppliedConfigAnnotation: lastApplied},
+		}
+	}
+
+	deployment := &appsv1.Deployment{
+		ObjectMeta: addonMeta("coredns", `{"apiVersion":"apps/v1","kind":"Deployment","metadata":{"name":"coredns","namespace":"kube-system","creationTimestamp":null,"labels":{"kubermatic-addon":"dns","app.kubernetes.io/name":"dns"}},
+			"spec":{"replicas":2,"paused":false,"template":{"spec":{"containers":[{"name":"coredns","image":"coredns:1.11","resources":{"limits":{"cpu":"0.5"}}}]}}},"status":{}}`),
+		Spec: appsv1.DeploymentSpec{
+			Replicas: ptr.To[int32](3),
+			Template: corev1.PodTemplateSpec{
+				Spec: corev1.PodSpec{
+					Containers: []corev1.Container{{
+						Name:                     "coredns",
+						Image:                    "coredns:1.11",
+						TerminationMessagePolicy: corev1.TerminationMessageReadFile,
+						Resources: corev1.ResourceRequirements{
+							Limits: corev1.ResourceList{corev1.ResourceCPU: resource.MustParse("500m")},
+						},
+					}},
+				},
+			},
+		},
+	}
+	deployment.UID = "deployment"
+	configMap := &corev1.ConfigMap{
+		ObjectMeta: addonMeta("coredns", `{"apiVersion":"v1","kind":"ConfigMap","metadata":{"name":"coredns","namespace":"kube-system"},"data":{"Corefile":".:53 {}"}}`),
+		Data:       map[string]string{"Corefile": ".:53 {}"},
+	}
+	configMap.UID = "configmap"
+	secret := &corev1.Secret{
+		ObjectMeta: addonMeta("token", `{"apiVersion":"v1","kind":"Secret","metadata":{"name":"token","namespace":"kube-system"},"stringData":{"token":"abc"}}`),
+		Data:       map[string][]byte{"token": []byte("changed")},
+	}
+	secret.UID = "secret"
+	// objects which were not applied with kubectl cannot be compared
+	unmanaged := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: "unmanaged", Namespace: "kube-system", UID: "unmanaged", Labels: map[string]string{addonLabelKey: "dns"}},
+	}
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().WithObjects(deployment, configMap, secret, unmanaged).Build()
+	configMapGVK := corev1.SchemeGroupVersion.WithKind("ConfigMap")
+	gvks := []schema.GroupVersionKind{configMapGVK, corev1.SchemeGroupVersion.WithKind("Secret"), appsv1.SchemeGroupVersion.WithKind("Deployment")}
+	drift, found := detectAddonDrift(ctx, client, gvks, nil, "dns")
+
+	require.True(t, drift.Drifted)
+	require.Empty(t, drift.Errors)
+	require.Equal(t, []apiv2.AddonDriftObject{
+		{
+			Status:     apiv2.AddonDriftObjectDrifted,
+			APIVersion: "apps/v1",
+			Kind:       "Deployment",
+			Namespace:  "kube-system",
+			Name:       "coredns",
+			Fields:     []apiv2.AddonDriftField{{Path: "spec.replicas", Applied: float64(2), Live: int64(3)}},
+		},
+		{
+			Status:     apiv2.AddonDriftObjectUnknown,
+			APIVersion: "v1",
+			Kind:       "ConfigMap",
+			Namespace:  "kube-system",
+			Name:       "unmanaged",
+		},
+	}, drift.Objects)
+	require.Equal(t, 4, found.Len())
+
+	// known objects which are gone are missing, only the types of the known objects need to be listed
+	require.NoError(t, client.Delete(ctx, configMap))
+	drift, found = detectAddonDrift(ctx, client, []schema.GroupVersionKind{configMapGVK}, found, "dns")
+	require.True(t, drift.Drifted)
+	require.Contains(t, drift.Objects, apiv2.AddonDriftObject{
+		Status:     apiv2.AddonDriftObjectMissing,
+		APIVersion: "v1",
+		Kind:       "ConfigMap",
+		Namespace:  "kube-system",
+		Name:       "coredns",
+	})
+	require.Equal(t, 1, found.Len())
+
+	// objects of other addons are not compared, objects whose drift is unknown are no drift
+	drift, _ = detectAddonDrift(ctx, client, []schema.GroupVersionKind{appsv1.SchemeGroupVersion.WithKind("Deployment")}, nil, "kube-proxy")
+	require.False(t, drift.Drifted)
+	require.Empty(t, drift.Objects)
+	drift, _ = detectAddonDrift(ctx, client, []schema.GroupVersionKind{configMapGVK}, nil, "dns")
+	require.False(t, drift.Drifted)
+	require.Len(t, drift.Objects, 1)
+}
+
+func TestDiffAddonFields(t *testing.T) {
+	t.Parallel()
+
+	fields := diffAddonFields("", map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "dns"},
+		},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{map[string]interface{}{"port": float64(53)}},
+			"args":  []interface{}{"-v"},
+		},
+	}, map[string]interface{}{
+		"metadata": map[string]interface{}{
+			"labels": map[string]interface{}{"app.kubernetes.io/name": "other"},
+		},
+		"spec": map[string]interface{}{
+			"ports": []interface{}{map[string]interface{}{"port": int64(5353), "protocol": "UDP"}},
+			"args":  []interface{}{"-v", "-debug"},
+		},
+	})
+	require.Equal(t, []apiv2.AddonDriftField{
+		{Path: "metadata.labels[app.kubernetes.io/name]", Applied: "dns", Live: "other"},
+		{Path: "spec.args", Applied: []interface{}{"-v"}, Live: []interface{}{"-v", "-debug"}},
+		{Path: "spec.ports[0].port", Applied: float64(53), Live: int64(5353)},
+	}, fields)
+}
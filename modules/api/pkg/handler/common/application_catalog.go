@@ -0,0 +1,767 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"context"
+	"crypto/tls"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"path"
+	"slices"
+	"strings"
+	"time"
+
+	semverlib "github.com/Masterminds/semver/v3"
+	"github.com/google/go-containerregistry/pkg/authn"
+	"github.com/google/go-containerregistry/pkg/name"
+	ociv1 "github.com/google/go-containerregistry/pkg/v1"
+	"github.com/google/go-containerregistry/pkg/v1/remote"
+	ocitypes "github.com/google/go-containerregistry/pkg/v1/types"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ApplicationCatalogSourceLabel holds the name of the catalog source an ApplicationDefinition is synced from.
+	ApplicationCatalogSourceLabel = "dashboard.k8c.io/application-catalog-source"
+
+	// ApplicationCatalogSyncLeaseName is the name of the lease the API replicas elect the one syncing the catalog
+	// sources with.
+	ApplicationCatalogSyncLeaseName = "dashboard-application-catalog-sync"
+
+	// applicationCatalogSyncCheckInterval is how often the catalog sources are checked for a due sync. Sources
+	// which were synced within their interval, e.g. on request of an admin, are skipped.
+	applicationCatalogSyncCheckInterval   = time.Minute
+	defaultApplicationCatalogSyncInterval = time.Hour
+	minApplicationCatalogSyncInterval     = 5 * time.Minute
+	defaultApplicationCatalogMaxVersions  = 5
+	applicationCatalogRequestTimeout      = 30 * time.Second
+	// maxHelmRepositoryIndexSize bounds the index.yaml read from a Helm chart repository, the indexes of large
+	// public repositories are a few ten megabytes.
+	maxHelmRepositoryIndexSize = 64 << 20
+
+	// maxHelmChartConfigSize bounds the Chart.yaml read from the config of a chart in an OCI registry.
+	maxHelmChartConfigSize = 1 << 20
+
+	helmChartConfigMediaType  ocitypes.MediaType = "application/vnd.cncf.helm.config.v1+json"
+	helmChartContentMediaType ocitypes.MediaType = "application/vnd.cncf.helm.chart.content.v1.tar+gzip"
+)
+
+// helmRepositoryIndex is the part of the index.yaml of a Helm chart repository the catalog sync reads.
+type helmRepositoryIndex struct {
+	Entries map[string][]helmChartMetadata `json:"entries"`
+}
+
+// helmChartMetadata is the part of the Chart.yaml of a chart the catalog sync reads. OCI registries store it as
+// the config of the chart.
+type helmChartMetadata struct {
+	Name        string   `json:"name"`
+	Version     string   `json:"version"`
+	Description string   `json:"description"`
+	Home        string   `json:"home"`
+	Sources     []string `json:"sources"`
+	Deprecated  bool     `json:"deprecated"`
+	// URLs are the locations of the chart archive, only set in repository indexes.
+	URLs []string `json:"urls"`
+}
+
+// applicationCatalogChart is a chart of a catalog source with the versions which are synced.
+type applicationCatalogChart struct {
+	metadata helmChartMetadata
+	versions []*semverlib.Version
+}
+
+// ListApplicationCatalogSourcesEndpoint returns all catalog sources.
+func ListApplicationCatalogSourcesEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider) ([]apiv2.ApplicationCatalogSource, error) {
+	if err := verifyApplicationCatalogAdmin(ctx, userInfoGetter); err != nil {
+		return nil, err
+	}
+
+	sources, err := sourceProvider.ListUnsecured(ctx)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return sources, nil
+}
+
+// GetApplicationCatalogSourceEndpoint returns the catalog source with the given name.
+func GetApplicationCatalogSourceEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider, name string) (*apiv2.ApplicationCatalogSource, error) {
+	if err := verifyApplicationCatalogAdmin(ctx, userInfoGetter); err != nil {
+		return nil, err
+	}
+
+	source, err := sourceProvider.GetUnsecured(ctx, name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return source, nil
+}
+
+// CreateApplicationCatalogSourceEndpoint creates a catalog source. Its charts are synced with the next sync run.
+func CreateApplicationCatalogSourceEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider, body apiv2.ApplicationCatalogSourceBody) (*apiv2.ApplicationCatalogSource, error) {
+	if err := verifyApplicationCatalogAdmin(ctx, userInfoGetter); err != nil {
+		return nil, err
+	}
+	if errs := validation.IsDNS1123Label(body.Name); len(errs) > 0 {
+		return nil, utilerrors.NewBadRequest("invalid catalog source name %q: %s", body.Name, strings.Join(errs, ", "))
+	}
+	if err := validateApplicationCatalogSourceSpec(&body.Spec); err != nil {
+		return nil, err
+	}
+
+	source, err := sourceProvider.CreateUnsecured(ctx, &apiv2.ApplicationCatalogSource{Name: body.Name, Spec: body.Spec}, body.Password)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return source, nil
+}
+
+// UpdateApplicationCatalogSourceEndpoint replaces the spec of a catalog source. The ApplicationDefinitions synced
+// from the source are updated with the next sync run.
+func UpdateApplicationCatalogSourceEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider, name string, body apiv2.ApplicationCatalogSourceBody) (*apiv2.ApplicationCatalogSource, error) {
+	if err := verifyApplicationCatalogAdmin(ctx, userInfoGetter); err != nil {
+		return nil, err
+	}
+	if body.Name != "" && body.Name != name {
+		return nil, utilerrors.NewBadRequest("changing the catalog source name is not allowed: %q to %q", name, body.Name)
+	}
+	if err := validateApplicationCatalogSourceSpec(&body.Spec); err != nil {
+		return nil, err
+	}
+
+	source, err := sourceProvider.UpdateUnsecured(ctx, &apiv2.ApplicationCatalogSource{Name: name, Spec: body.Spec}, body.Password)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return source, nil
+}
+
+// DeleteApplicationCatalogSourceEndpoint deletes a catalog source. The ApplicationDefinitions synced from it are
+// kept, but are not updated anymore.
+func DeleteApplicationCatalogSourceEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider, name string) error {
+	if err := verifyApplicationCatalogAdmin(ctx, userInfoGetter); err != nil {
+		return err
+	}
+
+	if err := sourceProvider.DeleteUnsecured(ctx, name); err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	return nil
+}
+
+// SyncApplicationCatalogSourceEndpoint syncs a catalog source right away and returns its new status. A source
+// which cannot be indexed is not an error of the request, the reason is part of the status.
+func SyncApplicationCatalogSourceEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider,
+	appDefProvider provider.ApplicationDefinitionProvider, name string) (*apiv2.ApplicationCatalogSource, error) {
+	if err := verifyApplicationCatalogAdmin(ctx, userInfoGetter); err != nil {
+		return nil, err
+	}
+
+	source, err := sourceProvider.GetUnsecured(ctx, name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if err := syncApplicationCatalogSource(ctx, sourceProvider, appDefProvider, source, time.Now()); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return source, nil
+}
+
+// RunApplicationCatalogSync syncs every catalog source in its interval until the context is done. It must only run
+// on the API replica holding the ApplicationCatalogSyncLeaseName lease, so that sources are not synced concurrently.
+func RunApplicationCatalogSync(ctx context.Context, sourceProvider provider.ApplicationCatalogSourceProvider, appDefProvider provider.ApplicationDefinitionProvider, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		sources, err := sourceProvider.ListUnsecured(ctx)
+		if err != nil {
+			log.Warnw("Failed to list application catalog sources", zap.Error(err))
+			return
+		}
+
+		now := time.Now()
+		for i := range sources {
+			source := &sources[i]
+			if source.Status.LastSyncTime != nil && now.Sub(source.Status.LastSyncTime.Time) < applicationCatalogSyncInterval(&source.Spec) {
+				continue
+			}
+
+			if err := syncApplicationCatalogSource(ctx, sourceProvider, appDefProvider, source, now); err != nil {
+				log.Warnw("Failed to sync application catalog source", "source", source.Name, zap.Error(err))
+			}
+		}
+	}, applicationCatalogSyncCheckInterval)
+}
+
+func verifyApplicationCatalogAdmin(ctx context.Context, userInfoGetter provider.UserInfoGetter) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin {
+		return utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+	return nil
+}
+
+func validateApplicationCatalogSourceSpec(spec *apiv2.ApplicationCatalogSourceSpec) error {
+	sourceURL, err := url.Parse(spec.URL)
+	if err != nil || sourceURL.Host == "" {
+		return utilerrors.NewBadRequest("invalid catalog source URL %q", spec.URL)
+	}
+
+	switch spec.Type {
+	case apiv2.ApplicationCatalogSourceTypeHelm:
+		if sourceURL.Scheme != "http" && sourceURL.Scheme != "https" {
+			return utilerrors.NewBadRequest("the URL of a Helm chart repository must start with http:// or https://, got %q", spec.URL)
+		}
+	case apiv2.ApplicationCatalogSourceTypeOCI:
+		if sourceURL.Scheme != "oci" {
+			return utilerrors.NewBadRequest("the URL of an OCI registry must start with oci://, got %q", spec.URL)
+		}
+		if len(spec.Charts) == 0 {
+			return utilerrors.NewBadRequest("the charts to sync from an OCI registry are required")
+		}
+		for _, chart := range spec.Charts {
+			if chart == "" || strings.Contains(chart, ":") {
+				return utilerrors.NewBadRequest("invalid chart name %q", chart)
+			}
+		}
+	default:
+		return utilerrors.NewBadRequest("the catalog source type must be %q or %q, got %q", apiv2.ApplicationCatalogSourceTypeHelm, apiv2.ApplicationCatalogSourceTypeOCI, spec.Type)
+	}
+
+	for _, pattern := range slices.Concat(spec.Include, spec.Exclude) {
+		if _, err := path.Match(pattern, ""); err != nil {
+			return utilerrors.NewBadRequest("invalid chart name pattern %q", pattern)
+		}
+	}
+	if spec.VersionConstraint != "" {
+		if _, err := semverlib.NewConstraint(spec.VersionConstraint); err != nil {
+			return utilerrors.NewBadRequest("invalid version constraint %q: %v", spec.VersionConstraint, err)
+		}
+	}
+	if spec.MaxVersions < 0 {
+		return utilerrors.NewBadRequest("the maximum number of versions must not be negative")
+	}
+	if spec.Interval != "" {
+		if interval, err := time.ParseDuration(spec.Interval); err != nil || interval < minApplicationCatalogSyncInterval {
+			return utilerrors.NewBadRequest("the sync interval must be a duration of at least %v, got %q", minApplicationCatalogSyncInterval, spec.Interval)
+		}
+	}
+	if spec.DefaultValuesBlock != "" {
+		values := map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(spec.DefaultValuesBlock), &values); err != nil {
+			return utilerrors.NewBadRequest("the default values are not a valid YAML object: %v", err)
+		}
+	}
+	return nil
+}
+
+func applicationCatalogSyncInterval(spec *apiv2.ApplicationCatalogSourceSpec) time.Duration {
+	if interval, err := time.ParseDuration(spec.Interval); err == nil && interval > 0 {
+		return interval
+	}
+	return defaultApplicationCatalogSyncInterval
+}
+
+// syncApplicationCatalogSource indexes a catalog source, creates or updates the ApplicationDefinitions of its
+// charts and records the result in the status of the source.
+func syncApplicationCatalogSource(ctx context.Context, sourceProvider provider.ApplicationCatalogSourceProvider, appDefProvider provider.ApplicationDefinitionProvider,
+	source *apiv2.ApplicationCatalogSource, now time.Time) error {
+	credentials, err := sourceProvider.GetCredentialsUnsecured(ctx, source.Name)
+	if err != nil {
+		return err
+	}
+
+	syncTime := apiv1.NewTime(now)
+	source.Status.LastSyncTime = &syncTime
+	source.Status.Error = ""
+
+	charts, err := indexApplicationCatalogSource(ctx, &source.Spec, credentials)
+	if err != nil {
+		// Keep the charts of the last successful sync, the ApplicationDefinitions are still there.
+		source.Status.Error = err.Error()
+		return sourceProvider.UpdateStatusUnsecured(ctx, source.Name, &source.Status)
+	}
+
+	applications := make([]apiv2.ApplicationCatalogSourceApplication, 0, len(charts))
+	for i := range charts {
+		chart := &charts[i]
+		application := apiv2.ApplicationCatalogSourceApplication{
+			Chart:                 chart.metadata.Name,
+			ApplicationDefinition: chart.metadata.Name,
+		}
+		for _, version := range chart.versions {
+			application.Versions = append(application.Versions, version.Original())
+		}
+
+		if err := reconcileApplicationCatalogDefinition(ctx, appDefProvider, source, credentials, chart); err != nil {
+			application.Error = err.Error()
+		}
+		applications = append(applications, application)
+	}
+
+	source.Status.Applications = applications
+	source.Status.LastSuccessfulSyncTime = &syncTime
+	return sourceProvider.UpdateStatusUnsecured(ctx, source.Name, &source.Status)
+}
+
+// reconcileApplicationCatalogDefinition creates the ApplicationDefinition of a chart or adds the new versions of
+// the chart to it. The source owns the versions, the description, the URLs and the default values of the
+// definitions it creates, admins can change the other fields. Versions are never removed, as installations may
+// still use them.
+func reconcileApplicationCatalogDefinition(ctx context.Context, appDefProvider provider.ApplicationDefinitionProvider, source *apiv2.ApplicationCatalogSource,
+	credentials *provider.ApplicationCatalogSourceCredentials, chart *applicationCatalogChart) error {
+	name := chart.metadata.Name
+	if errs := validation.IsDNS1123Subdomain(name); len(errs) > 0 {
+		return fmt.Errorf("the chart name is not a valid application definition name: %s", strings.Join(errs, ", "))
+	}
+	if len(chart.versions) == 0 {
+		return errors.New("no version of the chart satisfies the version constraint")
+	}
+
+	versions := make([]appskubermaticv1.ApplicationVersion, 0, len(chart.versions))
+	for _, version := range chart.versions {
+		helmSource := &appskubermaticv1.HelmSource{
+			URL:          source.Spec.URL,
+			ChartName:    name,
+			ChartVersion: version.Original(),
+		}
+		if credentials != nil {
+			helmSource.Credentials = credentials.HelmCredentials
+		}
+		if source.Spec.Insecure {
+			helmSource.Insecure = ptr.To(true)
+		}
+		if source.Spec.Type == apiv2.ApplicationCatalogSourceTypeOCI && source.Spec.PlainHTTP {
+			helmSource.PlainHTTP = ptr.To(true)
+		}
+		versions = append(versions, appskubermaticv1.ApplicationVersion{
+			Version: version.Original(),
+			Template: appskubermaticv1.ApplicationTemplate{
+				Source: appskubermaticv1.ApplicationSource{Helm: helmSource},
+			},
+		})
+	}
+
+	description := chart.metadata.Description
+	if description == "" {
+		description = fmt.Sprintf("The %s chart of %s", name, source.Spec.URL)
+	}
+	var sourceURL string
+	if len(chart.metadata.Sources) > 0 {
+		sourceURL = chart.metadata.Sources[0]
+	}
+
+	appDef, err := appDefProvider.GetUnsecured(ctx, name)
+	if apierrors.IsNotFound(err) {
+		appDef = &appskubermaticv1.ApplicationDefinition{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:   name,
+				Labels: map[string]string{ApplicationCatalogSourceLabel: source.Name},
+			},
+			Spec: appskubermaticv1.ApplicationDefinitionSpec{
+				DisplayName:        name,
+				Description:        description,
+				Method:             appskubermaticv1.HelmTemplateMethod,
+				DefaultValuesBlock: source.Spec.DefaultValuesBlock,
+				DocumentationURL:   chart.metadata.Home,
+				SourceURL:          sourceURL,
+				Versions:           versions,
+			},
+		}
+		_, err = appDefProvider.CreateUnsecured(ctx, appDef)
+		return err
+	}
+	if err != nil {
+		return err
+	}
+	if appDef.Labels[ApplicationCatalogSourceLabel] != source.Name {
+		return fmt.Errorf("the application definition %s exists and was not created by this catalog source", name)
+	}
+
+	updated := appDef.DeepCopy()
+	updated.Spec.Description = description
+	updated.Spec.DocumentationURL = chart.metadata.Home
+	updated.Spec.SourceURL = sourceURL
+	updated.Spec.DefaultValuesBlock = source.Spec.DefaultValuesBlock
+	updated.Spec.Versions = mergeApplicationVersions(appDef.Spec.Versions, versions)
+	if equality.Semantic.DeepEqual(appDef.Spec, updated.Spec) {
+		return nil
+	}
+
+	_, err = appDefProvider.UpdateUnsecured(ctx, updated)
+	return err
+}
+
+// mergeApplicationVersions adds the synced versions to the existing ones, or replaces them. The result is sorted
+// from the newest to the oldest version, versions which are not semantic versions come last.
+func mergeApplicationVersions(existing, synced []appskubermaticv1.ApplicationVersion) []appskubermaticv1.ApplicationVersion {
+	merged := slices.Clone(existing)
+	for _, version := range synced {
+		i := slices.IndexFunc(merged, func(v appskubermaticv1.ApplicationVersion) bool {
+			return v.Version == version.Version
+		})
+		if i < 0 {
+			merged = append(merged, version)
+		} else {
+			merged[i] = version
+		}
+	}
+
+	slices.SortStableFunc(merged, func(a, b appskubermaticv1.ApplicationVersion) int {
+		va, errA := semverlib.NewVersion(a.Version)
+		vb, errB := semverlib.NewVersion(b.Version)
+		switch {
+		case errA != nil && errB != nil:
+			return 0
+		case errA != nil:
+			return 1
+		case errB != nil:
+			return -1
+		}
+		return vb.Compare(va)
+	})
+	return merged
+}
+
+// indexApplicationCatalogSource returns the charts of a catalog source which match its filters, sorted by name.
+func indexApplicationCatalogSource(ctx context.Context, spec *apiv2.ApplicationCatalogSourceSpec, credentials *provider.ApplicationCatalogSourceCredentials) ([]applicationCatalogChart, error) {
+	var constraint *semverlib.Constraints
+	if spec.VersionConstraint != "" {
+		var err error
+		if constraint, err = semverlib.NewConstraint(spec.VersionConstraint); err != nil {
+			return nil, fmt.Errorf("invalid version constraint: %w", err)
+		}
+	}
+	maxVersions := spec.MaxVersions
+	if maxVersions == 0 {
+		maxVersions = defaultApplicationCatalogMaxVersions
+	}
+
+	client := newHelmHTTPClient(spec.Insecure)
+
+	var charts []applicationCatalogChart
+	switch spec.Type {
+	case apiv2.ApplicationCatalogSourceTypeHelm:
+		index, err := fetchHelmRepositoryIndex(ctx, client, spec.URL, credentials)
+		if err != nil {
+			return nil, err
+		}
+		for name, entries := range index.Entries {
+			if !applicationCatalogChartMatches(spec, name) || len(entries) == 0 {
+				continue
+			}
+			var rawVersions []string
+			for _, entry := range entries {
+				if !entry.Deprecated {
+					rawVersions = append(rawVersions, entry.Version)
+				}
+			}
+			// a chart whose versions are all deprecated is not offered anymore
+			if len(rawVersions) == 0 {
+				continue
+			}
+			chart := applicationCatalogChart{
+				metadata: helmChartMetadata{Name: name},
+				versions: selectApplicationCatalogVersions(rawVersions, constraint, maxVersions),
+			}
+			// the description and the URLs are taken from the newest synced version
+			if len(chart.versions) > 0 {
+				if i := slices.IndexFunc(entries, func(entry helmChartMetadata) bool {
+					return entry.Version == chart.versions[0].Original()
+				}); i >= 0 {
+					chart.metadata = entries[i]
+					chart.metadata.Name = name
+				}
+			}
+			charts = append(charts, chart)
+		}
+
+	case apiv2.ApplicationCatalogSourceTypeOCI:
+		registry, err := newOCIRegistryClient(client, spec.URL, spec.PlainHTTP, credentials)
+		if err != nil {
+			return nil, err
+		}
+		for _, name := range spec.Charts {
+			if !applicationCatalogChartMatches(spec, name) {
+				continue
+			}
+			tags, err := registry.listTags(ctx, name)
+			if err != nil {
+				return nil, err
+			}
+			// Helm replaces the "+" of build metadata with "_" in the tags, as tags cannot contain a "+".
+			rawVersions := make([]string, 0, len(tags))
+			for _, tag := range tags {
+				rawVersions = append(rawVersions, strings.ReplaceAll(tag, "_", "+"))
+			}
+			chart := applicationCatalogChart{
+				metadata: helmChartMetadata{Name: name},
+				versions: selectApplicationCatalogVersions(rawVersions, constraint, maxVersions),
+			}
+			if len(chart.versions) > 0 {
+				// The description of the chart is nice to have, a registry which does not serve it is still usable.
+				if metadata, err := registry.chartMetadata(ctx, name, strings.ReplaceAll(chart.versions[0].Original(), "+", "_")); err == nil {
+					chart.metadata = *metadata
+					chart.metadata.Name = name
+				}
+			}
+			charts = append(charts, chart)
+		}
+	}
+
+	slices.SortFunc(charts, func(a, b applicationCatalogChart) int {
+		return strings.Compare(a.metadata.Name, b.metadata.Name)
+	})
+	return charts, nil
+}
+
+// applicationCatalogChartMatches reports whether a chart is included and not excluded by the filters of the source.
+func applicationCatalogChartMatches(spec *apiv2.ApplicationCatalogSourceSpec, name string) bool {
+	matches := func(patterns []string) bool {
+		return slices.ContainsFunc(patterns, func(pattern string) bool {
+			matched, _ := path.Match(pattern, name)
+			return matched
+		})
+	}
+	if len(spec.Include) > 0 && !matches(spec.Include) {
+		return false
+	}
+	return !matches(spec.Exclude)
+}
+
+// selectApplicationCatalogVersions returns the newest versions which satisfy the constraint, at most maxVersions.
+// Without a constraint pre-releases are skipped, versions which are not semantic versions are always skipped.
+func selectApplicationCatalogVersions(rawVersions []string, constraint *semverlib.Constraints, maxVersions int) []*semverlib.Version {
+	var versions []*semverlib.Version
+	for _, raw := range rawVersions {
+		version, err := semverlib.NewVersion(raw)
+		if err != nil {
+			continue
+		}
+		if (constraint == nil && version.Prerelease() != "") || (constraint != nil && !constraint.Check(version)) {
+			continue
+		}
+		if slices.ContainsFunc(versions, version.Equal) {
+			continue
+		}
+		versions = append(versions, version)
+	}
+
+	slices.SortFunc(versions, func(a, b *semverlib.Version) int {
+		return b.Compare(a)
+	})
+	if len(versions) > maxVersions {
+		versions = versions[:maxVersions]
+	}
+	return versions
+}
+
+// newHelmHTTPClient returns a client for Helm chart repositories and OCI registries, which skips the verification
+// of their certificates if insecure is set.
+func newHelmHTTPClient(insecure bool) *http.Client {
+	transport := http.DefaultTransport.(*http.Transport).Clone()
+	if insecure {
+		transport.TLSClientConfig = &tls.Config{InsecureSkipVerify: true} //nolint:gosec // requested by the admin for this source
+	}
+	return &http.Client{Transport: transport, Timeout: applicationCatalogRequestTimeout}
+}
+
+func fetchHelmRepositoryIndex(ctx context.Context, client *http.Client, repositoryURL string, credentials *provider.ApplicationCatalogSourceCredentials) (*helmRepositoryIndex, error) {
+	indexURL := strings.TrimSuffix(repositoryURL, "/") + "/index.yaml"
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, indexURL, nil)
+	if err != nil {
+		return nil, err
+	}
+	if credentials != nil {
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the repository index: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the repository index: GET %s: %s", indexURL, resp.Status)
+	}
+
+	data, err := io.ReadAll(io.LimitReader(resp.Body, maxHelmRepositoryIndexSize))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the repository index: %w", err)
+	}
+	index := &helmRepositoryIndex{}
+	if err := yaml.Unmarshal(data, index); err != nil {
+		return nil, fmt.Errorf("failed to decode the repository index: %w", err)
+	}
+	return index, nil
+}
+
+// ociRegistryClient reads the tags and the charts of the repositories below the URL of a catalog source.
+type ociRegistryClient struct {
+	host        string
+	prefix      string
+	nameOptions []name.Option
+	options     []remote.Option
+}
+
+func newOCIRegistryClient(client *http.Client, registryURL string, plainHTTP bool, credentials *provider.ApplicationCatalogSourceCredentials) (*ociRegistryClient, error) {
+	sourceURL, err := url.Parse(registryURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid registry URL: %w", err)
+	}
+	var nameOptions []name.Option
+	if plainHTTP {
+		nameOptions = append(nameOptions, name.Insecure)
+	}
+	auth := authn.Anonymous
+	if credentials != nil {
+		auth = authn.FromConfig(authn.AuthConfig{Username: credentials.Username, Password: credentials.Password})
+	}
+	return &ociRegistryClient{
+		host:        sourceURL.Host,
+		prefix:      strings.Trim(sourceURL.Path, "/"),
+		nameOptions: nameOptions,
+		options:     []remote.Option{remote.WithTransport(client.Transport), remote.WithAuth(auth)},
+	}, nil
+}
+
+func (c *ociRegistryClient) repository(chart string) (name.Repository, error) {
+	return name.NewRepository(path.Join(c.host, c.prefix, chart), c.nameOptions...)
+}
+
+// remoteOptions returns the options of requests to the registry which are cancelled with ctx.
+func (c *ociRegistryClient) remoteOptions(ctx context.Context) []remote.Option {
+	return append(slices.Clone(c.options), remote.WithContext(ctx))
+}
+
+// listTags returns all tags of the repository of a chart.
+func (c *ociRegistryClient) listTags(ctx context.Context, chart string) ([]string, error) {
+	repository, err := c.repository(chart)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, applicationCatalogRequestTimeout)
+	defer cancel()
+	tags, err := remote.List(repository, c.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, fmt.Errorf("failed to list the tags of %s: %w", repository, err)
+	}
+	return tags, nil
+}
+
+// chartManifest returns the manifest of a chart version, failing for images which are no Helm charts.
+func (c *ociRegistryClient) chartManifest(ctx context.Context, repository name.Repository, tag string) (*ociv1.Manifest, error) {
+	descriptor, err := remote.Get(repository.Tag(tag), c.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	manifest, err := ociv1.ParseManifest(bytes.NewReader(descriptor.Manifest))
+	if err != nil {
+		return nil, err
+	}
+	if manifest.Config.MediaType != helmChartConfigMediaType {
+		return nil, fmt.Errorf("%s:%s is not a Helm chart", repository, tag)
+	}
+	return manifest, nil
+}
+
+// blob reads a blob of the repository, at most limit bytes.
+func (c *ociRegistryClient) blob(ctx context.Context, repository name.Repository, digest ociv1.Hash, limit int64) ([]byte, error) {
+	layer, err := remote.Layer(repository.Digest(digest.String()), c.remoteOptions(ctx)...)
+	if err != nil {
+		return nil, err
+	}
+	blob, err := layer.Compressed()
+	if err != nil {
+		return nil, err
+	}
+	defer blob.Close()
+
+	data, err := io.ReadAll(io.LimitReader(blob, limit+1))
+	if err != nil {
+		return nil, err
+	}
+	if int64(len(data)) > limit {
+		return nil, fmt.Errorf("the blob %s is larger than %d bytes", digest, limit)
+	}
+	return data, nil
+}
+
+// chartMetadata returns the Chart.yaml of a chart version, which Helm pushes as the config of the image.
+func (c *ociRegistryClient) chartMetadata(ctx context.Context, chart, tag string) (*helmChartMetadata, error) {
+	repository, err := c.repository(chart)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, applicationCatalogRequestTimeout)
+	defer cancel()
+	manifest, err := c.chartManifest(ctx, repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	config, err := c.blob(ctx, repository, manifest.Config.Digest, maxHelmChartConfigSize)
+	if err != nil {
+		return nil, err
+	}
+	metadata := &helmChartMetadata{}
+	if err := json.Unmarshal(config, metadata); err != nil {
+		return nil, err
+	}
+	return metadata, nil
+}
+
+// chartArchive returns the archive of a chart version, which Helm pushes as the only layer of the image.
+func (c *ociRegistryClient) chartArchive(ctx context.Context, chart, tag string) ([]byte, error) {
+	repository, err := c.repository(chart)
+	if err != nil {
+		return nil, err
+	}
+	ctx, cancel := context.WithTimeout(ctx, applicationCatalogRequestTimeout)
+	defer cancel()
+	manifest, err := c.chartManifest(ctx, repository, tag)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(manifest.Layers, func(layer ociv1.Descriptor) bool {
+		return layer.MediaType == helmChartContentMediaType
+	})
+	if i < 0 {
+		return nil, fmt.Errorf("%s:%s has no chart archive", repository, tag)
+	}
+	return c.blob(ctx, repository, manifest.Layers[i].Digest, maxHelmChartArchiveSize)
+}
@@ -0,0 +1,273 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"sync"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+const testHelmRepositoryIndex = `apiVersion: v1
+entries:
+  cert-manager:
+  - name: cert-manager
+    version: v1.14.0
+    description: A cloud-native certificate manager
+    home: https://cert-manager.io
+    sources:
+    - https://github.com/cert-manager/cert-manager
+  - name: cert-manager
+    version: v1.15.0
+    description: A cloud-native certificate manager
+    home: https://cert-manager.io
+    sources:
+    - https://github.com/cert-manager/cert-manager
+  - name: cert-manager
+    version: v1.16.0-rc.1
+  - name: cert-manager
+    version: v1.13.0
+  nginx:
+  - name: nginx
+    version: 1.0.0
+  internal-tool:
+  - name: internal-tool
+    version: 0.1.0
+  legacy:
+  - name: legacy
+    version: 2.0.0
+    deprecated: true
+`
+
+func TestSyncApplicationCatalogSourceFromHelmRepository(t *testing.T) {
+	t.Parallel()
+
+	var mu sync.Mutex
+	index := testHelmRepositoryIndex
+	repository := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if username, password, ok := r.BasicAuth(); !ok || username != "robot" || password != "secret" {
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+		if r.URL.Path != "/charts/index.yaml" {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		mu.Lock()
+		defer mu.Unlock()
+		_, _ = w.Write([]byte(index))
+	}))
+	defer repository.Close()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().
+		WithObjects(&appskubermaticv1.ApplicationDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "nginx"},
+			Spec:       appskubermaticv1.ApplicationDefinitionSpec{Method: appskubermaticv1.HelmTemplateMethod},
+		}).
+		Build()
+	sourceProvider := kubernetesprovider.NewApplicationCatalogSourceProvider(client)
+	appDefProvider := kubernetesprovider.NewApplicationDefinitionProvider(client)
+	admin := func(context.Context, string) (*provider.UserInfo, error) {
+		return &provider.UserInfo{Email: "admin@acme.com", IsAdmin: true}, nil
+	}
+	john := func(context.Context, string) (*provider.UserInfo, error) {
+		return &provider.UserInfo{Email: "john@acme.com"}, nil
+	}
+
+	body := apiv2.ApplicationCatalogSourceBody{
+		Name: "stable",
+		Spec: apiv2.ApplicationCatalogSourceSpec{
+			Type:               apiv2.ApplicationCatalogSourceTypeHelm,
+			URL:                repository.URL + "/charts",
+			Include:            []string{"cert-*", "nginx", "internal-*", "legacy"},
+			Exclude:            []string{"internal-*"},
+			MaxVersions:        2,
+			DefaultValuesBlock: "replicaCount: 2\n",
+			Username:           "robot",
+		},
+		Password: "secret",
+	}
+
+	_, err := CreateApplicationCatalogSourceEndpoint(ctx, john, sourceProvider, body)
+	requireHTTPStatus(t, http.StatusForbidden, err)
+
+	invalid := body
+	invalid.Spec.Type = apiv2.ApplicationCatalogSourceTypeOCI
+	_, err = CreateApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, invalid)
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	_, err = CreateApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, body)
+	require.NoError(t, err)
+
+	source, err := SyncApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, appDefProvider, "stable")
+	require.NoError(t, err)
+	require.Empty(t, source.Status.Error)
+	require.NotNil(t, source.Status.LastSuccessfulSyncTime)
+	require.Equal(t, []apiv2.ApplicationCatalogSourceApplication{
+		{Chart: "cert-manager", ApplicationDefinition: "cert-manager", Versions: []string{"v1.15.0", "v1.14.0"}},
+		{Chart: "nginx", ApplicationDefinition: "nginx", Versions: []string{"1.0.0"}, Error: "the application definition nginx exists and was not created by this catalog source"},
+	}, source.Status.Applications)
+
+	appDef, err := appDefProvider.GetUnsecured(ctx, "cert-manager")
+	require.NoError(t, err)
+	require.Equal(t, "stable", appDef.Labels[ApplicationCatalogSourceLabel])
+	require.Equal(t, "A cloud-native certificate manager", appDef.Spec.Description)
+	require.Equal(t, "https://cert-manager.io", appDef.Spec.DocumentationURL)
+	require.Equal(t, "https://github.com/cert-manager/cert-manager", appDef.Spec.SourceURL)
+	require.Equal(t, "replicaCount: 2\n", appDef.Spec.DefaultValuesBlock)
+	require.Len(t, appDef.Spec.Versions, 2)
+	helmSource := appDef.Spec.Versions[0].Template.Source.Helm
+	require.Equal(t, repository.URL+"/charts", helmSource.URL)
+	require.Equal(t, "cert-manager", helmSource.ChartName)
+	require.Equal(t, "v1.15.0", helmSource.ChartVersion)
+	require.NotNil(t, helmSource.Credentials)
+	require.Equal(t, "password", helmSource.Credentials.Password.Key)
+
+	// changes of admins to fields the source does not own are kept
+	appDef.Spec.Enforced = true
+	_, err = appDefProvider.UpdateUnsecured(ctx, appDef)
+	require.NoError(t, err)
+
+	mu.Lock()
+	index = strings.Replace(index, "version: v1.13.0", "version: v1.16.0", 1)
+	mu.Unlock()
+
+	// updating the source without a password keeps the password
+	body.Password = ""
+	_, err = UpdateApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, "stable", body)
+	require.NoError(t, err)
+
+	source, err = SyncApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, appDefProvider, "stable")
+	require.NoError(t, err)
+	require.Empty(t, source.Status.Error)
+
+	appDef, err = appDefProvider.GetUnsecured(ctx, "cert-manager")
+	require.NoError(t, err)
+	require.True(t, appDef.Spec.Enforced)
+	var versions []string
+	for _, version := range appDef.Spec.Versions {
+		versions = append(versions, version.Version)
+	}
+	require.Equal(t, []string{"v1.16.0", "v1.15.0", "v1.14.0"}, versions)
+
+	// a source which cannot be indexed keeps the result of its last successful sync
+	body.Spec.URL = repository.URL + "/missing"
+	_, err = UpdateApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, "stable", body)
+	require.NoError(t, err)
+
+	source, err = SyncApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, appDefProvider, "stable")
+	require.NoError(t, err)
+	require.Contains(t, source.Status.Error, "404 Not Found")
+	require.False(t, source.Status.LastSuccessfulSyncTime.After(source.Status.LastSyncTime.Time))
+	require.Len(t, source.Status.Applications, 2)
+}
+
+func TestSyncApplicationCatalogSourceFromOCIRegistry(t *testing.T) {
+	t.Parallel()
+
+	const token = "pull-token"
+	chartConfig := `{"name":"redis","version":"18.1.0+build.1","description":"An in-memory data store","home":"https://redis.io"}`
+	chartConfigDigest := fmt.Sprintf("sha256:%x", sha256.Sum256([]byte(chartConfig)))
+
+	var registry *httptest.Server
+	registry = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/token" {
+			if r.URL.Query().Get("scope") != "repository:charts/redis:pull" {
+				w.WriteHeader(http.StatusForbidden)
+				return
+			}
+			_ = json.NewEncoder(w).Encode(map[string]string{"token": token})
+			return
+		}
+		if r.Header.Get("Authorization") != "Bearer "+token {
+			w.Header().Set("WWW-Authenticate", fmt.Sprintf(`Bearer realm="%s/token",service="registry"`, registry.URL))
+			w.WriteHeader(http.StatusUnauthorized)
+			return
+		}
+
+		switch {
+		case r.URL.Path == "/v2/charts/redis/tags/list" && r.URL.Query().Get("last") == "":
+			w.Header().Set("Link", `</v2/charts/redis/tags/list?last=18.0.0&n=1>; rel="next"`)
+			_, _ = w.Write([]byte(`{"name":"charts/redis","tags":["18.0.0"]}`))
+		case r.URL.Path == "/v2/charts/redis/tags/list":
+			_, _ = w.Write([]byte(`{"name":"charts/redis","tags":["18.1.0_build.1","latest"]}`))
+		case r.URL.Path == "/v2/charts/redis/manifests/18.1.0_build.1":
+			w.Header().Set("Content-Type", "application/vnd.oci.image.manifest.v1+json")
+			_, _ = fmt.Fprintf(w, `{"schemaVersion":2,"config":{"mediaType":%q,"digest":%q,"size":%d},"layers":[]}`, helmChartConfigMediaType, chartConfigDigest, len(chartConfig))
+		case r.URL.Path == "/v2/charts/redis/blobs/"+chartConfigDigest:
+			_, _ = w.Write([]byte(chartConfig))
+		default:
+			w.WriteHeader(http.StatusNotFound)
+		}
+	}))
+	defer registry.Close()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	sourceProvider := kubernetesprovider.NewApplicationCatalogSourceProvider(client)
+	appDefProvider := kubernetesprovider.NewApplicationDefinitionProvider(client)
+	admin := func(context.Context, string) (*provider.UserInfo, error) {
+		return &provider.UserInfo{Email: "admin@acme.com", IsAdmin: true}, nil
+	}
+
+	url := "oci://" + strings.TrimPrefix(registry.URL, "http://") + "/charts"
+	_, err := CreateApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, apiv2.ApplicationCatalogSourceBody{
+		Name: "registry",
+		Spec: apiv2.ApplicationCatalogSourceSpec{
+			Type:      apiv2.ApplicationCatalogSourceTypeOCI,
+			URL:       url,
+			Charts:    []string{"redis"},
+			PlainHTTP: true,
+		},
+	})
+	require.NoError(t, err)
+
+	source, err := SyncApplicationCatalogSourceEndpoint(ctx, admin, sourceProvider, appDefProvider, "registry")
+	require.NoError(t, err)
+	require.Empty(t, source.Status.Error)
+	require.Equal(t, []apiv2.ApplicationCatalogSourceApplication{
+		{Chart: "redis", ApplicationDefinition: "redis", Versions: []string{"18.1.0+build.1", "18.0.0"}},
+	}, source.Status.Applications)
+
+	appDef, err := appDefProvider.GetUnsecured(ctx, "redis")
+	require.NoError(t, err)
+	require.Equal(t, "An in-memory data store", appDef.Spec.Description)
+	require.Equal(t, "https://redis.io", appDef.Spec.DocumentationURL)
+	helmSource := appDef.Spec.Versions[0].Template.Source.Helm
+	require.Equal(t, url, helmSource.URL)
+	require.Equal(t, "18.1.0+build.1", helmSource.ChartVersion)
+	require.NotNil(t, helmSource.PlainHTTP)
+	require.True(t, *helmSource.PlainHTTP)
+	require.Nil(t, helmSource.Credentials)
+}
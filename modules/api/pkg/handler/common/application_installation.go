@@ -0,0 +1,770 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha1"
+	"encoding/base64"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/pmezard/go-difflib/difflib"
+	"helm.sh/helm/v3/pkg/action"
+	helmchart "helm.sh/helm/v3/pkg/chart"
+	helmloader "helm.sh/helm/v3/pkg/chart/loader"
+	"helm.sh/helm/v3/pkg/chartutil"
+	helmrelease "helm.sh/helm/v3/pkg/release"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	applicationtemplate "k8c.io/kubermatic/v2/pkg/applications/providers/template"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// maxHelmChartArchiveSize bounds the chart archives downloaded for previews.
+	maxHelmChartArchiveSize = 32 << 20
+	// maxHelmReleaseNameLength is the length up to which the application controller names a release like its
+	// ApplicationInstallation, longer names are shortened with a hash.
+	maxHelmReleaseNameLength = 53
+	// helmReleaseSecretType is the type of the secrets Helm stores the revisions of releases in.
+	helmReleaseSecretType = "helm.sh/release.v1"
+)
+
+// PreviewApplicationInstallationEndpoint renders the chart of an ApplicationInstallation with the previewed version
+// and values and compares the objects with the ones of the deployed release. The installation is not changed.
+func PreviewApplicationInstallationEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	appDefProvider provider.ApplicationDefinitionProvider, projectID, clusterID, namespace, name string, body apiv2.ApplicationInstallationPreviewBody) (*apiv2.ApplicationInstallationPreview, error) {
+	cluster, client, appInstall, err := getApplicationInstallation(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	templateData, err := applicationValuesTemplateData(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return previewApplicationInstallation(ctx, client, appDefProvider, cluster.Spec.Version.String(), templateData, appInstall, body)
+}
+
+// ListApplicationInstallationRevisionsEndpoint returns the revisions of the Helm release of an
+// ApplicationInstallation, newest first.
+func ListApplicationInstallationRevisionsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	appDefProvider provider.ApplicationDefinitionProvider, projectID, clusterID, namespace, name string) ([]apiv2.ApplicationInstallationRevision, error) {
+	_, client, appInstall, err := getApplicationInstallation(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	return listApplicationInstallationRevisions(ctx, client, appDefProvider, appInstall)
+}
+
+// RollbackApplicationInstallationEndpoint sets the version and the values of an ApplicationInstallation to the ones
+// of a revision of its release. The application controller then upgrades the release, which creates a new revision
+// like helm rollback does.
+func RollbackApplicationInstallationEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	appDefProvider provider.ApplicationDefinitionProvider, projectID, clusterID, namespace, name string, body apiv2.ApplicationInstallationRollbackBody) (*appskubermaticv1.ApplicationInstallation, error) {
+	cluster, client, appInstall, err := getApplicationInstallation(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, namespace, name)
+	if err != nil {
+		return nil, err
+	}
+	templateData, err := applicationValuesTemplateData(ctx, cluster)
+	if err != nil {
+		return nil, err
+	}
+	return rollbackApplicationInstallation(ctx, client, appDefProvider, templateData, appInstall, body.Revision)
+}
+
+func getApplicationInstallation(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	projectID, clusterID, namespace, name string) (*kubermaticv1.Cluster, ctrlruntimeclient.Client, *appskubermaticv1.ApplicationInstallation, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	appInstall := &appskubermaticv1.ApplicationInstallation{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: namespace, Name: name}, appInstall); err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return cluster, client, appInstall, nil
+}
+
+// applicationValuesTemplateData returns the data the application controller renders the templates in the values of
+// the installations of the cluster with.
+func applicationValuesTemplateData(ctx context.Context, cluster *kubermaticv1.Cluster) (*applicationtemplate.TemplateData, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+	if !ok {
+		return nil, errors.New("cluster provider does not provide a seed client")
+	}
+	templateData, err := applicationtemplate.GetTemplateData(ctx, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), cluster.Name)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the template data of the cluster: %w", err)
+	}
+	return templateData, nil
+}
+
+func previewApplicationInstallation(ctx context.Context, client ctrlruntimeclient.Client, appDefProvider provider.ApplicationDefinitionProvider, kubeVersion string,
+	templateData *applicationtemplate.TemplateData, appInstall *appskubermaticv1.ApplicationInstallation, body apiv2.ApplicationInstallationPreviewBody) (*apiv2.ApplicationInstallationPreview, error) {
+	appDef, err := appDefProvider.GetUnsecured(ctx, appInstall.Spec.ApplicationRef.Name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	preview := &apiv2.ApplicationInstallationPreview{
+		CurrentVersion: appInstall.Spec.ApplicationRef.Version,
+		Version:        body.Version,
+		Changes:        []apiv2.ApplicationInstallationObjectChange{},
+	}
+	if preview.Version == "" {
+		preview.Version = preview.CurrentVersion
+	}
+	version := applicationDefinitionVersion(appDef, preview.Version)
+	if version == nil {
+		return nil, utilerrors.NewBadRequest("application %s has no version %s", appDef.Name, preview.Version)
+	}
+	source := version.Template.Source.Helm
+	if source == nil {
+		return nil, utilerrors.NewBadRequest("only applications installed from Helm charts can be previewed")
+	}
+	preview.ChartVersion = source.ChartVersion
+
+	currentValues, err := applicationInstallationValues(&appInstall.Spec)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid values of the installation: %v", err)
+	}
+	values := currentValues
+	if body.ValuesBlock != nil {
+		values = map[string]interface{}{}
+		if err := yaml.Unmarshal([]byte(*body.ValuesBlock), &values); err != nil {
+			return nil, utilerrors.NewBadRequest("invalid values: %v", err)
+		}
+		if values == nil {
+			values = map[string]interface{}{}
+		}
+	}
+	if preview.ValuesDiff, err = diffYAML(currentValues, values); err != nil {
+		return nil, err
+	}
+
+	releases, err := listApplicationInstallationReleases(ctx, client, appInstall)
+	if err != nil {
+		return nil, err
+	}
+	current := deployedRelease(releases)
+	if current != nil {
+		preview.CurrentChartVersion = helmReleaseChart(current).Version
+	} else if currentVersion := applicationDefinitionVersion(appDef, preview.CurrentVersion); currentVersion != nil && currentVersion.Template.Source.Helm != nil {
+		preview.CurrentChartVersion = currentVersion.Template.Source.Helm.ChartVersion
+	}
+
+	chart, err := downloadHelmChart(ctx, appDefProvider, source)
+	if err != nil {
+		return nil, fmt.Errorf("failed to download chart %s %s: %w", source.ChartName, source.ChartVersion, err)
+	}
+	// the application controller renders the templates in the values before it passes them to Helm
+	renderedValues, err := applicationtemplate.RenderValueTemplate(values, templateData)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("failed to render the values: %v", err)
+	}
+
+	var currentObjects []*unstructured.Unstructured
+	if current != nil {
+		if currentObjects, err = decodeManifest(current.Manifest); err != nil {
+			return nil, fmt.Errorf("failed to decode revision %d: %w", current.Version, err)
+		}
+	}
+	var namespace string
+	if appInstall.Spec.Namespace != nil {
+		namespace = appInstall.Spec.Namespace.Name
+	}
+	previewed, err := renderHelmRelease(chart, renderedValues, applicationInstallationReleaseName(appInstall), namespace, kubeVersion, current != nil)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("failed to render the chart: %v", err)
+	}
+	objects, err := decodeManifest(previewed.Manifest)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("the chart renders invalid objects: %v", err)
+	}
+	if preview.SchemaErrors, err = validateHelmValues(previewed.Chart, renderedValues); err != nil {
+		return nil, err
+	}
+
+	if preview.Changes, err = diffApplicationObjects(currentObjects, objects); err != nil {
+		return nil, err
+	}
+	return preview, nil
+}
+
+// renderHelmRelease renders the chart like helm template does, the hooks are not part of the manifest of the
+// returned release. The values are not validated against the schema of the chart.
+func renderHelmRelease(chart *helmchart.Chart, values map[string]interface{}, releaseName, namespace, kubeVersion string, isUpgrade bool) (*helmrelease.Release, error) {
+	install := action.NewInstall(&action.Configuration{Log: func(string, ...interface{}) {}})
+	install.DryRun = true
+	install.ClientOnly = true
+	install.ReleaseName = releaseName
+	install.Namespace = namespace
+	install.IsUpgrade = isUpgrade
+	install.SkipSchemaValidation = true
+	if kubeVersion != "" {
+		parsed, err := chartutil.ParseKubeVersion(kubeVersion)
+		if err != nil {
+			return nil, err
+		}
+		install.KubeVersion = parsed
+	}
+	return install.Run(chart, values)
+}
+
+// validateHelmValues validates the values, coalesced with the defaults of the chart, against the values.schema.json
+// of the chart and its dependencies like Helm does before it installs a chart. It returns the violations.
+func validateHelmValues(chart *helmchart.Chart, values map[string]interface{}) ([]string, error) {
+	coalesced, err := chartutil.CoalesceValues(chart, values)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid values: %v", err)
+	}
+	err = chartutil.ValidateAgainstSchema(chart, coalesced)
+	if err == nil {
+		return nil, nil
+	}
+
+	// the violations are listed per chart, one per line below a line with the name of the chart
+	var violations []string
+	chartName := chart.Name()
+	for _, line := range strings.Split(err.Error(), "\n") {
+		line = strings.TrimSpace(line)
+		switch {
+		case line == "":
+		case strings.HasSuffix(line, ":") && !strings.HasPrefix(line, "-"):
+			chartName = strings.TrimSuffix(line, ":")
+		default:
+			violations = append(violations, chartName+": "+strings.TrimSpace(strings.TrimPrefix(line, "-")))
+		}
+	}
+	return violations, nil
+}
+
+func listApplicationInstallationRevisions(ctx context.Context, client ctrlruntimeclient.Client, appDefProvider provider.ApplicationDefinitionProvider,
+	appInstall *appskubermaticv1.ApplicationInstallation) ([]apiv2.ApplicationInstallationRevision, error) {
+	appDef, err := appDefProvider.GetUnsecured(ctx, appInstall.Spec.ApplicationRef.Name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	releases, err := listApplicationInstallationReleases(ctx, client, appInstall)
+	if err != nil {
+		return nil, err
+	}
+
+	revisions := make([]apiv2.ApplicationInstallationRevision, 0, len(releases))
+	for _, release := range releases {
+		chart := helmReleaseChart(release)
+		revision := apiv2.ApplicationInstallationRevision{
+			Revision:           release.Version,
+			ChartName:          chart.Name,
+			ChartVersion:       chart.Version,
+			AppVersion:         chart.AppVersion,
+			ApplicationVersion: applicationVersionOfChart(appDef, chart.Name, chart.Version),
+		}
+		if release.Info != nil {
+			revision.Status = release.Info.Status.String()
+			revision.Description = release.Info.Description
+			if !release.Info.LastDeployed.IsZero() {
+				revision.Deployed = apiv1.NewTime(release.Info.LastDeployed.Time)
+			}
+		}
+		if len(release.Config) > 0 {
+			valuesBlock, err := yaml.Marshal(release.Config)
+			if err != nil {
+				return nil, err
+			}
+			revision.ValuesBlock = string(valuesBlock)
+		}
+		revisions = append(revisions, revision)
+	}
+	return revisions, nil
+}
+
+func rollbackApplicationInstallation(ctx context.Context, client ctrlruntimeclient.Client, appDefProvider provider.ApplicationDefinitionProvider,
+	templateData *applicationtemplate.TemplateData, appInstall *appskubermaticv1.ApplicationInstallation, revision int) (*appskubermaticv1.ApplicationInstallation, error) {
+	appDef, err := appDefProvider.GetUnsecured(ctx, appInstall.Spec.ApplicationRef.Name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	releases, err := listApplicationInstallationReleases(ctx, client, appInstall)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(releases, func(r *helmrelease.Release) bool {
+		return r.Version == revision
+	})
+	if i < 0 {
+		return nil, utilerrors.NewNotFound("revision", strconv.Itoa(revision))
+	}
+	target := releases[i]
+	chart := helmReleaseChart(target)
+	version := applicationVersionOfChart(appDef, chart.Name, chart.Version)
+	if version == "" {
+		return nil, utilerrors.NewBadRequest("application %s does not offer chart version %s of revision %d anymore", appInstall.Spec.ApplicationRef.Name, chart.Version, revision)
+	}
+
+	// The revision has the values after the application controller rendered their templates. The values which
+	// the current ones still render to are restored as the user supplied them, so that the templates are kept.
+	userValues, err := applicationInstallationValues(&appInstall.Spec)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid values of the installation: %v", err)
+	}
+	renderedValues, err := applicationtemplate.RenderValueTemplate(userValues, templateData)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("failed to render the values of the installation: %v", err)
+	}
+	values, err := restoreUserSuppliedValues(target.Config, userValues, renderedValues)
+	if err != nil {
+		return nil, err
+	}
+
+	appInstall.Spec.ApplicationRef.Version = version
+	appInstall.Spec.Values = runtime.RawExtension{}
+	appInstall.Spec.ValuesBlock = ""
+	if len(values) > 0 {
+		valuesBlock, err := yaml.Marshal(values)
+		if err != nil {
+			return nil, err
+		}
+		appInstall.Spec.ValuesBlock = string(valuesBlock)
+	}
+	if err := client.Update(ctx, appInstall); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return appInstall, nil
+}
+
+// restoreUserSuppliedValues returns the values of a revision with the values the user supplied where the rendered
+// values match the revision, recursing into maps.
+func restoreUserSuppliedValues(revision, user, rendered map[string]interface{}) (map[string]interface{}, error) {
+	restored := make(map[string]interface{}, len(revision))
+	for key, value := range revision {
+		equal, err := equalValues(value, rendered[key])
+		if err != nil {
+			return nil, err
+		}
+		revisionMap, revisionIsMap := value.(map[string]interface{})
+		userMap, userIsMap := user[key].(map[string]interface{})
+		renderedMap, renderedIsMap := rendered[key].(map[string]interface{})
+
+		switch {
+		case equal:
+			restored[key] = user[key]
+		case revisionIsMap && userIsMap && renderedIsMap:
+			if restored[key], err = restoreUserSuppliedValues(revisionMap, userMap, renderedMap); err != nil {
+				return nil, err
+			}
+		default:
+			restored[key] = value
+		}
+	}
+	return restored, nil
+}
+
+// equalValues compares values by their JSON, as the values of releases and rendered values decode numbers to
+// different types.
+func equalValues(a, b interface{}) (bool, error) {
+	aJSON, err := json.Marshal(a)
+	if err != nil {
+		return false, err
+	}
+	bJSON, err := json.Marshal(b)
+	if err != nil {
+		return false, err
+	}
+	return bytes.Equal(aJSON, bJSON), nil
+}
+
+// applicationInstallationReleaseName returns the name of the Helm release of an ApplicationInstallation. Until the
+// application controller reports it, it is derived like the controller does.
+func applicationInstallationReleaseName(appInstall *appskubermaticv1.ApplicationInstallation) string {
+	if appInstall.Status.HelmRelease != nil && appInstall.Status.HelmRelease.Name != "" {
+		return appInstall.Status.HelmRelease.Name
+	}
+	name := appInstall.Namespace + "-" + appInstall.Name
+	if len(name) <= maxHelmReleaseNameLength {
+		return name
+	}
+	// longer names are shortened and made unique with a hash of the namespace
+	namespaceHash := sha1.Sum([]byte(appInstall.Namespace))
+	name = appInstall.Name
+	if len(name) > maxHelmReleaseNameLength-10 {
+		name = name[:maxHelmReleaseNameLength-10]
+	}
+	return name + "-" + hex.EncodeToString(namespaceHash[:])[:9]
+}
+
+// listApplicationInstallationReleases returns the revisions of the release of an ApplicationInstallation, which
+// Helm stores as secrets in the namespace of the release, newest first.
+func listApplicationInstallationReleases(ctx context.Context, client ctrlruntimeclient.Client, appInstall *appskubermaticv1.ApplicationInstallation) ([]*helmrelease.Release, error) {
+	if appInstall.Spec.Namespace == nil {
+		return nil, nil
+	}
+
+	secrets := &corev1.SecretList{}
+	if err := client.List(ctx, secrets, ctrlruntimeclient.InNamespace(appInstall.Spec.Namespace.Name), ctrlruntimeclient.MatchingLabels{
+		"owner": "helm",
+		"name":  applicationInstallationReleaseName(appInstall),
+	}); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	releases := make([]*helmrelease.Release, 0, len(secrets.Items))
+	for _, secret := range secrets.Items {
+		if secret.Type != helmReleaseSecretType {
+			continue
+		}
+		release, err := decodeHelmRelease(secret.Data["release"])
+		if err != nil {
+			return nil, fmt.Errorf("failed to decode %s: %w", secret.Name, err)
+		}
+		releases = append(releases, release)
+	}
+	slices.SortFunc(releases, func(a, b *helmrelease.Release) int {
+		return b.Version - a.Version
+	})
+	return releases, nil
+}
+
+// decodeHelmRelease decodes a revision of a release like the secret storage driver of Helm does, the data of the
+// secret is the base64 encoded, optionally gzipped JSON of the revision.
+func decodeHelmRelease(data []byte) (*helmrelease.Release, error) {
+	decoded, err := base64.StdEncoding.DecodeString(string(data))
+	if err != nil {
+		return nil, err
+	}
+	if bytes.HasPrefix(decoded, []byte{0x1f, 0x8b, 0x08}) {
+		gz, err := gzip.NewReader(bytes.NewReader(decoded))
+		if err != nil {
+			return nil, err
+		}
+		defer gz.Close()
+		if decoded, err = io.ReadAll(gz); err != nil {
+			return nil, err
+		}
+	}
+
+	release := &helmrelease.Release{}
+	if err := json.Unmarshal(decoded, release); err != nil {
+		return nil, err
+	}
+	return release, nil
+}
+
+func helmReleaseChart(release *helmrelease.Release) helmchart.Metadata {
+	if release.Chart == nil || release.Chart.Metadata == nil {
+		return helmchart.Metadata{}
+	}
+	return *release.Chart.Metadata
+}
+
+// deployedRelease returns the deployed revision, which is the newest one if no revision is deployed.
+func deployedRelease(releases []*helmrelease.Release) *helmrelease.Release {
+	for _, release := range releases {
+		if release.Info != nil && release.Info.Status == helmrelease.StatusDeployed {
+			return release
+		}
+	}
+	if len(releases) > 0 {
+		return releases[0]
+	}
+	return nil
+}
+
+func applicationDefinitionVersion(appDef *appskubermaticv1.ApplicationDefinition, version string) *appskubermaticv1.ApplicationVersion {
+	i := slices.IndexFunc(appDef.Spec.Versions, func(v appskubermaticv1.ApplicationVersion) bool {
+		return v.Version == version
+	})
+	if i < 0 {
+		return nil
+	}
+	return &appDef.Spec.Versions[i]
+}
+
+// applicationVersionOfChart returns the version of an ApplicationDefinition which installs the chart version.
+func applicationVersionOfChart(appDef *appskubermaticv1.ApplicationDefinition, chartName, chartVersion string) string {
+	for _, version := range appDef.Spec.Versions {
+		if source := version.Template.Source.Helm; source != nil && source.ChartName == chartName && source.ChartVersion == chartVersion {
+			return version.Version
+		}
+	}
+	return ""
+}
+
+func applicationInstallationValues(spec *appskubermaticv1.ApplicationInstallationSpec) (map[string]interface{}, error) {
+	values := map[string]interface{}{}
+	switch {
+	case spec.ValuesBlock != "":
+		if err := yaml.Unmarshal([]byte(spec.ValuesBlock), &values); err != nil {
+			return nil, err
+		}
+	case len(spec.Values.Raw) > 0:
+		if err := json.Unmarshal(spec.Values.Raw, &values); err != nil {
+			return nil, err
+		}
+	}
+	if values == nil {
+		values = map[string]interface{}{}
+	}
+	return values, nil
+}
+
+type applicationObjectKey struct {
+	groupKind schema.GroupKind
+	namespace string
+	name      string
+}
+
+// diffApplicationObjects compares the objects by group, kind, namespace and name, so that objects which move to
+// another version of their API are updated and not replaced.
+func diffApplicationObjects(current, previewed []*unstructured.Unstructured) ([]apiv2.ApplicationInstallationObjectChange, error) {
+	objects := map[applicationObjectKey][2]*unstructured.Unstructured{}
+	var keys []applicationObjectKey
+	for i, list := range [][]*unstructured.Unstructured{current, previewed} {
+		for _, obj := range list {
+			key := applicationObjectKey{groupKind: obj.GroupVersionKind().GroupKind(), namespace: obj.GetNamespace(), name: obj.GetName()}
+			pair, ok := objects[key]
+			if !ok {
+				keys = append(keys, key)
+			}
+			pair[i] = obj
+			objects[key] = pair
+		}
+	}
+	slices.SortFunc(keys, func(a, b applicationObjectKey) int {
+		return strings.Compare(
+			strings.Join([]string{a.groupKind.String(), a.namespace, a.name}, "/"),
+			strings.Join([]string{b.groupKind.String(), b.namespace, b.name}, "/"),
+		)
+	})
+
+	changes := []apiv2.ApplicationInstallationObjectChange{}
+	for _, key := range keys {
+		pair := objects[key]
+		currentYAML, err := manifestObjectYAML(pair[0])
+		if err != nil {
+			return nil, err
+		}
+		previewedYAML, err := manifestObjectYAML(pair[1])
+		if err != nil {
+			return nil, err
+		}
+		if currentYAML == previewedYAML {
+			continue
+		}
+
+		obj := pair[1]
+		change := apiv2.ApplicationInstallationObjectChange{Action: apiv2.ApplicationInstallationObjectUpdated}
+		switch {
+		case pair[0] == nil:
+			change.Action = apiv2.ApplicationInstallationObjectCreated
+		case pair[1] == nil:
+			change.Action = apiv2.ApplicationInstallationObjectDeleted
+			obj = pair[0]
+		}
+		change.APIVersion = obj.GetAPIVersion()
+		change.Kind = obj.GetKind()
+		change.Namespace = obj.GetNamespace()
+		change.Name = obj.GetName()
+		if change.Diff, err = unifiedDiff(currentYAML, previewedYAML); err != nil {
+			return nil, err
+		}
+		changes = append(changes, change)
+	}
+	return changes, nil
+}
+
+func diffYAML(current, previewed interface{}) (string, error) {
+	currentYAML, err := yaml.Marshal(current)
+	if err != nil {
+		return "", err
+	}
+	previewedYAML, err := yaml.Marshal(previewed)
+	if err != nil {
+		return "", err
+	}
+	if bytes.Equal(currentYAML, previewedYAML) {
+		return "", nil
+	}
+	return unifiedDiff(string(currentYAML), string(previewedYAML))
+}
+
+func unifiedDiff(current, previewed string) (string, error) {
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(current),
+		B:        difflib.SplitLines(previewed),
+		FromFile: "current",
+		ToFile:   "preview",
+		Context:  3,
+	})
+}
+
+// downloadHelmChart downloads the chart of the Helm source of an ApplicationDefinition version, from a Helm chart
+// repository or from an OCI registry.
+func downloadHelmChart(ctx context.Context, appDefProvider provider.ApplicationDefinitionProvider, source *appskubermaticv1.HelmSource) (*helmchart.Chart, error) {
+	var credentials *provider.ApplicationCatalogSourceCredentials
+	if source.Credentials != nil {
+		stored, err := appDefProvider.GetHelmCredentialsUnsecured(ctx, source.Credentials)
+		if err != nil {
+			return nil, fmt.Errorf("failed to get the credentials: %w", err)
+		}
+		if credentials, err = helmSourceCredentials(source.URL, stored); err != nil {
+			return nil, err
+		}
+	}
+
+	client := newHelmHTTPClient(ptr.Deref(source.Insecure, false))
+	var archive []byte
+	if strings.HasPrefix(source.URL, "oci://") {
+		registry, err := newOCIRegistryClient(client, source.URL, ptr.Deref(source.PlainHTTP, false), credentials)
+		if err != nil {
+			return nil, err
+		}
+		// Helm replaces the "+" of build metadata with "_" in the tags, as tags cannot contain a "+".
+		if archive, err = registry.chartArchive(ctx, source.ChartName, strings.ReplaceAll(source.ChartVersion, "+", "_")); err != nil {
+			return nil, err
+		}
+	} else {
+		var err error
+		if archive, err = fetchHelmChartArchive(ctx, client, source.URL, source.ChartName, source.ChartVersion, credentials); err != nil {
+			return nil, err
+		}
+	}
+
+	return helmloader.LoadArchive(bytes.NewReader(archive))
+}
+
+// helmSourceCredentials returns the username and the password of the credentials, or the ones the registry config
+// file has for the host of the source.
+func helmSourceCredentials(sourceURL string, stored *provider.HelmSourceCredentials) (*provider.ApplicationCatalogSourceCredentials, error) {
+	if stored.Username != "" || len(stored.RegistryConfigFile) == 0 {
+		return &provider.ApplicationCatalogSourceCredentials{Username: stored.Username, Password: stored.Password}, nil
+	}
+
+	parsed, err := url.Parse(sourceURL)
+	if err != nil {
+		return nil, fmt.Errorf("invalid URL: %w", err)
+	}
+	config := struct {
+		Auths map[string]struct {
+			Auth     string `json:"auth"`
+			Username string `json:"username"`
+			Password string `json:"password"`
+		} `json:"auths"`
+	}{}
+	if err := json.Unmarshal(stored.RegistryConfigFile, &config); err != nil {
+		return nil, fmt.Errorf("invalid registry config file: %w", err)
+	}
+	auth, ok := config.Auths[parsed.Host]
+	if !ok {
+		return nil, nil
+	}
+	if auth.Auth != "" {
+		decoded, err := base64.StdEncoding.DecodeString(auth.Auth)
+		if err != nil {
+			return nil, fmt.Errorf("invalid registry config file: %w", err)
+		}
+		auth.Username, auth.Password, _ = strings.Cut(string(decoded), ":")
+	}
+	return &provider.ApplicationCatalogSourceCredentials{Username: auth.Username, Password: auth.Password}, nil
+}
+
+// fetchHelmChartArchive downloads a chart version from a Helm chart repository. Like Helm, the credentials are only
+// sent to the host of the repository.
+func fetchHelmChartArchive(ctx context.Context, client *http.Client, repositoryURL, chart, version string, credentials *provider.ApplicationCatalogSourceCredentials) ([]byte, error) {
+	index, err := fetchHelmRepositoryIndex(ctx, client, repositoryURL, credentials)
+	if err != nil {
+		return nil, err
+	}
+	i := slices.IndexFunc(index.Entries[chart], func(entry helmChartMetadata) bool {
+		return entry.Version == version
+	})
+	if i < 0 || len(index.Entries[chart][i].URLs) == 0 {
+		return nil, fmt.Errorf("the repository has no version %s of chart %s", version, chart)
+	}
+
+	base, err := url.Parse(strings.TrimSuffix(repositoryURL, "/") + "/")
+	if err != nil {
+		return nil, fmt.Errorf("invalid repository URL: %w", err)
+	}
+	archiveURL, err := base.Parse(index.Entries[chart][i].URLs[0])
+	if err != nil {
+		return nil, fmt.Errorf("invalid chart URL: %w", err)
+	}
+	req, err := http.NewRequestWithContext(ctx, http.MethodGet, archiveURL.String(), nil)
+	if err != nil {
+		return nil, err
+	}
+	if credentials != nil && archiveURL.Host == base.Host {
+		req.SetBasicAuth(credentials.Username, credentials.Password)
+	}
+
+	resp, err := client.Do(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the chart archive: %w", err)
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("failed to get the chart archive: GET %s: %s", archiveURL, resp.Status)
+	}
+	return readHelmChartArchive(resp.Body)
+}
+
+func readHelmChartArchive(r io.Reader) ([]byte, error) {
+	data, err := io.ReadAll(io.LimitReader(r, maxHelmChartArchiveSize+1))
+	if err != nil {
+		return nil, fmt.Errorf("failed to read the chart archive: %w", err)
+	}
+	if len(data) > maxHelmChartArchiveSize {
+		return nil, fmt.Errorf("the chart archive is larger than %d bytes", maxHelmChartArchiveSize)
+	}
+	return data, nil
+}
@@ -0,0 +1,279 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	applicationtemplate "k8c.io/kubermatic/v2/pkg/applications/providers/template"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+)
+
+const testWebDeployment = `apiVersion: apps/v1
+kind: Deployment
+metadata:
+  name: {{ .Release.Name }}
+spec:
+  replicas: {{ .Values.replicaCount }}
+  template:
+    spec:
+      containers:
+      - name: web
+        image: nginx:{{ .Chart.AppVersion }}
+`
+
+func testHelmChartArchive(t *testing.T, version string, templates map[string]string, schema string) []byte {
+	t.Helper()
+
+	files := map[string]string{
+		"Chart.yaml":  fmt.Sprintf("apiVersion: v2\nname: web\nversion: %s\nappVersion: %q\n", version, strings.TrimSuffix(version, ".0")),
+		"values.yaml": "replicaCount: 1\n",
+	}
+	for name, content := range templates {
+		files["templates/"+name] = content
+	}
+	if schema != "" {
+		files["values.schema.json"] = schema
+	}
+
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	archive := tar.NewWriter(gz)
+	for name, content := range files {
+		require.NoError(t, archive.WriteHeader(&tar.Header{Name: "web/" + name, Mode: 0o644, Size: int64(len(content)), Typeflag: tar.TypeReg}))
+		_, err := archive.Write([]byte(content))
+		require.NoError(t, err)
+	}
+	require.NoError(t, archive.Close())
+	require.NoError(t, gz.Close())
+	return buf.Bytes()
+}
+
+func testHelmReleaseSecret(t *testing.T, revision int, status, chartVersion string, config map[string]interface{}, manifest string) *corev1.Secret {
+	t.Helper()
+
+	data, err := json.Marshal(map[string]interface{}{
+		"name":      "apps-web",
+		"namespace": "apps",
+		"version":   revision,
+		"info": map[string]interface{}{
+			"status":         status,
+			"description":    "Upgrade complete",
+			"first_deployed": "2026-01-01T00:00:00Z",
+			"last_deployed":  fmt.Sprintf("2026-01-0%dT00:00:00Z", revision),
+		},
+		"chart":    map[string]interface{}{"metadata": map[string]interface{}{"name": "web", "version": chartVersion}},
+		"config":   config,
+		"manifest": manifest,
+	})
+	require.NoError(t, err)
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	_, err = gz.Write(data)
+	require.NoError(t, err)
+	require.NoError(t, gz.Close())
+
+	return &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      fmt.Sprintf("sh.helm.release.v1.apps-web.v%d", revision),
+			Namespace: "apps",
+			Labels:    map[string]string{"owner": "helm", "name": "apps-web", "status": status},
+		},
+		Type: helmReleaseSecretType,
+		Data: map[string][]byte{"release": []byte(base64.StdEncoding.EncodeToString(buf.Bytes()))},
+	}
+}
+
+func TestApplicationInstallationUpgrade(t *testing.T) {
+	t.Parallel()
+
+	archives := map[string][]byte{
+		"/charts/web-1.0.0.tgz": testHelmChartArchive(t, "1.0.0", map[string]string{
+			"deployment.yaml": testWebDeployment,
+			"service.yaml":    "apiVersion: v1\nkind: Service\nmetadata:\n  name: {{ .Release.Name }}\n",
+		}, ""),
+		"/archive/web-1.1.0.tgz": testHelmChartArchive(t, "1.1.0", map[string]string{
+			"deployment.yaml": testWebDeployment,
+			"configmap.yaml":  "apiVersion: v1\nkind: ConfigMap\nmetadata:\n  name: {{ .Release.Name }}\n",
+			"job.yaml":        "apiVersion: batch/v1\nkind: Job\nmetadata:\n  name: migrate\n  annotations:\n    helm.sh/hook: pre-upgrade\n",
+		}, `{"type":"object","properties":{"replicaCount":{"type":"integer"}}}`),
+	}
+	repository := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if r.URL.Path == "/charts/index.yaml" {
+			_, _ = fmt.Fprintf(w, `apiVersion: v1
+entries:
+  web:
+  - name: web
+    version: 1.1.0
+    urls:
+    - http://%s/archive/web-1.1.0.tgz
+  - name: web
+    version: 1.0.0
+    urls:
+    - web-1.0.0.tgz
+`, r.Host)
+			return
+		}
+		archive, ok := archives[r.URL.Path]
+		if !ok {
+			w.WriteHeader(http.StatusNotFound)
+			return
+		}
+		_, _ = w.Write(archive)
+	}))
+	defer repository.Close()
+
+	helmVersion := func(version, chartVersion string) appskubermaticv1.ApplicationVersion {
+		return appskubermaticv1.ApplicationVersion{
+			Version: version,
+			Template: appskubermaticv1.ApplicationTemplate{
+				Source: appskubermaticv1.ApplicationSource{
+					Helm: &appskubermaticv1.HelmSource{URL: repository.URL + "/charts", ChartName: "web", ChartVersion: chartVersion},
+				},
+			},
+		}
+	}
+	appDefProvider := kubernetesprovider.NewApplicationDefinitionProvider(fake.NewClientBuilder().
+		WithObjects(&appskubermaticv1.ApplicationDefinition{
+			ObjectMeta: metav1.ObjectMeta{Name: "web"},
+			Spec: appskubermaticv1.ApplicationDefinitionSpec{
+				Method:   appskubermaticv1.HelmTemplateMethod,
+				Versions: []appskubermaticv1.ApplicationVersion{helmVersion("1.0.0", "1.0.0"), helmVersion("1.1.0", "1.1.0")},
+			},
+		}).
+		Build())
+
+	appInstall := &appskubermaticv1.ApplicationInstallation{
+		ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "apps"},
+		Spec: appskubermaticv1.ApplicationInstallationSpec{
+			Namespace:      &appskubermaticv1.AppNamespaceSpec{Name: "apps"},
+			ApplicationRef: appskubermaticv1.ApplicationRef{Name: "web", Version: "1.0.0"},
+			ValuesBlock:    "clusterName: '{{ .Cluster.Name }}'\nreplicaCount: 1\n",
+		},
+	}
+	deployedManifest := "---\n# Source: web/templates/service.yaml\napiVersion: v1\nkind: Service\nmetadata:\n  name: apps-web\n" +
+		"---\n# Source: web/templates/deployment.yaml\n" + strings.NewReplacer("{{ .Release.Name }}", "apps-web", "{{ .Values.replicaCount }}", "1", "{{ .Chart.AppVersion }}", "1.0").Replace(testWebDeployment)
+	ctx := context.Background()
+	templateData := &applicationtemplate.TemplateData{Cluster: applicationtemplate.ClusterData{Name: "my-cluster"}}
+	client := fake.NewClientBuilder().
+		WithObjects(
+			appInstall,
+			testHelmReleaseSecret(t, 1, "superseded", "0.9.0", map[string]interface{}{"replicaCount": 3}, ""),
+			testHelmReleaseSecret(t, 2, "deployed", "1.0.0", map[string]interface{}{"replicaCount": 1, "clusterName": "my-cluster"}, deployedManifest),
+		).
+		Build()
+
+	// an unchanged installation renders like the deployed release
+	preview, err := previewApplicationInstallation(ctx, client, appDefProvider, "v1.31.0", templateData, appInstall, apiv2.ApplicationInstallationPreviewBody{})
+	require.NoError(t, err)
+	require.Empty(t, preview.ValuesDiff)
+	require.Empty(t, preview.Changes)
+
+	preview, err = previewApplicationInstallation(ctx, client, appDefProvider, "v1.31.0", templateData, appInstall, apiv2.ApplicationInstallationPreviewBody{
+		Version:     "1.1.0",
+		ValuesBlock: ptr.To("clusterName: '{{ .Cluster.Name }}'\nreplicaCount: 2\n"),
+	})
+	require.NoError(t, err)
+	require.Equal(t, "1.0.0", preview.CurrentChartVersion)
+	require.Equal(t, "1.1.0", preview.ChartVersion)
+	require.Contains(t, preview.ValuesDiff, "-replicaCount: 1\n+replicaCount: 2\n")
+	require.Empty(t, preview.SchemaErrors)
+	require.Len(t, preview.Changes, 3)
+	require.Equal(t, "ConfigMap", preview.Changes[0].Kind)
+	require.Equal(t, apiv2.ApplicationInstallationObjectCreated, preview.Changes[0].Action)
+	require.Equal(t, "Deployment", preview.Changes[1].Kind)
+	require.Equal(t, apiv2.ApplicationInstallationObjectUpdated, preview.Changes[1].Action)
+	require.Contains(t, preview.Changes[1].Diff, "+      - image: nginx:1.1\n")
+	require.Contains(t, preview.Changes[1].Diff, "-  replicas: 1\n+  replicas: 2\n")
+	require.Equal(t, "Service", preview.Changes[2].Kind)
+	require.Equal(t, apiv2.ApplicationInstallationObjectDeleted, preview.Changes[2].Action)
+
+	preview, err = previewApplicationInstallation(ctx, client, appDefProvider, "v1.31.0", templateData, appInstall, apiv2.ApplicationInstallationPreviewBody{
+		Version:     "1.1.0",
+		ValuesBlock: ptr.To("clusterName: '{{ .Cluster.Name }}'\nreplicaCount: two\n"),
+	})
+	require.NoError(t, err)
+	require.Len(t, preview.SchemaErrors, 1)
+
+	_, err = previewApplicationInstallation(ctx, client, appDefProvider, "v1.31.0", templateData, appInstall, apiv2.ApplicationInstallationPreviewBody{Version: "2.0.0"})
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	revisions, err := listApplicationInstallationRevisions(ctx, client, appDefProvider, appInstall)
+	require.NoError(t, err)
+	require.Equal(t, []apiv2.ApplicationInstallationRevision{
+		{
+			Revision:           2,
+			Status:             "deployed",
+			Description:        "Upgrade complete",
+			Deployed:           revisions[0].Deployed,
+			ChartName:          "web",
+			ChartVersion:       "1.0.0",
+			ApplicationVersion: "1.0.0",
+			ValuesBlock:        "clusterName: my-cluster\nreplicaCount: 1\n",
+		},
+		{
+			Revision:     1,
+			Status:       "superseded",
+			Description:  "Upgrade complete",
+			Deployed:     revisions[1].Deployed,
+			ChartName:    "web",
+			ChartVersion: "0.9.0",
+			ValuesBlock:  "replicaCount: 3\n",
+		},
+	}, revisions)
+	require.Equal(t, apiv1.NewTime(revisions[0].Deployed.Time), revisions[0].Deployed)
+	require.Equal(t, 2, revisions[0].Deployed.Day())
+
+	// revisions of chart versions the application does not offer anymore cannot be rolled back to
+	_, err = rollbackApplicationInstallation(ctx, client, appDefProvider, templateData, appInstall, 1)
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+	_, err = rollbackApplicationInstallation(ctx, client, appDefProvider, templateData, appInstall, 5)
+	requireHTTPStatus(t, http.StatusNotFound, err)
+
+	appInstall.Spec.ApplicationRef.Version = "1.1.0"
+	appInstall.Spec.ValuesBlock = "clusterName: '{{ .Cluster.Name }}'\nreplicaCount: 4\n"
+	require.NoError(t, client.Update(ctx, appInstall))
+	_, err = rollbackApplicationInstallation(ctx, client, appDefProvider, templateData, appInstall, 2)
+	require.NoError(t, err)
+
+	rolledBack := &appskubermaticv1.ApplicationInstallation{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: "apps", Name: "web"}, rolledBack))
+	require.Equal(t, "1.0.0", rolledBack.Spec.ApplicationRef.Version)
+	// the values rendered from templates are restored as templates
+	require.Equal(t, "clusterName: '{{ .Cluster.Name }}'\nreplicaCount: 1\n", rolledBack.Spec.ValuesBlock)
+}
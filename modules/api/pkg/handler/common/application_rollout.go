@@ -0,0 +1,549 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"sort"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/selection"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// ApplicationRolloutLabel holds the name of the rollout an ApplicationInstallation was created or last updated by.
+	ApplicationRolloutLabel = "dashboard.k8c.io/application-rollout"
+
+	applicationRolloutInterval              = 30 * time.Second
+	defaultApplicationRolloutMaxConcurrent  = 1
+	defaultApplicationRolloutTimeoutSeconds = 600
+)
+
+// errApplicationRolloutConflict is returned for ApplicationInstallations which are not managed by the rollout.
+var errApplicationRolloutConflict = errors.New("the ApplicationInstallation is not managed by the rollout")
+
+// applicationRolloutCluster is a cluster an application can be rolled out to.
+type applicationRolloutCluster struct {
+	cluster *kubermaticv1.Cluster
+	client  func(ctx context.Context) (ctrlruntimeclient.Client, error)
+}
+
+// ListApplicationRolloutsEndpoint returns the application rollouts of a project.
+func ListApplicationRolloutsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	rolloutProvider provider.ApplicationRolloutProvider, projectID string) ([]apiv2.ApplicationRollout, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	rollouts, err := rolloutProvider.ListUnsecured(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return rollouts, nil
+}
+
+// GetApplicationRolloutEndpoint returns the application rollout with the given name.
+func GetApplicationRolloutEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	rolloutProvider provider.ApplicationRolloutProvider, projectID, name string) (*apiv2.ApplicationRollout, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	rollout, err := rolloutProvider.GetUnsecured(ctx, projectID, name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return rollout, nil
+}
+
+// CreateApplicationRolloutEndpoint creates an application rollout. It is started with the next reconciliation.
+func CreateApplicationRolloutEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	rolloutProvider provider.ApplicationRolloutProvider, appDefProvider provider.ApplicationDefinitionProvider, projectID string, body apiv2.ApplicationRolloutBody) (*apiv2.ApplicationRollout, error) {
+	project, err := checkApplicationRolloutPermissions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if errs := validation.IsDNS1123Label(body.Name); len(errs) > 0 {
+		return nil, utilerrors.NewBadRequest("invalid application rollout name %q: %s", body.Name, strings.Join(errs, ", "))
+	}
+	if err := validateApplicationRolloutSpec(ctx, appDefProvider, &body.Spec); err != nil {
+		return nil, err
+	}
+
+	rollout, err := rolloutProvider.CreateUnsecured(ctx, project, &apiv2.ApplicationRollout{Name: body.Name, Spec: body.Spec})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return rollout, nil
+}
+
+// UpdateApplicationRolloutEndpoint replaces the spec of an application rollout. A changed spec is rolled out to all
+// selected clusters again.
+func UpdateApplicationRolloutEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	rolloutProvider provider.ApplicationRolloutProvider, appDefProvider provider.ApplicationDefinitionProvider, projectID, name string, body apiv2.ApplicationRolloutBody) (*apiv2.ApplicationRollout, error) {
+	if _, err := checkApplicationRolloutPermissions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID); err != nil {
+		return nil, err
+	}
+	if body.Name != "" && body.Name != name {
+		return nil, utilerrors.NewBadRequest("changing the application rollout name is not allowed: %q to %q", name, body.Name)
+	}
+	if err := validateApplicationRolloutSpec(ctx, appDefProvider, &body.Spec); err != nil {
+		return nil, err
+	}
+
+	rollout, err := rolloutProvider.UpdateUnsecured(ctx, &apiv2.ApplicationRollout{Name: name, ProjectID: projectID, Spec: body.Spec})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return rollout, nil
+}
+
+// DeleteApplicationRolloutEndpoint deletes an application rollout. The applications it installed are kept.
+func DeleteApplicationRolloutEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	rolloutProvider provider.ApplicationRolloutProvider, projectID, name string) error {
+	if _, err := checkApplicationRolloutPermissions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID); err != nil {
+		return err
+	}
+
+	return common.KubernetesErrorToHTTPError(rolloutProvider.DeleteUnsecured(ctx, projectID, name))
+}
+
+// RetryApplicationRolloutEndpoint resets the clusters the application failed or conflicted on, which resumes a failed
+// rollout.
+func RetryApplicationRolloutEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	rolloutProvider provider.ApplicationRolloutProvider, projectID, name string) (*apiv2.ApplicationRollout, error) {
+	if _, err := checkApplicationRolloutPermissions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID); err != nil {
+		return nil, err
+	}
+
+	rollout, err := rolloutProvider.GetUnsecured(ctx, projectID, name)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	for i := range rollout.Status.Clusters {
+		cluster := &rollout.Status.Clusters[i]
+		if cluster.Phase == apiv2.ApplicationRolloutClusterFailed || cluster.Phase == apiv2.ApplicationRolloutClusterConflict {
+			*cluster = apiv2.ApplicationRolloutClusterStatus{
+				ClusterID:          cluster.ClusterID,
+				ClusterName:        cluster.ClusterName,
+				Phase:              apiv2.ApplicationRolloutClusterPending,
+				ObservedGeneration: cluster.ObservedGeneration,
+			}
+		}
+	}
+	setApplicationRolloutPhase(rollout)
+	if err := rolloutProvider.UpdateStatusUnsecured(ctx, rollout); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return rollout, nil
+}
+
+// RunApplicationRollouts reconciles the application rollouts of all projects until the context is done.
+func RunApplicationRollouts(ctx context.Context, rolloutProvider provider.ApplicationRolloutProvider, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		rollouts, err := rolloutProvider.ListUnsecured(ctx, "")
+		if err != nil {
+			log.Warnw("Failed to list application rollouts", zap.Error(err))
+			return
+		}
+		if len(rollouts) == 0 {
+			return
+		}
+
+		clusters, err := listApplicationRolloutClusters(ctx, rollouts, seedsGetter, clusterProviderGetter, log)
+		if err != nil {
+			log.Warnw("Failed to list the clusters of application rollouts", zap.Error(err))
+			return
+		}
+
+		for i := range rollouts {
+			rollout := &rollouts[i]
+			if err := reconcileApplicationRollout(ctx, rolloutProvider, rollout, clusters, time.Now()); err != nil {
+				if apierrors.IsConflict(err) {
+					// another API replica reconciled the rollout in the meantime
+					continue
+				}
+				log.Warnw("Failed to reconcile application rollout", "project", rollout.ProjectID, "rollout", rollout.Name, zap.Error(err))
+			}
+		}
+	}, applicationRolloutInterval)
+}
+
+// listApplicationRolloutClusters returns the clusters of all seeds which belong to a project with rollouts.
+func listApplicationRolloutClusters(ctx context.Context, rollouts []apiv2.ApplicationRollout, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter,
+	log *zap.SugaredLogger) ([]applicationRolloutCluster, error) {
+	projectIDs := make([]string, 0, len(rollouts))
+	for _, rollout := range rollouts {
+		projectIDs = append(projectIDs, rollout.ProjectID)
+	}
+	requirement, err := labels.NewRequirement(kubermaticv1.ProjectIDLabelKey, selection.In, projectIDs)
+	if err != nil {
+		return nil, err
+	}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, err
+	}
+
+	var clusters []applicationRolloutCluster
+	for _, seed := range seeds {
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			log.Warnw("Failed to get cluster provider for application rollouts", "seed", seed.Name, zap.Error(err))
+			continue
+		}
+		clusterList, err := clusterProvider.ListAll(ctx, labels.NewSelector().Add(*requirement))
+		if err != nil {
+			log.Warnw("Failed to list clusters for application rollouts", "seed", seed.Name, zap.Error(err))
+			continue
+		}
+		for i := range clusterList.Items {
+			cluster := &clusterList.Items[i]
+			clusters = append(clusters, applicationRolloutCluster{
+				cluster: cluster,
+				client: func(ctx context.Context) (ctrlruntimeclient.Client, error) {
+					return clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+				},
+			})
+		}
+	}
+	return clusters, nil
+}
+
+// reconcileApplicationRollout updates the progress of the clusters the application is rolled out to and starts the
+// next clusters while there are free slots. The status is stored before the next clusters are started, so that
+// concurrent API replicas cannot start them twice.
+func reconcileApplicationRollout(ctx context.Context, rolloutProvider provider.ApplicationRolloutProvider, rollout *apiv2.ApplicationRollout, clusters []applicationRolloutCluster, now time.Time) error {
+	selector, err := metav1.LabelSelectorAsSelector(&rollout.Spec.ClusterSelector)
+	if err != nil {
+		return err
+	}
+
+	var targets []applicationRolloutCluster
+	for _, target := range clusters {
+		if target.cluster.Labels[kubermaticv1.ProjectIDLabelKey] == rollout.ProjectID && target.cluster.DeletionTimestamp == nil && selector.Matches(labels.Set(target.cluster.Labels)) {
+			targets = append(targets, target)
+		}
+	}
+	sort.Slice(targets, func(i, j int) bool {
+		return targets[i].cluster.Name < targets[j].cluster.Name
+	})
+
+	previous := map[string]apiv2.ApplicationRolloutClusterStatus{}
+	for _, status := range rollout.Status.Clusters {
+		previous[status.ClusterID] = status
+	}
+
+	statuses := make([]apiv2.ApplicationRolloutClusterStatus, len(targets))
+	for i, target := range targets {
+		status, ok := previous[target.cluster.Name]
+		if !ok || status.ObservedGeneration != rollout.Generation {
+			status = apiv2.ApplicationRolloutClusterStatus{
+				ClusterID:          target.cluster.Name,
+				Phase:              apiv2.ApplicationRolloutClusterPending,
+				ObservedGeneration: rollout.Generation,
+			}
+		}
+		status.ClusterName = target.cluster.Spec.HumanReadableName
+		if status.Phase == apiv2.ApplicationRolloutClusterProgressing {
+			updateApplicationRolloutClusterProgress(ctx, target, rollout, &status, now)
+		}
+		statuses[i] = status
+	}
+
+	maxConcurrent := rollout.Spec.MaxConcurrent
+	if maxConcurrent <= 0 {
+		maxConcurrent = defaultApplicationRolloutMaxConcurrent
+	}
+	progressing, failed := 0, false
+	for _, status := range statuses {
+		switch status.Phase {
+		case apiv2.ApplicationRolloutClusterProgressing:
+			progressing++
+		case apiv2.ApplicationRolloutClusterFailed:
+			failed = true
+		}
+	}
+	var started []int
+	for i := range statuses {
+		if failed || progressing >= maxConcurrent {
+			break
+		}
+		if statuses[i].Phase == apiv2.ApplicationRolloutClusterPending {
+			statuses[i].Phase = apiv2.ApplicationRolloutClusterProgressing
+			statuses[i].StartTime = ptr.To(apiv1.NewTime(now))
+			started = append(started, i)
+			progressing++
+		}
+	}
+
+	rollout.Status.Clusters = statuses
+	rollout.Status.LastReconcileTime = ptr.To(apiv1.NewTime(now))
+	setApplicationRolloutPhase(rollout)
+	if err := rolloutProvider.UpdateStatusUnsecured(ctx, rollout); err != nil {
+		return err
+	}
+	if len(started) == 0 {
+		return nil
+	}
+
+	for _, i := range started {
+		if err := applyApplicationRollout(ctx, targets[i], rollout); err != nil {
+			statuses[i].Phase = apiv2.ApplicationRolloutClusterFailed
+			if errors.Is(err, errApplicationRolloutConflict) {
+				statuses[i].Phase = apiv2.ApplicationRolloutClusterConflict
+			}
+			statuses[i].Message = err.Error()
+			statuses[i].CompletionTime = ptr.To(apiv1.NewTime(now))
+		}
+	}
+	setApplicationRolloutPhase(rollout)
+	return rolloutProvider.UpdateStatusUnsecured(ctx, rollout)
+}
+
+// updateApplicationRolloutClusterProgress checks whether the application became ready on a cluster or failed. The
+// conditions of the ApplicationInstallation only count once the application controller observed the latest spec.
+func updateApplicationRolloutClusterProgress(ctx context.Context, target applicationRolloutCluster, rollout *apiv2.ApplicationRollout, status *apiv2.ApplicationRolloutClusterStatus, now time.Time) {
+	fail := func(message string) {
+		status.Phase = apiv2.ApplicationRolloutClusterFailed
+		status.Message = message
+		status.CompletionTime = ptr.To(apiv1.NewTime(now))
+	}
+
+	timeoutSeconds := rollout.Spec.TimeoutSeconds
+	if timeoutSeconds <= 0 {
+		timeoutSeconds = defaultApplicationRolloutTimeoutSeconds
+	}
+	timeout := time.Duration(timeoutSeconds) * time.Second
+	timedOut := status.StartTime != nil && now.Sub(status.StartTime.Time) > timeout
+
+	client, err := target.client(ctx)
+	if err != nil {
+		status.Message = fmt.Sprintf("failed to connect to the cluster: %v", err)
+		if timedOut {
+			fail(status.Message)
+		}
+		return
+	}
+
+	appInstall := &appskubermaticv1.ApplicationInstallation{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: rollout.Spec.Namespace, Name: applicationRolloutInstallationName(rollout)}, appInstall); err != nil {
+		if apierrors.IsNotFound(err) {
+			fail("the ApplicationInstallation was deleted")
+			return
+		}
+		status.Message = fmt.Sprintf("failed to get the ApplicationInstallation: %v", err)
+		if timedOut {
+			fail(status.Message)
+		}
+		return
+	}
+	status.Message = ""
+
+	ready, ok := appInstall.Status.Conditions[appskubermaticv1.Ready]
+	if ok && ready.ObservedGeneration >= appInstall.Generation {
+		version := appInstall.Status.ApplicationVersion
+		switch {
+		case ready.Status == corev1.ConditionTrue && version != nil && version.Version == rollout.Spec.ApplicationRef.Version:
+			status.Phase = apiv2.ApplicationRolloutClusterSucceeded
+			status.CompletionTime = ptr.To(apiv1.NewTime(now))
+			return
+		case ready.Status == corev1.ConditionFalse && strings.HasPrefix(ready.Reason, "InstallationFailed"):
+			fail(ready.Message)
+			return
+		}
+	}
+	if timedOut {
+		fail(fmt.Sprintf("the application did not become ready within %s", timeout))
+	}
+}
+
+// applyApplicationRollout creates the ApplicationInstallation of the rollout in a cluster or upgrades the existing one.
+// Only installations created by the rollout are upgraded, others are reported as conflicts.
+func applyApplicationRollout(ctx context.Context, target applicationRolloutCluster, rollout *apiv2.ApplicationRollout) error {
+	client, err := target.client(ctx)
+	if err != nil {
+		return fmt.Errorf("failed to connect to the cluster: %w", err)
+	}
+
+	namespace := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: rollout.Spec.Namespace}}
+	if err := client.Create(ctx, namespace); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create namespace %s: %w", rollout.Spec.Namespace, err)
+	}
+
+	appRef := appskubermaticv1.ApplicationRef{Name: rollout.Spec.ApplicationRef.Name, Version: rollout.Spec.ApplicationRef.Version}
+	appInstall := &appskubermaticv1.ApplicationInstallation{}
+	key := types.NamespacedName{Namespace: rollout.Spec.Namespace, Name: applicationRolloutInstallationName(rollout)}
+	if err := client.Get(ctx, key, appInstall); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return fmt.Errorf("failed to get the ApplicationInstallation: %w", err)
+		}
+		appInstall = &appskubermaticv1.ApplicationInstallation{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Labels:    map[string]string{ApplicationRolloutLabel: rollout.Name},
+			},
+			Spec: appskubermaticv1.ApplicationInstallationSpec{
+				Namespace:      &appskubermaticv1.AppNamespaceSpec{Name: rollout.Spec.Namespace, Create: true},
+				ApplicationRef: appRef,
+				ValuesBlock:    rollout.Spec.ValuesBlock,
+			},
+		}
+		if err := client.Create(ctx, appInstall); err != nil {
+			return fmt.Errorf("failed to create the ApplicationInstallation: %w", err)
+		}
+		return nil
+	}
+
+	if owner := appInstall.Labels[ApplicationRolloutLabel]; owner != rollout.Name {
+		if owner == "" {
+			return fmt.Errorf("%w: ApplicationInstallation %s already exists", errApplicationRolloutConflict, key)
+		}
+		return fmt.Errorf("%w: ApplicationInstallation %s is managed by the rollout %s", errApplicationRolloutConflict, key, owner)
+	}
+	if appInstall.Spec.ApplicationRef.Name != appRef.Name {
+		return fmt.Errorf("%w: ApplicationInstallation %s installs application %s", errApplicationRolloutConflict, key, appInstall.Spec.ApplicationRef.Name)
+	}
+	appInstall.Spec.ApplicationRef = appRef
+	appInstall.Spec.ValuesBlock = rollout.Spec.ValuesBlock
+	appInstall.Spec.Values = runtime.RawExtension{}
+	if err := client.Update(ctx, appInstall); err != nil {
+		return fmt.Errorf("failed to update the ApplicationInstallation: %w", err)
+	}
+	return nil
+}
+
+// setApplicationRolloutPhase derives the phase of a rollout from the phases of its clusters. Conflicting clusters are
+// skipped and only mentioned in the message.
+func setApplicationRolloutPhase(rollout *apiv2.ApplicationRollout) {
+	rollout.Status.Phase = apiv2.ApplicationRolloutSucceeded
+	rollout.Status.Message = ""
+	conflicts := 0
+	for _, cluster := range rollout.Status.Clusters {
+		switch cluster.Phase {
+		case apiv2.ApplicationRolloutClusterFailed:
+			rollout.Status.Phase = apiv2.ApplicationRolloutFailed
+			rollout.Status.Message = fmt.Sprintf("the application failed on cluster %s: %s", cluster.ClusterName, cluster.Message)
+			return
+		case apiv2.ApplicationRolloutClusterPending, apiv2.ApplicationRolloutClusterProgressing:
+			rollout.Status.Phase = apiv2.ApplicationRolloutProgressing
+		case apiv2.ApplicationRolloutClusterConflict:
+			conflicts++
+		}
+	}
+	if conflicts > 0 {
+		rollout.Status.Message = fmt.Sprintf("%d cluster(s) have an ApplicationInstallation which is not managed by the rollout", conflicts)
+	}
+}
+
+func applicationRolloutInstallationName(rollout *apiv2.ApplicationRollout) string {
+	if rollout.Spec.ApplicationInstallationName != "" {
+		return rollout.Spec.ApplicationInstallationName
+	}
+	return rollout.Name
+}
+
+// checkApplicationRolloutPermissions verifies that the user may manage the application rollouts of the project.
+// Rollouts install applications on all clusters of the project, so viewers are not allowed to.
+func checkApplicationRolloutPermissions(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID string) (*kubermaticv1.Project, error) {
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, err
+	}
+	if adminUserInfo.IsAdmin {
+		return project, nil
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, err
+	}
+	if !userInfo.Roles.HasAny(provider.OwnersRole, provider.EditorsRole) {
+		return nil, utilerrors.New(http.StatusForbidden, "only project owners and editors can manage application rollouts")
+	}
+	return project, nil
+}
+
+func validateApplicationRolloutSpec(ctx context.Context, appDefProvider provider.ApplicationDefinitionProvider, spec *apiv2.ApplicationRolloutSpec) error {
+	if _, err := metav1.LabelSelectorAsSelector(&spec.ClusterSelector); err != nil {
+		return utilerrors.NewBadRequest("invalid cluster selector: %v", err)
+	}
+	if errs := validation.IsDNS1123Label(spec.Namespace); len(errs) > 0 {
+		return utilerrors.NewBadRequest("invalid namespace %q: %s", spec.Namespace, strings.Join(errs, ", "))
+	}
+	if spec.ApplicationInstallationName != "" {
+		if errs := validation.IsDNS1123Subdomain(spec.ApplicationInstallationName); len(errs) > 0 {
+			return utilerrors.NewBadRequest("invalid ApplicationInstallation name %q: %s", spec.ApplicationInstallationName, strings.Join(errs, ", "))
+		}
+	}
+	if spec.MaxConcurrent < 0 {
+		return utilerrors.NewBadRequest("maxConcurrent must not be negative")
+	}
+	if spec.TimeoutSeconds < 0 {
+		return utilerrors.NewBadRequest("timeoutSeconds must not be negative")
+	}
+	values := map[string]interface{}{}
+	if err := yaml.Unmarshal([]byte(spec.ValuesBlock), &values); err != nil {
+		return utilerrors.NewBadRequest("invalid values: %v", err)
+	}
+
+	appDef, err := appDefProvider.GetUnsecured(ctx, spec.ApplicationRef.Name)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return utilerrors.NewBadRequest("application %q does not exist", spec.ApplicationRef.Name)
+		}
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	for _, version := range appDef.Spec.Versions {
+		if version.Version == spec.ApplicationRef.Version {
+			return nil
+		}
+	}
+	return utilerrors.NewBadRequest("application %q has no version %q", spec.ApplicationRef.Name, spec.ApplicationRef.Version)
+}
@@ -0,0 +1,229 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestReconcileApplicationRollout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rolloutProvider := kubernetesprovider.NewApplicationRolloutProvider(fake.NewClientBuilder().Build())
+	rollout, err := rolloutProvider.CreateUnsecured(ctx, &kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}}, &apiv2.ApplicationRollout{
+		Name: "ingress",
+		Spec: apiv2.ApplicationRolloutSpec{
+			ClusterSelector: metav1.LabelSelector{MatchLabels: map[string]string{"env": "prod"}},
+			Namespace:       "ingress",
+			ApplicationRef:  apiv1.ApplicationRef{Name: "ingress-nginx", Version: "4.10.0"},
+			ValuesBlock:     "replicaCount: 2\n",
+			MaxConcurrent:   2,
+		},
+	})
+	require.NoError(t, err)
+
+	clients := map[string]ctrlruntimeclient.Client{}
+	var clusters []applicationRolloutCluster
+	addCluster := func(name, projectID, env string) {
+		clients[name] = fake.NewClientBuilder().Build()
+		clusters = append(clusters, applicationRolloutCluster{
+			cluster: &kubermaticv1.Cluster{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:   name,
+					Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: projectID, "env": env},
+				},
+				Spec: kubermaticv1.ClusterSpec{HumanReadableName: name + "-name"},
+			},
+			client: func(context.Context) (ctrlruntimeclient.Client, error) {
+				return clients[name], nil
+			},
+		})
+	}
+	addCluster("a", "project", "prod")
+	addCluster("b", "project", "prod")
+	addCluster("c", "project", "prod")
+	addCluster("staging", "project", "staging")
+	addCluster("other", "other", "prod")
+
+	reconcile := func(now time.Time) *apiv2.ApplicationRollout {
+		t.Helper()
+		rollout, err := rolloutProvider.GetUnsecured(ctx, "project", "ingress")
+		require.NoError(t, err)
+		require.NoError(t, reconcileApplicationRollout(ctx, rolloutProvider, rollout, clusters, now))
+		return rollout
+	}
+	phases := func(rollout *apiv2.ApplicationRollout) map[string]apiv2.ApplicationRolloutClusterPhase {
+		phases := map[string]apiv2.ApplicationRolloutClusterPhase{}
+		for _, cluster := range rollout.Status.Clusters {
+			phases[cluster.ClusterID] = cluster.Phase
+		}
+		return phases
+	}
+	appInstall := func(cluster string) *appskubermaticv1.ApplicationInstallation {
+		t.Helper()
+		appInstall := &appskubermaticv1.ApplicationInstallation{}
+		require.NoError(t, clients[cluster].Get(ctx, types.NamespacedName{Namespace: "ingress", Name: "ingress"}, appInstall))
+		return appInstall
+	}
+	setReady := func(cluster string, status corev1.ConditionStatus, reason string) {
+		t.Helper()
+		ai := appInstall(cluster)
+		ai.Status.ApplicationVersion = &appskubermaticv1.ApplicationVersion{Version: ai.Spec.ApplicationRef.Version}
+		ai.SetCondition(appskubermaticv1.Ready, status, reason, "helm install failed")
+		require.NoError(t, clients[cluster].Status().Update(ctx, ai))
+	}
+
+	// the first wave installs the application on two clusters
+	start := time.Now()
+	rollout = reconcile(start)
+	require.Equal(t, apiv2.ApplicationRolloutProgressing, rollout.Status.Phase)
+	require.Equal(t, map[string]apiv2.ApplicationRolloutClusterPhase{
+		"a": apiv2.ApplicationRolloutClusterProgressing,
+		"b": apiv2.ApplicationRolloutClusterProgressing,
+		"c": apiv2.ApplicationRolloutClusterPending,
+	}, phases(rollout))
+	require.Equal(t, "a-name", rollout.Status.Clusters[0].ClusterName)
+	ai := appInstall("a")
+	require.Equal(t, "4.10.0", ai.Spec.ApplicationRef.Version)
+	require.Equal(t, "replicaCount: 2\n", ai.Spec.ValuesBlock)
+	require.Equal(t, "ingress", ai.Labels[ApplicationRolloutLabel])
+	require.NoError(t, clients["a"].Get(ctx, types.NamespacedName{Name: "ingress"}, &corev1.Namespace{}))
+
+	// the next cluster starts once a slot is free
+	setReady("a", corev1.ConditionTrue, "InstallationSuccessful")
+	rollout = reconcile(start.Add(time.Minute))
+	require.Equal(t, map[string]apiv2.ApplicationRolloutClusterPhase{
+		"a": apiv2.ApplicationRolloutClusterSucceeded,
+		"b": apiv2.ApplicationRolloutClusterProgressing,
+		"c": apiv2.ApplicationRolloutClusterProgressing,
+	}, phases(rollout))
+
+	// a failure stops the rollout, new clusters are not started anymore
+	setReady("b", corev1.ConditionFalse, "InstallationFailed")
+	addCluster("d", "project", "prod")
+	rollout = reconcile(start.Add(2 * time.Minute))
+	require.Equal(t, apiv2.ApplicationRolloutFailed, rollout.Status.Phase)
+	require.Contains(t, rollout.Status.Message, "b-name: helm install failed")
+	require.Equal(t, apiv2.ApplicationRolloutClusterPending, phases(rollout)["d"])
+
+	// clusters which do not become ready in time fail
+	rollout = reconcile(start.Add(time.Hour))
+	require.Equal(t, apiv2.ApplicationRolloutClusterFailed, phases(rollout)["c"])
+	require.Equal(t, apiv2.ApplicationRolloutClusterPending, phases(rollout)["d"])
+
+	// a new spec is rolled out to all clusters again, existing installations are upgraded
+	rollout.Spec.ApplicationRef.Version = "4.11.0"
+	_, err = rolloutProvider.UpdateUnsecured(ctx, rollout)
+	require.NoError(t, err)
+	rollout = reconcile(start.Add(2 * time.Hour))
+	require.Equal(t, map[string]apiv2.ApplicationRolloutClusterPhase{
+		"a": apiv2.ApplicationRolloutClusterProgressing,
+		"b": apiv2.ApplicationRolloutClusterProgressing,
+		"c": apiv2.ApplicationRolloutClusterPending,
+		"d": apiv2.ApplicationRolloutClusterPending,
+	}, phases(rollout))
+	require.Equal(t, "4.11.0", appInstall("a").Spec.ApplicationRef.Version)
+
+	// the old ready condition does not count for the new version
+	rollout = reconcile(start.Add(2*time.Hour + time.Minute))
+	require.Equal(t, apiv2.ApplicationRolloutClusterProgressing, phases(rollout)["a"])
+
+	// an outdated status is not stored
+	stale := *rollout
+	stale.ResourceVersion = "1"
+	require.Error(t, reconcileApplicationRollout(ctx, rolloutProvider, &stale, clusters, start.Add(3*time.Hour)))
+}
+
+func TestReconcileApplicationRolloutConflicts(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	rolloutProvider := kubernetesprovider.NewApplicationRolloutProvider(fake.NewClientBuilder().Build())
+	_, err := rolloutProvider.CreateUnsecured(ctx, &kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project"}}, &apiv2.ApplicationRollout{
+		Name: "ingress",
+		Spec: apiv2.ApplicationRolloutSpec{
+			Namespace:      "ingress",
+			ApplicationRef: apiv1.ApplicationRef{Name: "ingress-nginx", Version: "4.10.0"},
+			MaxConcurrent:  3,
+		},
+	})
+	require.NoError(t, err)
+
+	installation := func(labels map[string]string) *appskubermaticv1.ApplicationInstallation {
+		return &appskubermaticv1.ApplicationInstallation{
+			ObjectMeta: metav1.ObjectMeta{Name: "ingress", Namespace: "ingress", Labels: labels},
+			Spec: appskubermaticv1.ApplicationInstallationSpec{
+				ApplicationRef: appskubermaticv1.ApplicationRef{Name: "ingress-nginx", Version: "4.9.0"},
+				ValuesBlock:    "custom: true\n",
+			},
+		}
+	}
+	clients := map[string]ctrlruntimeclient.Client{
+		"manual":  fake.NewClientBuilder().WithObjects(installation(nil)).Build(),
+		"managed": fake.NewClientBuilder().WithObjects(installation(map[string]string{ApplicationRolloutLabel: "ingress"})).Build(),
+		"other":   fake.NewClientBuilder().WithObjects(installation(map[string]string{ApplicationRolloutLabel: "other"})).Build(),
+	}
+	var clusters []applicationRolloutCluster
+	for name := range clients {
+		clusters = append(clusters, applicationRolloutCluster{
+			cluster: &kubermaticv1.Cluster{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: map[string]string{kubermaticv1.ProjectIDLabelKey: "project"}}},
+			client: func(context.Context) (ctrlruntimeclient.Client, error) {
+				return clients[name], nil
+			},
+		})
+	}
+
+	rollout, err := rolloutProvider.GetUnsecured(ctx, "project", "ingress")
+	require.NoError(t, err)
+	require.NoError(t, reconcileApplicationRollout(ctx, rolloutProvider, rollout, clusters, time.Now()))
+
+	phases := map[string]apiv2.ApplicationRolloutClusterPhase{}
+	for _, cluster := range rollout.Status.Clusters {
+		phases[cluster.ClusterID] = cluster.Phase
+	}
+	require.Equal(t, map[string]apiv2.ApplicationRolloutClusterPhase{
+		"managed": apiv2.ApplicationRolloutClusterProgressing,
+		"manual":  apiv2.ApplicationRolloutClusterConflict,
+		"other":   apiv2.ApplicationRolloutClusterConflict,
+	}, phases)
+	require.Equal(t, apiv2.ApplicationRolloutProgressing, rollout.Status.Phase)
+	require.Contains(t, rollout.Status.Message, "2 cluster(s)")
+
+	// installations which are not managed by the rollout are left untouched
+	for name, version := range map[string]string{"managed": "4.10.0", "manual": "4.9.0", "other": "4.9.0"} {
+		ai := &appskubermaticv1.ApplicationInstallation{}
+		require.NoError(t, clients[name].Get(ctx, types.NamespacedName{Namespace: "ingress", Name: "ingress"}, ai))
+		require.Equal(t, version, ai.Spec.ApplicationRef.Version, name)
+	}
+}
@@ -0,0 +1,63 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// findSeedClusterProvider returns the cluster provider and the seed client of the seed the cluster with the given
+// name runs in. A nil provider is returned if the cluster does not exist anymore, resumers have nothing to do then.
+func findSeedClusterProvider(ctx context.Context, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, clusterName string) (provider.ClusterProvider, ctrlruntimeclient.Client, error) {
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, nil, fmt.Errorf("failed to get seeds: %w", err)
+	}
+
+	for _, seed := range seeds {
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get cluster provider for seed %s: %w", seed.Name, err)
+		}
+		privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+		if !ok {
+			return nil, nil, errors.New("cluster provider does not provide a seed client")
+		}
+		seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+		err = seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, &kubermaticv1.Cluster{})
+		if apierrors.IsNotFound(err) {
+			continue
+		}
+		if err != nil {
+			return nil, nil, fmt.Errorf("failed to get cluster in seed %s: %w", seed.Name, err)
+		}
+
+		return clusterProvider, seedClient, nil
+	}
+
+	return nil, nil, nil
+}
@@ -30,6 +30,7 @@ import (
 	"go.uber.org/zap"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/label"
@@ -598,6 +599,10 @@ func PatchEndpoint(
 		return nil, err
 	}
 
+	if err := ValidateDashboardProxyTarget(apiv2.DashboardProxyTarget(newInternalCluster.Annotations[DashboardProxyTargetAnnotation])); err != nil {
+		return nil, err
+	}
+
 	// Checking kubelet versions on user cluster machines requires network connection between kubermatic-api and user cluster api-server.
 	// In case where the connection is blocked, we still want to be able to send a patch request. This can be achieved with an additional
 	// query param attached to the patch request: "skip_kubelet_version_validation=true"
@@ -0,0 +1,202 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/clusteragent"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation"
+)
+
+func CreateClusterAgentTokenEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	tokenProvider provider.ClusterAgentTokenProvider, projectID, name string, expiry *apiv1.Time) (*apiv2.ClusterAgentToken, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, utilerrors.NewBadRequest("the token name is required")
+	}
+	var expiryTime *time.Time
+	if expiry != nil && !expiry.IsZero() {
+		if !expiry.After(time.Now()) {
+			return nil, utilerrors.NewBadRequest("the token expiry must be in the future")
+		}
+		expiryTime = &expiry.Time
+	}
+
+	if err := checkClusterAgentTokenPermissions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID); err != nil {
+		return nil, err
+	}
+
+	token, err := tokenProvider.CreateUnsecured(ctx, projectID, name, expiryTime)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return token, nil
+}
+
+func ListClusterAgentTokensEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	tokenProvider provider.ClusterAgentTokenProvider, projectID string) ([]apiv2.ClusterAgentToken, error) {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	tokens, err := tokenProvider.ListUnsecured(ctx, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return tokens, nil
+}
+
+func DeleteClusterAgentTokenEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	tokenProvider provider.ClusterAgentTokenProvider, projectID, tokenID string) error {
+	if err := checkClusterAgentTokenPermissions(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID); err != nil {
+		return err
+	}
+
+	return common.KubernetesErrorToHTTPError(tokenProvider.DeleteUnsecured(ctx, projectID, tokenID))
+}
+
+// checkClusterAgentTokenPermissions verifies that the user may manage the agent tokens of the
+// project. The tokens allow to add clusters to the project, so viewers are not allowed to.
+func checkClusterAgentTokenPermissions(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID string) error {
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return err
+	}
+	if adminUserInfo.IsAdmin {
+		return nil
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return err
+	}
+	if !userInfo.Roles.HasAny(provider.OwnersRole, provider.EditorsRole) {
+		return utilerrors.New(http.StatusForbidden, "only project owners and editors can manage cluster agent tokens")
+	}
+	return nil
+}
+
+// RegisterClusterAgent authenticates a connecting cluster agent and returns the external cluster
+// it serves. The cluster is created on the first connect of the agent. Tokens are bound to the
+// agent and clusters to the token they were registered with, so a token can not be used to take
+// over the tunnel of another cluster.
+func RegisterClusterAgent(ctx context.Context, tokenProvider provider.ClusterAgentTokenProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	privilegedClusterProvider provider.PrivilegedExternalClusterProvider, token, agentID, name string) (*kubermaticv1.ExternalCluster, error) {
+	if agentID == "" {
+		return nil, utilerrors.NewBadRequest("the %s header is required", clusteragent.ClusterIDHeader)
+	}
+	if errs := validation.IsValidLabelValue(agentID); len(errs) > 0 {
+		return nil, utilerrors.NewBadRequest("invalid cluster agent ID: %s", strings.Join(errs, ", "))
+	}
+
+	projectID, tokenID, err := tokenProvider.Authenticate(ctx, token, agentID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	project, err := privilegedProjectProvider.GetUnsecured(ctx, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	clusterName := clusteragent.ClusterName(projectID, agentID)
+	cluster, err := privilegedClusterProvider.GetUnsecured(ctx, clusterName)
+	if err == nil {
+		return bindClusterAgentToken(ctx, privilegedClusterProvider, cluster, tokenID)
+	}
+	if !apierrors.IsNotFound(err) {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if name == "" {
+		name = clusterName
+	}
+	cluster = &kubermaticv1.ExternalCluster{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: clusterName,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey:      projectID,
+				resources.ExternalClusterIsImported: resources.ExternalClusterIsImportedTrue,
+				clusteragent.AgentIDLabel:           agentID,
+				clusteragent.AgentTokenLabel:        tokenID,
+			},
+			Annotations: map[string]string{
+				clusteragent.ConnectivityAnnotation: clusteragent.ConnectivityAgent,
+			},
+		},
+		Spec: kubermaticv1.ExternalClusterSpec{
+			HumanReadableName: name,
+			CloudSpec: kubermaticv1.ExternalClusterCloudSpec{
+				ProviderName: kubermaticv1.ExternalClusterBringYourOwnProvider,
+				BringYourOwn: &kubermaticv1.ExternalClusterBringYourOwnCloudSpec{},
+			},
+		},
+	}
+	created, err := privilegedClusterProvider.NewUnsecured(ctx, project, cluster)
+	if apierrors.IsAlreadyExists(err) {
+		// another replica registered the cluster concurrently
+		if created, err = privilegedClusterProvider.GetUnsecured(ctx, clusterName); err == nil {
+			return bindClusterAgentToken(ctx, privilegedClusterProvider, created, tokenID)
+		}
+	}
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return created, nil
+}
+
+// bindClusterAgentToken verifies that the cluster was registered with the given token. Clusters
+// registered before tokens were bound are bound to the first token that connects them.
+func bindClusterAgentToken(ctx context.Context, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, cluster *kubermaticv1.ExternalCluster, tokenID string) (*kubermaticv1.ExternalCluster, error) {
+	if cluster.DeletionTimestamp != nil {
+		return nil, utilerrors.New(http.StatusConflict, "the cluster is being deleted")
+	}
+
+	switch cluster.Labels[clusteragent.AgentTokenLabel] {
+	case tokenID:
+		return cluster, nil
+	case "":
+		if cluster.Labels == nil {
+			cluster.Labels = map[string]string{}
+		}
+		cluster.Labels[clusteragent.AgentTokenLabel] = tokenID
+		updated, err := privilegedClusterProvider.UpdateUnsecured(ctx, cluster)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		return updated, nil
+	default:
+		return nil, utilerrors.New(http.StatusForbidden, "the cluster was registered with another cluster agent token")
+	}
+}
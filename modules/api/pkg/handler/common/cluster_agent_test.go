@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/clusteragent"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestRegisterClusterAgent(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().
+		WithObjects(&kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "project-a"}}).
+		Build()
+	tokenProvider := kubernetesprovider.NewClusterAgentTokenProvider(client)
+	projectProvider, err := kubernetesprovider.NewPrivilegedProjectProvider(client)
+	require.NoError(t, err)
+	clusterProvider, err := kubernetesprovider.NewExternalClusterProvider(func(rest.ImpersonationConfig) (ctrlruntimeclient.Client, error) {
+		return client, nil
+	}, client)
+	require.NoError(t, err)
+
+	token, err := tokenProvider.CreateUnsecured(ctx, "project-a", "on-prem", nil)
+	require.NoError(t, err)
+
+	_, err = RegisterClusterAgent(ctx, tokenProvider, projectProvider, clusterProvider, "invalid", "3f0a", "edge-1")
+	requireHTTPStatus(t, http.StatusUnauthorized, err)
+
+	_, err = RegisterClusterAgent(ctx, tokenProvider, projectProvider, clusterProvider, token.Token, "", "edge-1")
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	cluster, err := RegisterClusterAgent(ctx, tokenProvider, projectProvider, clusterProvider, token.Token, "3f0a", "edge-1")
+	require.NoError(t, err)
+	require.Equal(t, clusteragent.ClusterName("project-a", "3f0a"), cluster.Name)
+	require.Equal(t, "edge-1", cluster.Spec.HumanReadableName)
+	require.Equal(t, "project-a", cluster.Labels[kubermaticv1.ProjectIDLabelKey])
+	require.True(t, clusteragent.UsesAgent(cluster))
+
+	// a reconnecting agent is served the cluster it registered before
+	reconnected, err := RegisterClusterAgent(ctx, tokenProvider, projectProvider, clusterProvider, token.Token, "3f0a", "renamed")
+	require.NoError(t, err)
+	require.Equal(t, cluster.Name, reconnected.Name)
+	require.Equal(t, "edge-1", reconnected.Spec.HumanReadableName)
+
+	// the token is bound to the agent that used it first
+	_, err = RegisterClusterAgent(ctx, tokenProvider, projectProvider, clusterProvider, token.Token, "9b21", "edge-2")
+	requireHTTPStatus(t, http.StatusForbidden, err)
+
+	// and the cluster to the token it was registered with
+	other, err := tokenProvider.CreateUnsecured(ctx, "project-a", "other", nil)
+	require.NoError(t, err)
+	_, err = RegisterClusterAgent(ctx, tokenProvider, projectProvider, clusterProvider, other.Token, "3f0a", "edge-1")
+	requireHTTPStatus(t, http.StatusForbidden, err)
+
+	clusters := &kubermaticv1.ExternalClusterList{}
+	require.NoError(t, client.List(ctx, clusters))
+	require.Len(t, clusters.Items, 1)
+}
+
+func requireHTTPStatus(t *testing.T, expected int, err error) {
+	var httpErr utilerrors.HTTPError
+	require.True(t, errors.As(err, &httpErr), "expected an HTTP error, got %v", err)
+	require.Equal(t, expected, httpErr.StatusCode())
+}
@@ -0,0 +1,941 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net"
+	"net/http"
+	"slices"
+	"strconv"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// clusterReadinessChecksConfigMapName is the ConfigMap in the cluster namespace that stores the readiness check
+	// runs, newest first.
+	clusterReadinessChecksConfigMapName = "cluster-readiness-checks"
+	clusterReadinessChecksKey           = "runs"
+	// maxClusterReadinessCheckRuns is the number of runs kept per cluster, older runs are dropped.
+	maxClusterReadinessCheckRuns = 10
+	// maxClusterReadinessCheckImages limits the images of the ImagePull check, every image gets its own timeout.
+	maxClusterReadinessCheckImages = 5
+
+	defaultClusterReadinessCheckImage = "registry.k8s.io/e2e-test-images/agnhost:2.52"
+	clusterReadinessCheckRunLabel     = "dashboard.k8c.io/readiness-check-run"
+	clusterReadinessCheckAppLabel     = "dashboard.k8c.io/readiness-check-app"
+
+	clusterReadinessCheckPollInterval = 2 * time.Second
+	clusterReadinessCheckTimeout      = 3 * time.Minute
+	// provisioning a cloud load balancer regularly takes several minutes
+	clusterReadinessCheckLoadBalancerTimeout = 10 * time.Minute
+	// clusterReadinessCheckRunTimeout is the longest a run can take. Runs that are still running afterwards were
+	// interrupted, e.g. because the API replica executing them was restarted.
+	clusterReadinessCheckRunTimeout = time.Hour
+
+	// ClusterReadinessCheckOperationKind is the kind of the background operations which execute readiness check runs.
+	ClusterReadinessCheckOperationKind = "cluster-readiness-check"
+
+	readinessCheckServerName = "server"
+	readinessCheckServerPort = 8080
+)
+
+// loadBalancerProviders are the cloud providers whose clusters provision load balancers through the cloud controller
+// manager. The LoadBalancer check is skipped for other providers unless it was requested explicitly.
+var loadBalancerProviders = sets.New(
+	string(kubermaticv1.AWSCloudProvider),
+	string(kubermaticv1.AzureCloudProvider),
+	string(kubermaticv1.GCPCloudProvider),
+	string(kubermaticv1.OpenstackCloudProvider),
+	string(kubermaticv1.HetznerCloudProvider),
+	string(kubermaticv1.DigitaloceanCloudProvider),
+	string(kubermaticv1.KubevirtCloudProvider),
+	string(kubermaticv1.AlibabaCloudProvider),
+)
+
+// StartClusterReadinessCheckEndpoint starts a run of end-to-end checks against the user cluster. The checks deploy
+// workloads into a temporary namespace of the cluster, which is removed once the run completed.
+func StartClusterReadinessCheckEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, spec apiv2.ClusterReadinessCheckRunSpec, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, allowedRegistryProvider provider.PrivilegedAllowedRegistryProvider, backgroundOperationProvider provider.BackgroundOperationProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, projectID); err != nil {
+		return nil, err
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if err := defaultClusterReadinessCheckRunSpec(&spec); err != nil {
+		return nil, err
+	}
+
+	allowedRegistries, err := getEnforcedAllowedRegistries(ctx, cluster, allowedRegistryProvider)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	runs, cm, err := getClusterReadinessCheckRuns(ctx, seedClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	now := time.Now()
+	markInterruptedClusterReadinessCheckRuns(runs, now)
+	for _, run := range runs {
+		if run.Status.Phase == apiv2.ClusterReadinessCheckRunning {
+			return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("readiness check run %s is still running", run.ID))
+		}
+	}
+
+	run := apiv2.ClusterReadinessCheckRun{
+		ID:   rand.String(8),
+		Spec: spec,
+		Status: apiv2.ClusterReadinessCheckRunStatus{
+			Phase:     apiv2.ClusterReadinessCheckRunning,
+			StartTime: apiv1.NewTime(now),
+		},
+	}
+	for _, check := range clusterReadinessChecksOf(spec) {
+		run.Status.Results = append(run.Status.Results, apiv2.ClusterReadinessCheckResult{Check: check, Phase: apiv2.ClusterReadinessCheckPending})
+	}
+
+	runs = append([]apiv2.ClusterReadinessCheckRun{run}, runs...)
+	if len(runs) > maxClusterReadinessCheckRuns {
+		runs = runs[:maxClusterReadinessCheckRuns]
+	}
+	if err := saveClusterReadinessCheckRuns(ctx, seedClient, cluster, cm, runs); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	checker := newClusterReadinessChecker(client, cluster, &run, allowedRegistries)
+	op := provider.BackgroundOperation{Kind: ClusterReadinessCheckOperationKind, Cluster: cluster.Name, Name: run.ID}
+	if err := backgroundOperationProvider.Start(ctx, op, clusterReadinessCheckOperation(seedClient, cluster, checker, run)); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return &run, nil
+}
+
+// ClusterReadinessCheckResumer resumes the readiness check runs which were executed by API replicas that are gone.
+// The checks that did not finish are executed again.
+func ClusterReadinessCheckResumer(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, allowedRegistryProvider provider.PrivilegedAllowedRegistryProvider) provider.BackgroundOperationResumer {
+	return func(ctx context.Context, op provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return nil, err
+		}
+
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Name: op.Cluster}, cluster); err != nil {
+			return nil, ctrlruntimeclient.IgnoreNotFound(err)
+		}
+
+		runs, _, err := getClusterReadinessCheckRuns(ctx, seedClient, cluster)
+		if err != nil {
+			return nil, err
+		}
+		markInterruptedClusterReadinessCheckRuns(runs, time.Now())
+		i := slices.IndexFunc(runs, func(r apiv2.ClusterReadinessCheckRun) bool { return r.ID == op.Name })
+		if i < 0 || runs[i].Status.Phase != apiv2.ClusterReadinessCheckRunning {
+			return nil, nil
+		}
+
+		allowedRegistries, err := getEnforcedAllowedRegistries(ctx, cluster, allowedRegistryProvider)
+		if err != nil {
+			return nil, err
+		}
+		client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+		if err != nil {
+			return nil, err
+		}
+
+		return clusterReadinessCheckOperation(seedClient, cluster, newClusterReadinessChecker(client, cluster, &runs[i], allowedRegistries), runs[i]), nil
+	}
+}
+
+// ClusterReadinessCheckFailureHandler marks the readiness check runs which were given up after failing repeatedly as
+// failed.
+func ClusterReadinessCheckFailureHandler(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationFailureHandler {
+	return func(ctx context.Context, op provider.BackgroundOperation, cause error) error {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return err
+		}
+
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Name: op.Cluster}, cluster); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+		runs, _, err := getClusterReadinessCheckRuns(ctx, seedClient, cluster)
+		if err != nil {
+			return err
+		}
+		i := slices.IndexFunc(runs, func(r apiv2.ClusterReadinessCheckRun) bool { return r.ID == op.Name })
+		if i < 0 || runs[i].Status.Phase != apiv2.ClusterReadinessCheckRunning {
+			return nil
+		}
+
+		failClusterReadinessCheckRun(&runs[i], fmt.Sprintf("the run was given up: %v", cause))
+		return updateClusterReadinessCheckRun(ctx, seedClient, cluster, &runs[i])
+	}
+}
+
+func clusterReadinessCheckOperation(seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, checker *clusterReadinessChecker, run apiv2.ClusterReadinessCheckRun) provider.BackgroundOperationRun {
+	// the run changes its own copy while the response is encoded
+	run.Status.Results = slices.Clone(run.Status.Results)
+
+	return func(ctx context.Context) error {
+		ctx, cancel := context.WithDeadline(ctx, run.Status.StartTime.Add(clusterReadinessCheckRunTimeout))
+		defer cancel()
+
+		// an interrupted execution might have left its namespace behind
+		if err := checker.removeNamespace(ctx); err != nil {
+			return err
+		}
+
+		log := kubermaticlog.Logger.With("cluster", cluster.Name, "run", run.ID)
+		checker.execute(ctx, &run, func(run *apiv2.ClusterReadinessCheckRun) {
+			if err := updateClusterReadinessCheckRun(ctx, seedClient, cluster, run); err != nil {
+				log.Warnw("Failed to save readiness check run", zap.Error(err))
+			}
+		})
+
+		return nil
+	}
+}
+
+// ListClusterReadinessChecksEndpoint returns the stored readiness check runs of the cluster, newest first.
+func ListClusterReadinessChecksEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, _, err := getClusterReadinessCheckRuns(ctx, seedClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	markInterruptedClusterReadinessCheckRuns(runs, time.Now())
+
+	if runs == nil {
+		runs = []apiv2.ClusterReadinessCheckRun{}
+	}
+	return runs, nil
+}
+
+// GetClusterReadinessCheckEndpoint returns a single readiness check run of the cluster.
+func GetClusterReadinessCheckEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, runID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	runs, _, err := getClusterReadinessCheckRuns(ctx, seedClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	markInterruptedClusterReadinessCheckRuns(runs, time.Now())
+
+	for i := range runs {
+		if runs[i].ID == runID {
+			return &runs[i], nil
+		}
+	}
+	return nil, utilerrors.NewNotFound("readiness check run", runID)
+}
+
+// clusterReadinessChecksOf returns the checks of the run in the order they are executed.
+func clusterReadinessChecksOf(spec apiv2.ClusterReadinessCheckRunSpec) []apiv2.ClusterReadinessCheckType {
+	if len(spec.Checks) == 0 {
+		return apiv2.AllClusterReadinessChecks
+	}
+	return slices.DeleteFunc(slices.Clone(apiv2.AllClusterReadinessChecks), func(check apiv2.ClusterReadinessCheckType) bool {
+		return !slices.Contains(spec.Checks, check)
+	})
+}
+
+func defaultClusterReadinessCheckRunSpec(spec *apiv2.ClusterReadinessCheckRunSpec) error {
+	seen := sets.New[apiv2.ClusterReadinessCheckType]()
+	for _, check := range spec.Checks {
+		if !slices.Contains(apiv2.AllClusterReadinessChecks, check) {
+			return utilerrors.NewBadRequest("unknown check %q", check)
+		}
+		if seen.Has(check) {
+			return utilerrors.NewBadRequest("check %q is listed more than once", check)
+		}
+		seen.Insert(check)
+	}
+
+	if spec.Image == "" {
+		spec.Image = defaultClusterReadinessCheckImage
+	}
+	if len(spec.Images) == 0 {
+		spec.Images = []string{spec.Image}
+	}
+	if len(spec.Images) > maxClusterReadinessCheckImages {
+		return utilerrors.NewBadRequest("at most %d images can be pulled in one run", maxClusterReadinessCheckImages)
+	}
+
+	return nil
+}
+
+// getEnforcedAllowedRegistries returns the registry prefixes images of the cluster are restricted to, or nil if images
+// from all registries are allowed. The allowed registries are only enforced in clusters with the OPA integration.
+func getEnforcedAllowedRegistries(ctx context.Context, cluster *kubermaticv1.Cluster, allowedRegistryProvider provider.PrivilegedAllowedRegistryProvider) ([]string, error) {
+	if cluster.Spec.OPAIntegration == nil || !cluster.Spec.OPAIntegration.Enabled {
+		return nil, nil
+	}
+
+	allowedRegistries, err := allowedRegistryProvider.ListUnsecured(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	var prefixes []string
+	for _, ar := range allowedRegistries.Items {
+		prefixes = append(prefixes, ar.Spec.RegistryPrefix)
+	}
+	return prefixes, nil
+}
+
+// markInterruptedClusterReadinessCheckRuns fails runs that are running for longer than a run can take.
+func markInterruptedClusterReadinessCheckRuns(runs []apiv2.ClusterReadinessCheckRun, now time.Time) {
+	for i := range runs {
+		run := &runs[i]
+		if run.Status.Phase != apiv2.ClusterReadinessCheckRunning || now.Sub(run.Status.StartTime.Time) <= clusterReadinessCheckRunTimeout {
+			continue
+		}
+		failClusterReadinessCheckRun(run, "the run was interrupted before all checks completed")
+	}
+}
+
+// failClusterReadinessCheckRun fails the run and the checks of it that did not finish.
+func failClusterReadinessCheckRun(run *apiv2.ClusterReadinessCheckRun, message string) {
+	run.Status.Phase = apiv2.ClusterReadinessCheckFailed
+	run.Status.Message = message
+	for j := range run.Status.Results {
+		if run.Status.Results[j].Phase == apiv2.ClusterReadinessCheckRunning {
+			run.Status.Results[j].Phase = apiv2.ClusterReadinessCheckFailed
+			run.Status.Results[j].Message = "interrupted"
+		}
+	}
+}
+
+func getClusterReadinessCheckRuns(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) ([]apiv2.ClusterReadinessCheckRun, *corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: clusterReadinessChecksConfigMapName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	var runs []apiv2.ClusterReadinessCheckRun
+	if err := json.Unmarshal([]byte(cm.Data[clusterReadinessChecksKey]), &runs); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode readiness check runs: %w", err)
+	}
+
+	return runs, cm, nil
+}
+
+// saveClusterReadinessCheckRuns stores the runs in the ConfigMap they were read from, so that concurrent changes fail
+// with a conflict instead of being lost.
+func saveClusterReadinessCheckRuns(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, cm *corev1.ConfigMap, runs []apiv2.ClusterReadinessCheckRun) error {
+	data, err := json.Marshal(runs)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		return seedClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      clusterReadinessChecksConfigMapName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+			Data: map[string]string{clusterReadinessChecksKey: string(data)},
+		})
+	}
+
+	cm.Data = map[string]string{clusterReadinessChecksKey: string(data)}
+	return seedClient.Update(ctx, cm)
+}
+
+// updateClusterReadinessCheckRun replaces the stored run with the given one. Runs that were dropped in the meantime
+// are not stored again.
+func updateClusterReadinessCheckRun(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, run *apiv2.ClusterReadinessCheckRun) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		runs, cm, err := getClusterReadinessCheckRuns(ctx, seedClient, cluster)
+		if err != nil {
+			return err
+		}
+
+		i := slices.IndexFunc(runs, func(r apiv2.ClusterReadinessCheckRun) bool { return r.ID == run.ID })
+		if i < 0 {
+			return nil
+		}
+		runs[i] = *run
+
+		return saveClusterReadinessCheckRuns(ctx, seedClient, cluster, cm, runs)
+	})
+}
+
+// checkSkippedError is returned by checks that do not apply to the cluster.
+type checkSkippedError struct {
+	reason string
+}
+
+func (e checkSkippedError) Error() string {
+	return e.reason
+}
+
+// clusterReadinessChecker executes the checks of a run with an admin client of the user cluster.
+type clusterReadinessChecker struct {
+	client            ctrlruntimeclient.Client
+	runID             string
+	namespace         string
+	image             string
+	images            []string
+	dnsDomain         string
+	providerName      string
+	allowedRegistries []string
+	// loadBalancerRequested is true if the LoadBalancer check was requested explicitly, it is run even if the
+	// provider has no known load balancer integration then.
+	loadBalancerRequested bool
+
+	pollInterval        time.Duration
+	timeout             time.Duration
+	loadBalancerTimeout time.Duration
+
+	// the server pod and its service are shared by the networking checks
+	serverPodIP string
+	service     *corev1.Service
+}
+
+func newClusterReadinessChecker(client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, run *apiv2.ClusterReadinessCheckRun, allowedRegistries []string) *clusterReadinessChecker {
+	dnsDomain := cluster.Spec.ClusterNetwork.DNSDomain
+	if dnsDomain == "" {
+		dnsDomain = "cluster.local"
+	}
+
+	return &clusterReadinessChecker{
+		client:                client,
+		runID:                 run.ID,
+		namespace:             "kubermatic-readiness-check-" + run.ID,
+		image:                 run.Spec.Image,
+		images:                run.Spec.Images,
+		dnsDomain:             dnsDomain,
+		providerName:          cluster.Spec.Cloud.ProviderName,
+		allowedRegistries:     allowedRegistries,
+		loadBalancerRequested: slices.Contains(run.Spec.Checks, apiv2.ClusterReadinessCheckLoadBalancer),
+		pollInterval:          clusterReadinessCheckPollInterval,
+		timeout:               clusterReadinessCheckTimeout,
+		loadBalancerTimeout:   clusterReadinessCheckLoadBalancerTimeout,
+	}
+}
+
+// execute runs all checks of the run one after another and calls save whenever the run changed. Checks which already
+// finished, before the run was resumed, are not executed again.
+func (c *clusterReadinessChecker) execute(ctx context.Context, run *apiv2.ClusterReadinessCheckRun, save func(*apiv2.ClusterReadinessCheckRun)) {
+	defer c.cleanup()
+
+	setupErr := c.client.Create(ctx, &corev1.Namespace{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   c.namespace,
+			Labels: map[string]string{clusterReadinessCheckRunLabel: c.runID},
+		},
+	})
+	if setupErr != nil {
+		setupErr = fmt.Errorf("failed to create namespace %s: %w", c.namespace, setupErr)
+	}
+
+	failed := 0
+	for i := range run.Status.Results {
+		result := &run.Status.Results[i]
+		switch result.Phase {
+		case apiv2.ClusterReadinessCheckFailed:
+			failed++
+			continue
+		case apiv2.ClusterReadinessCheckPassed, apiv2.ClusterReadinessCheckSkipped:
+			continue
+		}
+
+		start := time.Now()
+		result.Phase = apiv2.ClusterReadinessCheckRunning
+		result.StartTime = ptr.To(apiv1.NewTime(start))
+		save(run)
+
+		message, err := "", setupErr
+		if err == nil {
+			message, err = c.runCheck(ctx, result.Check)
+		}
+		result.DurationMilliseconds = time.Since(start).Milliseconds()
+
+		var skipped checkSkippedError
+		switch {
+		case errors.As(err, &skipped):
+			result.Phase = apiv2.ClusterReadinessCheckSkipped
+			result.Message = skipped.reason
+		case err != nil:
+			failed++
+			result.Phase = apiv2.ClusterReadinessCheckFailed
+			result.Message = err.Error()
+		default:
+			result.Phase = apiv2.ClusterReadinessCheckPassed
+			result.Message = message
+		}
+	}
+
+	run.Status.Phase = apiv2.ClusterReadinessCheckPassed
+	if failed > 0 {
+		run.Status.Phase = apiv2.ClusterReadinessCheckFailed
+		run.Status.Message = fmt.Sprintf("%d of %d checks failed", failed, len(run.Status.Results))
+	}
+	run.Status.CompletionTime = ptr.To(apiv1.Now())
+	save(run)
+}
+
+func (c *clusterReadinessChecker) runCheck(ctx context.Context, check apiv2.ClusterReadinessCheckType) (string, error) {
+	timeout := c.timeout
+	switch check {
+	case apiv2.ClusterReadinessCheckLoadBalancer:
+		timeout = c.loadBalancerTimeout
+	case apiv2.ClusterReadinessCheckImagePull:
+		timeout *= time.Duration(len(c.images))
+	}
+	ctx, cancel := context.WithTimeout(ctx, timeout)
+	defer cancel()
+
+	switch check {
+	case apiv2.ClusterReadinessCheckPodNetwork:
+		if err := c.ensureServer(ctx); err != nil {
+			return "", err
+		}
+		target := net.JoinHostPort(c.serverPodIP, strconv.Itoa(readinessCheckServerPort))
+		return "connected to pod " + target, c.connect(ctx, "pod-network", target)
+
+	case apiv2.ClusterReadinessCheckServiceNetwork:
+		if err := c.ensureServer(ctx); err != nil {
+			return "", err
+		}
+		target := net.JoinHostPort(c.service.Spec.ClusterIP, "80")
+		return "connected to service " + target, c.connect(ctx, "service-network", target)
+
+	case apiv2.ClusterReadinessCheckDNS:
+		if err := c.ensureServer(ctx); err != nil {
+			return "", err
+		}
+		target := fmt.Sprintf("%s.%s.svc.%s:80", c.service.Name, c.namespace, c.dnsDomain)
+		return "resolved and connected to " + target, c.connect(ctx, "dns", target)
+
+	case apiv2.ClusterReadinessCheckPersistentVolume:
+		return c.checkPersistentVolume(ctx)
+
+	case apiv2.ClusterReadinessCheckLoadBalancer:
+		return c.checkLoadBalancer(ctx)
+
+	case apiv2.ClusterReadinessCheckImagePull:
+		return c.checkImagePull(ctx)
+	}
+
+	return "", fmt.Errorf("unknown check %q", check)
+}
+
+// ensureServer deploys the HTTP server the networking checks connect to, once per run.
+func (c *clusterReadinessChecker) ensureServer(ctx context.Context) error {
+	if c.service != nil {
+		return nil
+	}
+
+	pod := c.pod(readinessCheckServerName, c.image, "netexec", fmt.Sprintf("--http-port=%d", readinessCheckServerPort))
+	pod.Labels = map[string]string{clusterReadinessCheckAppLabel: readinessCheckServerName}
+	pod.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	pod.Spec.Containers[0].ReadinessProbe = &corev1.Probe{
+		ProbeHandler: corev1.ProbeHandler{
+			TCPSocket: &corev1.TCPSocketAction{Port: intstr.FromInt32(readinessCheckServerPort)},
+		},
+		PeriodSeconds: 2,
+	}
+	if err := c.client.Create(ctx, pod); err != nil && !apierrors.IsAlreadyExists(err) {
+		return fmt.Errorf("failed to create the server pod: %w", err)
+	}
+
+	err := c.waitFor(ctx, "the server pod to become ready", func(ctx context.Context) (bool, error) {
+		if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(pod), pod); err != nil {
+			return false, err
+		}
+		return isPodReady(pod) && pod.Status.PodIP != "", nil
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, describePod(pod))
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: readinessCheckServerName, Namespace: c.namespace},
+		Spec: corev1.ServiceSpec{
+			Selector: map[string]string{clusterReadinessCheckAppLabel: readinessCheckServerName},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(readinessCheckServerPort)}},
+		},
+	}
+	if err := c.client.Create(ctx, service); err != nil {
+		return fmt.Errorf("failed to create the server service: %w", err)
+	}
+	err = c.waitFor(ctx, "the server service to get a cluster IP", func(ctx context.Context) (bool, error) {
+		if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(service), service); err != nil {
+			return false, err
+		}
+		return service.Spec.ClusterIP != "", nil
+	})
+	if err != nil {
+		return err
+	}
+
+	c.serverPodIP = pod.Status.PodIP
+	c.service = service
+	return nil
+}
+
+// connect runs a pod that opens a TCP connection to the target. Failed attempts are restarted by the kubelet until
+// the check times out, so that a slowly programmed network does not fail the check.
+func (c *clusterReadinessChecker) connect(ctx context.Context, name, target string) error {
+	pod := c.pod(name, c.image, "connect", target, "--timeout=5s")
+	pod.Spec.RestartPolicy = corev1.RestartPolicyOnFailure
+	// prefer another node than the server, so that the traffic crosses nodes
+	pod.Spec.Affinity = &corev1.Affinity{
+		PodAntiAffinity: &corev1.PodAntiAffinity{
+			PreferredDuringSchedulingIgnoredDuringExecution: []corev1.WeightedPodAffinityTerm{{
+				Weight: 100,
+				PodAffinityTerm: corev1.PodAffinityTerm{
+					LabelSelector: &metav1.LabelSelector{MatchLabels: map[string]string{clusterReadinessCheckAppLabel: readinessCheckServerName}},
+					TopologyKey:   corev1.LabelHostname,
+				},
+			}},
+		},
+	}
+	if err := c.client.Create(ctx, pod); err != nil {
+		return fmt.Errorf("failed to create pod %s: %w", name, err)
+	}
+
+	err := c.waitFor(ctx, "a connection to "+target, func(ctx context.Context) (bool, error) {
+		if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(pod), pod); err != nil {
+			return false, err
+		}
+		return pod.Status.Phase == corev1.PodSucceeded, nil
+	})
+	if err != nil {
+		return fmt.Errorf("%w: %s", err, describePod(pod))
+	}
+	return nil
+}
+
+func (c *clusterReadinessChecker) checkPersistentVolume(ctx context.Context) (string, error) {
+	storageClasses := &storagev1.StorageClassList{}
+	if err := c.client.List(ctx, storageClasses); err != nil {
+		return "", fmt.Errorf("failed to list storage classes: %w", err)
+	}
+	var storageClass string
+	for _, sc := range storageClasses.Items {
+		if sc.Annotations["storageclass.kubernetes.io/is-default-class"] == "true" {
+			storageClass = sc.Name
+			break
+		}
+	}
+	if storageClass == "" {
+		return "", errors.New("the cluster has no default storage class")
+	}
+
+	pvc := &corev1.PersistentVolumeClaim{
+		ObjectMeta: metav1.ObjectMeta{Name: "volume", Namespace: c.namespace},
+		Spec: corev1.PersistentVolumeClaimSpec{
+			AccessModes:      []corev1.PersistentVolumeAccessMode{corev1.ReadWriteOnce},
+			StorageClassName: &storageClass,
+			Resources: corev1.VolumeResourceRequirements{
+				Requests: corev1.ResourceList{corev1.ResourceStorage: resource.MustParse("1Gi")},
+			},
+		},
+	}
+	if err := c.client.Create(ctx, pvc); err != nil {
+		return "", fmt.Errorf("failed to create the persistent volume claim: %w", err)
+	}
+
+	// volumes with WaitForFirstConsumer binding are only provisioned for a pod
+	pod := c.pod("volume", c.image, "pause")
+	pod.Spec.RestartPolicy = corev1.RestartPolicyAlways
+	pod.Spec.Volumes = []corev1.Volume{{
+		Name: "data",
+		VolumeSource: corev1.VolumeSource{
+			PersistentVolumeClaim: &corev1.PersistentVolumeClaimVolumeSource{ClaimName: pvc.Name},
+		},
+	}}
+	pod.Spec.Containers[0].VolumeMounts = []corev1.VolumeMount{{Name: "data", MountPath: "/data"}}
+	if err := c.client.Create(ctx, pod); err != nil {
+		return "", fmt.Errorf("failed to create the volume pod: %w", err)
+	}
+
+	err := c.waitFor(ctx, "the volume to be provisioned and mounted", func(ctx context.Context) (bool, error) {
+		if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(pvc), pvc); err != nil {
+			return false, err
+		}
+		if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(pod), pod); err != nil {
+			return false, err
+		}
+		return pvc.Status.Phase == corev1.ClaimBound && pod.Status.Phase == corev1.PodRunning, nil
+	})
+	if err != nil {
+		return "", fmt.Errorf("%w: claim of storage class %s is %s, %s", err, storageClass, pvc.Status.Phase, describePod(pod))
+	}
+
+	return fmt.Sprintf("provisioned and mounted a volume of storage class %s", storageClass), nil
+}
+
+func (c *clusterReadinessChecker) checkLoadBalancer(ctx context.Context) (string, error) {
+	if !c.loadBalancerRequested && !loadBalancerProviders.Has(c.providerName) {
+		return "", checkSkippedError{reason: fmt.Sprintf("the %s provider has no load balancer integration, request the check explicitly to run it anyway", c.providerName)}
+	}
+
+	if err := c.ensureServer(ctx); err != nil {
+		return "", err
+	}
+
+	service := &corev1.Service{
+		ObjectMeta: metav1.ObjectMeta{Name: "load-balancer", Namespace: c.namespace},
+		Spec: corev1.ServiceSpec{
+			Type:     corev1.ServiceTypeLoadBalancer,
+			Selector: map[string]string{clusterReadinessCheckAppLabel: readinessCheckServerName},
+			Ports:    []corev1.ServicePort{{Port: 80, TargetPort: intstr.FromInt32(readinessCheckServerPort)}},
+		},
+	}
+	if err := c.client.Create(ctx, service); err != nil {
+		return "", fmt.Errorf("failed to create the load balancer service: %w", err)
+	}
+
+	err := c.waitFor(ctx, "the load balancer to be provisioned", func(ctx context.Context) (bool, error) {
+		if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(service), service); err != nil {
+			return false, err
+		}
+		return len(service.Status.LoadBalancer.Ingress) > 0, nil
+	})
+	if err != nil {
+		return "", err
+	}
+
+	ingress := service.Status.LoadBalancer.Ingress[0]
+	address := ingress.IP
+	if address == "" {
+		address = ingress.Hostname
+	}
+	return "provisioned load balancer " + address, nil
+}
+
+func (c *clusterReadinessChecker) checkImagePull(ctx context.Context) (string, error) {
+	for i, image := range c.images {
+		if !isImageAllowed(image, c.allowedRegistries) {
+			return "", fmt.Errorf("image %s is not from an allowed registry", image)
+		}
+
+		// the image is pulled even if it cannot run with the restricted security context
+		pod := c.pod(fmt.Sprintf("image-pull-%d", i), image)
+		pod.Spec.RestartPolicy = corev1.RestartPolicyNever
+		pod.Spec.Containers[0].ImagePullPolicy = corev1.PullAlways
+		if err := c.client.Create(ctx, pod); err != nil {
+			return "", fmt.Errorf("failed to create the pod for image %s: %w", image, err)
+		}
+
+		err := c.waitFor(ctx, "image "+image+" to be pulled", func(ctx context.Context) (bool, error) {
+			if err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(pod), pod); err != nil {
+				return false, err
+			}
+			return isImagePulled(pod)
+		})
+		if err != nil {
+			return "", err
+		}
+	}
+
+	return "pulled " + strings.Join(c.images, ", "), nil
+}
+
+// waitFor polls the condition until it is met, fails or the check times out.
+func (c *clusterReadinessChecker) waitFor(ctx context.Context, what string, condition wait.ConditionWithContextFunc) error {
+	err := wait.PollUntilContextCancel(ctx, c.pollInterval, true, condition)
+	if wait.Interrupted(err) {
+		return fmt.Errorf("timed out waiting for %s", what)
+	}
+	return err
+}
+
+// removeNamespace deletes the namespace of the run and waits until it is gone.
+func (c *clusterReadinessChecker) removeNamespace(ctx context.Context) error {
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: c.namespace}}
+	if err := c.client.Delete(ctx, ns, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil {
+		return ctrlruntimeclient.IgnoreNotFound(err)
+	}
+
+	return c.waitFor(ctx, "the namespace of an interrupted run to be removed", func(ctx context.Context) (bool, error) {
+		err := c.client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(ns), ns)
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	})
+}
+
+// cleanup removes the namespace of the run with all workloads the checks created.
+func (c *clusterReadinessChecker) cleanup() {
+	ctx, cancel := context.WithTimeout(context.Background(), time.Minute)
+	defer cancel()
+
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: c.namespace}}
+	if err := c.client.Delete(ctx, ns, ctrlruntimeclient.PropagationPolicy(metav1.DeletePropagationBackground)); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+		kubermaticlog.Logger.Warnw("Failed to delete readiness check namespace", "namespace", c.namespace, zap.Error(err))
+	}
+}
+
+// pod returns a pod running a single container that complies with the restricted pod security standard.
+func (c *clusterReadinessChecker) pod(name, image string, args ...string) *corev1.Pod {
+	return &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: c.namespace},
+		Spec: corev1.PodSpec{
+			AutomountServiceAccountToken:  ptr.To(false),
+			TerminationGracePeriodSeconds: ptr.To[int64](0),
+			SecurityContext: &corev1.PodSecurityContext{
+				RunAsNonRoot:   ptr.To(true),
+				RunAsUser:      ptr.To[int64](65534),
+				SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+			},
+			Containers: []corev1.Container{{
+				Name:  "check",
+				Image: image,
+				Args:  args,
+				// failed connection attempts are reported through the termination message
+				TerminationMessagePolicy: corev1.TerminationMessageFallbackToLogsOnError,
+				SecurityContext: &corev1.SecurityContext{
+					AllowPrivilegeEscalation: ptr.To(false),
+					Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+				},
+			}},
+		},
+	}
+}
+
+func isPodReady(pod *corev1.Pod) bool {
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodReady {
+			return condition.Status == corev1.ConditionTrue
+		}
+	}
+	return false
+}
+
+// isImagePulled returns true once the container of the pod got past pulling its image. Image pull failures are
+// returned as error.
+func isImagePulled(pod *corev1.Pod) (bool, error) {
+	for _, status := range pod.Status.ContainerStatuses {
+		if status.State.Running != nil || status.State.Terminated != nil {
+			return true, nil
+		}
+		if waiting := status.State.Waiting; waiting != nil {
+			switch waiting.Reason {
+			case "ErrImagePull", "ImagePullBackOff", "InvalidImageName", "ErrImageNeverPull":
+				return false, fmt.Errorf("failed to pull image %s: %s: %s", status.Image, waiting.Reason, waiting.Message)
+			// the container is only created from a pulled image
+			case "CreateContainerConfigError", "CreateContainerError", "RunContainerError", "CrashLoopBackOff":
+				return true, nil
+			}
+		}
+	}
+	return false, nil
+}
+
+func isImageAllowed(image string, allowedRegistries []string) bool {
+	if len(allowedRegistries) == 0 {
+		return true
+	}
+	for _, prefix := range allowedRegistries {
+		if strings.HasPrefix(image, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+// describePod summarizes why a pod did not get to the expected state.
+func describePod(pod *corev1.Pod) string {
+	description := fmt.Sprintf("pod %s is %s", pod.Name, pod.Status.Phase)
+	if pod.Status.Phase == "" {
+		description = fmt.Sprintf("pod %s is Pending", pod.Name)
+	}
+
+	for _, condition := range pod.Status.Conditions {
+		if condition.Type == corev1.PodScheduled && condition.Status == corev1.ConditionFalse && condition.Message != "" {
+			return description + ": " + condition.Message
+		}
+	}
+	for _, status := range pod.Status.ContainerStatuses {
+		switch {
+		case status.State.Waiting != nil && status.State.Waiting.Message != "":
+			description += fmt.Sprintf(": %s: %s", status.State.Waiting.Reason, status.State.Waiting.Message)
+		case status.LastTerminationState.Terminated != nil:
+			description += ": " + strings.TrimSpace(status.LastTerminationState.Terminated.Message)
+		case status.State.Terminated != nil:
+			description += ": " + strings.TrimSpace(status.State.Terminated.Message)
+		}
+	}
+	return description
+}
@@ -0,0 +1,192 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClusterReadinessChecker(t *testing.T) {
+	t.Parallel()
+
+	client := ctrlruntimefake.NewClientBuilder().
+		WithObjects(&storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "standard", Annotations: map[string]string{"storageclass.kubernetes.io/is-default-class": "true"}},
+			Provisioner: "csi.example.com",
+		}).
+		Build()
+	cluster := &kubermaticv1.Cluster{
+		Spec: kubermaticv1.ClusterSpec{
+			Cloud: kubermaticv1.CloudSpec{ProviderName: string(kubermaticv1.VSphereCloudProvider)},
+		},
+	}
+	spec := apiv2.ClusterReadinessCheckRunSpec{Images: []string{defaultClusterReadinessCheckImage, "docker.io/library/nginx:latest"}}
+	require.NoError(t, defaultClusterReadinessCheckRunSpec(&spec))
+	run := &apiv2.ClusterReadinessCheckRun{ID: "abc", Spec: spec}
+	for _, check := range clusterReadinessChecksOf(spec) {
+		run.Status.Results = append(run.Status.Results, apiv2.ClusterReadinessCheckResult{Check: check, Phase: apiv2.ClusterReadinessCheckPending})
+	}
+
+	checker := newClusterReadinessChecker(client, cluster, run, []string{"registry.k8s.io/"})
+	checker.pollInterval = time.Millisecond
+	checker.timeout = 10 * time.Second
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	go simulateUserCluster(ctx, client)
+
+	saves := 0
+	checker.execute(ctx, run, func(*apiv2.ClusterReadinessCheckRun) { saves++ })
+
+	phases := map[apiv2.ClusterReadinessCheckType]apiv2.ClusterReadinessCheckPhase{}
+	for _, result := range run.Status.Results {
+		phases[result.Check] = result.Phase
+		if result.Check == apiv2.ClusterReadinessCheckImagePull {
+			require.Contains(t, result.Message, "docker.io/library/nginx:latest is not from an allowed registry")
+		}
+	}
+	require.Equal(t, map[apiv2.ClusterReadinessCheckType]apiv2.ClusterReadinessCheckPhase{
+		apiv2.ClusterReadinessCheckPodNetwork:       apiv2.ClusterReadinessCheckPassed,
+		apiv2.ClusterReadinessCheckServiceNetwork:   apiv2.ClusterReadinessCheckPassed,
+		apiv2.ClusterReadinessCheckDNS:              apiv2.ClusterReadinessCheckPassed,
+		apiv2.ClusterReadinessCheckPersistentVolume: apiv2.ClusterReadinessCheckPassed,
+		apiv2.ClusterReadinessCheckLoadBalancer:     apiv2.ClusterReadinessCheckSkipped,
+		apiv2.ClusterReadinessCheckImagePull:        apiv2.ClusterReadinessCheckFailed,
+	}, phases)
+	require.Equal(t, apiv2.ClusterReadinessCheckFailed, run.Status.Phase)
+	require.Equal(t, "1 of 6 checks failed", run.Status.Message)
+	require.NotNil(t, run.Status.CompletionTime)
+	// every check is saved when it starts, and the run once it completed
+	require.Equal(t, len(run.Status.Results)+1, saves)
+
+	err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Name: checker.namespace}, &corev1.Namespace{})
+	require.True(t, apierrors.IsNotFound(err), "expected the namespace of the run to be removed, got %v", err)
+}
+
+// simulateUserCluster plays the kubelet and the controllers of a healthy user cluster for the readiness checks.
+func simulateUserCluster(ctx context.Context, client ctrlruntimeclient.Client) {
+	for ctx.Err() == nil {
+		pods := &corev1.PodList{}
+		_ = client.List(ctx, pods)
+		for _, pod := range pods.Items {
+			switch {
+			case pod.Name == readinessCheckServerName:
+				pod.Status.Phase = corev1.PodRunning
+				pod.Status.PodIP = "10.244.0.10"
+				pod.Status.Conditions = []corev1.PodCondition{{Type: corev1.PodReady, Status: corev1.ConditionTrue}}
+			case strings.HasPrefix(pod.Name, "image-pull-"):
+				pod.Status.ContainerStatuses = []corev1.ContainerStatus{{Image: pod.Spec.Containers[0].Image, State: corev1.ContainerState{Running: &corev1.ContainerStateRunning{}}}}
+			case pod.Name == "volume":
+				pod.Status.Phase = corev1.PodRunning
+			default:
+				pod.Status.Phase = corev1.PodSucceeded
+			}
+			_ = client.Status().Update(ctx, &pod)
+		}
+
+		services := &corev1.ServiceList{}
+		_ = client.List(ctx, services)
+		for _, service := range services.Items {
+			if service.Spec.ClusterIP == "" {
+				service.Spec.ClusterIP = "10.96.0.20"
+				_ = client.Update(ctx, &service)
+			}
+		}
+
+		pvcs := &corev1.PersistentVolumeClaimList{}
+		_ = client.List(ctx, pvcs)
+		for _, pvc := range pvcs.Items {
+			pvc.Status.Phase = corev1.ClaimBound
+			_ = client.Status().Update(ctx, &pvc)
+		}
+
+		time.Sleep(time.Millisecond)
+	}
+}
+
+func TestDefaultClusterReadinessCheckRunSpec(t *testing.T) {
+	t.Parallel()
+
+	spec := apiv2.ClusterReadinessCheckRunSpec{}
+	require.NoError(t, defaultClusterReadinessCheckRunSpec(&spec))
+	require.Equal(t, defaultClusterReadinessCheckImage, spec.Image)
+	require.Equal(t, []string{defaultClusterReadinessCheckImage}, spec.Images)
+	require.Equal(t, apiv2.AllClusterReadinessChecks, clusterReadinessChecksOf(spec))
+
+	// checks are executed in their fixed order, independent of the requested order
+	spec = apiv2.ClusterReadinessCheckRunSpec{Checks: []apiv2.ClusterReadinessCheckType{apiv2.ClusterReadinessCheckImagePull, apiv2.ClusterReadinessCheckDNS}}
+	require.NoError(t, defaultClusterReadinessCheckRunSpec(&spec))
+	require.Equal(t, []apiv2.ClusterReadinessCheckType{apiv2.ClusterReadinessCheckDNS, apiv2.ClusterReadinessCheckImagePull}, clusterReadinessChecksOf(spec))
+
+	for _, invalid := range []apiv2.ClusterReadinessCheckRunSpec{
+		{Checks: []apiv2.ClusterReadinessCheckType{"Unknown"}},
+		{Checks: []apiv2.ClusterReadinessCheckType{apiv2.ClusterReadinessCheckDNS, apiv2.ClusterReadinessCheckDNS}},
+		{Images: []string{"a", "b", "c", "d", "e", "f"}},
+	} {
+		requireHTTPStatus(t, http.StatusBadRequest, defaultClusterReadinessCheckRunSpec(&invalid))
+	}
+}
+
+func TestMarkInterruptedClusterReadinessCheckRuns(t *testing.T) {
+	t.Parallel()
+
+	now := time.Now()
+	runs := []apiv2.ClusterReadinessCheckRun{
+		{
+			ID: "recent",
+			Status: apiv2.ClusterReadinessCheckRunStatus{
+				Phase:     apiv2.ClusterReadinessCheckRunning,
+				StartTime: apiv1.NewTime(now.Add(-time.Minute)),
+			},
+		},
+		{
+			ID: "stale",
+			Status: apiv2.ClusterReadinessCheckRunStatus{
+				Phase:     apiv2.ClusterReadinessCheckRunning,
+				StartTime: apiv1.NewTime(now.Add(-2 * clusterReadinessCheckRunTimeout)),
+				Results: []apiv2.ClusterReadinessCheckResult{
+					{Check: apiv2.ClusterReadinessCheckDNS, Phase: apiv2.ClusterReadinessCheckPassed},
+					{Check: apiv2.ClusterReadinessCheckImagePull, Phase: apiv2.ClusterReadinessCheckRunning},
+				},
+			},
+		},
+	}
+
+	markInterruptedClusterReadinessCheckRuns(runs, now)
+
+	require.Equal(t, apiv2.ClusterReadinessCheckRunning, runs[0].Status.Phase)
+	require.Equal(t, apiv2.ClusterReadinessCheckFailed, runs[1].Status.Phase)
+	require.Equal(t, apiv2.ClusterReadinessCheckPassed, runs[1].Status.Results[0].Phase)
+	require.Equal(t, apiv2.ClusterReadinessCheckFailed, runs[1].Status.Results[1].Phase)
+}
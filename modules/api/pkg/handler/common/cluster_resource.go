@@ -0,0 +1,252 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"slices"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/fields"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/discovery"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	defaultClusterResourceListLimit = 100
+	maxClusterResourceListLimit     = 1000
+)
+
+// ClusterResourceListOptions select the resources returned by ListClusterResourcesEndpoint.
+type ClusterResourceListOptions struct {
+	// Namespace limits namespaced resources to a single namespace, all namespaces are listed if empty.
+	Namespace     string
+	LabelSelector string
+	FieldSelector string
+	// Limit is the page size, Continue the token of the page to return.
+	Limit    int64
+	Continue string
+}
+
+// ListClusterAPIResourcesEndpoint returns the resource types served by the API server of the user cluster in their
+// preferred version. Only resources that can be listed are returned, subresources are omitted.
+func ListClusterAPIResourcesEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	// Discovery is readable by every authenticated user of the cluster, so the admin config reveals nothing
+	// beyond what the user could discover on their own.
+	cfg, err := clusterProvider.GetAdminClientConfigForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	discoveryClient, err := discovery.NewDiscoveryClientForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	resourceLists, err := discoveryClient.ServerPreferredResources()
+	// aggregated APIs that are down must not hide all other resources
+	if err != nil && !discovery.IsGroupDiscoveryFailedError(err) {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return convertAPIResourceLists(resourceLists), nil
+}
+
+// ListClusterResourcesEndpoint lists the resources of a type in the user cluster with the permissions of the user.
+func ListClusterResourcesEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, gvk schema.GroupVersionKind, options ClusterResourceListOptions, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	client, err := getClusterResourceClient(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return listClusterResources(ctx, client, gvk, options)
+}
+
+// GetClusterResourceEndpoint returns a single resource of the user cluster with the permissions of the user.
+func GetClusterResourceEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, gvk schema.GroupVersionKind, namespace, name string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	client, err := getClusterResourceClient(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return getClusterResource(ctx, client, gvk, namespace, name)
+}
+
+// getClusterResourceClient returns a client of the user cluster that impersonates the user, so that the RBAC of the
+// cluster applies to everything read through it.
+func getClusterResourceClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (ctrlruntimeclient.Client, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return client, nil
+}
+
+func listClusterResources(ctx context.Context, client ctrlruntimeclient.Client, gvk schema.GroupVersionKind, options ClusterResourceListOptions) (*apiv2.ClusterResourceList, error) {
+	mapping, err := getClusterResourceMapping(client, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	if options.Limit < 0 {
+		return nil, utilerrors.NewBadRequest("limit must not be negative")
+	}
+	if options.Limit == 0 {
+		options.Limit = defaultClusterResourceListLimit
+	}
+	options.Limit = min(options.Limit, maxClusterResourceListLimit)
+
+	listOptions := []ctrlruntimeclient.ListOption{
+		ctrlruntimeclient.Limit(options.Limit),
+		ctrlruntimeclient.Continue(options.Continue),
+	}
+	if options.Namespace != "" {
+		if mapping.Scope.Name() != meta.RESTScopeNameNamespace {
+			return nil, utilerrors.NewBadRequest("%s is not namespaced", mapping.GroupVersionKind.Kind)
+		}
+		listOptions = append(listOptions, ctrlruntimeclient.InNamespace(options.Namespace))
+	}
+	if options.LabelSelector != "" {
+		selector, err := labels.Parse(options.LabelSelector)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid label selector: %v", err)
+		}
+		listOptions = append(listOptions, ctrlruntimeclient.MatchingLabelsSelector{Selector: selector})
+	}
+	if options.FieldSelector != "" {
+		selector, err := fields.ParseSelector(options.FieldSelector)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid field selector: %v", err)
+		}
+		listOptions = append(listOptions, ctrlruntimeclient.MatchingFieldsSelector{Selector: selector})
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(mapping.GroupVersionKind.GroupVersion().WithKind(mapping.GroupVersionKind.Kind + "List"))
+	if err := client.List(ctx, list, listOptions...); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	result := &apiv2.ClusterResourceList{
+		Items:              make([]apiv2.ClusterResource, 0, len(list.Items)),
+		Continue:           list.GetContinue(),
+		RemainingItemCount: list.GetRemainingItemCount(),
+	}
+	for _, item := range list.Items {
+		result.Items = append(result.Items, item.Object)
+	}
+	return result, nil
+}
+
+func getClusterResource(ctx context.Context, client ctrlruntimeclient.Client, gvk schema.GroupVersionKind, namespace, name string) (apiv2.ClusterResource, error) {
+	mapping, err := getClusterResourceMapping(client, gvk)
+	if err != nil {
+		return nil, err
+	}
+
+	namespaced := mapping.Scope.Name() == meta.RESTScopeNameNamespace
+	switch {
+	case namespaced && namespace == "":
+		return nil, utilerrors.NewBadRequest("%s is namespaced, the namespace is required", mapping.GroupVersionKind.Kind)
+	case !namespaced && namespace != "":
+		return nil, utilerrors.NewBadRequest("%s is not namespaced", mapping.GroupVersionKind.Kind)
+	}
+
+	obj := &unstructured.Unstructured{}
+	obj.SetGroupVersionKind(mapping.GroupVersionKind)
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: namespace, Name: name}, obj); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	return obj.Object, nil
+}
+
+// getClusterResourceMapping resolves the kind with the discovery of the cluster. The preferred version of the group
+// is used if no version is given.
+func getClusterResourceMapping(client ctrlruntimeclient.Client, gvk schema.GroupVersionKind) (*meta.RESTMapping, error) {
+	if gvk.Kind == "" {
+		return nil, utilerrors.NewBadRequest("the kind is required")
+	}
+
+	var versions []string
+	if gvk.Version != "" {
+		versions = append(versions, gvk.Version)
+	}
+	mapping, err := client.RESTMapper().RESTMapping(gvk.GroupKind(), versions...)
+	if err != nil {
+		if meta.IsNoMatchError(err) {
+			return nil, utilerrors.NewNotFound("resource type", gvk.String())
+		}
+		return nil, err
+	}
+	return mapping, nil
+}
+
+func convertAPIResourceLists(resourceLists []*metav1.APIResourceList) []apiv2.ClusterAPIResource {
+	result := []apiv2.ClusterAPIResource{}
+	for _, resourceList := range resourceLists {
+		gv, err := schema.ParseGroupVersion(resourceList.GroupVersion)
+		if err != nil {
+			continue
+		}
+		for _, resource := range resourceList.APIResources {
+			if strings.Contains(resource.Name, "/") || !slices.Contains(resource.Verbs, "list") {
+				continue
+			}
+			result = append(result, apiv2.ClusterAPIResource{
+				Group:      gv.Group,
+				Version:    gv.Version,
+				Kind:       resource.Kind,
+				Name:       resource.Name,
+				Namespaced: resource.Namespaced,
+				ShortNames: resource.ShortNames,
+				Verbs:      resource.Verbs,
+			})
+		}
+	}
+
+	slices.SortFunc(result, func(a, b apiv2.ClusterAPIResource) int {
+		if c := strings.Compare(a.Group, b.Group); c != 0 {
+			return c
+		}
+		return strings.Compare(a.Kind, b.Kind)
+	})
+	return result
+}
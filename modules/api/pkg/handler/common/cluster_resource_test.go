@@ -0,0 +1,110 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestClusterResources(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := ctrlruntimefake.NewClientBuilder().
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(scheme.Scheme)).
+		WithObjects(
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "default", Labels: map[string]string{"app": "web"}}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "db", Namespace: "default", Labels: map[string]string{"app": "db"}}},
+			&appsv1.Deployment{ObjectMeta: metav1.ObjectMeta{Name: "web", Namespace: "staging", Labels: map[string]string{"app": "web"}}},
+			&corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "default"}},
+		).
+		Build()
+	deployments := schema.GroupVersionKind{Group: "apps", Kind: "Deployment"}
+
+	list, err := listClusterResources(ctx, client, deployments, ClusterResourceListOptions{})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 3)
+
+	list, err = listClusterResources(ctx, client, deployments, ClusterResourceListOptions{Namespace: "default", LabelSelector: "app=web"})
+	require.NoError(t, err)
+	require.Len(t, list.Items, 1)
+	require.Equal(t, "apps/v1", list.Items[0]["apiVersion"])
+
+	_, err = listClusterResources(ctx, client, deployments, ClusterResourceListOptions{LabelSelector: "app in"})
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	_, err = listClusterResources(ctx, client, schema.GroupVersionKind{Kind: "Namespace"}, ClusterResourceListOptions{Namespace: "default"})
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	_, err = listClusterResources(ctx, client, schema.GroupVersionKind{Group: "example.com", Kind: "Unknown"}, ClusterResourceListOptions{})
+	requireHTTPStatus(t, http.StatusNotFound, err)
+
+	resource, err := getClusterResource(ctx, client, deployments, "staging", "web")
+	require.NoError(t, err)
+	require.Equal(t, "Deployment", resource["kind"])
+	require.Equal(t, "staging", resource["metadata"].(map[string]interface{})["namespace"])
+
+	_, err = getClusterResource(ctx, client, deployments, "", "web")
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	_, err = getClusterResource(ctx, client, deployments, "default", "missing")
+	requireHTTPStatus(t, http.StatusNotFound, err)
+
+	resource, err = getClusterResource(ctx, client, schema.GroupVersionKind{Version: "v1", Kind: "Namespace"}, "", "default")
+	require.NoError(t, err)
+	require.Equal(t, "Namespace", resource["kind"])
+}
+
+func TestConvertAPIResourceLists(t *testing.T) {
+	t.Parallel()
+
+	resources := convertAPIResourceLists([]*metav1.APIResourceList{
+		{
+			GroupVersion: "apps/v1",
+			APIResources: []metav1.APIResource{
+				{Name: "deployments", Kind: "Deployment", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+				{Name: "deployments/scale", Kind: "Scale", Namespaced: true, Verbs: []string{"get", "update"}},
+			},
+		},
+		{
+			GroupVersion: "v1",
+			APIResources: []metav1.APIResource{
+				{Name: "pods", Kind: "Pod", Namespaced: true, ShortNames: []string{"po"}, Verbs: []string{"get", "list"}},
+				{Name: "bindings", Kind: "Binding", Namespaced: true, Verbs: []string{"create"}},
+			},
+		},
+	})
+
+	require.Equal(t, []apiv2.ClusterAPIResource{
+		{Version: "v1", Kind: "Pod", Name: "pods", Namespaced: true, ShortNames: []string{"po"}, Verbs: []string{"get", "list"}},
+		{Group: "apps", Version: "v1", Kind: "Deployment", Name: "deployments", Namespaced: true, Verbs: []string{"get", "list", "watch"}},
+	}, resources)
+}
@@ -0,0 +1,516 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"slices"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	"k8c.io/kubermatic/v2/pkg/version"
+	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
+	clustercommon "k8c.io/machine-controller/sdk/apis/cluster/common"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/intstr"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// clusterUpgradeOperationConfigMapName is the ConfigMap in the cluster namespace that stores the upgrade operation,
+	// so that its state survives API restarts and is shared between replicas.
+	clusterUpgradeOperationConfigMapName = "cluster-upgrade-operation"
+	clusterUpgradeOperationKey           = "operation"
+
+	// ClusterUpgradeOperationKind is the kind of the background operations which drive cluster upgrades.
+	ClusterUpgradeOperationKind = "cluster-upgrade"
+
+	clusterUpgradePollInterval = 10 * time.Second
+	// clusterUpgradeStepTimeout is how long a step may wait for the cluster to become healthy before the operation is paused.
+	clusterUpgradeStepTimeout = 15 * time.Minute
+)
+
+type userClusterClientGetter func(context.Context, *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
+
+// StartClusterUpgradeEndpoint upgrades the control plane to the requested version, waits for it to become healthy
+// and then rolls out all machine deployments one after another.
+func StartClusterUpgradeEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, spec apiv2.ClusterUpgradeOperationSpec, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, configGetter provider.KubermaticConfigurationGetter) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, projectID); err != nil {
+		return nil, err
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if spec.MaxUnavailable < 0 {
+		return nil, utilerrors.NewBadRequest("maxUnavailable must not be negative")
+	}
+	if spec.MaxUnavailable == 0 {
+		spec.MaxUnavailable = 1
+	}
+
+	if !cluster.Spec.Version.LessThan(&spec.Version) {
+		return nil, utilerrors.NewBadRequest("version %s must be greater than the current cluster version %s", spec.Version.String(), cluster.Spec.Version.String())
+	}
+
+	if err := ensureVersionIsPossibleUpdate(ctx, configGetter, cluster, &spec); err != nil {
+		return nil, err
+	}
+
+	client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	existing := sets.New[string]()
+	for _, md := range machineDeployments.Items {
+		existing.Insert(md.Name)
+	}
+	for _, name := range spec.MachineDeploymentOrder {
+		if !existing.Has(name) {
+			return nil, utilerrors.NewBadRequest("machine deployment %q does not exist", name)
+		}
+	}
+
+	op, cm, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op != nil && (op.Status.Phase == apiv2.ClusterUpgradeOperationRunning || op.Status.Phase == apiv2.ClusterUpgradeOperationPaused) {
+		return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("an upgrade to %s is already in progress, abort it first", op.Spec.Version.String()))
+	}
+
+	now := apiv1.Now()
+	op = &apiv2.ClusterUpgradeOperation{
+		Spec: spec,
+		Status: apiv2.ClusterUpgradeOperationStatus{
+			Phase:         apiv2.ClusterUpgradeOperationRunning,
+			Step:          apiv2.ClusterUpgradeStepControlPlane,
+			StartTime:     now,
+			StepStartTime: now,
+		},
+	}
+	if err := saveClusterUpgradeOperation(ctx, seedClient, cluster, cm, op); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if err := startClusterUpgradeRun(ctx, backgroundOperationProvider, seedClient, clusterProvider.GetAdminClientForUserCluster, cluster.Name); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+// GetClusterUpgradeEndpoint returns the current or last upgrade operation of the cluster.
+func GetClusterUpgradeEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	op, _, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op == nil {
+		return nil, utilerrors.NewNotFound("cluster upgrade operation", cluster.Name)
+	}
+
+	return op, nil
+}
+
+// ResumeClusterUpgradeEndpoint continues a paused upgrade operation with the step it was paused in.
+func ResumeClusterUpgradeEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	op, err := updateClusterUpgradeOperationPhase(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider, func(op *apiv2.ClusterUpgradeOperation) error {
+		if op.Status.Phase != apiv2.ClusterUpgradeOperationPaused {
+			return utilerrors.New(http.StatusConflict, fmt.Sprintf("only paused upgrades can be resumed, the upgrade is %s", op.Status.Phase))
+		}
+		op.Status.Phase = apiv2.ClusterUpgradeOperationRunning
+		op.Status.StepStartTime = apiv1.Now()
+		op.Status.Message = ""
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	if err := startClusterUpgradeRun(ctx, backgroundOperationProvider, privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), clusterProvider.GetAdminClientForUserCluster, clusterID); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+// AbortClusterUpgradeEndpoint stops a running or paused upgrade operation. Already upgraded components are not rolled back.
+func AbortClusterUpgradeEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	return updateClusterUpgradeOperationPhase(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider, func(op *apiv2.ClusterUpgradeOperation) error {
+		if op.Status.Phase != apiv2.ClusterUpgradeOperationRunning && op.Status.Phase != apiv2.ClusterUpgradeOperationPaused {
+			return utilerrors.New(http.StatusConflict, fmt.Sprintf("only running or paused upgrades can be aborted, the upgrade is %s", op.Status.Phase))
+		}
+		op.Status.Phase = apiv2.ClusterUpgradeOperationAborted
+		return nil
+	})
+}
+
+func updateClusterUpgradeOperationPhase(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, modify func(*apiv2.ClusterUpgradeOperation) error) (*apiv2.ClusterUpgradeOperation, error) {
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, projectID); err != nil {
+		return nil, err
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	op, cm, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op == nil {
+		return nil, utilerrors.NewNotFound("cluster upgrade operation", cluster.Name)
+	}
+
+	if err := modify(op); err != nil {
+		return nil, err
+	}
+
+	if err := saveClusterUpgradeOperation(ctx, seedClient, cluster, cm, op); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+func ensureVersionIsPossibleUpdate(ctx context.Context, configGetter provider.KubermaticConfigurationGetter, cluster *kubermaticv1.Cluster, spec *apiv2.ClusterUpgradeOperationSpec) error {
+	config, err := configGetter(ctx)
+	if err != nil {
+		return err
+	}
+
+	providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return fmt.Errorf("failed to get the cloud provider name: %w", err)
+	}
+
+	updates, err := version.NewFromConfiguration(config).GetPossibleUpdates(cluster.Spec.Version.String(), kubermaticv1.ProviderType(providerName), clusterversion.GetVersionConditions(&cluster.Spec)...)
+	if err != nil {
+		return err
+	}
+
+	for _, u := range updates {
+		if u.Version.Equal(spec.Version.Semver()) {
+			return nil
+		}
+	}
+
+	return utilerrors.NewBadRequest("version %s is not a possible update for this cluster, check the upgrade plan for intermediate versions", spec.Version.String())
+}
+
+func startClusterUpgradeRun(ctx context.Context, backgroundOperationProvider provider.BackgroundOperationProvider, seedClient ctrlruntimeclient.Client, clientGetter userClusterClientGetter, clusterName string) error {
+	op := provider.BackgroundOperation{Kind: ClusterUpgradeOperationKind, Cluster: clusterName}
+	return backgroundOperationProvider.Start(ctx, op, clusterUpgradeRun(seedClient, clientGetter, clusterName))
+}
+
+// ClusterUpgradeResumer resumes the upgrade operations which were driven by API replicas that are gone.
+func ClusterUpgradeResumer(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationResumer {
+	return func(ctx context.Context, op provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return nil, err
+		}
+		return clusterUpgradeRun(seedClient, clusterProvider.GetAdminClientForUserCluster, op.Cluster), nil
+	}
+}
+
+// ClusterUpgradeFailureHandler marks the upgrade operations which were given up after failing repeatedly as failed.
+func ClusterUpgradeFailureHandler(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationFailureHandler {
+	return func(ctx context.Context, op provider.BackgroundOperation, cause error) error {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return err
+		}
+
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Name: op.Cluster}, cluster); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+		upgrade, cm, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+		if err != nil || upgrade == nil || upgrade.Status.Phase != apiv2.ClusterUpgradeOperationRunning {
+			return err
+		}
+
+		upgrade.Status.Phase = apiv2.ClusterUpgradeOperationFailed
+		upgrade.Status.Message = fmt.Sprintf("the upgrade was given up: %v", cause)
+		return saveClusterUpgradeOperation(ctx, seedClient, cluster, cm, upgrade)
+	}
+}
+
+func clusterUpgradeRun(seedClient ctrlruntimeclient.Client, clientGetter userClusterClientGetter, clusterName string) provider.BackgroundOperationRun {
+	return func(ctx context.Context) error {
+		log := kubermaticlog.Logger.With("cluster", clusterName)
+
+		return wait.PollUntilContextCancel(ctx, clusterUpgradePollInterval, true, func(ctx context.Context) (bool, error) {
+			done, err := reconcileClusterUpgrade(ctx, seedClient, clientGetter, clusterName, time.Now())
+			if err != nil {
+				log.Warnw("Failed to reconcile cluster upgrade", zap.Error(err))
+				return false, nil
+			}
+			return done, nil
+		})
+	}
+}
+
+// reconcileClusterUpgrade moves the upgrade operation of the cluster forward by at most one step. It returns
+// true once the operation does not need to be driven anymore, i.e. it is paused, completed or aborted.
+func reconcileClusterUpgrade(ctx context.Context, seedClient ctrlruntimeclient.Client, clientGetter userClusterClientGetter, clusterName string, now time.Time) (bool, error) {
+	cluster := &kubermaticv1.Cluster{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+		if apierrors.IsNotFound(err) {
+			return true, nil
+		}
+		return false, err
+	}
+
+	op, cm, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+	if err != nil {
+		return false, err
+	}
+	if op == nil || op.Status.Phase != apiv2.ClusterUpgradeOperationRunning {
+		return true, nil
+	}
+
+	timedOut := now.Sub(op.Status.StepStartTime.Time) > clusterUpgradeStepTimeout
+
+	switch op.Status.Step {
+	case apiv2.ClusterUpgradeStepControlPlane:
+		if !cluster.Spec.Version.Equal(&op.Spec.Version) {
+			oldCluster := cluster.DeepCopy()
+			cluster.Spec.Version = op.Spec.Version
+			if err := seedClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+				return false, err
+			}
+		}
+		op.Status.Step = apiv2.ClusterUpgradeStepControlPlaneHealth
+		op.Status.StepStartTime = apiv1.NewTime(now)
+
+	case apiv2.ClusterUpgradeStepControlPlaneHealth:
+		switch {
+		case cluster.Status.Versions.ControlPlane.Equal(&op.Spec.Version) && cluster.Status.ExtendedHealth.AllHealthy():
+			op.Status.Step = apiv2.ClusterUpgradeStepMachineDeployments
+			op.Status.StepStartTime = apiv1.NewTime(now)
+		case timedOut:
+			pauseClusterUpgrade(op, fmt.Sprintf("the control plane did not become healthy on %s within %v", op.Spec.Version.String(), clusterUpgradeStepTimeout))
+		default:
+			return false, nil
+		}
+
+	case apiv2.ClusterUpgradeStepMachineDeployments:
+		client, err := clientGetter(ctx, cluster)
+		if err != nil {
+			return false, err
+		}
+		changed, err := reconcileMachineDeploymentUpgrades(ctx, client, cluster, op, now, timedOut)
+		if err != nil {
+			return false, err
+		}
+		if !changed {
+			return false, nil
+		}
+	}
+
+	if err := saveClusterUpgradeOperation(ctx, seedClient, cluster, cm, op); err != nil {
+		return false, err
+	}
+
+	return op.Status.Phase != apiv2.ClusterUpgradeOperationRunning, nil
+}
+
+// reconcileMachineDeploymentUpgrades waits for the current machine deployment to finish its rollout and then starts
+// the next one. It returns false if the operation did not change.
+func reconcileMachineDeploymentUpgrades(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, op *apiv2.ClusterUpgradeOperation, now time.Time, timedOut bool) (bool, error) {
+	if current := op.Status.CurrentMachineDeployment; current != "" {
+		md := &clusterv1alpha1.MachineDeployment{}
+		err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: current}, md)
+		if err != nil && !apierrors.IsNotFound(err) {
+			return false, err
+		}
+
+		switch {
+		case apierrors.IsNotFound(err), isMachineDeploymentRolledOut(md) && cluster.Status.ExtendedHealth.AllHealthy():
+			op.Status.UpgradedMachineDeployments = append(op.Status.UpgradedMachineDeployments, current)
+			op.Status.CurrentMachineDeployment = ""
+		case timedOut:
+			pauseClusterUpgrade(op, fmt.Sprintf("machine deployment %s did not finish its rollout within %v", current, clusterUpgradeStepTimeout))
+			return true, nil
+		default:
+			return false, nil
+		}
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return false, err
+	}
+
+	pending := machineDeploymentUpgradeOrder(op.Spec.MachineDeploymentOrder, machineDeployments.Items, op.Status.UpgradedMachineDeployments)
+	if len(pending) == 0 {
+		op.Status.Phase = apiv2.ClusterUpgradeOperationCompleted
+		return true, nil
+	}
+
+	md := pending[0]
+	maxUnavailable := intstr.FromInt32(op.Spec.MaxUnavailable)
+	if md.Spec.Strategy == nil {
+		md.Spec.Strategy = &clusterv1alpha1.MachineDeploymentStrategy{Type: clustercommon.RollingUpdateMachineDeploymentStrategyType}
+	}
+	if md.Spec.Strategy.RollingUpdate == nil {
+		md.Spec.Strategy.RollingUpdate = &clusterv1alpha1.MachineRollingUpdateDeployment{}
+	}
+	md.Spec.Strategy.RollingUpdate.MaxUnavailable = &maxUnavailable
+	md.Spec.Template.Spec.Versions.Kubelet = op.Spec.Version.String()
+
+	if err := client.Update(ctx, md); err != nil {
+		return false, err
+	}
+
+	op.Status.CurrentMachineDeployment = md.Name
+	op.Status.StepStartTime = apiv1.NewTime(now)
+
+	return true, nil
+}
+
+// machineDeploymentUpgradeOrder returns the machine deployments that still have to be upgraded, the explicitly
+// ordered ones first and all others sorted by name.
+func machineDeploymentUpgradeOrder(order []string, mds []clusterv1alpha1.MachineDeployment, upgraded []string) []*clusterv1alpha1.MachineDeployment {
+	done := sets.New(upgraded...)
+	byName := map[string]*clusterv1alpha1.MachineDeployment{}
+	var rest []string
+	for i := range mds {
+		md := &mds[i]
+		if done.Has(md.Name) {
+			continue
+		}
+		byName[md.Name] = md
+		if !slices.Contains(order, md.Name) {
+			rest = append(rest, md.Name)
+		}
+	}
+	slices.Sort(rest)
+
+	var result []*clusterv1alpha1.MachineDeployment
+	for _, name := range append(slices.Clone(order), rest...) {
+		if md, ok := byName[name]; ok {
+			result = append(result, md)
+		}
+	}
+
+	return result
+}
+
+func isMachineDeploymentRolledOut(md *clusterv1alpha1.MachineDeployment) bool {
+	replicas := int32(1)
+	if md.Spec.Replicas != nil {
+		replicas = *md.Spec.Replicas
+	}
+
+	return md.Status.ObservedGeneration >= md.Generation &&
+		md.Status.UpdatedReplicas == replicas &&
+		md.Status.AvailableReplicas == replicas
+}
+
+func pauseClusterUpgrade(op *apiv2.ClusterUpgradeOperation, message string) {
+	op.Status.Phase = apiv2.ClusterUpgradeOperationPaused
+	op.Status.Message = message
+}
+
+func getClusterUpgradeOperation(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (*apiv2.ClusterUpgradeOperation, *corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := seedClient.Get(ctx, types.NamespacedName{Namespace: cluster.Status.NamespaceName, Name: clusterUpgradeOperationConfigMapName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	op := &apiv2.ClusterUpgradeOperation{}
+	if err := json.Unmarshal([]byte(cm.Data[clusterUpgradeOperationKey]), op); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode cluster upgrade operation: %w", err)
+	}
+
+	return op, cm, nil
+}
+
+// saveClusterUpgradeOperation stores the operation in the ConfigMap it was read from. Updating the previously read
+// ConfigMap makes concurrent changes, e.g. an abort while a step is reconciled, fail with a conflict instead of being lost.
+func saveClusterUpgradeOperation(ctx context.Context, seedClient ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster, cm *corev1.ConfigMap, op *apiv2.ClusterUpgradeOperation) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		return seedClient.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      clusterUpgradeOperationConfigMapName,
+				Namespace: cluster.Status.NamespaceName,
+			},
+			Data: map[string]string{clusterUpgradeOperationKey: string(data)},
+		})
+	}
+
+	cm.Data = map[string]string{clusterUpgradeOperationKey: string(data)}
+	return seedClient.Update(ctx, cm)
+}
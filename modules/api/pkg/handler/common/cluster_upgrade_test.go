@@ -0,0 +1,212 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	ksemver "k8c.io/kubermatic/sdk/v2/semver"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMachineDeploymentUpgradeOrder(t *testing.T) {
+	t.Parallel()
+
+	mds := []clusterv1alpha1.MachineDeployment{
+		{ObjectMeta: metav1.ObjectMeta{Name: "workers-c"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "workers-a"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "gpu"}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "workers-b"}},
+	}
+
+	testCases := []struct {
+		name     string
+		order    []string
+		upgraded []string
+		expected []string
+	}{
+		{
+			name:     "alphabetical without explicit order",
+			expected: []string{"gpu", "workers-a", "workers-b", "workers-c"},
+		},
+		{
+			name:     "explicit order first",
+			order:    []string{"workers-c", "gpu"},
+			expected: []string{"workers-c", "gpu", "workers-a", "workers-b"},
+		},
+		{
+			name:     "upgraded machine deployments are skipped",
+			order:    []string{"workers-c", "gpu"},
+			upgraded: []string{"workers-c", "workers-a"},
+			expected: []string{"gpu", "workers-b"},
+		},
+		{
+			name:     "unknown machine deployments in the order are ignored",
+			order:    []string{"deleted"},
+			upgraded: []string{"gpu", "workers-a", "workers-b"},
+			expected: []string{"workers-c"},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var names []string
+			for _, md := range machineDeploymentUpgradeOrder(tc.order, mds, tc.upgraded) {
+				names = append(names, md.Name)
+			}
+			require.Equal(t, tc.expected, names)
+		})
+	}
+}
+
+func TestReconcileClusterUpgrade(t *testing.T) {
+	t.Parallel()
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(corev1.AddToScheme(scheme))
+	utilruntime.Must(kubermaticv1.AddToScheme(scheme))
+	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme))
+
+	healthy := kubermaticv1.ExtendedClusterHealth{
+		Apiserver:                    kubermaticv1.HealthStatusUp,
+		Scheduler:                    kubermaticv1.HealthStatusUp,
+		Controller:                   kubermaticv1.HealthStatusUp,
+		Etcd:                         kubermaticv1.HealthStatusUp,
+		MachineController:            kubermaticv1.HealthStatusUp,
+		CloudProviderInfrastructure:  kubermaticv1.HealthStatusUp,
+		UserClusterControllerManager: kubermaticv1.HealthStatusUp,
+	}
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "cluster-1"},
+		Spec: kubermaticv1.ClusterSpec{
+			Version: *ksemver.NewSemverOrDie("1.30.1"),
+		},
+		Status: kubermaticv1.ClusterStatus{
+			NamespaceName: "cluster-cluster-1",
+			Versions: kubermaticv1.ClusterVersionsStatus{
+				ControlPlane: *ksemver.NewSemverOrDie("1.30.1"),
+			},
+			ExtendedHealth: healthy,
+		},
+	}
+
+	md := &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{Name: "workers", Namespace: metav1.NamespaceSystem},
+		Spec: clusterv1alpha1.MachineDeploymentSpec{
+			Template: clusterv1alpha1.MachineTemplateSpec{
+				Spec: clusterv1alpha1.MachineSpec{
+					Versions: clusterv1alpha1.MachineVersionInfo{Kubelet: "1.30.1"},
+				},
+			},
+		},
+	}
+
+	seedClient := ctrlruntimefake.NewClientBuilder().WithScheme(scheme).WithObjects(cluster).Build()
+	userClusterClient := ctrlruntimefake.NewClientBuilder().WithScheme(scheme).WithObjects(md).Build()
+	clientGetter := func(context.Context, *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error) {
+		return userClusterClient, nil
+	}
+
+	ctx := context.Background()
+	start := time.Now()
+
+	op := &apiv2.ClusterUpgradeOperation{
+		Spec: apiv2.ClusterUpgradeOperationSpec{
+			Version:        *ksemver.NewSemverOrDie("1.31.0"),
+			MaxUnavailable: 2,
+		},
+		Status: apiv2.ClusterUpgradeOperationStatus{
+			Phase:         apiv2.ClusterUpgradeOperationRunning,
+			Step:          apiv2.ClusterUpgradeStepControlPlane,
+			StartTime:     apiv1.NewTime(start),
+			StepStartTime: apiv1.NewTime(start),
+		},
+	}
+	require.NoError(t, saveClusterUpgradeOperation(ctx, seedClient, cluster, nil, op))
+
+	reconcile := func(now time.Time) *apiv2.ClusterUpgradeOperation {
+		t.Helper()
+		_, err := reconcileClusterUpgrade(ctx, seedClient, clientGetter, cluster.Name, now)
+		require.NoError(t, err)
+		op, _, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+		require.NoError(t, err)
+		return op
+	}
+
+	// the control plane version is bumped first
+	op = reconcile(start)
+	require.Equal(t, apiv2.ClusterUpgradeStepControlPlaneHealth, op.Status.Step)
+	current := &kubermaticv1.Cluster{}
+	require.NoError(t, seedClient.Get(ctx, types.NamespacedName{Name: cluster.Name}, current))
+	require.Equal(t, "1.31.0", current.Spec.Version.String())
+
+	// an unhealthy control plane pauses the operation once the step timed out
+	op = reconcile(start.Add(clusterUpgradeStepTimeout + time.Minute))
+	require.Equal(t, apiv2.ClusterUpgradeOperationPaused, op.Status.Phase)
+	require.NotEmpty(t, op.Status.Message)
+
+	// resuming continues with the health check, which passes once the control plane rolled out
+	_, cm, err := getClusterUpgradeOperation(ctx, seedClient, cluster)
+	require.NoError(t, err)
+	op.Status.Phase = apiv2.ClusterUpgradeOperationRunning
+	op.Status.StepStartTime = apiv1.NewTime(start)
+	require.NoError(t, saveClusterUpgradeOperation(ctx, seedClient, cluster, cm, op))
+
+	oldCluster := current.DeepCopy()
+	current.Status.Versions.ControlPlane = *ksemver.NewSemverOrDie("1.31.0")
+	require.NoError(t, seedClient.Patch(ctx, current, ctrlruntimeclient.MergeFrom(oldCluster)))
+
+	op = reconcile(start)
+	require.Equal(t, apiv2.ClusterUpgradeStepMachineDeployments, op.Status.Step)
+
+	// machine deployments are updated one after another
+	op = reconcile(start)
+	require.Equal(t, "workers", op.Status.CurrentMachineDeployment)
+	currentMD := &clusterv1alpha1.MachineDeployment{}
+	require.NoError(t, userClusterClient.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(md), currentMD))
+	require.Equal(t, "1.31.0", currentMD.Spec.Template.Spec.Versions.Kubelet)
+	require.Equal(t, int32(2), currentMD.Spec.Strategy.RollingUpdate.MaxUnavailable.IntVal)
+
+	// the operation completes once the rollout finished
+	oldMD := currentMD.DeepCopy()
+	currentMD.Status = clusterv1alpha1.MachineDeploymentStatus{
+		ObservedGeneration: currentMD.Generation,
+		UpdatedReplicas:    1,
+		AvailableReplicas:  1,
+	}
+	require.NoError(t, userClusterClient.Patch(ctx, currentMD, ctrlruntimeclient.MergeFrom(oldMD)))
+
+	op = reconcile(start)
+	require.Equal(t, apiv2.ClusterUpgradeOperationCompleted, op.Status.Phase)
+	require.Equal(t, []string{"workers"}, op.Status.UpgradedMachineDeployments)
+}
@@ -0,0 +1,392 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"math"
+	"net/http"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/pricing"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/api/resource"
+)
+
+// openNebulaCloudProvider is not part of the known provider types, but OpenNebula
+// node deployments can still be priced by their resources.
+const openNebulaCloudProvider kubermaticv1.ProviderType = "opennebula"
+
+// nodeSize describes the size of a single node, either by a named instance
+// type or, for resource based providers, by its CPUs and memory.
+type nodeSize struct {
+	provider     kubermaticv1.ProviderType
+	instanceType string
+	cpus         int64
+	memoryMiB    int64
+}
+
+func (s nodeSize) String() string {
+	if s.instanceType != "" {
+		return s.instanceType
+	}
+	return fmt.Sprintf("%d CPU, %d MiB", s.cpus, s.memoryMiB)
+}
+
+func getNodeSize(spec apiv1.NodeCloudSpec) (*nodeSize, error) {
+	switch {
+	case spec.AWS != nil:
+		return &nodeSize{provider: kubermaticv1.AWSCloudProvider, instanceType: spec.AWS.InstanceType}, nil
+	case spec.Azure != nil:
+		return &nodeSize{provider: kubermaticv1.AzureCloudProvider, instanceType: spec.Azure.Size}, nil
+	case spec.GCP != nil:
+		return &nodeSize{provider: kubermaticv1.GCPCloudProvider, instanceType: spec.GCP.MachineType}, nil
+	case spec.Hetzner != nil:
+		return &nodeSize{provider: kubermaticv1.HetznerCloudProvider, instanceType: spec.Hetzner.Type}, nil
+	case spec.Digitalocean != nil:
+		return &nodeSize{provider: kubermaticv1.DigitaloceanCloudProvider, instanceType: spec.Digitalocean.Size}, nil
+	case spec.Openstack != nil:
+		return &nodeSize{provider: kubermaticv1.OpenstackCloudProvider, instanceType: spec.Openstack.Flavor}, nil
+	case spec.Alibaba != nil:
+		return &nodeSize{provider: kubermaticv1.AlibabaCloudProvider, instanceType: spec.Alibaba.InstanceType}, nil
+	case spec.VSphere != nil:
+		return &nodeSize{provider: kubermaticv1.VSphereCloudProvider, cpus: int64(spec.VSphere.CPUs), memoryMiB: int64(spec.VSphere.Memory)}, nil
+	case spec.Anexia != nil:
+		return &nodeSize{provider: kubermaticv1.AnexiaCloudProvider, cpus: int64(spec.Anexia.CPUs), memoryMiB: spec.Anexia.Memory}, nil
+	case spec.Nutanix != nil:
+		return &nodeSize{provider: kubermaticv1.NutanixCloudProvider, cpus: spec.Nutanix.CPUs, memoryMiB: spec.Nutanix.MemoryMB}, nil
+	case spec.VMwareCloudDirector != nil:
+		return &nodeSize{provider: kubermaticv1.VMwareCloudDirectorCloudProvider, cpus: int64(spec.VMwareCloudDirector.CPUs), memoryMiB: int64(spec.VMwareCloudDirector.MemoryMB)}, nil
+	case spec.OpenNebula != nil:
+		size := &nodeSize{provider: openNebulaCloudProvider}
+		if spec.OpenNebula.VCPU != nil {
+			size.cpus = int64(*spec.OpenNebula.VCPU)
+		}
+		if spec.OpenNebula.Memory != nil {
+			size.memoryMiB = int64(*spec.OpenNebula.Memory)
+		}
+		return size, nil
+	case spec.Kubevirt != nil:
+		if spec.Kubevirt.Instancetype != nil && spec.Kubevirt.Instancetype.Name != "" {
+			return &nodeSize{provider: kubermaticv1.KubevirtCloudProvider, instanceType: spec.Kubevirt.Instancetype.Name}, nil
+		}
+		cpus, err := resource.ParseQuantity(spec.Kubevirt.CPUs)
+		if err != nil {
+			return nil, fmt.Errorf("invalid CPUs %q: %w", spec.Kubevirt.CPUs, err)
+		}
+		memory, err := resource.ParseQuantity(spec.Kubevirt.Memory)
+		if err != nil {
+			return nil, fmt.Errorf("invalid memory %q: %w", spec.Kubevirt.Memory, err)
+		}
+		return &nodeSize{provider: kubermaticv1.KubevirtCloudProvider, cpus: cpus.Value(), memoryMiB: memory.Value() / (1024 * 1024)}, nil
+	}
+
+	return nil, errors.New("the cloud provider of the node deployment is not supported")
+}
+
+// getDatacenterRegion returns the provider region of the datacenter. Providers
+// without a notion of regions use the datacenter name instead.
+func getDatacenterRegion(dc *kubermaticv1.Datacenter, dcName string) string {
+	spec := dc.Spec
+	switch {
+	case spec.AWS != nil:
+		return spec.AWS.Region
+	case spec.Azure != nil:
+		return spec.Azure.Location
+	case spec.GCP != nil:
+		return spec.GCP.Region
+	case spec.Hetzner != nil && spec.Hetzner.Location != "":
+		return spec.Hetzner.Location
+	case spec.Hetzner != nil:
+		return spec.Hetzner.Datacenter
+	case spec.Digitalocean != nil:
+		return spec.Digitalocean.Region
+	case spec.Openstack != nil:
+		return spec.Openstack.Region
+	case spec.Alibaba != nil:
+		return spec.Alibaba.Region
+	case spec.Anexia != nil:
+		return spec.Anexia.LocationID
+	}
+
+	return dcName
+}
+
+func estimateNodeDeploymentCost(catalog pricing.Catalog, region, clusterID string, nd *apiv1.NodeDeployment) apiv2.CostEstimateItem {
+	item := apiv2.CostEstimateItem{
+		Name:      nd.Name,
+		ClusterID: clusterID,
+		Region:    region,
+		Replicas:  nd.Spec.Replicas,
+	}
+
+	size, err := getNodeSize(nd.Spec.Template.Cloud)
+	if err != nil {
+		item.Unpriced = true
+		item.Message = err.Error()
+		return item
+	}
+	item.Provider = string(size.provider)
+	item.Size = size.String()
+
+	var (
+		price float64
+		found bool
+	)
+	if size.instanceType != "" {
+		price, found = catalog.InstancePrice(item.Provider, region, size.instanceType)
+	} else {
+		price, found = catalog.ResourcePrice(item.Provider, region, size.cpus, size.memoryMiB)
+	}
+	if !found {
+		item.Unpriced = true
+		item.Message = fmt.Sprintf("no price for %s in %s/%s", item.Size, item.Provider, region)
+		return item
+	}
+
+	item.HourlyPricePerNode = price
+	item.MonthlyCost = roundPrice(price * pricing.HoursPerMonth * float64(nd.Spec.Replicas))
+
+	return item
+}
+
+func newCostEstimate(catalog pricing.Catalog, items []apiv2.CostEstimateItem) *apiv2.CostEstimate {
+	estimate := &apiv2.CostEstimate{
+		Currency: catalog.Currency(),
+		Items:    items,
+	}
+	if estimate.Items == nil {
+		estimate.Items = []apiv2.CostEstimateItem{}
+	}
+
+	for _, item := range items {
+		estimate.MonthlyCost += item.MonthlyCost
+	}
+	estimate.MonthlyCost = roundPrice(estimate.MonthlyCost)
+
+	return estimate
+}
+
+// NewUnpricedCostEstimate returns an empty estimate which lists the given reason as unpriced.
+func NewUnpricedCostEstimate(catalog pricing.Catalog, reason string) *apiv2.CostEstimate {
+	estimate := newCostEstimate(catalog, nil)
+	estimate.Unpriced = []string{reason}
+	return estimate
+}
+
+func roundPrice(price float64) float64 {
+	return math.Round(price*100) / 100
+}
+
+// EstimateNodeDeploymentsCost returns the estimated monthly cost of the given node deployments,
+// which are all placed in the given datacenter.
+func EstimateNodeDeploymentsCost(catalog pricing.Catalog, dc *kubermaticv1.Datacenter, dcName, clusterID string, nds ...*apiv1.NodeDeployment) *apiv2.CostEstimate {
+	region := getDatacenterRegion(dc, dcName)
+
+	items := make([]apiv2.CostEstimateItem, 0, len(nds))
+	for _, nd := range nds {
+		items = append(items, estimateNodeDeploymentCost(catalog, region, clusterID, nd))
+	}
+
+	return newCostEstimate(catalog, items)
+}
+
+func checkPriceCatalog(catalog pricing.Catalog) error {
+	if catalog == nil {
+		return utilerrors.New(http.StatusNotFound, "no price catalog has been configured")
+	}
+	return nil
+}
+
+// EstimateMachineDeploymentCostEndpoint estimates the monthly cost of a machine deployment
+// that would be created in the given cluster.
+func EstimateMachineDeploymentCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, nd *apiv1.NodeDeployment,
+	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, catalog pricing.Catalog) (interface{}, error) {
+	if err := checkPriceCatalog(catalog); err != nil {
+		return nil, err
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	dc, err := getClusterDatacenter(ctx, userInfoGetter, seedsGetter, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	return EstimateNodeDeploymentsCost(catalog, dc, cluster.Spec.Cloud.DatacenterName, cluster.Name, nd), nil
+}
+
+// EstimateClusterCostEndpoint estimates the monthly cost of all machine deployments of a cluster.
+func EstimateClusterCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string,
+	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, catalog pricing.Catalog) (interface{}, error) {
+	if err := checkPriceCatalog(catalog); err != nil {
+		return nil, err
+	}
+
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	items, err := estimateClusterCostItems(ctx, userInfoGetter, clusterProvider, seedsGetter, catalog, cluster, projectID)
+	if err != nil {
+		return nil, err
+	}
+
+	return newCostEstimate(catalog, items), nil
+}
+
+// EstimateProjectCostEndpoint estimates the monthly cost of all machine deployments in all clusters of a project.
+// The estimate is best-effort, clusters and seeds which cannot be estimated are listed as unpriced.
+func EstimateProjectCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string,
+	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter, catalog pricing.Catalog) (interface{}, error) {
+	if err := checkPriceCatalog(catalog); err != nil {
+		return nil, err
+	}
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	seeds, err := seedsGetter()
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	var unpriced []string
+	items := []apiv2.CostEstimateItem{}
+	for _, seed := range seeds {
+		if seed.Status.Phase == kubermaticv1.SeedInvalidPhase {
+			kubermaticlog.Logger.Warnf("skipping seed %s as it is in an invalid phase", seed.Name)
+			continue
+		}
+
+		clusterProvider, err := clusterProviderGetter(seed)
+		if err != nil {
+			kubermaticlog.Logger.Errorw("failed to create cluster provider", "seed", seed.Name, zap.Error(err))
+			unpriced = append(unpriced, fmt.Sprintf("seed %s: failed to create cluster provider", seed.Name))
+			continue
+		}
+
+		clusters, err := clusterProvider.List(ctx, project, nil)
+		if err != nil {
+			unpriced = append(unpriced, fmt.Sprintf("seed %s: failed to list clusters: %v", seed.Name, err))
+			continue
+		}
+
+		for i := range clusters.Items {
+			clusterItems, err := estimateClusterCostItems(ctx, userInfoGetter, clusterProvider, seedsGetter, catalog, &clusters.Items[i], projectID)
+			if err != nil {
+				unpriced = append(unpriced, fmt.Sprintf("cluster %s: %v", clusters.Items[i].Name, err))
+				continue
+			}
+			items = append(items, clusterItems...)
+		}
+	}
+
+	estimate := newCostEstimate(catalog, items)
+	estimate.Unpriced = unpriced
+
+	return estimate, nil
+}
+
+// EstimateClusterSpecCostEndpoint estimates the monthly cost of the initial node deployment of a
+// cluster creation request without creating anything.
+func EstimateClusterSpecCostEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string, body *apiv1.CreateClusterSpec,
+	projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, catalog pricing.Catalog) (interface{}, error) {
+	if err := checkPriceCatalog(catalog); err != nil {
+		return nil, err
+	}
+
+	if _, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	dcName := body.Cluster.Spec.Cloud.DatacenterName
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, dcName)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid datacenter %q: %v", dcName, err)
+	}
+
+	if body.NodeDeployment == nil {
+		return newCostEstimate(catalog, nil), nil
+	}
+
+	return EstimateNodeDeploymentsCost(catalog, dc, dcName, "", body.NodeDeployment), nil
+}
+
+func estimateClusterCostItems(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, seedsGetter provider.SeedsGetter,
+	catalog pricing.Catalog, cluster *kubermaticv1.Cluster, projectID string) ([]apiv2.CostEstimateItem, error) {
+	dc, err := getClusterDatacenter(ctx, userInfoGetter, seedsGetter, cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	machineDeployments, err := listClusterMachineDeployments(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	region := getDatacenterRegion(dc, cluster.Spec.Cloud.DatacenterName)
+
+	items := make([]apiv2.CostEstimateItem, 0, len(machineDeployments.Items))
+	for i := range machineDeployments.Items {
+		nd, err := OutputMachineDeployment(&machineDeployments.Items[i])
+		if err != nil {
+			return nil, fmt.Errorf("failed to output machine deployment %s: %w", machineDeployments.Items[i].Name, err)
+		}
+		items = append(items, estimateNodeDeploymentCost(catalog, region, cluster.Name, nd))
+	}
+
+	return items, nil
+}
+
+func getClusterDatacenter(ctx context.Context, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, cluster *kubermaticv1.Cluster) (*kubermaticv1.Datacenter, error) {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	_, dc, err := provider.DatacenterFromSeedMap(adminUserInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get datacenter %s: %w", cluster.Spec.Cloud.DatacenterName, err)
+	}
+
+	return dc, nil
+}
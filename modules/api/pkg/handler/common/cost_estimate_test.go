@@ -0,0 +1,127 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/pricing"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	"k8s.io/utils/ptr"
+)
+
+func TestEstimateNodeDeploymentsCost(t *testing.T) {
+	t.Parallel()
+
+	catalog, err := pricing.NewCatalog(pricing.PriceSheet{
+		Currency: "EUR",
+		Providers: map[string]map[string]pricing.RegionPrices{
+			"aws": {
+				"eu-central-1": {InstanceTypes: map[string]float64{"t3.medium": 0.05}},
+			},
+			"vsphere": {
+				pricing.AnyRegion: {CPU: ptr.To(0.01), MemoryGiB: ptr.To(0.005)},
+			},
+		},
+	})
+	require.NoError(t, err)
+
+	awsDC := &kubermaticv1.Datacenter{Spec: kubermaticv1.DatacenterSpec{AWS: &kubermaticv1.DatacenterSpecAWS{Region: "eu-central-1"}}}
+	vsphereDC := &kubermaticv1.Datacenter{Spec: kubermaticv1.DatacenterSpec{VSphere: &kubermaticv1.DatacenterSpecVSphere{}}}
+
+	nodeDeployment := func(name string, replicas int32, cloud apiv1.NodeCloudSpec) *apiv1.NodeDeployment {
+		return &apiv1.NodeDeployment{
+			ObjectMeta: apiv1.ObjectMeta{Name: name},
+			Spec: apiv1.NodeDeploymentSpec{
+				Replicas: replicas,
+				Template: apiv1.NodeSpec{Cloud: cloud},
+			},
+		}
+	}
+
+	testCases := []struct {
+		name     string
+		dc       *kubermaticv1.Datacenter
+		nds      []*apiv1.NodeDeployment
+		expected *apiv2.CostEstimate
+	}{
+		{
+			name: "instance type pricing",
+			dc:   awsDC,
+			nds: []*apiv1.NodeDeployment{
+				nodeDeployment("workers", 3, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}}),
+			},
+			expected: &apiv2.CostEstimate{
+				Currency:    "EUR",
+				MonthlyCost: 109.5,
+				Items: []apiv2.CostEstimateItem{
+					{Name: "workers", ClusterID: "cluster-1", Provider: "aws", Region: "eu-central-1", Size: "t3.medium", Replicas: 3, HourlyPricePerNode: 0.05, MonthlyCost: 109.5},
+				},
+			},
+		},
+		{
+			name: "unpriced instance types do not count towards the total",
+			dc:   awsDC,
+			nds: []*apiv1.NodeDeployment{
+				nodeDeployment("workers", 1, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "t3.medium"}}),
+				nodeDeployment("gpu", 1, apiv1.NodeCloudSpec{AWS: &apiv1.AWSNodeSpec{InstanceType: "p3.2xlarge"}}),
+			},
+			expected: &apiv2.CostEstimate{
+				Currency:    "EUR",
+				MonthlyCost: 36.5,
+				Items: []apiv2.CostEstimateItem{
+					{Name: "workers", ClusterID: "cluster-1", Provider: "aws", Region: "eu-central-1", Size: "t3.medium", Replicas: 1, HourlyPricePerNode: 0.05, MonthlyCost: 36.5},
+					{Name: "gpu", ClusterID: "cluster-1", Provider: "aws", Region: "eu-central-1", Size: "p3.2xlarge", Replicas: 1, Unpriced: true, Message: "no price for p3.2xlarge in aws/eu-central-1"},
+				},
+			},
+		},
+		{
+			name: "resource based pricing falls back to the datacenter name as region",
+			dc:   vsphereDC,
+			nds: []*apiv1.NodeDeployment{
+				nodeDeployment("workers", 2, apiv1.NodeCloudSpec{VSphere: &apiv1.VSphereNodeSpec{CPUs: 2, Memory: 4096}}),
+			},
+			expected: &apiv2.CostEstimate{
+				Currency:    "EUR",
+				MonthlyCost: 58.4,
+				Items: []apiv2.CostEstimateItem{
+					{Name: "workers", ClusterID: "cluster-1", Provider: "vsphere", Region: "dc-1", Size: "2 CPU, 4096 MiB", Replicas: 2, HourlyPricePerNode: 0.04, MonthlyCost: 58.4},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			estimate := EstimateNodeDeploymentsCost(catalog, tc.dc, "dc-1", "cluster-1", tc.nds...)
+			require.Equal(t, tc.expected.Currency, estimate.Currency)
+			require.InDelta(t, tc.expected.MonthlyCost, estimate.MonthlyCost, 1e-9)
+			require.Len(t, estimate.Items, len(tc.expected.Items))
+			for i, item := range estimate.Items {
+				expected := tc.expected.Items[i]
+				require.InDelta(t, expected.HourlyPricePerNode, item.HourlyPricePerNode, 1e-9)
+				item.HourlyPricePerNode = expected.HourlyPricePerNode
+				require.Equal(t, expected, item)
+			}
+		})
+	}
+}
@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// DashboardProxyTargetAnnotation selects the web UI served by the dashboard proxy. On a cluster it overrides the
+// dashboardProxyTarget of the global settings, which is stored in the same annotation of the settings object.
+const DashboardProxyTargetAnnotation = "dashboard.k8c.io/dashboard-proxy-target"
+
+// ValidateDashboardProxyTarget returns a bad request error for unknown targets. The empty target selects the default.
+func ValidateDashboardProxyTarget(target apiv2.DashboardProxyTarget) error {
+	switch target {
+	case "", apiv2.DashboardProxyTargetKubernetesDashboard, apiv2.DashboardProxyTargetHeadlamp:
+		return nil
+	default:
+		return utilerrors.NewBadRequest("unknown dashboard proxy target %q, must be one of %q, %q", target, apiv2.DashboardProxyTargetKubernetesDashboard, apiv2.DashboardProxyTargetHeadlamp)
+	}
+}
+
+// GetDashboardProxyTarget returns the web UI the dashboard proxy serves for the cluster. The target of the cluster
+// takes precedence over the one of the global settings.
+func GetDashboardProxyTarget(settings *kubermaticv1.KubermaticSetting, cluster *kubermaticv1.Cluster) (apiv2.DashboardProxyTarget, error) {
+	for _, annotations := range []map[string]string{cluster.Annotations, settings.Annotations} {
+		if target := apiv2.DashboardProxyTarget(annotations[DashboardProxyTargetAnnotation]); target != "" {
+			return target, ValidateDashboardProxyTarget(target)
+		}
+	}
+	return apiv2.DashboardProxyTargetKubernetesDashboard, nil
+}
@@ -0,0 +1,86 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"net/http"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestGetDashboardProxyTarget(t *testing.T) {
+	t.Parallel()
+
+	withTarget := func(target string) metav1.ObjectMeta {
+		if target == "" {
+			return metav1.ObjectMeta{}
+		}
+		return metav1.ObjectMeta{Annotations: map[string]string{DashboardProxyTargetAnnotation: target}}
+	}
+
+	testCases := []struct {
+		name           string
+		globalTarget   string
+		clusterTarget  string
+		expectedTarget apiv2.DashboardProxyTarget
+		expectedStatus int
+	}{
+		{
+			name:           "defaults to the Kubernetes Dashboard",
+			expectedTarget: apiv2.DashboardProxyTargetKubernetesDashboard,
+		},
+		{
+			name:           "global target",
+			globalTarget:   "headlamp",
+			expectedTarget: apiv2.DashboardProxyTargetHeadlamp,
+		},
+		{
+			name:           "cluster target overrides the global target",
+			globalTarget:   "headlamp",
+			clusterTarget:  "kubernetes-dashboard",
+			expectedTarget: apiv2.DashboardProxyTargetKubernetesDashboard,
+		},
+		{
+			name:           "unknown cluster target",
+			clusterTarget:  "octant",
+			expectedStatus: http.StatusBadRequest,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := &kubermaticv1.KubermaticSetting{ObjectMeta: withTarget(tc.globalTarget)}
+			cluster := &kubermaticv1.Cluster{ObjectMeta: withTarget(tc.clusterTarget)}
+
+			target, err := GetDashboardProxyTarget(settings, cluster)
+			if tc.expectedStatus != 0 {
+				requireHTTPStatus(t, tc.expectedStatus, err)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expectedTarget, target)
+		})
+	}
+}
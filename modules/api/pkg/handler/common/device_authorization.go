@@ -0,0 +1,42 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// deviceAuthorizationCleanupInterval is the lifetime of the device codes, so expired authorizations
+// are kept for one more lifetime at most.
+const deviceAuthorizationCleanupInterval = 10 * time.Minute
+
+// RunDeviceAuthorizationCleanup removes the expired device authorizations until the context is done.
+// Devices which are never approved leave their authorizations behind.
+func RunDeviceAuthorizationCleanup(ctx context.Context, deviceAuthorizationProvider provider.DeviceAuthorizationProvider, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := deviceAuthorizationProvider.DeleteExpired(ctx); err != nil {
+			log.Warnw("Failed to delete expired device authorizations", zap.Error(err))
+		}
+	}, deviceAuthorizationCleanupInterval)
+}
@@ -0,0 +1,372 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsclientset "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// ExternalClusterInventoryAnnotation holds the JSON encoded inventory of a cluster imported from a kubeconfig.
+	ExternalClusterInventoryAnnotation = "dashboard.k8c.io/inventory"
+
+	// externalClusterInventoryInterval is how often every imported cluster is checked. Clusters that another API
+	// replica checked within the interval are skipped.
+	externalClusterInventoryInterval = 5 * time.Minute
+	// externalClusterRequestTimeout bounds every request to an imported cluster, so that an unreachable cluster does
+	// not hold up the checks of the others.
+	externalClusterRequestTimeout = 15 * time.Second
+
+	defaultStorageClassAnnotation = "storageclass.kubernetes.io/is-default-class"
+	flannelBackendAnnotation      = "flannel.alpha.coreos.com/backend-type"
+)
+
+// versionDistributions detects distributions by a suffix in the version of the API server, e.g. v1.30.2+k3s1.
+var versionDistributions = []struct {
+	marker       string
+	distribution string
+}{
+	{marker: "+k3s", distribution: "k3s"},
+	{marker: "+rke2", distribution: "rke2"},
+	{marker: "+k0s", distribution: "k0s"},
+	{marker: "-eks-", distribution: "eks"},
+	{marker: "-gke.", distribution: "gke"},
+}
+
+// nodeLabelDistributions detects distributions by a label they put on every node.
+var nodeLabelDistributions = []struct {
+	label        string
+	distribution string
+}{
+	{label: "minikube.k8s.io/name", distribution: "minikube"},
+	{label: "microk8s.io/cluster", distribution: "microk8s"},
+	{label: "kubernetes.azure.com/cluster", distribution: "aks"},
+}
+
+// cniDaemonSets maps the DaemonSets of the common network plugins to the plugin name. Canal is listed before
+// Calico and Flannel, which it bundles.
+var cniDaemonSets = []struct {
+	name string
+	cni  string
+}{
+	{name: "canal", cni: "canal"},
+	{name: "calico-node", cni: "calico"},
+	{name: "cilium", cni: "cilium"},
+	{name: "kube-flannel-ds", cni: "flannel"},
+	{name: "kube-flannel", cni: "flannel"},
+	{name: "weave-net", cni: "weave"},
+	{name: "kube-router", cni: "kube-router"},
+	{name: "antrea-agent", cni: "antrea"},
+	{name: "kindnet", cni: "kindnet"},
+	{name: "ovnkube-node", cni: "ovn-kubernetes"},
+	{name: "aws-node", cni: "aws-vpc-cni"},
+}
+
+// GetExternalClusterInventory returns the last inventory collected for a cluster imported from a kubeconfig. It
+// returns nil if the inventory was not collected yet.
+func GetExternalClusterInventory(cluster *kubermaticv1.ExternalCluster) (*apiv2.ExternalClusterInventory, error) {
+	data, ok := cluster.Annotations[ExternalClusterInventoryAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	inventory := &apiv2.ExternalClusterInventory{}
+	if err := json.Unmarshal([]byte(data), inventory); err != nil {
+		return nil, fmt.Errorf("failed to decode external cluster inventory: %w", err)
+	}
+
+	return inventory, nil
+}
+
+// RunExternalClusterInventory checks the reachability of all clusters imported from a kubeconfig and collects their
+// inventory until the context is done.
+func RunExternalClusterInventory(ctx context.Context, masterClient ctrlruntimeclient.Client, clusterProvider provider.ExternalClusterProvider, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		clusters := &kubermaticv1.ExternalClusterList{}
+		if err := masterClient.List(ctx, clusters); err != nil {
+			log.Warnw("Failed to list external clusters for the inventory", zap.Error(err))
+			return
+		}
+
+		for i := range clusters.Items {
+			cluster := &clusters.Items[i]
+			if cluster.Spec.CloudSpec.ProviderName != kubermaticv1.ExternalClusterBringYourOwnProvider || cluster.DeletionTimestamp != nil {
+				continue
+			}
+
+			if err := reconcileExternalClusterInventory(ctx, masterClient, clusterProvider, cluster, time.Now()); err != nil {
+				log.Warnw("Failed to update external cluster inventory", "cluster", cluster.Name, zap.Error(err))
+			}
+		}
+	}, time.Minute)
+}
+
+func reconcileExternalClusterInventory(ctx context.Context, masterClient ctrlruntimeclient.Client, clusterProvider provider.ExternalClusterProvider, cluster *kubermaticv1.ExternalCluster, now time.Time) error {
+	previous, err := GetExternalClusterInventory(cluster)
+	if err != nil {
+		return err
+	}
+	if previous != nil && now.Sub(previous.Reachability.LastCheckTime.Time) < externalClusterInventoryInterval {
+		return nil
+	}
+
+	inventory, err := checkExternalCluster(ctx, masterClient, clusterProvider, cluster, now)
+	if err != nil {
+		// Keep what is known about the cluster from the last time it was reachable.
+		inventory = &apiv2.ExternalClusterInventory{}
+		if previous != nil {
+			inventory = previous
+		}
+		inventory.Reachability = apiv2.ExternalClusterReachability{
+			LastCheckTime:     apiv1.NewTime(now),
+			LastReachableTime: inventory.Reachability.LastReachableTime,
+			Message:           err.Error(),
+		}
+	}
+
+	data, err := json.Marshal(inventory)
+	if err != nil {
+		return err
+	}
+
+	oldCluster := cluster.DeepCopy()
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[ExternalClusterInventoryAnnotation] = string(data)
+
+	return masterClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
+
+func checkExternalCluster(ctx context.Context, masterClient ctrlruntimeclient.Client, clusterProvider provider.ExternalClusterProvider, cluster *kubermaticv1.ExternalCluster, now time.Time) (*apiv2.ExternalClusterInventory, error) {
+	cfg, err := clusterProvider.GetRestConfig(ctx, masterClient, cluster)
+	if err != nil {
+		return nil, fmt.Errorf("failed to load kubeconfig: %w", err)
+	}
+	cfg = rest.CopyConfig(cfg)
+	cfg.Timeout = externalClusterRequestTimeout
+
+	client, err := kubernetes.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+	crdClient, err := apiextensionsclientset.NewForConfig(cfg)
+	if err != nil {
+		return nil, err
+	}
+
+	return collectExternalClusterInventory(ctx, client, crdClient, now)
+}
+
+// collectExternalClusterInventory fails if the cluster is not reachable. Parts of the inventory that the imported
+// kubeconfig is not allowed to read are left empty.
+func collectExternalClusterInventory(ctx context.Context, client kubernetes.Interface, crdClient apiextensionsclientset.Interface, now time.Time) (*apiv2.ExternalClusterInventory, error) {
+	start := time.Now()
+	version, err := client.Discovery().ServerVersion()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the server version: %w", err)
+	}
+
+	checkTime := apiv1.NewTime(now)
+	inventory := &apiv2.ExternalClusterInventory{
+		Version: version.GitVersion,
+		Reachability: apiv2.ExternalClusterReachability{
+			Reachable:           true,
+			LastCheckTime:       checkTime,
+			LastReachableTime:   &checkTime,
+			LatencyMilliseconds: time.Since(start).Milliseconds(),
+		},
+		CollectionTime: &checkTime,
+	}
+
+	groups, err := client.Discovery().ServerGroups()
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the server groups: %w", err)
+	}
+
+	nodes, err := client.CoreV1().Nodes().List(ctx, metav1.ListOptions{})
+	switch {
+	case apierrors.IsForbidden(err):
+		nodes = &corev1.NodeList{}
+	case err != nil:
+		return nil, fmt.Errorf("failed to list nodes: %w", err)
+	}
+
+	daemonSets, err := client.AppsV1().DaemonSets(metav1.NamespaceAll).List(ctx, metav1.ListOptions{})
+	switch {
+	case apierrors.IsForbidden(err):
+		daemonSets = &appsv1.DaemonSetList{}
+	case err != nil:
+		return nil, fmt.Errorf("failed to list daemon sets: %w", err)
+	}
+
+	operatingSystems := sets.New[string]()
+	containerRuntimes := sets.New[string]()
+	for _, node := range nodes.Items {
+		if node.Status.NodeInfo.OSImage != "" {
+			operatingSystems.Insert(node.Status.NodeInfo.OSImage)
+		}
+		if node.Status.NodeInfo.ContainerRuntimeVersion != "" {
+			containerRuntimes.Insert(node.Status.NodeInfo.ContainerRuntimeVersion)
+		}
+	}
+	inventory.NodeOperatingSystems = sets.List(operatingSystems)
+	inventory.ContainerRuntimes = sets.List(containerRuntimes)
+	inventory.Distribution = detectDistribution(version.GitVersion, groups, nodes.Items)
+	inventory.CNI = detectCNI(daemonSets.Items, nodes.Items)
+
+	storageClasses, err := client.StorageV1().StorageClasses().List(ctx, metav1.ListOptions{})
+	switch {
+	case apierrors.IsForbidden(err):
+		storageClasses = &storagev1.StorageClassList{}
+	case err != nil:
+		return nil, fmt.Errorf("failed to list storage classes: %w", err)
+	}
+	for _, sc := range storageClasses.Items {
+		inventory.StorageClasses = append(inventory.StorageClasses, apiv2.ExternalClusterStorageClass{
+			Name:        sc.Name,
+			Provisioner: sc.Provisioner,
+			Default:     sc.Annotations[defaultStorageClassAnnotation] == "true",
+		})
+	}
+
+	crds, err := crdClient.ApiextensionsV1().CustomResourceDefinitions().List(ctx, metav1.ListOptions{})
+	switch {
+	case apierrors.IsForbidden(err):
+		crds = &apiextensionsv1.CustomResourceDefinitionList{}
+	case err != nil:
+		return nil, fmt.Errorf("failed to list custom resource definitions: %w", err)
+	}
+	for _, crd := range crds.Items {
+		inventory.CustomResourceDefinitions = append(inventory.CustomResourceDefinitions, crd.Name)
+	}
+	slices.Sort(inventory.CustomResourceDefinitions)
+
+	if restClient := client.Discovery().RESTClient(); restClient != nil {
+		// An unready API server answers with an error status, but still lists its checks.
+		body, err := restClient.Get().AbsPath("/readyz").Param("verbose", "true").DoRaw(ctx)
+		if err != nil && len(body) == 0 {
+			return nil, fmt.Errorf("failed to get the readiness checks: %w", err)
+		}
+		inventory.ControlPlaneComponents = parseReadyzChecks(body)
+	}
+
+	return inventory, nil
+}
+
+func detectDistribution(gitVersion string, groups *metav1.APIGroupList, nodes []corev1.Node) string {
+	for _, d := range versionDistributions {
+		if strings.Contains(gitVersion, d.marker) {
+			return d.distribution
+		}
+	}
+
+	for _, group := range groups.Groups {
+		if group.Name == "config.openshift.io" {
+			return "openshift"
+		}
+	}
+
+	for _, node := range nodes {
+		if strings.HasPrefix(node.Spec.ProviderID, "kind://") {
+			return "kind"
+		}
+		for _, d := range nodeLabelDistributions {
+			if _, ok := node.Labels[d.label]; ok {
+				return d.distribution
+			}
+		}
+	}
+
+	return "kubernetes"
+}
+
+func detectCNI(daemonSets []appsv1.DaemonSet, nodes []corev1.Node) string {
+	names := sets.New[string]()
+	for _, ds := range daemonSets {
+		names.Insert(ds.Name)
+	}
+	for _, c := range cniDaemonSets {
+		if names.Has(c.name) {
+			return c.cni
+		}
+	}
+
+	// k3s and RKE2 run an embedded flannel without a DaemonSet.
+	for _, node := range nodes {
+		if _, ok := node.Annotations[flannelBackendAnnotation]; ok {
+			return "flannel"
+		}
+	}
+
+	return ""
+}
+
+// parseReadyzChecks parses the verbose output of the /readyz endpoint of the API server, which reports a check per
+// line, e.g. "[+]etcd ok" or "[-]etcd failed: reason withheld".
+func parseReadyzChecks(body []byte) []apiv2.ExternalClusterComponentHealth {
+	var checks []apiv2.ExternalClusterComponentHealth
+
+	scanner := bufio.NewScanner(bytes.NewReader(body))
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		var healthy bool
+		switch {
+		case strings.HasPrefix(line, "[+]"):
+			healthy = true
+		case strings.HasPrefix(line, "[-]"):
+		default:
+			continue
+		}
+
+		name, message, _ := strings.Cut(line[3:], " ")
+		check := apiv2.ExternalClusterComponentHealth{Name: name, Healthy: healthy}
+		if !healthy {
+			check.Message = message
+		}
+		checks = append(checks, check)
+	}
+
+	return checks
+}
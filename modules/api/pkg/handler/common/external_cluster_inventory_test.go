@@ -0,0 +1,168 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	storagev1 "k8s.io/api/storage/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apiextensionsfake "k8s.io/apiextensions-apiserver/pkg/client/clientset/clientset/fake"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/version"
+	fakediscovery "k8s.io/client-go/discovery/fake"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+)
+
+func TestCollectExternalClusterInventory(t *testing.T) {
+	t.Parallel()
+
+	node := func(name, osImage string) *corev1.Node {
+		return &corev1.Node{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        name,
+				Annotations: map[string]string{flannelBackendAnnotation: "vxlan"},
+			},
+			Status: corev1.NodeStatus{NodeInfo: corev1.NodeSystemInfo{OSImage: osImage, ContainerRuntimeVersion: "containerd://1.7.15-k3s1"}},
+		}
+	}
+	client := kubefake.NewSimpleClientset(
+		node("server-0", "Ubuntu 24.04 LTS"),
+		node("agent-0", "Ubuntu 24.04 LTS"),
+		node("agent-1", "Flatcar Container Linux by Kinvolk 3815.2.2"),
+		&appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: "svclb-traefik", Namespace: metav1.NamespaceSystem}},
+		&storagev1.StorageClass{
+			ObjectMeta:  metav1.ObjectMeta{Name: "local-path", Annotations: map[string]string{defaultStorageClassAnnotation: "true"}},
+			Provisioner: "rancher.io/local-path",
+		},
+	)
+	client.Discovery().(*fakediscovery.FakeDiscovery).FakedServerVersion = &version.Info{GitVersion: "v1.30.2+k3s1"}
+	crdClient := apiextensionsfake.NewSimpleClientset(
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "helmcharts.helm.cattle.io"}},
+		&apiextensionsv1.CustomResourceDefinition{ObjectMeta: metav1.ObjectMeta{Name: "addons.k3s.cattle.io"}},
+	)
+
+	now := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	inventory, err := collectExternalClusterInventory(context.Background(), client, crdClient, now)
+	require.NoError(t, err)
+
+	require.Equal(t, "k3s", inventory.Distribution)
+	require.Equal(t, "v1.30.2+k3s1", inventory.Version)
+	require.Equal(t, "flannel", inventory.CNI)
+	require.Equal(t, []string{"Flatcar Container Linux by Kinvolk 3815.2.2", "Ubuntu 24.04 LTS"}, inventory.NodeOperatingSystems)
+	require.Equal(t, []string{"containerd://1.7.15-k3s1"}, inventory.ContainerRuntimes)
+	require.Equal(t, []string{"addons.k3s.cattle.io", "helmcharts.helm.cattle.io"}, inventory.CustomResourceDefinitions)
+	require.Equal(t, []apiv2.ExternalClusterStorageClass{{Name: "local-path", Provisioner: "rancher.io/local-path", Default: true}}, inventory.StorageClasses)
+	require.True(t, inventory.Reachability.Reachable)
+	require.True(t, inventory.Reachability.LastCheckTime.Time.Equal(now))
+}
+
+func TestDetectDistribution(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name       string
+		gitVersion string
+		groups     []string
+		node       corev1.Node
+		expected   string
+	}{
+		{
+			name:       "rke2",
+			gitVersion: "v1.29.4+rke2r1",
+			expected:   "rke2",
+		},
+		{
+			name:       "openshift",
+			gitVersion: "v1.28.7+6e2789b",
+			groups:     []string{"apps", "config.openshift.io"},
+			expected:   "openshift",
+		},
+		{
+			name:       "kind",
+			gitVersion: "v1.30.0",
+			node:       corev1.Node{Spec: corev1.NodeSpec{ProviderID: "kind://docker/kind/kind-control-plane"}},
+			expected:   "kind",
+		},
+		{
+			name:       "minikube",
+			gitVersion: "v1.30.0",
+			node:       corev1.Node{ObjectMeta: metav1.ObjectMeta{Labels: map[string]string{"minikube.k8s.io/name": "minikube"}}},
+			expected:   "minikube",
+		},
+		{
+			name:       "upstream",
+			gitVersion: "v1.30.0",
+			expected:   "kubernetes",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			groups := &metav1.APIGroupList{}
+			for _, g := range tc.groups {
+				groups.Groups = append(groups.Groups, metav1.APIGroup{Name: g})
+			}
+			require.Equal(t, tc.expected, detectDistribution(tc.gitVersion, groups, []corev1.Node{tc.node}))
+		})
+	}
+}
+
+func TestDetectCNI(t *testing.T) {
+	t.Parallel()
+
+	daemonSets := func(names ...string) []appsv1.DaemonSet {
+		var result []appsv1.DaemonSet
+		for _, name := range names {
+			result = append(result, appsv1.DaemonSet{ObjectMeta: metav1.ObjectMeta{Name: name}})
+		}
+		return result
+	}
+
+	require.Equal(t, "cilium", detectCNI(daemonSets("kube-proxy", "cilium"), nil))
+	require.Equal(t, "canal", detectCNI(daemonSets("calico-node", "canal"), nil))
+	require.Equal(t, "kindnet", detectCNI(daemonSets("kindnet"), nil))
+	require.Empty(t, detectCNI(daemonSets("kube-proxy"), nil))
+}
+
+func TestParseReadyzChecks(t *testing.T) {
+	t.Parallel()
+
+	body := []byte(`[+]ping ok
+[+]log ok
+[-]etcd failed: reason withheld
+[+]poststarthook/start-apiextensions-controllers ok
+readyz check failed
+`)
+
+	require.Equal(t, []apiv2.ExternalClusterComponentHealth{
+		{Name: "ping", Healthy: true},
+		{Name: "log", Healthy: true},
+		{Name: "etcd", Message: "failed: reason withheld"},
+		{Name: "poststarthook/start-apiextensions-controllers", Healthy: true},
+	}, parseReadyzChecks(body))
+}
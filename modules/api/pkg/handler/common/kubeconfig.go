@@ -39,6 +39,7 @@ import (
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
+	kubermaticcontext "k8c.io/kubermatic/v2/pkg/util/context"
 	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
 
 	corev1 "k8s.io/api/core/v1"
@@ -330,71 +331,34 @@ func CreateOIDCKubeconfigEndpoint(
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		// create a kubeconfig that contains OIDC tokens
-		oidcKubeCfg := clientcmdapi.NewConfig()
-		{
-			// grab admin kubeconfig to read the cluster info
-			var clusterFromAdminKubeCfg *clientcmdapi.Cluster
-			for clusterName, configCluster := range adminKubeConfig.Clusters {
-				if clusterName == req.ClusterID {
-					clusterFromAdminKubeCfg = configCluster
-				}
-
-				// If the cluster has an external address, we need to add to use that address in the KubeConfig
-				if cluster.Status.Address.APIServerExternalAddress != "" {
-					clusterFromAdminKubeCfg.Server = cluster.Status.Address.APIServerExternalAddress
-				}
-			}
-			if clusterFromAdminKubeCfg == nil {
-				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("unable to construct kubeconfig because couldn't find %s cluster entry in existing kubecfg", req.ClusterID))
-			}
-
-			// create cluster entry
-			clientCmdCluster := clientcmdapi.NewCluster()
-			clientCmdCluster.Server = clusterFromAdminKubeCfg.Server
-			clientCmdCluster.CertificateAuthorityData = clusterFromAdminKubeCfg.CertificateAuthorityData
-			oidcKubeCfg.Clusters[req.ClusterID] = clientCmdCluster
-
-			// create auth entry
-			clientCmdAuth := clientcmdapi.NewAuthInfo()
-
-			if req.decodedState.OIDCKubeLoginEnabled {
-				execConfig := &clientcmdapi.ExecConfig{
-					APIVersion: "client.authentication.k8s.io/v1",
-					Command:    "kubectl",
-					Args: []string{
-						"oidc-login",
-						"get-token",
-						"--oidc-issuer-url=" + oidcIssuerVerifier.OIDCConfig().URL,
-						"--oidc-client-id=" + oidcIssuerVerifier.OIDCConfig().ClientID,
-						"--oidc-client-secret=" + oidcIssuerVerifier.OIDCConfig().ClientSecret,
-						"--oidc-extra-scope=email",
-						"--oidc-extra-scope=groups",
-					},
-					InteractiveMode:    clientcmdapi.NeverExecInteractiveMode,
-					ProvideClusterInfo: false,
-				}
-				clientCmdAuth.Exec = execConfig
-			} else {
-				clientCmdAuthProvider := &clientcmdapi.AuthProviderConfig{Config: map[string]string{}}
-				clientCmdAuthProvider.Name = oidc
-				clientCmdAuthProvider.Config["id-token"] = oidcTokens.IDToken
-				clientCmdAuthProvider.Config["refresh-token"] = oidcTokens.RefreshToken
-				clientCmdAuthProvider.Config["idp-issuer-url"] = oidcIssuerVerifier.OIDCConfig().URL
-				clientCmdAuthProvider.Config["client-id"] = oidcIssuerVerifier.OIDCConfig().ClientID
-				clientCmdAuthProvider.Config["client-secret"] = oidcIssuerVerifier.OIDCConfig().ClientSecret
-				clientCmdAuth.AuthProvider = clientCmdAuthProvider
+		// create auth entry
+		clientCmdAuth := clientcmdapi.NewAuthInfo()
+
+		if req.decodedState.OIDCKubeLoginEnabled {
+			execConfig := &clientcmdapi.ExecConfig{
+				APIVersion: "client.authentication.k8s.io/v1",
+				Command:    "kubectl",
+				Args: []string{
+					"oidc-login",
+					"get-token",
+					"--oidc-issuer-url=" + oidcIssuerVerifier.OIDCConfig().URL,
+					"--oidc-client-id=" + oidcIssuerVerifier.OIDCConfig().ClientID,
+					"--oidc-client-secret=" + oidcIssuerVerifier.OIDCConfig().ClientSecret,
+					"--oidc-extra-scope=email",
+					"--oidc-extra-scope=groups",
+				},
+				InteractiveMode:    clientcmdapi.NeverExecInteractiveMode,
+				ProvideClusterInfo: false,
 			}
+			clientCmdAuth.Exec = execConfig
+		} else {
+			clientCmdAuth.AuthProvider = newOIDCAuthProvider(oidcIssuerVerifier.OIDCConfig(), oidcTokens)
+		}
 
-			// Normalize email to lowercase to match how users are stored
-			email := strings.ToLower(claims.Email)
-			oidcKubeCfg.AuthInfos[email] = clientCmdAuth
-			// create default ctx
-			clientCmdCtx := clientcmdapi.NewContext()
-			clientCmdCtx.Cluster = req.ClusterID
-			clientCmdCtx.AuthInfo = email
-			oidcKubeCfg.Contexts[req.ClusterID] = clientCmdCtx
-			oidcKubeCfg.CurrentContext = req.ClusterID
+		// create a kubeconfig that contains OIDC tokens
+		oidcKubeCfg, err := newOIDCKubeconfig(cluster, adminKubeConfig, req.ClusterID, claims.Email, clientCmdAuth)
+		if err != nil {
+			return nil, err
 		}
 
 		// prepare final rsp that holds kubeconfig
@@ -442,6 +406,103 @@ func CreateOIDCKubeconfigEndpoint(
 	return rsp, nil
 }
 
+// CreateDeviceOIDCKubeconfig returns an OIDC kubeconfig of a user cluster for the user who approved a
+// device authorization. The kubeconfig carries the tokens of the issuer the device was authorized with,
+// the user must have access to the cluster.
+func CreateDeviceOIDCKubeconfig(
+	ctx context.Context,
+	userProvider provider.UserProvider,
+	userInfoGetter provider.UserInfoGetter,
+	projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider,
+	seedsGetter provider.SeedsGetter,
+	clusterProviderGetter provider.ClusterProviderGetter,
+	oidcConfig *authtypes.OIDCConfiguration,
+	userEmail, projectID, clusterID string,
+	oidcTokens authtypes.OIDCToken,
+) (*clientcmdapi.Config, error) {
+	// the device is not authenticated by the middlewares, the user is looked up like they would
+	user, err := userProvider.UserByEmail(ctx, userEmail)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	ctx = context.WithValue(ctx, kubermaticcontext.UserCRContextKey, user)
+
+	clusterProvider, ctx, err := middleware.GetClusterProvider(ctx, CreateOIDCKubeconfigReq{ClusterID: clusterID}, seedsGetter, clusterProviderGetter)
+	if err != nil {
+		return nil, err
+	}
+	privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+	if !ok {
+		return nil, utilerrors.New(http.StatusInternalServerError, "no privileged cluster provider for the cluster")
+	}
+	ctx = context.WithValue(ctx, middleware.ClusterProviderContextKey, clusterProvider)
+	ctx = context.WithValue(ctx, middleware.PrivilegedClusterProviderContextKey, privilegedClusterProvider)
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	adminKubeConfig, err := clusterProvider.GetAdminKubeconfigForUserCluster(ctx, cluster)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	clientCmdAuth := clientcmdapi.NewAuthInfo()
+	clientCmdAuth.AuthProvider = newOIDCAuthProvider(oidcConfig, oidcTokens)
+	return newOIDCKubeconfig(cluster, adminKubeConfig, clusterID, user.Spec.Email, clientCmdAuth)
+}
+
+// newOIDCAuthProvider returns the auth provider config of a kubeconfig which authenticates with the given
+// tokens of the issuer.
+func newOIDCAuthProvider(oidcConfig *authtypes.OIDCConfiguration, oidcTokens authtypes.OIDCToken) *clientcmdapi.AuthProviderConfig {
+	return &clientcmdapi.AuthProviderConfig{
+		Name: oidc,
+		Config: map[string]string{
+			"id-token":       oidcTokens.IDToken,
+			"refresh-token":  oidcTokens.RefreshToken,
+			"idp-issuer-url": oidcConfig.URL,
+			"client-id":      oidcConfig.ClientID,
+			"client-secret":  oidcConfig.ClientSecret,
+		},
+	}
+}
+
+// newOIDCKubeconfig returns a kubeconfig of the user cluster for the user with the given email. The
+// cluster entry is taken over from the admin kubeconfig, the user authenticates with the given auth info.
+func newOIDCKubeconfig(cluster *kubermaticv1.Cluster, adminKubeConfig *clientcmdapi.Config, clusterID, email string, authInfo *clientcmdapi.AuthInfo) (*clientcmdapi.Config, error) {
+	// grab admin kubeconfig to read the cluster info
+	clusterFromAdminKubeCfg, ok := adminKubeConfig.Clusters[clusterID]
+	if !ok || clusterFromAdminKubeCfg == nil {
+		return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("unable to construct kubeconfig because couldn't find %s cluster entry in existing kubecfg", clusterID))
+	}
+
+	oidcKubeCfg := clientcmdapi.NewConfig()
+
+	// create cluster entry
+	clientCmdCluster := clientcmdapi.NewCluster()
+	clientCmdCluster.Server = clusterFromAdminKubeCfg.Server
+	// If the cluster has an external address, we need to add to use that address in the KubeConfig
+	if cluster.Status.Address.APIServerExternalAddress != "" {
+		clientCmdCluster.Server = cluster.Status.Address.APIServerExternalAddress
+	}
+	clientCmdCluster.CertificateAuthorityData = clusterFromAdminKubeCfg.CertificateAuthorityData
+	oidcKubeCfg.Clusters[clusterID] = clientCmdCluster
+
+	// Normalize email to lowercase to match how users are stored
+	email = strings.ToLower(email)
+	oidcKubeCfg.AuthInfos[email] = authInfo
+	// create default ctx
+	clientCmdCtx := clientcmdapi.NewContext()
+	clientCmdCtx.Cluster = clusterID
+	clientCmdCtx.AuthInfo = email
+	oidcKubeCfg.Contexts[clusterID] = clientCmdCtx
+	oidcKubeCfg.CurrentContext = clusterID
+
+	return oidcKubeCfg, nil
+}
+
 func CreateOIDCKubeconfigSecretEndpoint(
 	ctx context.Context,
 	projectProvider provider.ProjectProvider,
@@ -495,50 +556,11 @@ func CreateOIDCKubeconfigSecretEndpoint(
 		}
 
 		// create a kubeconfig that contains OIDC tokens
-		oidcKubeCfg := clientcmdapi.NewConfig()
-		{
-			// grab admin kubeconfig to read the cluster info
-			var clusterFromAdminKubeCfg *clientcmdapi.Cluster
-			for clusterName, configCluster := range adminKubeConfig.Clusters {
-				if clusterName == req.ClusterID {
-					clusterFromAdminKubeCfg = configCluster
-				}
-
-				// If the cluster has an external address, we need to add to use that address in the KubeConfig
-				if cluster.Status.Address.APIServerExternalAddress != "" {
-					clusterFromAdminKubeCfg.Server = cluster.Status.Address.APIServerExternalAddress
-				}
-			}
-			if clusterFromAdminKubeCfg == nil {
-				return nil, utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("unable to construct kubeconfig because couldn't find %s cluster entry in existing kubecfg", req.ClusterID))
-			}
-
-			// create cluster entry
-			clientCmdCluster := clientcmdapi.NewCluster()
-			clientCmdCluster.Server = clusterFromAdminKubeCfg.Server
-			clientCmdCluster.CertificateAuthorityData = clusterFromAdminKubeCfg.CertificateAuthorityData
-			oidcKubeCfg.Clusters[req.ClusterID] = clientCmdCluster
-
-			// create auth entry
-			clientCmdAuth := clientcmdapi.NewAuthInfo()
-			clientCmdAuthProvider := &clientcmdapi.AuthProviderConfig{Config: map[string]string{}}
-			clientCmdAuthProvider.Name = oidc
-			clientCmdAuthProvider.Config["id-token"] = oidcTokens.IDToken
-			clientCmdAuthProvider.Config["refresh-token"] = oidcTokens.RefreshToken
-			clientCmdAuthProvider.Config["idp-issuer-url"] = oidcIssuerVerifier.OIDCConfig().URL
-			clientCmdAuthProvider.Config["client-id"] = oidcIssuerVerifier.OIDCConfig().ClientID
-			clientCmdAuthProvider.Config["client-secret"] = oidcIssuerVerifier.OIDCConfig().ClientSecret
-			clientCmdAuth.AuthProvider = clientCmdAuthProvider
-			// Normalize email to lowercase to match how users are stored
-			email := strings.ToLower(claims.Email)
-			oidcKubeCfg.AuthInfos[email] = clientCmdAuth
-
-			// create default ctx
-			clientCmdCtx := clientcmdapi.NewContext()
-			clientCmdCtx.Cluster = req.ClusterID
-			clientCmdCtx.AuthInfo = email
-			oidcKubeCfg.Contexts[req.ClusterID] = clientCmdCtx
-			oidcKubeCfg.CurrentContext = req.ClusterID
+		clientCmdAuth := clientcmdapi.NewAuthInfo()
+		clientCmdAuth.AuthProvider = newOIDCAuthProvider(oidcIssuerVerifier.OIDCConfig(), oidcTokens)
+		oidcKubeCfg, err := newOIDCKubeconfig(cluster, adminKubeConfig, req.ClusterID, claims.Email, clientCmdAuth)
+		if err != nil {
+			return nil, err
 		}
 
 		// prepare final rsp that holds kubeconfig
@@ -0,0 +1,363 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"io"
+	"net/http"
+	"sort"
+	"strconv"
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/client-go/kubernetes"
+)
+
+// defaultContainerAnnotation is the annotation kubectl uses to pick the container of a multi-container pod.
+const defaultContainerAnnotation = "kubectl.kubernetes.io/default-container"
+
+// controlPlaneLogComponents are the control plane components in the cluster namespace of the seed whose logs can be
+// streamed. They are selected by their app label, which matches the name of their Deployment or StatefulSet.
+var controlPlaneLogComponents = sets.New(
+	resources.ApiserverDeploymentName,
+	resources.ControllerManagerDeploymentName,
+	resources.SchedulerDeploymentName,
+	resources.EtcdStatefulSetName,
+	resources.MachineControllerDeploymentName,
+	resources.MachineControllerWebhookDeploymentName,
+	resources.OperatingSystemManagerDeploymentName,
+	resources.UserClusterControllerDeploymentName,
+)
+
+// nodeLogComponents are the control plane components whose logs explain why a machine did not become a node.
+var nodeLogComponents = sets.New(
+	resources.MachineControllerDeploymentName,
+	resources.OperatingSystemManagerDeploymentName,
+)
+
+// LogOptions selects the part of a container log to stream. It is embedded in the requests of the log endpoints.
+type LogOptions struct {
+	// The container to stream the log of, defaults to the main container of the pod
+	// in: query
+	Container string `json:"container,omitempty"`
+	// Keep streaming new log lines
+	// in: query
+	Follow bool `json:"follow,omitempty"`
+	// Stream the log of the previous, terminated container
+	// in: query
+	Previous bool `json:"previous,omitempty"`
+	// Prefix every line with its timestamp
+	// in: query
+	Timestamps bool `json:"timestamps,omitempty"`
+	// Only stream the given number of lines from the end of the log
+	// in: query
+	TailLines *int64 `json:"tailLines,omitempty"`
+	// Only stream the lines of the given number of past seconds
+	// in: query
+	SinceSeconds *int64 `json:"sinceSeconds,omitempty"`
+	// Only stream the lines since the given RFC3339 timestamp
+	// in: query
+	SinceTime *metav1.Time `json:"sinceTime,omitempty"`
+}
+
+// LogStream is a container log that is written to the client line by line as it is read.
+type LogStream struct {
+	io.ReadCloser
+	// Filter, if not empty, drops all lines that contain none of its strings.
+	Filter []string
+}
+
+// DecodeLogOptions decodes the query parameters of the log endpoints.
+func DecodeLogOptions(r *http.Request) (LogOptions, error) {
+	query := r.URL.Query()
+	options := LogOptions{Container: query.Get("container")}
+
+	var err error
+	if options.Follow, err = decodeBoolQuery(r, "follow"); err != nil {
+		return options, err
+	}
+	if options.Previous, err = decodeBoolQuery(r, "previous"); err != nil {
+		return options, err
+	}
+	if options.Timestamps, err = decodeBoolQuery(r, "timestamps"); err != nil {
+		return options, err
+	}
+
+	if value := query.Get("tailLines"); value != "" {
+		tailLines, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || tailLines < 0 {
+			return options, utilerrors.NewBadRequest("tailLines must be a non-negative number")
+		}
+		options.TailLines = &tailLines
+	}
+	if value := query.Get("sinceSeconds"); value != "" {
+		sinceSeconds, err := strconv.ParseInt(value, 10, 64)
+		if err != nil || sinceSeconds <= 0 {
+			return options, utilerrors.NewBadRequest("sinceSeconds must be a positive number")
+		}
+		options.SinceSeconds = &sinceSeconds
+	}
+	if value := query.Get("sinceTime"); value != "" {
+		sinceTime, err := time.Parse(time.RFC3339, value)
+		if err != nil {
+			return options, utilerrors.NewBadRequest("sinceTime must be a RFC3339 timestamp: %v", err)
+		}
+		options.SinceTime = &metav1.Time{Time: sinceTime}
+	}
+	if options.SinceSeconds != nil && options.SinceTime != nil {
+		return options, utilerrors.NewBadRequest("only one of sinceSeconds and sinceTime can be set")
+	}
+
+	return options, nil
+}
+
+func decodeBoolQuery(r *http.Request, name string) (bool, error) {
+	value := r.URL.Query().Get(name)
+	if value == "" {
+		return false, nil
+	}
+	b, err := strconv.ParseBool(value)
+	if err != nil {
+		return false, utilerrors.NewBadRequest("%s must be a boolean", name)
+	}
+	return b, nil
+}
+
+// GetPodLogs streams the log of a pod in a user cluster. Unless the user is an admin, the request impersonates the user
+// so that the RBAC of the user cluster decides whether the log can be read.
+func GetPodLogs(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, namespace, podName string, options LogOptions) (*LogStream, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	client, err := common.GetClusterK8sClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return streamPodLogs(ctx, client, namespace, podName, "", options)
+}
+
+// ListControlPlaneLogComponents lists the control plane components of a cluster whose logs can be streamed, together
+// with their pods.
+func ListControlPlaneLogComponents(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) ([]apiv2.ControlPlaneLogComponent, error) {
+	seedClient, cluster, err := getControlPlaneLogClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	components := []apiv2.ControlPlaneLogComponent{}
+	for _, name := range sets.List(controlPlaneLogComponents) {
+		pods, err := listControlPlanePods(ctx, seedClient, cluster, name)
+		if err != nil {
+			return nil, err
+		}
+		if len(pods) == 0 {
+			continue
+		}
+
+		component := apiv2.ControlPlaneLogComponent{Name: name}
+		containers := sets.New[string]()
+		for _, pod := range pods {
+			component.Pods = append(component.Pods, pod.Name)
+			for _, container := range pod.Spec.Containers {
+				containers.Insert(container.Name)
+			}
+		}
+		component.Containers = sets.List(containers)
+		components = append(components, component)
+	}
+
+	return components, nil
+}
+
+// GetControlPlaneLogs streams the log of a control plane component of a cluster from the seed. If no pod is given,
+// the most recently started pod of the component is used.
+func GetControlPlaneLogs(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, component, podName string, options LogOptions) (*LogStream, error) {
+	if !controlPlaneLogComponents.Has(component) {
+		return nil, utilerrors.NewBadRequest("unknown control plane component %q, must be one of %v", component, sets.List(controlPlaneLogComponents))
+	}
+
+	seedClient, cluster, err := getControlPlaneLogClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	return getControlPlaneLogs(ctx, seedClient, cluster, component, podName, options)
+}
+
+// GetMachineNodeLogs streams the lines of the machine-controller or operating-system-manager log that mention a node
+// or its machine, which explain why a machine never joined the cluster.
+func GetMachineNodeLogs(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID, component string, options LogOptions) (*LogStream, error) {
+	if component == "" {
+		component = resources.MachineControllerDeploymentName
+	}
+	if !nodeLogComponents.Has(component) {
+		return nil, utilerrors.NewBadRequest("unknown component %q, must be one of %v", component, sets.List(nodeLogComponents))
+	}
+
+	seedClient, cluster, err := getControlPlaneLogClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machine, node, err := findMachineAndNode(ctx, nodeID, client)
+	if err != nil {
+		return nil, err
+	}
+	if machine == nil && node == nil {
+		return nil, utilerrors.NewNotFound("Node", nodeID)
+	}
+
+	filter := sets.New[string]()
+	if machine != nil {
+		filter.Insert(machine.Name)
+	}
+	if node != nil {
+		filter.Insert(node.Name)
+	}
+
+	stream, err := getControlPlaneLogs(ctx, seedClient, cluster, component, "", options)
+	if err != nil {
+		return nil, err
+	}
+	stream.Filter = sets.List(filter)
+
+	return stream, nil
+}
+
+func getControlPlaneLogs(ctx context.Context, seedClient kubernetes.Interface, cluster *kubermaticv1.Cluster, component, podName string, options LogOptions) (*LogStream, error) {
+	pods, err := listControlPlanePods(ctx, seedClient, cluster, component)
+	if err != nil {
+		return nil, err
+	}
+	if len(pods) == 0 {
+		return nil, utilerrors.NewNotFound("control plane component", component)
+	}
+
+	pod := &pods[0]
+	if podName != "" {
+		pod = nil
+		for i := range pods {
+			if pods[i].Name == podName {
+				pod = &pods[i]
+				break
+			}
+		}
+		if pod == nil {
+			return nil, utilerrors.NewNotFound("Pod", podName)
+		}
+	}
+
+	// Most control plane pods run sidecars next to the component, which is the container named like it.
+	return streamPodLogs(ctx, seedClient, pod.Namespace, pod.Name, component, options)
+}
+
+func getControlPlaneLogClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (kubernetes.Interface, *kubermaticv1.Cluster, error) {
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+	if cluster.Status.NamespaceName == "" {
+		return nil, nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("the control plane of cluster %s has not been created yet", clusterID))
+	}
+
+	return privilegedClusterProvider.GetSeedClusterAdminClient(), cluster, nil
+}
+
+// listControlPlanePods returns the pods of a control plane component, most recently created first.
+func listControlPlanePods(ctx context.Context, client kubernetes.Interface, cluster *kubermaticv1.Cluster, component string) ([]corev1.Pod, error) {
+	pods, err := client.CoreV1().Pods(cluster.Status.NamespaceName).List(ctx, metav1.ListOptions{
+		LabelSelector: fmt.Sprintf("%s=%s", resources.AppLabelKey, component),
+	})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	sort.SliceStable(pods.Items, func(i, j int) bool {
+		return pods.Items[j].CreationTimestamp.Before(&pods.Items[i].CreationTimestamp)
+	})
+
+	return pods.Items, nil
+}
+
+// streamPodLogs opens the log stream of a pod. If no container is requested, the defaultContainer, the container
+// named by the kubectl default container annotation or the first container is used, like kubectl does.
+func streamPodLogs(ctx context.Context, client kubernetes.Interface, namespace, podName, defaultContainer string, options LogOptions) (*LogStream, error) {
+	pod, err := client.CoreV1().Pods(namespace).Get(ctx, podName, metav1.GetOptions{})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	container := options.Container
+	if container == "" {
+		container = podLogContainer(pod, defaultContainer)
+	}
+
+	stream, err := client.CoreV1().Pods(namespace).GetLogs(podName, &corev1.PodLogOptions{
+		Container:    container,
+		Follow:       options.Follow,
+		Previous:     options.Previous,
+		Timestamps:   options.Timestamps,
+		TailLines:    options.TailLines,
+		SinceSeconds: options.SinceSeconds,
+		SinceTime:    options.SinceTime,
+	}).Stream(ctx)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return &LogStream{ReadCloser: stream}, nil
+}
+
+func podLogContainer(pod *corev1.Pod, defaultContainer string) string {
+	names := sets.New[string]()
+	for _, container := range pod.Spec.Containers {
+		names.Insert(container.Name)
+	}
+
+	if names.Has(defaultContainer) {
+		return defaultContainer
+	}
+	if name := pod.Annotations[defaultContainerAnnotation]; names.Has(name) {
+		return name
+	}
+	if len(pod.Spec.Containers) > 0 {
+		return pod.Spec.Containers[0].Name
+	}
+	return ""
+}
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"io"
+	"net/http/httptest"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	kubefake "k8s.io/client-go/kubernetes/fake"
+	clienttesting "k8s.io/client-go/testing"
+	"k8s.io/utils/ptr"
+)
+
+func TestDecodeLogOptions(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		query       string
+		expected    LogOptions
+		expectedErr string
+	}{
+		{
+			name:     "defaults",
+			expected: LogOptions{},
+		},
+		{
+			name:     "all options",
+			query:    "container=apiserver&follow=true&previous=false&timestamps=1&tailLines=100&sinceSeconds=60",
+			expected: LogOptions{Container: "apiserver", Follow: true, Timestamps: true, TailLines: ptr.To[int64](100), SinceSeconds: ptr.To[int64](60)},
+		},
+		{
+			name:        "invalid boolean",
+			query:       "follow=sure",
+			expectedErr: "follow must be a boolean",
+		},
+		{
+			name:        "negative tail",
+			query:       "tailLines=-1",
+			expectedErr: "tailLines must be a non-negative number",
+		},
+		{
+			name:        "both since options",
+			query:       "sinceSeconds=60&sinceTime=2026-01-01T00:00:00Z",
+			expectedErr: "only one of sinceSeconds and sinceTime can be set",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			options, err := DecodeLogOptions(httptest.NewRequest("GET", "/logs?"+tc.query, nil))
+			if tc.expectedErr != "" {
+				require.ErrorContains(t, err, tc.expectedErr)
+				return
+			}
+			require.NoError(t, err)
+			require.Equal(t, tc.expected, options)
+		})
+	}
+}
+
+func TestPodLogContainer(t *testing.T) {
+	t.Parallel()
+
+	pod := &corev1.Pod{
+		Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "openvpn-client"}, {Name: "apiserver"}, {Name: "dnat-controller"}}},
+	}
+	require.Equal(t, "apiserver", podLogContainer(pod, "apiserver"))
+	require.Equal(t, "openvpn-client", podLogContainer(pod, ""))
+
+	pod.Annotations = map[string]string{defaultContainerAnnotation: "dnat-controller"}
+	require.Equal(t, "dnat-controller", podLogContainer(pod, ""))
+}
+
+func TestGetControlPlaneLogs(t *testing.T) {
+	t.Parallel()
+
+	cluster := &kubermaticv1.Cluster{
+		ObjectMeta: metav1.ObjectMeta{Name: "abc"},
+		Status:     kubermaticv1.ClusterStatus{NamespaceName: "cluster-abc"},
+	}
+	controlPlanePod := func(name string, created time.Time) *corev1.Pod {
+		return &corev1.Pod{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              name,
+				Namespace:         "cluster-abc",
+				Labels:            map[string]string{"app": "machine-controller"},
+				CreationTimestamp: metav1.NewTime(created),
+			},
+			Spec: corev1.PodSpec{Containers: []corev1.Container{{Name: "machine-controller"}}},
+		}
+	}
+	now := time.Now()
+	client := kubefake.NewSimpleClientset(
+		controlPlanePod("machine-controller-old", now.Add(-time.Hour)),
+		controlPlanePod("machine-controller-new", now),
+		&corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: "machine-controller-other", Namespace: "cluster-xyz", Labels: map[string]string{"app": "machine-controller"}}},
+	)
+
+	logContainers := func() []string {
+		var containers []string
+		for _, action := range client.Actions() {
+			if action.GetSubresource() == "log" {
+				containers = append(containers, action.(clienttesting.GenericAction).GetValue().(*corev1.PodLogOptions).Container)
+			}
+		}
+		return containers
+	}
+
+	stream, err := getControlPlaneLogs(context.Background(), client, cluster, "machine-controller", "", LogOptions{})
+	require.NoError(t, err)
+	data, err := io.ReadAll(stream)
+	require.NoError(t, err)
+	require.Equal(t, "fake logs", string(data))
+	require.Equal(t, []string{"machine-controller"}, logContainers())
+
+	pods, err := listControlPlanePods(context.Background(), client, cluster, "machine-controller")
+	require.NoError(t, err)
+	require.Len(t, pods, 2)
+	require.Equal(t, "machine-controller-new", pods[0].Name)
+
+	_, err = getControlPlaneLogs(context.Background(), client, cluster, "machine-controller", "machine-controller-other", LogOptions{})
+	require.ErrorContains(t, err, "not found")
+}
@@ -0,0 +1,585 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	osmresources "k8c.io/operating-system-manager/pkg/controllers/osc/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// machineDeploymentRolloutConfigMapPrefix prefixes the ConfigMap in the kube-system namespace of the user cluster
+	// that stores the rollout of a machine deployment, so that its state survives API restarts and is shared between replicas.
+	machineDeploymentRolloutConfigMapPrefix = "machine-deployment-rollout-"
+	machineDeploymentRolloutKey             = "operation"
+	// machineDeploymentRolloutOfAnnotation is set on the new machine deployment and holds the name of the original one.
+	machineDeploymentRolloutOfAnnotation = "dashboard.k8c.io/rollout-of"
+
+	// MachineDeploymentRolloutOperationKind is the kind of the background operations which drive machine deployment
+	// rollouts.
+	MachineDeploymentRolloutOperationKind = "machine-deployment-rollout"
+
+	machineDeploymentRolloutPollInterval = 10 * time.Second
+	// defaultMachineDeploymentRolloutTimeoutSeconds is used if the rollout spec does not specify a timeout.
+	defaultMachineDeploymentRolloutTimeoutSeconds = 1800
+)
+
+// machineDeploymentScalingAnnotations size a machine deployment. They stay on the original machine deployment until
+// the rollout is promoted, so that neither the autoscaler nor a scaling policy resizes the canary.
+var machineDeploymentScalingAnnotations = []string{
+	machine.AutoscalerMinSizeAnnotation,
+	machine.AutoscalerMaxSizeAnnotation,
+	MachineDeploymentScalingPolicyAnnotation,
+	machineDeploymentScalingStateAnnotation,
+	machineDeploymentScalingHistoryAnnotation,
+}
+
+// StartMachineDeploymentRollout creates a new machine deployment with the given node spec next to the original one.
+// The original machine deployment is left untouched until the rollout is promoted.
+func StartMachineDeploymentRollout(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, sshKeyProvider provider.SSHKeyProvider, seedsGetter provider.SeedsGetter, settingsProvider provider.SettingsProvider, backgroundOperationProvider provider.BackgroundOperationProvider, projectID, clusterID, machineDeploymentID string, spec apiv2.MachineDeploymentRolloutSpec) (interface{}, error) {
+	switch spec.Strategy {
+	case "":
+		spec.Strategy = apiv2.MachineDeploymentRolloutCanary
+	case apiv2.MachineDeploymentRolloutCanary, apiv2.MachineDeploymentRolloutBlueGreen:
+	default:
+		return nil, utilerrors.NewBadRequest("unknown rollout strategy %q", spec.Strategy)
+	}
+	if spec.CanaryReplicas < 0 {
+		return nil, utilerrors.NewBadRequest("canaryReplicas must not be negative")
+	}
+	if spec.CanaryReplicas == 0 {
+		spec.CanaryReplicas = 1
+	}
+	if spec.TimeoutSeconds < 0 {
+		return nil, utilerrors.NewBadRequest("timeoutSeconds must not be negative")
+	}
+	if spec.TimeoutSeconds == 0 {
+		spec.TimeoutSeconds = defaultMachineDeploymentRolloutTimeoutSeconds
+	}
+
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, nil)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	cluster, client, err := getMachineDeploymentRolloutClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	original := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, original); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	op, cm, err := getMachineDeploymentRollout(ctx, client, machineDeploymentID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op != nil && isMachineDeploymentRolloutActive(op) {
+		return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("a rollout to machine deployment %s is already %s, roll it back first", op.Status.NewMachineDeployment, op.Status.Phase))
+	}
+
+	originalReplicas := ptr.Deref(original.Spec.Replicas, 1)
+	replicas := originalReplicas
+	if spec.Strategy == apiv2.MachineDeploymentRolloutCanary {
+		replicas = min(spec.CanaryReplicas, originalReplicas)
+	}
+
+	nodeDeployment, err := OutputMachineDeployment(original)
+	if err != nil {
+		return nil, fmt.Errorf("cannot output existing node deployment: %w", err)
+	}
+	if spec.Template.Versions.Kubelet == "" {
+		spec.Template.Versions.Kubelet = nodeDeployment.Spec.Template.Versions.Kubelet
+	}
+
+	nodeDeployment.Name = ""
+	nodeDeployment.Annotations = machineDeploymentRolloutAnnotations(original, !reflect.DeepEqual(spec.Template.OperatingSystem, nodeDeployment.Spec.Template.OperatingSystem))
+	nodeDeployment.Spec.Template = spec.Template
+	nodeDeployment.Spec.Replicas = replicas
+	nodeDeployment.Spec.MinReplicas = nil
+	nodeDeployment.Spec.MaxReplicas = nil
+	nodeDeployment.Spec.Paused = nil
+
+	nodeDeployment, err = machine.Validate(nodeDeployment, cluster.Spec.Version.Semver())
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("node deployment validation failed: %s", err)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, cluster.Spec.Cloud.DatacenterName)
+	if err != nil {
+		return nil, fmt.Errorf("error getting dc: %w", err)
+	}
+
+	keys, err := sshKeyProvider.List(ctx, project, &provider.SSHKeyListOptions{ClusterName: clusterID})
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	newMachineDeployment, err := machine.Deployment(ctx, cluster, nodeDeployment, dc, keys, settingsProvider)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create machine deployment from template: %w", err)
+	}
+	newMachineDeployment.GenerateName = original.Name + "-"
+	newMachineDeployment.Spec.Strategy = original.Spec.Strategy
+	newMachineDeployment.Spec.MinReadySeconds = original.Spec.MinReadySeconds
+
+	if err := client.Create(ctx, newMachineDeployment); err != nil {
+		return nil, fmt.Errorf("failed to create machine deployment: %w", err)
+	}
+
+	now := apiv1.Now()
+	op = &apiv2.MachineDeploymentRollout{
+		MachineDeployment: original.Name,
+		Spec:              spec,
+		Status: apiv2.MachineDeploymentRolloutStatus{
+			Phase:                apiv2.MachineDeploymentRolloutProgressing,
+			NewMachineDeployment: newMachineDeployment.Name,
+			OriginalReplicas:     originalReplicas,
+			StartTime:            now,
+			PhaseStartTime:       now,
+		},
+	}
+	if err := saveMachineDeploymentRollout(ctx, client, original.Name, cm, op); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if err := startMachineDeploymentRolloutRun(ctx, backgroundOperationProvider, clusterID, original.Name); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+// GetMachineDeploymentRollout returns the current or last rollout of a machine deployment.
+func GetMachineDeploymentRollout(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (interface{}, error) {
+	_, client, err := getMachineDeploymentRolloutClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	op, _, err := getMachineDeploymentRollout(ctx, client, machineDeploymentID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op == nil {
+		return nil, utilerrors.NewNotFound("machine deployment rollout", machineDeploymentID)
+	}
+
+	return op, nil
+}
+
+// PromoteMachineDeploymentRollout scales the new machine deployment to the replicas of the original one. Once it is
+// available, the original machine deployment is scaled down and deleted.
+func PromoteMachineDeploymentRollout(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, projectID, clusterID, machineDeploymentID string) (interface{}, error) {
+	_, client, err := getMachineDeploymentRolloutClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	op, cm, err := getMachineDeploymentRollout(ctx, client, machineDeploymentID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op == nil {
+		return nil, utilerrors.NewNotFound("machine deployment rollout", machineDeploymentID)
+	}
+	if op.Status.Phase != apiv2.MachineDeploymentRolloutReady {
+		return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("only ready rollouts can be promoted, the rollout is %s", op.Status.Phase))
+	}
+
+	original := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, original); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	newMachineDeployment := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: op.Status.NewMachineDeployment}, newMachineDeployment); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	newMachineDeployment.Spec.Replicas = ptr.To(op.Status.OriginalReplicas)
+	copyMachineDeploymentScalingAnnotations(original, newMachineDeployment)
+	if err := client.Update(ctx, newMachineDeployment); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	op.Status.Phase = apiv2.MachineDeploymentRolloutPromoting
+	op.Status.PhaseStartTime = apiv1.Now()
+	if err := saveMachineDeploymentRollout(ctx, client, machineDeploymentID, cm, op); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if err := startMachineDeploymentRolloutRun(ctx, backgroundOperationProvider, clusterID, machineDeploymentID); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+// RollbackMachineDeploymentRollout deletes the new machine deployment and restores the replicas of the original one,
+// in case a failed promotion already scaled it down.
+func RollbackMachineDeploymentRollout(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (interface{}, error) {
+	_, client, err := getMachineDeploymentRolloutClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID)
+	if err != nil {
+		return nil, err
+	}
+
+	op, cm, err := getMachineDeploymentRollout(ctx, client, machineDeploymentID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op == nil {
+		return nil, utilerrors.NewNotFound("machine deployment rollout", machineDeploymentID)
+	}
+
+	if err := rollbackMachineDeploymentRollout(ctx, client, op); err != nil {
+		return nil, err
+	}
+
+	finishMachineDeploymentRollout(op, apiv2.MachineDeploymentRolloutRolledBack, "", time.Now())
+	if err := saveMachineDeploymentRollout(ctx, client, machineDeploymentID, cm, op); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+func rollbackMachineDeploymentRollout(ctx context.Context, client ctrlruntimeclient.Client, op *apiv2.MachineDeploymentRollout) error {
+	if op.Status.Phase != apiv2.MachineDeploymentRolloutProgressing && op.Status.Phase != apiv2.MachineDeploymentRolloutReady && op.Status.Phase != apiv2.MachineDeploymentRolloutFailed {
+		return utilerrors.New(http.StatusConflict, fmt.Sprintf("only progressing, ready or failed rollouts can be rolled back, the rollout is %s", op.Status.Phase))
+	}
+
+	newMachineDeployment := &clusterv1alpha1.MachineDeployment{}
+	err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: op.Status.NewMachineDeployment}, newMachineDeployment)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	newMachineDeploymentExists := err == nil
+
+	original := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: op.MachineDeployment}, original); err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if ptr.Deref(original.Spec.Replicas, 1) != op.Status.OriginalReplicas {
+		original.Spec.Replicas = ptr.To(op.Status.OriginalReplicas)
+		if newMachineDeploymentExists {
+			copyMachineDeploymentScalingAnnotations(newMachineDeployment, original)
+		}
+		if err := client.Update(ctx, original); err != nil {
+			return common.KubernetesErrorToHTTPError(err)
+		}
+	}
+
+	if newMachineDeploymentExists {
+		if err := client.Delete(ctx, newMachineDeployment); err != nil && !apierrors.IsNotFound(err) {
+			return common.KubernetesErrorToHTTPError(err)
+		}
+	}
+
+	return nil
+}
+
+func getMachineDeploymentRolloutClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID string) (*kubermaticv1.Cluster, ctrlruntimeclient.Client, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return cluster, client, nil
+}
+
+// machineDeploymentRolloutAnnotations returns the annotations of the original machine deployment without the ones that
+// size it. The operating system profile is dropped if the operating system changes, so that the datacenter default
+// for the new operating system is used.
+func machineDeploymentRolloutAnnotations(original *clusterv1alpha1.MachineDeployment, operatingSystemChanged bool) map[string]string {
+	annotations := map[string]string{}
+	for k, v := range original.Annotations {
+		annotations[k] = v
+	}
+	for _, k := range machineDeploymentScalingAnnotations {
+		delete(annotations, k)
+	}
+	if operatingSystemChanged {
+		delete(annotations, osmresources.MachineDeploymentOSPAnnotation)
+	}
+	annotations[machineDeploymentRolloutOfAnnotation] = original.Name
+
+	return annotations
+}
+
+// copyMachineDeploymentScalingAnnotations moves the annotations that size a machine deployment from one machine
+// deployment to another one.
+func copyMachineDeploymentScalingAnnotations(from, to *clusterv1alpha1.MachineDeployment) {
+	for _, k := range machineDeploymentScalingAnnotations {
+		if v, ok := from.Annotations[k]; ok {
+			if to.Annotations == nil {
+				to.Annotations = map[string]string{}
+			}
+			to.Annotations[k] = v
+		}
+	}
+}
+
+func isMachineDeploymentRolloutActive(op *apiv2.MachineDeploymentRollout) bool {
+	switch op.Status.Phase {
+	case apiv2.MachineDeploymentRolloutProgressing, apiv2.MachineDeploymentRolloutReady, apiv2.MachineDeploymentRolloutPromoting:
+		return true
+	}
+	return false
+}
+
+// startMachineDeploymentRolloutRunnerFromContext drives the rollout with an admin client for the user cluster, which
+// keeps working after the request that started it is gone.
+func startMachineDeploymentRolloutRun(ctx context.Context, backgroundOperationProvider provider.BackgroundOperationProvider, clusterName, machineDeploymentName string) error {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+
+	op := provider.BackgroundOperation{Kind: MachineDeploymentRolloutOperationKind, Cluster: clusterName, Name: machineDeploymentName}
+	run := machineDeploymentRolloutRun(privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), clusterProvider.GetAdminClientForUserCluster, clusterName, machineDeploymentName)
+
+	return backgroundOperationProvider.Start(ctx, op, run)
+}
+
+// MachineDeploymentRolloutResumer resumes the rollouts which were driven by API replicas that are gone.
+func MachineDeploymentRolloutResumer(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationResumer {
+	return func(ctx context.Context, op provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return nil, err
+		}
+		return machineDeploymentRolloutRun(seedClient, clusterProvider.GetAdminClientForUserCluster, op.Cluster, op.Name), nil
+	}
+}
+
+// MachineDeploymentRolloutFailureHandler marks the rollouts which were given up after failing repeatedly as failed.
+func MachineDeploymentRolloutFailureHandler(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationFailureHandler {
+	return func(ctx context.Context, op provider.BackgroundOperation, cause error) error {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return err
+		}
+
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Name: op.Cluster}, cluster); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+		client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+		if err != nil {
+			return err
+		}
+		rollout, cm, err := getMachineDeploymentRollout(ctx, client, op.Name)
+		if err != nil || rollout == nil || !isMachineDeploymentRolloutActive(rollout) {
+			return err
+		}
+
+		finishMachineDeploymentRollout(rollout, apiv2.MachineDeploymentRolloutFailed, fmt.Sprintf("the rollout was given up: %v", cause), time.Now())
+		return saveMachineDeploymentRollout(ctx, client, op.Name, cm, rollout)
+	}
+}
+
+func machineDeploymentRolloutRun(seedClient ctrlruntimeclient.Client, clientGetter userClusterClientGetter, clusterName, machineDeploymentName string) provider.BackgroundOperationRun {
+	return func(ctx context.Context) error {
+		log := kubermaticlog.Logger.With("cluster", clusterName, "machinedeployment", machineDeploymentName)
+
+		return wait.PollUntilContextCancel(ctx, machineDeploymentRolloutPollInterval, true, func(ctx context.Context) (bool, error) {
+			cluster := &kubermaticv1.Cluster{}
+			if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterName}, cluster); err != nil {
+				if apierrors.IsNotFound(err) {
+					return true, nil
+				}
+				log.Warnw("Failed to get cluster for machine deployment rollout", zap.Error(err))
+				return false, nil
+			}
+			client, err := clientGetter(ctx, cluster)
+			if err != nil {
+				log.Warnw("Failed to get client for machine deployment rollout", zap.Error(err))
+				return false, nil
+			}
+			done, err := reconcileMachineDeploymentRollout(ctx, client, machineDeploymentName, time.Now())
+			if err != nil {
+				log.Warnw("Failed to reconcile machine deployment rollout", zap.Error(err))
+				return false, nil
+			}
+			return done, nil
+		})
+	}
+}
+
+// reconcileMachineDeploymentRollout waits for the new machine deployment to become available and, once the rollout
+// is promoted, scales the original machine deployment down. It returns true once the rollout does not need to be
+// driven anymore, i.e. it waits for a promotion or it is finished.
+func reconcileMachineDeploymentRollout(ctx context.Context, client ctrlruntimeclient.Client, machineDeploymentName string, now time.Time) (bool, error) {
+	op, cm, err := getMachineDeploymentRollout(ctx, client, machineDeploymentName)
+	if err != nil {
+		return false, err
+	}
+	if op == nil || (op.Status.Phase != apiv2.MachineDeploymentRolloutProgressing && op.Status.Phase != apiv2.MachineDeploymentRolloutPromoting) {
+		return true, nil
+	}
+
+	timeout := time.Duration(op.Spec.TimeoutSeconds) * time.Second
+	timedOut := now.Sub(op.Status.PhaseStartTime.Time) > timeout
+
+	newMachineDeployment := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: op.Status.NewMachineDeployment}, newMachineDeployment); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		finishMachineDeploymentRollout(op, apiv2.MachineDeploymentRolloutFailed, fmt.Sprintf("machine deployment %s was deleted", op.Status.NewMachineDeployment), now)
+		return true, saveMachineDeploymentRollout(ctx, client, machineDeploymentName, cm, op)
+	}
+
+	switch {
+	case !isMachineDeploymentRolledOut(newMachineDeployment) && timedOut:
+		finishMachineDeploymentRollout(op, apiv2.MachineDeploymentRolloutFailed, fmt.Sprintf("machine deployment %s did not become available within %v", newMachineDeployment.Name, timeout), now)
+	case !isMachineDeploymentRolledOut(newMachineDeployment):
+		return false, nil
+	case op.Status.Phase == apiv2.MachineDeploymentRolloutProgressing:
+		op.Status.Phase = apiv2.MachineDeploymentRolloutReady
+		op.Status.PhaseStartTime = apiv1.NewTime(now)
+	default:
+		changed, err := scaleDownOriginalMachineDeployment(ctx, client, op, now, timedOut)
+		if err != nil || !changed {
+			return false, err
+		}
+	}
+
+	if err := saveMachineDeploymentRollout(ctx, client, machineDeploymentName, cm, op); err != nil {
+		return false, err
+	}
+
+	return !isMachineDeploymentRolloutActive(op) || op.Status.Phase == apiv2.MachineDeploymentRolloutReady, nil
+}
+
+// scaleDownOriginalMachineDeployment scales the original machine deployment of a promoted rollout to zero and deletes
+// it once its machines are gone. It returns false if the rollout did not change.
+func scaleDownOriginalMachineDeployment(ctx context.Context, client ctrlruntimeclient.Client, op *apiv2.MachineDeploymentRollout, now time.Time, timedOut bool) (bool, error) {
+	original := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: op.MachineDeployment}, original); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		finishMachineDeploymentRollout(op, apiv2.MachineDeploymentRolloutCompleted, "", now)
+		return true, nil
+	}
+
+	switch {
+	case ptr.Deref(original.Spec.Replicas, 1) != 0:
+		original.Spec.Replicas = ptr.To[int32](0)
+		// The scaling annotations were copied to the new machine deployment when the rollout was promoted.
+		for _, k := range machineDeploymentScalingAnnotations {
+			delete(original.Annotations, k)
+		}
+		return false, client.Update(ctx, original)
+	case original.Status.Replicas > 0 && timedOut:
+		finishMachineDeploymentRollout(op, apiv2.MachineDeploymentRolloutFailed, fmt.Sprintf("machine deployment %s did not scale down within %v", original.Name, time.Duration(op.Spec.TimeoutSeconds)*time.Second), now)
+		return true, nil
+	case original.Status.Replicas > 0:
+		return false, nil
+	}
+
+	if err := client.Delete(ctx, original); err != nil && !apierrors.IsNotFound(err) {
+		return false, err
+	}
+	finishMachineDeploymentRollout(op, apiv2.MachineDeploymentRolloutCompleted, "", now)
+
+	return true, nil
+}
+
+func finishMachineDeploymentRollout(op *apiv2.MachineDeploymentRollout, phase apiv2.MachineDeploymentRolloutPhase, message string, now time.Time) {
+	completionTime := apiv1.NewTime(now)
+	op.Status.Phase = phase
+	op.Status.Message = message
+	op.Status.PhaseStartTime = completionTime
+	op.Status.CompletionTime = &completionTime
+}
+
+func getMachineDeploymentRollout(ctx context.Context, client ctrlruntimeclient.Client, machineDeploymentName string) (*apiv2.MachineDeploymentRollout, *corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentRolloutConfigMapPrefix + machineDeploymentName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	op := &apiv2.MachineDeploymentRollout{}
+	if err := json.Unmarshal([]byte(cm.Data[machineDeploymentRolloutKey]), op); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode machine deployment rollout: %w", err)
+	}
+
+	return op, cm, nil
+}
+
+// saveMachineDeploymentRollout stores the rollout in the ConfigMap it was read from, so that concurrent changes, e.g.
+// a rollback while the rollout is reconciled, fail with a conflict instead of being lost.
+func saveMachineDeploymentRollout(ctx context.Context, client ctrlruntimeclient.Client, machineDeploymentName string, cm *corev1.ConfigMap, op *apiv2.MachineDeploymentRollout) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		return client.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      machineDeploymentRolloutConfigMapPrefix + machineDeploymentName,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{machineDeploymentRolloutKey: string(data)},
+		})
+	}
+
+	cm.Data = map[string]string{machineDeploymentRolloutKey: string(data)}
+	return client.Update(ctx, cm)
+}
@@ -0,0 +1,244 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/resources/machine"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+	osmresources "k8c.io/operating-system-manager/pkg/controllers/osc/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestMachineDeploymentRolloutAnnotations(t *testing.T) {
+	t.Parallel()
+
+	original := &clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name: "workers",
+			Annotations: map[string]string{
+				machine.AutoscalerMaxSizeAnnotation:         "5",
+				MachineDeploymentScalingPolicyAnnotation:    "{}",
+				osmresources.MachineDeploymentOSPAnnotation: "osp-ubuntu",
+				"example.com/owner":                         "team-a",
+			},
+		},
+	}
+
+	annotations := machineDeploymentRolloutAnnotations(original, false)
+	require.Equal(t, map[string]string{
+		osmresources.MachineDeploymentOSPAnnotation: "osp-ubuntu",
+		"example.com/owner":                         "team-a",
+		machineDeploymentRolloutOfAnnotation:        "workers",
+	}, annotations)
+	require.Len(t, original.Annotations, 4)
+
+	annotations = machineDeploymentRolloutAnnotations(original, true)
+	require.NotContains(t, annotations, osmresources.MachineDeploymentOSPAnnotation)
+}
+
+func TestReconcileMachineDeploymentRollout(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	client := newMachineDeploymentRolloutClient(t, start)
+	ctx := context.Background()
+
+	getMachineDeployment := func(name string) *clusterv1alpha1.MachineDeployment {
+		md := &clusterv1alpha1.MachineDeployment{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: name}, md))
+		return md
+	}
+	reconcile := func(now time.Time, expectedDone bool) *apiv2.MachineDeploymentRollout {
+		done, err := reconcileMachineDeploymentRollout(ctx, client, "workers", now)
+		require.NoError(t, err)
+		require.Equal(t, expectedDone, done)
+		op, _, err := getMachineDeploymentRollout(ctx, client, "workers")
+		require.NoError(t, err)
+		return op
+	}
+
+	// the canary is still provisioning
+	op := reconcile(start.Add(time.Minute), false)
+	require.Equal(t, apiv2.MachineDeploymentRolloutProgressing, op.Status.Phase)
+
+	canary := getMachineDeployment("workers-canary")
+	canary.Status = clusterv1alpha1.MachineDeploymentStatus{UpdatedReplicas: 1, AvailableReplicas: 1}
+	require.NoError(t, client.Status().Update(ctx, canary))
+
+	op = reconcile(start.Add(2*time.Minute), true)
+	require.Equal(t, apiv2.MachineDeploymentRolloutReady, op.Status.Phase)
+
+	// promote, which the endpoint does by scaling up the canary
+	canary = getMachineDeployment("workers-canary")
+	canary.Spec.Replicas = ptr.To[int32](3)
+	require.NoError(t, client.Update(ctx, canary))
+	setMachineDeploymentRolloutPhase(t, client, apiv2.MachineDeploymentRolloutPromoting, start.Add(3*time.Minute))
+
+	// the original machine deployment is only scaled down after the canary is fully available
+	op = reconcile(start.Add(4*time.Minute), false)
+	require.Equal(t, apiv2.MachineDeploymentRolloutPromoting, op.Status.Phase)
+	require.Equal(t, int32(3), *getMachineDeployment("workers").Spec.Replicas)
+
+	canary = getMachineDeployment("workers-canary")
+	canary.Status = clusterv1alpha1.MachineDeploymentStatus{UpdatedReplicas: 3, AvailableReplicas: 3}
+	require.NoError(t, client.Status().Update(ctx, canary))
+
+	reconcile(start.Add(5*time.Minute), false)
+	original := getMachineDeployment("workers")
+	require.Equal(t, int32(0), *original.Spec.Replicas)
+	require.NotContains(t, original.Annotations, machine.AutoscalerMaxSizeAnnotation)
+
+	// the machines of the original machine deployment are being deleted
+	reconcile(start.Add(6*time.Minute), false)
+
+	original.Status = clusterv1alpha1.MachineDeploymentStatus{}
+	require.NoError(t, client.Status().Update(ctx, original))
+
+	op = reconcile(start.Add(7*time.Minute), true)
+	require.Equal(t, apiv2.MachineDeploymentRolloutCompleted, op.Status.Phase)
+	require.NotNil(t, op.Status.CompletionTime)
+
+	err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers"}, &clusterv1alpha1.MachineDeployment{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+func TestReconcileMachineDeploymentRolloutTimeout(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	client := newMachineDeploymentRolloutClient(t, start)
+
+	done, err := reconcileMachineDeploymentRollout(context.Background(), client, "workers", start.Add(31*time.Minute))
+	require.NoError(t, err)
+	require.True(t, done)
+
+	op, _, err := getMachineDeploymentRollout(context.Background(), client, "workers")
+	require.NoError(t, err)
+	require.Equal(t, apiv2.MachineDeploymentRolloutFailed, op.Status.Phase)
+	require.Contains(t, op.Status.Message, "did not become available within 30m0s")
+}
+
+func TestRollbackMachineDeploymentRollout(t *testing.T) {
+	t.Parallel()
+
+	start := time.Date(2026, time.March, 2, 12, 0, 0, 0, time.UTC)
+	client := newMachineDeploymentRolloutClient(t, start)
+	ctx := context.Background()
+
+	// a promotion that failed after the original machine deployment was scaled down
+	original := &clusterv1alpha1.MachineDeployment{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers"}, original))
+	original.Spec.Replicas = ptr.To[int32](0)
+	delete(original.Annotations, machine.AutoscalerMaxSizeAnnotation)
+	require.NoError(t, client.Update(ctx, original))
+
+	canary := &clusterv1alpha1.MachineDeployment{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers-canary"}, canary))
+	canary.Annotations[machine.AutoscalerMaxSizeAnnotation] = "5"
+	require.NoError(t, client.Update(ctx, canary))
+
+	op := setMachineDeploymentRolloutPhase(t, client, apiv2.MachineDeploymentRolloutPromoting, start)
+	require.ErrorContains(t, rollbackMachineDeploymentRollout(ctx, client, op), "only progressing, ready or failed rollouts can be rolled back")
+
+	op = setMachineDeploymentRolloutPhase(t, client, apiv2.MachineDeploymentRolloutFailed, start)
+	require.NoError(t, rollbackMachineDeploymentRollout(ctx, client, op))
+
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers"}, original))
+	require.Equal(t, int32(3), *original.Spec.Replicas)
+	require.Equal(t, "5", original.Annotations[machine.AutoscalerMaxSizeAnnotation])
+
+	err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers-canary"}, &clusterv1alpha1.MachineDeployment{})
+	require.True(t, apierrors.IsNotFound(err))
+}
+
+// newMachineDeploymentRolloutClient returns a client with a running canary rollout of the "workers" machine deployment.
+func newMachineDeploymentRolloutClient(t *testing.T, start time.Time) ctrlruntimeclient.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(corev1.AddToScheme(scheme))
+
+	client := ctrlruntimefake.NewClientBuilder().
+		WithScheme(scheme).
+		WithStatusSubresource(&clusterv1alpha1.MachineDeployment{}).
+		WithObjects(
+			&clusterv1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "workers",
+					Namespace:   metav1.NamespaceSystem,
+					Annotations: map[string]string{machine.AutoscalerMaxSizeAnnotation: "5"},
+				},
+				Spec:   clusterv1alpha1.MachineDeploymentSpec{Replicas: ptr.To[int32](3)},
+				Status: clusterv1alpha1.MachineDeploymentStatus{Replicas: 3, UpdatedReplicas: 3, AvailableReplicas: 3},
+			},
+			&clusterv1alpha1.MachineDeployment{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:        "workers-canary",
+					Namespace:   metav1.NamespaceSystem,
+					Annotations: map[string]string{machineDeploymentRolloutOfAnnotation: "workers"},
+				},
+				Spec: clusterv1alpha1.MachineDeploymentSpec{Replicas: ptr.To[int32](1)},
+			},
+		).
+		Build()
+
+	op := &apiv2.MachineDeploymentRollout{
+		MachineDeployment: "workers",
+		Spec: apiv2.MachineDeploymentRolloutSpec{
+			Strategy:       apiv2.MachineDeploymentRolloutCanary,
+			CanaryReplicas: 1,
+			TimeoutSeconds: defaultMachineDeploymentRolloutTimeoutSeconds,
+		},
+		Status: apiv2.MachineDeploymentRolloutStatus{
+			Phase:                apiv2.MachineDeploymentRolloutProgressing,
+			NewMachineDeployment: "workers-canary",
+			OriginalReplicas:     3,
+			StartTime:            apiv1.NewTime(start),
+			PhaseStartTime:       apiv1.NewTime(start),
+		},
+	}
+	require.NoError(t, saveMachineDeploymentRollout(context.Background(), client, "workers", nil, op))
+
+	return client
+}
+
+func setMachineDeploymentRolloutPhase(t *testing.T, client ctrlruntimeclient.Client, phase apiv2.MachineDeploymentRolloutPhase, now time.Time) *apiv2.MachineDeploymentRollout {
+	op, cm, err := getMachineDeploymentRollout(context.Background(), client, "workers")
+	require.NoError(t, err)
+
+	op.Status.Phase = phase
+	op.Status.PhaseStartTime = apiv1.NewTime(now)
+	require.NoError(t, saveMachineDeploymentRollout(context.Background(), client, "workers", cm, op))
+
+	return op
+}
@@ -0,0 +1,435 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"time"
+	// The API image has no time zone database, but the scaling policies are evaluated in their time zone.
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// MachineDeploymentScalingPolicyAnnotation holds the JSON encoded scaling policy of a machine deployment.
+	MachineDeploymentScalingPolicyAnnotation = "dashboard.k8c.io/scaling-policy"
+	// machineDeploymentScalingStateAnnotation holds the last schedule time that was applied, so that every schedule
+	// time is applied once even with several API replicas.
+	machineDeploymentScalingStateAnnotation = "dashboard.k8c.io/scaling-state"
+	// machineDeploymentScalingHistoryAnnotation holds the most recent scaling actions.
+	machineDeploymentScalingHistoryAnnotation = "dashboard.k8c.io/scaling-history"
+	// clusterScalingPoliciesAnnotation marks the clusters with scaling policies, so that the reconciler only connects
+	// to those user clusters.
+	clusterScalingPoliciesAnnotation = "dashboard.k8c.io/scaling-policies"
+
+	machineDeploymentScalingInterval     = time.Minute
+	machineDeploymentScalingHistoryLimit = 50
+	// maxScalingScheduleLookback bounds how far back schedule times that were missed, e.g. during an outage of the
+	// API, are still applied.
+	maxScalingScheduleLookback = 7 * 24 * time.Hour
+)
+
+type machineDeploymentScalingState struct {
+	LastScheduleTime time.Time `json:"lastScheduleTime"`
+}
+
+// GetMachineDeploymentScalingPolicy returns the scaling policy of a machine deployment.
+func GetMachineDeploymentScalingPolicy(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (*apiv2.MachineDeploymentScalingPolicy, error) {
+	_, md, _, err := getScalingMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	policy, err := machineDeploymentScalingPolicy(md)
+	if err != nil {
+		return nil, err
+	}
+	if policy == nil {
+		return nil, utilerrors.NewNotFound("scaling policy", machineDeploymentID)
+	}
+
+	return policy, nil
+}
+
+// UpdateMachineDeploymentScalingPolicy sets the scaling policy of a machine deployment. Rules only fire for schedule
+// times after the policy was set.
+func UpdateMachineDeploymentScalingPolicy(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string, policy apiv2.MachineDeploymentScalingPolicy) (*apiv2.MachineDeploymentScalingPolicy, error) {
+	client, md, cluster, err := getScalingMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	minReplicas, maxReplicas, err := getAutoscalingConfiguration(md)
+	if err != nil {
+		return nil, err
+	}
+	if err := ValidateMachineDeploymentScalingPolicy(policy, minReplicas, maxReplicas); err != nil {
+		return nil, utilerrors.NewBadRequest("invalid scaling policy: %v", err)
+	}
+
+	policyData, err := json.Marshal(policy)
+	if err != nil {
+		return nil, err
+	}
+	stateData, err := json.Marshal(machineDeploymentScalingState{LastScheduleTime: time.Now().UTC()})
+	if err != nil {
+		return nil, err
+	}
+
+	if md.Annotations == nil {
+		md.Annotations = map[string]string{}
+	}
+	md.Annotations[MachineDeploymentScalingPolicyAnnotation] = string(policyData)
+	md.Annotations[machineDeploymentScalingStateAnnotation] = string(stateData)
+	if err := client.Update(ctx, md); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if err := markClusterScalingPolicies(ctx, cluster); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return &policy, nil
+}
+
+// DeleteMachineDeploymentScalingPolicy removes the scaling policy and the scaling history of a machine deployment.
+func DeleteMachineDeploymentScalingPolicy(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) error {
+	client, md, _, err := getScalingMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID)
+	if err != nil {
+		return err
+	}
+
+	if _, ok := md.Annotations[MachineDeploymentScalingPolicyAnnotation]; !ok {
+		return utilerrors.NewNotFound("scaling policy", machineDeploymentID)
+	}
+
+	delete(md.Annotations, MachineDeploymentScalingPolicyAnnotation)
+	delete(md.Annotations, machineDeploymentScalingStateAnnotation)
+	delete(md.Annotations, machineDeploymentScalingHistoryAnnotation)
+	if err := client.Update(ctx, md); err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	return nil
+}
+
+// ListMachineDeploymentScalingHistory returns the most recent scaling actions of a machine deployment, oldest first.
+func ListMachineDeploymentScalingHistory(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) ([]apiv2.MachineDeploymentScalingAction, error) {
+	_, md, _, err := getScalingMachineDeployment(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, machineDeploymentID)
+	if err != nil {
+		return nil, err
+	}
+
+	return machineDeploymentScalingHistory(md)
+}
+
+// ValidateMachineDeploymentScalingPolicy checks the schedules of a policy and that its replicas stay within the
+// autoscaler limits of the machine deployment, if there are any.
+func ValidateMachineDeploymentScalingPolicy(policy apiv2.MachineDeploymentScalingPolicy, minReplicas, maxReplicas *uint32) error {
+	if _, err := time.LoadLocation(policy.TimeZone); err != nil {
+		return fmt.Errorf("unknown time zone %q", policy.TimeZone)
+	}
+	if len(policy.Rules) == 0 {
+		return errors.New("at least one rule is required")
+	}
+
+	names := sets.New[string]()
+	for _, rule := range policy.Rules {
+		if rule.Name == "" {
+			return errors.New("every rule requires a name")
+		}
+		if names.Has(rule.Name) {
+			return fmt.Errorf("rule name %q is used more than once", rule.Name)
+		}
+		names.Insert(rule.Name)
+
+		if _, err := cron.ParseStandard(rule.Schedule); err != nil {
+			return fmt.Errorf("rule %q has an invalid schedule: %w", rule.Name, err)
+		}
+		if rule.Replicas < 0 {
+			return fmt.Errorf("rule %q must not have negative replicas", rule.Name)
+		}
+		if minReplicas != nil && rule.Replicas < int32(*minReplicas) {
+			return fmt.Errorf("rule %q cannot scale below the autoscaler min replicas (%d)", rule.Name, *minReplicas)
+		}
+		if maxReplicas != nil && rule.Replicas > int32(*maxReplicas) {
+			return fmt.Errorf("rule %q cannot scale above the autoscaler max replicas (%d)", rule.Name, *maxReplicas)
+		}
+	}
+
+	return nil
+}
+
+func getScalingMachineDeployment(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, machineDeploymentID string) (ctrlruntimeclient.Client, *clusterv1alpha1.MachineDeployment, *kubermaticv1.Cluster, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	md := &clusterv1alpha1.MachineDeployment{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentID}, md); err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return client, md, cluster, nil
+}
+
+func markClusterScalingPolicies(ctx context.Context, cluster *kubermaticv1.Cluster) error {
+	if _, ok := cluster.Annotations[clusterScalingPoliciesAnnotation]; ok {
+		return nil
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	oldCluster := cluster.DeepCopy()
+	if cluster.Annotations == nil {
+		cluster.Annotations = map[string]string{}
+	}
+	cluster.Annotations[clusterScalingPoliciesAnnotation] = "true"
+	return seedClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster))
+}
+
+func machineDeploymentScalingPolicy(md *clusterv1alpha1.MachineDeployment) (*apiv2.MachineDeploymentScalingPolicy, error) {
+	data, ok := md.Annotations[MachineDeploymentScalingPolicyAnnotation]
+	if !ok {
+		return nil, nil
+	}
+
+	policy := &apiv2.MachineDeploymentScalingPolicy{}
+	if err := json.Unmarshal([]byte(data), policy); err != nil {
+		return nil, fmt.Errorf("failed to decode scaling policy: %w", err)
+	}
+	return policy, nil
+}
+
+func machineDeploymentScalingHistory(md *clusterv1alpha1.MachineDeployment) ([]apiv2.MachineDeploymentScalingAction, error) {
+	history := []apiv2.MachineDeploymentScalingAction{}
+	if data, ok := md.Annotations[machineDeploymentScalingHistoryAnnotation]; ok {
+		if err := json.Unmarshal([]byte(data), &history); err != nil {
+			return nil, fmt.Errorf("failed to decode scaling history: %w", err)
+		}
+	}
+	return history, nil
+}
+
+// RunMachineDeploymentScalingSchedules applies the scaling policies of the machine deployments in all seeds until the
+// context is done.
+func RunMachineDeploymentScalingSchedules(ctx context.Context, seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		seeds, err := seedsGetter()
+		if err != nil {
+			log.Warnw("Failed to get seeds for machine deployment scaling", zap.Error(err))
+			return
+		}
+
+		for _, seed := range seeds {
+			if err := reconcileSeedScalingSchedules(ctx, seed, clusterProviderGetter, log, time.Now()); err != nil {
+				log.Warnw("Failed to apply machine deployment scaling policies", "seed", seed.Name, zap.Error(err))
+			}
+		}
+	}, machineDeploymentScalingInterval)
+}
+
+func reconcileSeedScalingSchedules(ctx context.Context, seed *kubermaticv1.Seed, clusterProviderGetter provider.ClusterProviderGetter, log *zap.SugaredLogger, now time.Time) error {
+	clusterProvider, err := clusterProviderGetter(seed)
+	if err != nil {
+		return err
+	}
+	privilegedClusterProvider, ok := clusterProvider.(provider.PrivilegedClusterProvider)
+	if !ok {
+		return errors.New("cluster provider does not provide a seed client")
+	}
+	seedClient := privilegedClusterProvider.GetSeedClusterAdminRuntimeClient()
+
+	clusters := &kubermaticv1.ClusterList{}
+	if err := seedClient.List(ctx, clusters); err != nil {
+		return err
+	}
+
+	for i := range clusters.Items {
+		cluster := &clusters.Items[i]
+		if _, ok := cluster.Annotations[clusterScalingPoliciesAnnotation]; !ok || cluster.DeletionTimestamp != nil {
+			continue
+		}
+
+		clusterLog := log.With("cluster", cluster.Name)
+		client, err := clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+		if err != nil {
+			clusterLog.Warnw("Failed to get client for machine deployment scaling", zap.Error(err))
+			continue
+		}
+
+		hasPolicies, err := reconcileClusterScalingSchedules(ctx, client, clusterLog, now)
+		if err != nil {
+			clusterLog.Warnw("Failed to apply machine deployment scaling policies", zap.Error(err))
+			continue
+		}
+
+		if !hasPolicies {
+			oldCluster := cluster.DeepCopy()
+			delete(cluster.Annotations, clusterScalingPoliciesAnnotation)
+			if err := seedClient.Patch(ctx, cluster, ctrlruntimeclient.MergeFrom(oldCluster)); err != nil {
+				clusterLog.Warnw("Failed to unmark cluster without scaling policies", zap.Error(err))
+			}
+		}
+	}
+
+	return nil
+}
+
+// reconcileClusterScalingSchedules applies the scaling policies of the machine deployments in a user cluster. It
+// returns whether any machine deployment has a scaling policy.
+func reconcileClusterScalingSchedules(ctx context.Context, client ctrlruntimeclient.Client, log *zap.SugaredLogger, now time.Time) (bool, error) {
+	mds := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, mds, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil {
+		return false, err
+	}
+
+	hasPolicies := false
+	for i := range mds.Items {
+		md := &mds.Items[i]
+		if _, ok := md.Annotations[MachineDeploymentScalingPolicyAnnotation]; !ok {
+			continue
+		}
+		hasPolicies = true
+
+		if err := reconcileMachineDeploymentScaling(ctx, client, md, now); err != nil {
+			log.Warnw("Failed to apply scaling policy", "machinedeployment", md.Name, zap.Error(err))
+		}
+	}
+
+	return hasPolicies, nil
+}
+
+// reconcileMachineDeploymentScaling applies the rule whose schedule fired last since the last applied schedule time.
+// Replica changes made in between, e.g. by hand, are kept until the next schedule time.
+func reconcileMachineDeploymentScaling(ctx context.Context, client ctrlruntimeclient.Client, md *clusterv1alpha1.MachineDeployment, now time.Time) error {
+	policy, err := machineDeploymentScalingPolicy(md)
+	if err != nil || policy == nil {
+		return err
+	}
+
+	state := machineDeploymentScalingState{}
+	if data, ok := md.Annotations[machineDeploymentScalingStateAnnotation]; ok {
+		if err := json.Unmarshal([]byte(data), &state); err != nil {
+			return fmt.Errorf("failed to decode scaling state: %w", err)
+		}
+	}
+
+	loc, err := time.LoadLocation(policy.TimeZone)
+	if err != nil {
+		return err
+	}
+
+	var firedRule *apiv2.MachineDeploymentScalingRule
+	var firedAt time.Time
+	for i, rule := range policy.Rules {
+		schedule, err := cron.ParseStandard(rule.Schedule)
+		if err != nil {
+			return fmt.Errorf("rule %q has an invalid schedule: %w", rule.Name, err)
+		}
+		if t := lastScheduleTime(schedule, state.LastScheduleTime, now.In(loc)); t.After(firedAt) {
+			firedRule = &policy.Rules[i]
+			firedAt = t
+		}
+	}
+	if firedRule == nil {
+		return nil
+	}
+
+	oldReplicas := int32(0)
+	if md.Spec.Replicas != nil {
+		oldReplicas = *md.Spec.Replicas
+	}
+
+	stateData, err := json.Marshal(machineDeploymentScalingState{LastScheduleTime: firedAt.UTC()})
+	if err != nil {
+		return err
+	}
+	md.Annotations[machineDeploymentScalingStateAnnotation] = string(stateData)
+
+	if oldReplicas != firedRule.Replicas {
+		history, err := machineDeploymentScalingHistory(md)
+		if err != nil {
+			return err
+		}
+		history = append(history, apiv2.MachineDeploymentScalingAction{
+			Timestamp:    apiv1.NewTime(now),
+			Rule:         firedRule.Name,
+			ScheduleTime: apiv1.NewTime(firedAt),
+			FromReplicas: oldReplicas,
+			ToReplicas:   firedRule.Replicas,
+		})
+		if len(history) > machineDeploymentScalingHistoryLimit {
+			history = history[len(history)-machineDeploymentScalingHistoryLimit:]
+		}
+		historyData, err := json.Marshal(history)
+		if err != nil {
+			return err
+		}
+		md.Annotations[machineDeploymentScalingHistoryAnnotation] = string(historyData)
+		md.Spec.Replicas = &firedRule.Replicas
+	}
+
+	// The update fails on a conflict if another API replica applied the schedule time first.
+	if err := client.Update(ctx, md); err != nil && !apierrors.IsConflict(err) {
+		return err
+	}
+	return nil
+}
+
+// lastScheduleTime returns the latest time after the given time and not after now that the schedule fired at, or the
+// zero time if it did not fire.
+func lastScheduleTime(schedule cron.Schedule, after, now time.Time) time.Time {
+	if earliest := now.Add(-maxScalingScheduleLookback); after.Before(earliest) {
+		after = earliest
+	}
+
+	var last time.Time
+	for t := schedule.Next(after.In(now.Location())); !t.IsZero() && !t.After(now); t = schedule.Next(t) {
+		last = t
+	}
+	return last
+}
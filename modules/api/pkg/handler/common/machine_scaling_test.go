@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/utils/ptr"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+// nightlyScaleDown scales to zero on weekday evenings and back up on weekday mornings, which keeps the machine
+// deployment at zero replicas over the weekend.
+var nightlyScaleDown = apiv2.MachineDeploymentScalingPolicy{
+	TimeZone: "Europe/Berlin",
+	Rules: []apiv2.MachineDeploymentScalingRule{
+		{Name: "evening", Schedule: "0 19 * * 1-5", Replicas: 0},
+		{Name: "morning", Schedule: "0 7 * * 1-5", Replicas: 3},
+	},
+}
+
+func TestValidateMachineDeploymentScalingPolicy(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name        string
+		policy      apiv2.MachineDeploymentScalingPolicy
+		minReplicas *uint32
+		expectedErr string
+	}{
+		{
+			name:   "valid policy",
+			policy: nightlyScaleDown,
+		},
+		{
+			name:        "unknown time zone",
+			policy:      apiv2.MachineDeploymentScalingPolicy{TimeZone: "Mars/Olympus", Rules: nightlyScaleDown.Rules},
+			expectedErr: `unknown time zone "Mars/Olympus"`,
+		},
+		{
+			name:        "invalid schedule",
+			policy:      apiv2.MachineDeploymentScalingPolicy{Rules: []apiv2.MachineDeploymentScalingRule{{Name: "broken", Schedule: "every evening"}}},
+			expectedErr: `rule "broken" has an invalid schedule`,
+		},
+		{
+			name:        "duplicate rule",
+			policy:      apiv2.MachineDeploymentScalingPolicy{Rules: []apiv2.MachineDeploymentScalingRule{nightlyScaleDown.Rules[0], nightlyScaleDown.Rules[0]}},
+			expectedErr: `rule name "evening" is used more than once`,
+		},
+		{
+			name:        "below autoscaler min replicas",
+			policy:      nightlyScaleDown,
+			minReplicas: ptr.To[uint32](1),
+			expectedErr: `rule "evening" cannot scale below the autoscaler min replicas (1)`,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			err := ValidateMachineDeploymentScalingPolicy(tc.policy, tc.minReplicas, nil)
+			if tc.expectedErr == "" {
+				require.NoError(t, err)
+				return
+			}
+			require.ErrorContains(t, err, tc.expectedErr)
+		})
+	}
+}
+
+func TestReconcileMachineDeploymentScaling(t *testing.T) {
+	t.Parallel()
+
+	berlin, err := time.LoadLocation("Europe/Berlin")
+	require.NoError(t, err)
+	// Monday, 2026-03-02
+	monday := func(hour, minute int) time.Time {
+		return time.Date(2026, time.March, 2, hour, minute, 0, 0, berlin)
+	}
+
+	policyData, err := json.Marshal(nightlyScaleDown)
+	require.NoError(t, err)
+	stateData, err := json.Marshal(machineDeploymentScalingState{LastScheduleTime: monday(12, 0).UTC()})
+	require.NoError(t, err)
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clusterv1alpha1.AddToScheme(scheme))
+	client := ctrlruntimefake.NewClientBuilder().WithScheme(scheme).WithObjects(&clusterv1alpha1.MachineDeployment{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      "workers",
+			Namespace: metav1.NamespaceSystem,
+			Annotations: map[string]string{
+				MachineDeploymentScalingPolicyAnnotation: string(policyData),
+				machineDeploymentScalingStateAnnotation:  string(stateData),
+			},
+		},
+		Spec: clusterv1alpha1.MachineDeploymentSpec{Replicas: ptr.To[int32](3)},
+	}).Build()
+
+	ctx := context.Background()
+	reconcile := func(now time.Time) *clusterv1alpha1.MachineDeployment {
+		md := &clusterv1alpha1.MachineDeployment{}
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers"}, md))
+		require.NoError(t, reconcileMachineDeploymentScaling(ctx, client, md, now))
+		require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: "workers"}, md))
+		return md
+	}
+
+	// nothing fired since the policy was set
+	md := reconcile(monday(18, 59))
+	require.Equal(t, int32(3), *md.Spec.Replicas)
+
+	md = reconcile(monday(19, 1))
+	require.Equal(t, int32(0), *md.Spec.Replicas)
+
+	// a manual scale-up is kept until the next schedule time
+	md.Spec.Replicas = ptr.To[int32](2)
+	require.NoError(t, client.Update(ctx, md))
+	md = reconcile(monday(22, 0))
+	require.Equal(t, int32(2), *md.Spec.Replicas)
+
+	// the API was down from Monday evening until Saturday, the Friday evening rule is the latest one
+	md = reconcile(monday(22, 0).AddDate(0, 0, 5))
+	require.Equal(t, int32(0), *md.Spec.Replicas)
+
+	// the following Monday morning
+	md = reconcile(monday(7, 30).AddDate(0, 0, 7))
+	require.Equal(t, int32(3), *md.Spec.Replicas)
+
+	history, err := machineDeploymentScalingHistory(md)
+	require.NoError(t, err)
+	require.Len(t, history, 3)
+	require.Equal(t, "evening", history[0].Rule)
+	require.Equal(t, int32(3), history[0].FromReplicas)
+	require.Equal(t, int32(0), history[0].ToReplicas)
+	require.Equal(t, "evening", history[1].Rule)
+	require.True(t, history[1].ScheduleTime.Time.Equal(time.Date(2026, time.March, 6, 19, 0, 0, 0, berlin)))
+	require.Equal(t, int32(2), history[1].FromReplicas)
+	require.Equal(t, "morning", history[2].Rule)
+}
@@ -0,0 +1,435 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"slices"
+	"strings"
+	"time"
+
+	"github.com/pmezard/go-difflib/difflib"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apiextensionshelpers "k8s.io/apiextensions-apiserver/pkg/apihelpers"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"k8s.io/apimachinery/pkg/util/wait"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	defaultManifestFieldManager = "kubermatic-dashboard"
+	// maxManifestSize limits the size of applied manifests, larger sets of objects should be split.
+	maxManifestSize = 5 << 20
+	// manifestCRDEstablishTimeout is how long the CRDs of a manifest may take to be served before the
+	// objects using them are applied.
+	manifestCRDEstablishTimeout = 30 * time.Second
+)
+
+var crdGroupKind = schema.GroupKind{Group: "apiextensions.k8s.io", Kind: "CustomResourceDefinition"}
+
+// ApplyManifestEndpoint applies the objects of the manifest to the user cluster with server-side apply. The objects
+// are applied with the permissions of the user, every object is applied even if others failed.
+func ApplyManifestEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID string, spec apiv2.ManifestApplySpec, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) (interface{}, error) {
+	if len(spec.Manifest) > maxManifestSize {
+		return nil, utilerrors.NewBadRequest("the manifest must not be larger than %d bytes", maxManifestSize)
+	}
+	if spec.FieldManager == "" {
+		spec.FieldManager = defaultManifestFieldManager
+	}
+	if spec.Namespace == "" {
+		spec.Namespace = metav1.NamespaceDefault
+	}
+	if errs := validation.IsDNS1123Label(spec.Namespace); len(errs) > 0 {
+		return nil, utilerrors.NewBadRequest("invalid namespace: %s", strings.Join(errs, ", "))
+	}
+
+	var pruneSelector labels.Selector
+	if spec.PruneSelector != "" {
+		var err error
+		pruneSelector, err = labels.Parse(spec.PruneSelector)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid prune selector: %v", err)
+		}
+		if pruneSelector.Empty() {
+			return nil, utilerrors.NewBadRequest("the prune selector must not select all objects")
+		}
+	}
+
+	objects, err := decodeManifest(spec.Manifest)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid manifest: %v", err)
+	}
+	if len(objects) == 0 {
+		return nil, utilerrors.NewBadRequest("the manifest contains no objects")
+	}
+
+	// the objects are applied with the permissions of the user, like all other requests for cluster resources
+	client, err := getClusterResourceClient(ctx, userInfoGetter, projectID, clusterID, projectProvider, privilegedProjectProvider)
+	if err != nil {
+		return nil, err
+	}
+
+	return applyManifest(ctx, client, objects, spec, pruneSelector), nil
+}
+
+func applyManifest(ctx context.Context, client ctrlruntimeclient.Client, objects []*unstructured.Unstructured, spec apiv2.ManifestApplySpec, pruneSelector labels.Selector) *apiv2.ManifestApplyResult {
+	result := &apiv2.ManifestApplyResult{DryRun: spec.DryRun, Objects: []apiv2.ManifestObjectResult{}}
+
+	// namespaces and CRDs have to exist before the objects that use them
+	slices.SortStableFunc(objects, func(a, b *unstructured.Unstructured) int {
+		return manifestObjectPriority(a) - manifestObjectPriority(b)
+	})
+
+	// kinds of the CRDs in the manifest, their objects can only be applied once the CRDs are served
+	manifestCRDs := map[schema.GroupKind]string{}
+	for _, obj := range objects {
+		if obj.GroupVersionKind().GroupKind() == crdGroupKind {
+			group, _, _ := unstructured.NestedString(obj.Object, "spec", "group")
+			kind, _, _ := unstructured.NestedString(obj.Object, "spec", "names", "kind")
+			manifestCRDs[schema.GroupKind{Group: group, Kind: kind}] = obj.GetName()
+		}
+	}
+
+	applied := sets.New[manifestObjectKey]()
+	var appliedCRDs []string
+	failed := false
+	for i, obj := range objects {
+		if len(appliedCRDs) > 0 && manifestObjectPriority(obj) > manifestObjectPriority(objects[i-1]) {
+			waitForManifestCRDs(ctx, client, appliedCRDs)
+			appliedCRDs = nil
+		}
+
+		objResult := applyManifestObject(ctx, client, obj, spec, manifestCRDs)
+		switch objResult.Action {
+		case apiv2.ManifestObjectFailed:
+			failed = true
+		case apiv2.ManifestObjectSkipped:
+			// skipped objects would be created, they must not be pruned
+			applied.Insert(newManifestObjectKey(obj))
+		default:
+			applied.Insert(newManifestObjectKey(obj))
+			if obj.GroupVersionKind().GroupKind() == crdGroupKind && !spec.DryRun {
+				appliedCRDs = append(appliedCRDs, obj.GetName())
+			}
+		}
+		result.Objects = append(result.Objects, objResult)
+	}
+
+	if pruneSelector != nil {
+		// a failed object would be pruned if it exists from a previous apply
+		if failed {
+			result.PruneSkipped = "objects are only pruned if all objects were applied"
+		} else {
+			result.Objects = append(result.Objects, pruneManifestObjects(ctx, client, applied, spec, pruneSelector)...)
+		}
+	}
+
+	return result
+}
+
+// waitForManifestCRDs waits until the given CRDs are established and resets the RESTMapper, so the objects using them
+// can be applied right away. CRDs which do not become established in time fail the objects that use them.
+func waitForManifestCRDs(ctx context.Context, client ctrlruntimeclient.Client, names []string) {
+	_ = wait.PollUntilContextTimeout(ctx, time.Second, manifestCRDEstablishTimeout, true, func(ctx context.Context) (bool, error) {
+		for _, name := range names {
+			crd := &apiextensionsv1.CustomResourceDefinition{}
+			if err := client.Get(ctx, types.NamespacedName{Name: name}, crd); err != nil {
+				return false, nil
+			}
+			if !apiextensionshelpers.IsCRDConditionTrue(crd, apiextensionsv1.Established) {
+				return false, nil
+			}
+		}
+		return true, nil
+	})
+	meta.MaybeResetRESTMapper(client.RESTMapper())
+}
+
+func applyManifestObject(ctx context.Context, client ctrlruntimeclient.Client, obj *unstructured.Unstructured, spec apiv2.ManifestApplySpec, manifestCRDs map[schema.GroupKind]string) apiv2.ManifestObjectResult {
+	gvk := obj.GroupVersionKind()
+	mapping, err := client.RESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version)
+	if err != nil {
+		crdName, ok := manifestCRDs[gvk.GroupKind()]
+		if ok && meta.IsNoMatchError(err) {
+			if spec.DryRun {
+				result := newManifestObjectResult(obj)
+				result.Action = apiv2.ManifestObjectSkipped
+				result.Message = fmt.Sprintf("skipped: CRD %s not yet established", crdName)
+				return result
+			}
+			return failedManifestObject(obj, fmt.Errorf("the CRD %s is not established: %w", crdName, err))
+		}
+		return failedManifestObject(obj, err)
+	}
+	if mapping.Scope.Name() == meta.RESTScopeNameNamespace {
+		if obj.GetNamespace() == "" {
+			obj.SetNamespace(spec.Namespace)
+		}
+	} else {
+		obj.SetNamespace("")
+	}
+
+	result := newManifestObjectResult(obj)
+
+	live := &unstructured.Unstructured{}
+	live.SetGroupVersionKind(gvk)
+	if err := client.Get(ctx, ctrlruntimeclient.ObjectKeyFromObject(obj), live); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return failedManifestObject(obj, err)
+		}
+		live = nil
+	}
+
+	options := []ctrlruntimeclient.ApplyOption{ctrlruntimeclient.FieldOwner(spec.FieldManager)}
+	if spec.Force {
+		options = append(options, ctrlruntimeclient.ForceOwnership)
+	}
+	if spec.DryRun {
+		options = append(options, ctrlruntimeclient.DryRunAll)
+	}
+	appliedObj := obj.DeepCopy()
+	if err := client.Apply(ctx, ctrlruntimeclient.ApplyConfigurationFromUnstructured(appliedObj), options...); err != nil {
+		return failedManifestObject(obj, err)
+	}
+
+	diff, err := diffManifestObjects(live, appliedObj)
+	if err != nil {
+		return failedManifestObject(obj, err)
+	}
+	switch {
+	case live == nil:
+		result.Action = apiv2.ManifestObjectCreated
+	case diff == "":
+		result.Action = apiv2.ManifestObjectUnchanged
+	default:
+		result.Action = apiv2.ManifestObjectConfigured
+	}
+	if spec.DryRun {
+		result.Diff = diff
+	}
+
+	return result
+}
+
+// pruneManifestObjects deletes the objects that match the selector but were not applied. Only kinds that are part of
+// the manifest are pruned, namespaced objects only in the namespaces the manifest applied objects to.
+func pruneManifestObjects(ctx context.Context, client ctrlruntimeclient.Client, applied sets.Set[manifestObjectKey], spec apiv2.ManifestApplySpec, selector labels.Selector) []apiv2.ManifestObjectResult {
+	namespaces := map[schema.GroupVersionKind]sets.Set[string]{}
+	for key := range applied {
+		if namespaces[key.gvk] == nil {
+			namespaces[key.gvk] = sets.New[string]()
+		}
+		namespaces[key.gvk].Insert(key.namespace)
+	}
+
+	var deleteOptions []ctrlruntimeclient.DeleteOption
+	if spec.DryRun {
+		deleteOptions = append(deleteOptions, ctrlruntimeclient.DryRunAll)
+	}
+
+	var results []apiv2.ManifestObjectResult
+	for _, gvk := range sortedGroupVersionKinds(namespaces) {
+		for _, namespace := range sets.List(namespaces[gvk]) {
+			list := &unstructured.UnstructuredList{}
+			list.SetGroupVersionKind(gvk.GroupVersion().WithKind(gvk.Kind + "List"))
+			listOptions := []ctrlruntimeclient.ListOption{ctrlruntimeclient.MatchingLabelsSelector{Selector: selector}}
+			if namespace != "" {
+				listOptions = append(listOptions, ctrlruntimeclient.InNamespace(namespace))
+			}
+
+			if err := client.List(ctx, list, listOptions...); err != nil {
+				results = append(results, apiv2.ManifestObjectResult{
+					APIVersion: gvk.GroupVersion().String(),
+					Kind:       gvk.Kind,
+					Namespace:  namespace,
+					Action:     apiv2.ManifestObjectFailed,
+					Message:    fmt.Sprintf("failed to list objects to prune: %v", err),
+				})
+				continue
+			}
+
+			for i := range list.Items {
+				obj := &list.Items[i]
+				if applied.Has(newManifestObjectKey(obj)) || obj.GetDeletionTimestamp() != nil {
+					continue
+				}
+
+				result := newManifestObjectResult(obj)
+				result.Action = apiv2.ManifestObjectPruned
+				if err := client.Delete(ctx, obj, deleteOptions...); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+					result = failedManifestObject(obj, err)
+				}
+				results = append(results, result)
+			}
+		}
+	}
+
+	return results
+}
+
+// decodeManifest splits a multi-document YAML or JSON manifest into its objects. Lists are flattened.
+func decodeManifest(manifest string) ([]*unstructured.Unstructured, error) {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(strings.NewReader(manifest), 4096)
+
+	var objects []*unstructured.Unstructured
+	for i := 1; ; i++ {
+		obj := &unstructured.Unstructured{}
+		if err := decoder.Decode(&obj.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return objects, nil
+			}
+			return nil, fmt.Errorf("document %d: %w", i, err)
+		}
+		// empty documents, e.g. between two separators
+		if len(obj.Object) == 0 {
+			continue
+		}
+
+		items := []*unstructured.Unstructured{obj}
+		if obj.IsList() {
+			list, err := obj.ToList()
+			if err != nil {
+				return nil, fmt.Errorf("document %d: %w", i, err)
+			}
+			items = nil
+			for j := range list.Items {
+				items = append(items, &list.Items[j])
+			}
+		}
+
+		for _, item := range items {
+			if item.GetAPIVersion() == "" || item.GetKind() == "" {
+				return nil, fmt.Errorf("document %d: apiVersion and kind are required", i)
+			}
+			if item.GetName() == "" {
+				return nil, fmt.Errorf("document %d: %s without name, generated names are not supported", i, item.GetKind())
+			}
+			objects = append(objects, item)
+		}
+	}
+}
+
+// diffManifestObjects returns a unified diff between the live and the applied object, ignoring the fields the API
+// server maintains on its own.
+func diffManifestObjects(live, applied *unstructured.Unstructured) (string, error) {
+	liveYAML, err := manifestObjectYAML(live)
+	if err != nil {
+		return "", err
+	}
+	appliedYAML, err := manifestObjectYAML(applied)
+	if err != nil {
+		return "", err
+	}
+	if liveYAML == appliedYAML {
+		return "", nil
+	}
+
+	return difflib.GetUnifiedDiffString(difflib.UnifiedDiff{
+		A:        difflib.SplitLines(liveYAML),
+		B:        difflib.SplitLines(appliedYAML),
+		FromFile: "live",
+		ToFile:   "applied",
+		Context:  3,
+	})
+}
+
+func manifestObjectYAML(obj *unstructured.Unstructured) (string, error) {
+	if obj == nil {
+		return "", nil
+	}
+
+	obj = obj.DeepCopy()
+	for _, field := range [][]string{
+		{"metadata", "managedFields"},
+		{"metadata", "resourceVersion"},
+		{"metadata", "generation"},
+		{"metadata", "uid"},
+		{"metadata", "creationTimestamp"},
+		{"status"},
+	} {
+		unstructured.RemoveNestedField(obj.Object, field...)
+	}
+
+	data, err := yaml.Marshal(obj.Object)
+	return string(data), err
+}
+
+func manifestObjectPriority(obj *unstructured.Unstructured) int {
+	switch obj.GroupVersionKind().GroupKind() {
+	case schema.GroupKind{Kind: "Namespace"}:
+		return 0
+	case crdGroupKind:
+		return 1
+	default:
+		return 2
+	}
+}
+
+type manifestObjectKey struct {
+	gvk       schema.GroupVersionKind
+	namespace string
+	name      string
+}
+
+func newManifestObjectKey(obj *unstructured.Unstructured) manifestObjectKey {
+	return manifestObjectKey{gvk: obj.GroupVersionKind(), namespace: obj.GetNamespace(), name: obj.GetName()}
+}
+
+func sortedGroupVersionKinds(m map[schema.GroupVersionKind]sets.Set[string]) []schema.GroupVersionKind {
+	gvks := make([]schema.GroupVersionKind, 0, len(m))
+	for gvk := range m {
+		gvks = append(gvks, gvk)
+	}
+	slices.SortFunc(gvks, func(a, b schema.GroupVersionKind) int {
+		return strings.Compare(a.String(), b.String())
+	})
+	return gvks
+}
+
+func newManifestObjectResult(obj *unstructured.Unstructured) apiv2.ManifestObjectResult {
+	return apiv2.ManifestObjectResult{
+		APIVersion: obj.GetAPIVersion(),
+		Kind:       obj.GetKind(),
+		Namespace:  obj.GetNamespace(),
+		Name:       obj.GetName(),
+	}
+}
+
+func failedManifestObject(obj *unstructured.Unstructured, err error) apiv2.ManifestObjectResult {
+	result := newManifestObjectResult(obj)
+	result.Action = apiv2.ManifestObjectFailed
+	result.Message = err.Error()
+	return result
+}
@@ -0,0 +1,176 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta/testrestmapper"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/labels"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/kubernetes/scheme"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+)
+
+func TestDecodeManifest(t *testing.T) {
+	t.Parallel()
+
+	objects, err := decodeManifest(`
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: a
+---
+---
+{"apiVersion": "v1", "kind": "List", "items": [
+  {"apiVersion": "v1", "kind": "Secret", "metadata": {"name": "b"}},
+  {"apiVersion": "v1", "kind": "Namespace", "metadata": {"name": "c"}}
+]}
+`)
+	require.NoError(t, err)
+	require.Len(t, objects, 3)
+	require.Equal(t, "ConfigMap", objects[0].GetKind())
+	require.Equal(t, "b", objects[1].GetName())
+	require.Equal(t, "Namespace", objects[2].GetKind())
+
+	_, err = decodeManifest("apiVersion: v1\nkind: ConfigMap\nmetadata:\n  generateName: a-\n")
+	require.ErrorContains(t, err, "without name")
+
+	_, err = decodeManifest("metadata:\n  name: a\n")
+	require.ErrorContains(t, err, "apiVersion and kind are required")
+}
+
+func TestApplyManifest(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	testScheme := runtime.NewScheme()
+	require.NoError(t, scheme.AddToScheme(testScheme))
+	require.NoError(t, apiextensionsv1.AddToScheme(testScheme))
+	client := ctrlruntimefake.NewClientBuilder().
+		WithScheme(testScheme).
+		WithRESTMapper(testrestmapper.TestOnlyStaticRESTMapper(testScheme)).
+		WithObjects(
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "settings", Namespace: "default", Labels: map[string]string{"app": "demo"}}, Data: map[string]string{"mode": "old"}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "obsolete", Namespace: "default", Labels: map[string]string{"app": "demo"}}},
+			&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unrelated", Namespace: "default"}},
+		).
+		Build()
+	selector := labels.SelectorFromSet(labels.Set{"app": "demo"})
+	manifest := `
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: settings
+  labels:
+    app: demo
+data:
+  mode: new
+---
+apiVersion: v1
+kind: ConfigMap
+metadata:
+  name: extra
+  labels:
+    app: demo
+`
+	decode := func(manifest string) []*unstructured.Unstructured {
+		objects, err := decodeManifest(manifest)
+		require.NoError(t, err)
+		return objects
+	}
+	actions := func(result *apiv2.ManifestApplyResult) map[string]apiv2.ManifestObjectAction {
+		actions := map[string]apiv2.ManifestObjectAction{}
+		for _, obj := range result.Objects {
+			actions[obj.Kind+"/"+obj.Name] = obj.Action
+		}
+		return actions
+	}
+	spec := apiv2.ManifestApplySpec{Namespace: "default", FieldManager: defaultManifestFieldManager}
+
+	// fields set by others conflict unless the apply is forced, and nothing is pruned after a failure
+	result := applyManifest(ctx, client, decode(manifest), spec, selector)
+	require.Equal(t, map[string]apiv2.ManifestObjectAction{
+		"ConfigMap/settings": apiv2.ManifestObjectFailed,
+		"ConfigMap/extra":    apiv2.ManifestObjectCreated,
+	}, actions(result))
+	require.NotEmpty(t, result.PruneSkipped)
+
+	spec.Force = true
+	result = applyManifest(ctx, client, decode(manifest), spec, selector)
+	require.Empty(t, result.PruneSkipped)
+	require.Equal(t, map[string]apiv2.ManifestObjectAction{
+		"ConfigMap/settings": apiv2.ManifestObjectConfigured,
+		"ConfigMap/extra":    apiv2.ManifestObjectUnchanged,
+		"ConfigMap/obsolete": apiv2.ManifestObjectPruned,
+	}, actions(result))
+	require.Empty(t, result.Objects[0].Diff)
+
+	err := client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "obsolete"}, &corev1.ConfigMap{})
+	require.True(t, apierrors.IsNotFound(err))
+	require.NoError(t, client.Get(ctx, ctrlruntimeclient.ObjectKey{Namespace: "default", Name: "unrelated"}, &corev1.ConfigMap{}))
+
+	// objects of unknown kinds fail
+	result = applyManifest(ctx, client, decode(manifest+"---\napiVersion: example.com/v1\nkind: Widget\nmetadata:\n  name: w\n"), spec, selector)
+	require.Equal(t, map[string]apiv2.ManifestObjectAction{
+		"ConfigMap/settings": apiv2.ManifestObjectUnchanged,
+		"ConfigMap/extra":    apiv2.ManifestObjectUnchanged,
+		"Widget/w":           apiv2.ManifestObjectFailed,
+	}, actions(result))
+
+	spec.DryRun = true
+	result = applyManifest(ctx, client, decode(strings.Replace(manifest, "mode: new", "mode: newer", 1)), spec, selector)
+	require.True(t, result.DryRun)
+	require.Equal(t, apiv2.ManifestObjectConfigured, result.Objects[0].Action)
+	require.Contains(t, result.Objects[0].Diff, "-  mode: new\n+  mode: newer")
+	require.Empty(t, result.Objects[1].Diff)
+
+	// objects of CRDs in the same manifest cannot be validated before the CRD is established
+	result = applyManifest(ctx, client, decode(manifest+`---
+apiVersion: example.com/v1
+kind: Widget
+metadata:
+  name: w
+---
+apiVersion: apiextensions.k8s.io/v1
+kind: CustomResourceDefinition
+metadata:
+  name: widgets.example.com
+spec:
+  group: example.com
+  names:
+    kind: Widget
+    plural: widgets
+  scope: Namespaced
+`), spec, selector)
+	require.Equal(t, apiv2.ManifestObjectCreated, actions(result)["CustomResourceDefinition/widgets.example.com"])
+	require.Equal(t, apiv2.ManifestObjectSkipped, actions(result)["Widget/w"])
+	require.Equal(t, "CustomResourceDefinition", result.Objects[0].Kind)
+	require.Contains(t, result.Objects[3].Message, "not yet established")
+}
@@ -0,0 +1,503 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"reflect"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticlog "k8c.io/kubermatic/v2/pkg/log"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	corev1 "k8s.io/api/core/v1"
+	policyv1 "k8s.io/api/policy/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/wait"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// nodeDrainConfigMapPrefix prefixes the ConfigMap in the kube-system namespace of the drained cluster that stores
+	// the drain operation of a node, so that its state survives API restarts and is shared between replicas.
+	nodeDrainConfigMapPrefix = "node-drain-"
+	nodeDrainOperationKey    = "operation"
+
+	// MachineNodeDrainOperationKind is the kind of the background operations which drain nodes of user clusters.
+	MachineNodeDrainOperationKind = "node-drain"
+	// ExternalClusterNodeDrainOperationKind is the kind of the background operations which drain nodes of external clusters.
+	ExternalClusterNodeDrainOperationKind = "external-cluster-node-drain"
+
+	nodeDrainPollInterval = 5 * time.Second
+	// defaultNodeDrainTimeoutSeconds is used if the drain options do not specify a timeout.
+	defaultNodeDrainTimeoutSeconds = 600
+)
+
+// NodeClientGetter returns a client for the cluster of a drained node. It is called outside of the request that
+// started the drain, so it must not depend on the request context.
+type NodeClientGetter func(ctx context.Context) (ctrlruntimeclient.Client, error)
+
+// CordonMachineNode marks a node of a user cluster as unschedulable or, for unschedulable set to false, uncordons it.
+func CordonMachineNode(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string, unschedulable bool) (interface{}, error) {
+	client, machine, node, err := getMachineNodeClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	node, err = CordonNode(ctx, client, node.Name, unschedulable)
+	if err != nil {
+		return nil, err
+	}
+
+	if machine != nil {
+		return outputMachine(machine, node, false)
+	}
+	return outputNode(node, false), nil
+}
+
+// DrainMachineNode starts draining a node of a user cluster.
+func DrainMachineNode(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, projectID, clusterID, nodeID string, options apiv2.NodeDrainOptions) (interface{}, error) {
+	client, _, node, err := getMachineNodeClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	privilegedClusterProvider := ctx.Value(middleware.PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+	clientGetter := machineNodeClientGetter(ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider), privilegedClusterProvider.GetSeedClusterAdminRuntimeClient(), clusterID)
+
+	return StartNodeDrain(ctx, client, clientGetter, backgroundOperationProvider, MachineNodeDrainOperationKind, clusterID, node.Name, options)
+}
+
+// GetMachineNodeDrain returns the current or last drain operation of a node of a user cluster.
+func GetMachineNodeDrain(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string) (interface{}, error) {
+	client, _, node, err := getMachineNodeClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, clusterID, nodeID)
+	if err != nil {
+		return nil, err
+	}
+
+	return GetNodeDrain(ctx, client, node.Name)
+}
+
+func getMachineNodeClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, projectID, clusterID, nodeID string) (ctrlruntimeclient.Client, *clusterv1alpha1.Machine, *corev1.Node, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machine, node, err := findMachineAndNode(ctx, nodeID, client)
+	if err != nil {
+		return nil, nil, nil, err
+	}
+	if node == nil {
+		return nil, nil, nil, utilerrors.NewNotFound("Node", nodeID)
+	}
+
+	return client, machine, node, nil
+}
+
+// machineNodeClientGetter returns an admin client for the user cluster, which keeps driving a drain after the request
+// that started it is gone.
+func machineNodeClientGetter(clusterProvider provider.ClusterProvider, seedClient ctrlruntimeclient.Client, clusterID string) NodeClientGetter {
+	return func(ctx context.Context) (ctrlruntimeclient.Client, error) {
+		cluster := &kubermaticv1.Cluster{}
+		if err := seedClient.Get(ctx, types.NamespacedName{Name: clusterID}, cluster); err != nil {
+			return nil, err
+		}
+		return clusterProvider.GetAdminClientForUserCluster(ctx, cluster)
+	}
+}
+
+// CordonNode sets the unschedulable flag of a node. Uncordoning a node stops a running drain of it.
+func CordonNode(ctx context.Context, client ctrlruntimeclient.Client, nodeName string, unschedulable bool) (*corev1.Node, error) {
+	node := &corev1.Node{}
+	if err := client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	if !unschedulable {
+		op, cm, err := getNodeDrainOperation(ctx, client, nodeName)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		if op != nil && op.Status.Phase == apiv2.NodeDrainRunning {
+			finishNodeDrain(op, apiv2.NodeDrainFailed, "the node was uncordoned", time.Now())
+			if err := saveNodeDrainOperation(ctx, client, nodeName, cm, op); err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+		}
+	}
+
+	if node.Spec.Unschedulable == unschedulable {
+		return node, nil
+	}
+
+	oldNode := node.DeepCopy()
+	node.Spec.Unschedulable = unschedulable
+	if err := client.Patch(ctx, node, ctrlruntimeclient.MergeFrom(oldNode)); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return node, nil
+}
+
+// ExternalClusterNodeClientGetter returns a client for the external cluster, which keeps driving a drain after the
+// request that started it is gone.
+func ExternalClusterNodeClientGetter(clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, cluster *kubermaticv1.ExternalCluster) NodeClientGetter {
+	return func(ctx context.Context) (ctrlruntimeclient.Client, error) {
+		return clusterProvider.GetClient(ctx, privilegedClusterProvider.GetMasterClient(), cluster)
+	}
+}
+
+// MachineNodeDrainResumer resumes the drains of user cluster nodes which were driven by API replicas that are gone.
+func MachineNodeDrainResumer(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationResumer {
+	return func(ctx context.Context, op provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return nil, err
+		}
+		return nodeDrainRun(machineNodeClientGetter(clusterProvider, seedClient, op.Cluster), op.Cluster, op.Name), nil
+	}
+}
+
+// ExternalClusterNodeDrainResumer resumes the drains of external cluster nodes which were driven by API replicas that
+// are gone.
+func ExternalClusterNodeDrainResumer(clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider) provider.BackgroundOperationResumer {
+	return func(ctx context.Context, op provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+		cluster := &kubermaticv1.ExternalCluster{}
+		if err := privilegedClusterProvider.GetMasterClient().Get(ctx, types.NamespacedName{Name: op.Cluster}, cluster); err != nil {
+			if apierrors.IsNotFound(err) {
+				return nil, nil
+			}
+			return nil, err
+		}
+		return nodeDrainRun(ExternalClusterNodeClientGetter(clusterProvider, privilegedClusterProvider, cluster), op.Cluster, op.Name), nil
+	}
+}
+
+// MachineNodeDrainFailureHandler marks the drains of user cluster nodes which were given up after failing repeatedly
+// as failed.
+func MachineNodeDrainFailureHandler(seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter) provider.BackgroundOperationFailureHandler {
+	return func(ctx context.Context, op provider.BackgroundOperation, cause error) error {
+		clusterProvider, seedClient, err := findSeedClusterProvider(ctx, seedsGetter, clusterProviderGetter, op.Cluster)
+		if err != nil || clusterProvider == nil {
+			return err
+		}
+		return failNodeDrain(ctx, machineNodeClientGetter(clusterProvider, seedClient, op.Cluster), op.Name, cause)
+	}
+}
+
+// ExternalClusterNodeDrainFailureHandler marks the drains of external cluster nodes which were given up after failing
+// repeatedly as failed.
+func ExternalClusterNodeDrainFailureHandler(clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider) provider.BackgroundOperationFailureHandler {
+	return func(ctx context.Context, op provider.BackgroundOperation, cause error) error {
+		cluster := &kubermaticv1.ExternalCluster{}
+		if err := privilegedClusterProvider.GetMasterClient().Get(ctx, types.NamespacedName{Name: op.Cluster}, cluster); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+		return failNodeDrain(ctx, ExternalClusterNodeClientGetter(clusterProvider, privilegedClusterProvider, cluster), op.Name, cause)
+	}
+}
+
+func failNodeDrain(ctx context.Context, clientGetter NodeClientGetter, nodeName string, cause error) error {
+	client, err := clientGetter(ctx)
+	if err != nil {
+		return err
+	}
+	op, cm, err := getNodeDrainOperation(ctx, client, nodeName)
+	if err != nil || op == nil || op.Status.Phase != apiv2.NodeDrainRunning {
+		return err
+	}
+
+	finishNodeDrain(op, apiv2.NodeDrainFailed, fmt.Sprintf("the drain was given up: %v", cause), time.Now())
+	return saveNodeDrainOperation(ctx, client, nodeName, cm, op)
+}
+
+// StartNodeDrain cordons the node and evicts its pods in the background. The drain is driven as background operation
+// of the given kind, which identifies together with the cluster name the cluster of the node among all clusters this
+// API serves.
+func StartNodeDrain(ctx context.Context, client ctrlruntimeclient.Client, clientGetter NodeClientGetter, backgroundOperationProvider provider.BackgroundOperationProvider, kind, clusterName, nodeName string, options apiv2.NodeDrainOptions) (*apiv2.NodeDrainOperation, error) {
+	if options.TimeoutSeconds < 0 {
+		return nil, utilerrors.NewBadRequest("timeoutSeconds must not be negative")
+	}
+	if options.TimeoutSeconds == 0 {
+		options.TimeoutSeconds = defaultNodeDrainTimeoutSeconds
+	}
+	if options.GracePeriodSeconds != nil && *options.GracePeriodSeconds < 0 {
+		return nil, utilerrors.NewBadRequest("gracePeriodSeconds must not be negative")
+	}
+
+	op, cm, err := getNodeDrainOperation(ctx, client, nodeName)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op != nil && op.Status.Phase == apiv2.NodeDrainRunning {
+		return nil, utilerrors.New(http.StatusConflict, fmt.Sprintf("node %s is already being drained", nodeName))
+	}
+
+	if _, err := CordonNode(ctx, client, nodeName, true); err != nil {
+		return nil, err
+	}
+
+	op = &apiv2.NodeDrainOperation{
+		Node: nodeName,
+		Spec: options,
+		Status: apiv2.NodeDrainStatus{
+			Phase:     apiv2.NodeDrainRunning,
+			StartTime: apiv1.Now(),
+		},
+	}
+	if err := saveNodeDrainOperation(ctx, client, nodeName, cm, op); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	backgroundOp := provider.BackgroundOperation{Kind: kind, Cluster: clusterName, Name: nodeName}
+	if err := backgroundOperationProvider.Start(ctx, backgroundOp, nodeDrainRun(clientGetter, clusterName, nodeName)); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return op, nil
+}
+
+// GetNodeDrain returns the current or last drain operation of a node.
+func GetNodeDrain(ctx context.Context, client ctrlruntimeclient.Client, nodeName string) (*apiv2.NodeDrainOperation, error) {
+	op, _, err := getNodeDrainOperation(ctx, client, nodeName)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if op == nil {
+		return nil, utilerrors.NewNotFound("node drain operation", nodeName)
+	}
+
+	return op, nil
+}
+
+func nodeDrainRun(clientGetter NodeClientGetter, clusterName, nodeName string) provider.BackgroundOperationRun {
+	return func(ctx context.Context) error {
+		log := kubermaticlog.Logger.With("cluster", clusterName, "node", nodeName)
+
+		return wait.PollUntilContextCancel(ctx, nodeDrainPollInterval, true, func(ctx context.Context) (bool, error) {
+			client, err := clientGetter(ctx)
+			if err != nil {
+				log.Warnw("Failed to get client for node drain", zap.Error(err))
+				return false, nil
+			}
+			done, err := reconcileNodeDrain(ctx, client, nodeName, time.Now())
+			if err != nil {
+				log.Warnw("Failed to reconcile node drain", zap.Error(err))
+				return false, nil
+			}
+			return done, nil
+		})
+	}
+}
+
+// reconcileNodeDrain evicts the pods that are left on the node. It returns true once the drain does not need to be
+// driven anymore, i.e. it completed or failed.
+func reconcileNodeDrain(ctx context.Context, client ctrlruntimeclient.Client, nodeName string, now time.Time) (bool, error) {
+	op, cm, err := getNodeDrainOperation(ctx, client, nodeName)
+	if err != nil {
+		return false, err
+	}
+	if op == nil || op.Status.Phase != apiv2.NodeDrainRunning {
+		return true, nil
+	}
+	// The status is only ever replaced, never modified in place, so a shallow copy is enough to detect changes.
+	oldStatus := op.Status
+
+	node := &corev1.Node{}
+	if err := client.Get(ctx, types.NamespacedName{Name: nodeName}, node); err != nil {
+		if !apierrors.IsNotFound(err) {
+			return false, err
+		}
+		finishNodeDrain(op, apiv2.NodeDrainCompleted, "the node was deleted", now)
+		return true, saveNodeDrainOperation(ctx, client, nodeName, cm, op)
+	}
+
+	pods := &corev1.PodList{}
+	if err := client.List(ctx, pods, ctrlruntimeclient.MatchingFields{"spec.nodeName": nodeName}); err != nil {
+		return false, err
+	}
+
+	evict, blocked := nodeDrainPods(pods.Items, op.Spec)
+	op.Status.RemainingPods = make([]string, 0, len(evict))
+	for _, pod := range evict {
+		op.Status.RemainingPods = append(op.Status.RemainingPods, pod.Namespace+"/"+pod.Name)
+	}
+	op.Status.Message = ""
+
+	switch {
+	case len(blocked) > 0:
+		finishNodeDrain(op, apiv2.NodeDrainFailed, fmt.Sprintf("cannot evict pods: %s", strings.Join(blocked, "; ")), now)
+	case len(evict) == 0:
+		finishNodeDrain(op, apiv2.NodeDrainCompleted, "", now)
+	case now.Sub(op.Status.StartTime.Time) > time.Duration(op.Spec.TimeoutSeconds)*time.Second:
+		finishNodeDrain(op, apiv2.NodeDrainFailed, fmt.Sprintf("the pods did not leave the node within %ds", op.Spec.TimeoutSeconds), now)
+	default:
+		var waiting []string
+		for _, pod := range evict {
+			if pod.DeletionTimestamp != nil {
+				continue
+			}
+
+			eviction := &policyv1.Eviction{
+				ObjectMeta: metav1.ObjectMeta{Name: pod.Name, Namespace: pod.Namespace},
+			}
+			if op.Spec.GracePeriodSeconds != nil {
+				eviction.DeleteOptions = &metav1.DeleteOptions{GracePeriodSeconds: op.Spec.GracePeriodSeconds}
+			}
+
+			err := client.SubResource("eviction").Create(ctx, pod, eviction)
+			switch {
+			case err == nil:
+				op.Status.EvictedPods++
+			case apierrors.IsTooManyRequests(err):
+				// The eviction would violate a PodDisruptionBudget, try again later.
+				waiting = append(waiting, pod.Namespace+"/"+pod.Name)
+			case apierrors.IsNotFound(err):
+			default:
+				return false, fmt.Errorf("failed to evict pod %s/%s: %w", pod.Namespace, pod.Name, err)
+			}
+		}
+		if len(waiting) > 0 {
+			op.Status.Message = fmt.Sprintf("waiting for PodDisruptionBudgets to allow the eviction of %s", strings.Join(waiting, ", "))
+		}
+	}
+
+	if !reflect.DeepEqual(oldStatus, op.Status) {
+		if err := saveNodeDrainOperation(ctx, client, nodeName, cm, op); err != nil {
+			return false, err
+		}
+	}
+
+	return op.Status.Phase != apiv2.NodeDrainRunning, nil
+}
+
+// nodeDrainPods returns the pods that have to be evicted from a node and, for pods that cannot be evicted with the
+// given options, the reasons why. Like kubectl, mirror pods are skipped and DaemonSet pods only if requested.
+func nodeDrainPods(pods []corev1.Pod, options apiv2.NodeDrainOptions) ([]*corev1.Pod, []string) {
+	var evict []*corev1.Pod
+	var blocked []string
+
+	for i := range pods {
+		pod := &pods[i]
+		if _, ok := pod.Annotations[corev1.MirrorPodAnnotationKey]; ok {
+			continue
+		}
+
+		finished := pod.Status.Phase == corev1.PodSucceeded || pod.Status.Phase == corev1.PodFailed
+		controller := metav1.GetControllerOf(pod)
+
+		if controller != nil && controller.Kind == "DaemonSet" {
+			if options.IgnoreDaemonSets {
+				continue
+			}
+			blocked = append(blocked, fmt.Sprintf("%s/%s is managed by a DaemonSet", pod.Namespace, pod.Name))
+			continue
+		}
+
+		if !finished {
+			if controller == nil {
+				blocked = append(blocked, fmt.Sprintf("%s/%s is not managed by a controller", pod.Namespace, pod.Name))
+				continue
+			}
+			if !options.DeleteEmptyDirData && hasEmptyDirVolume(pod) {
+				blocked = append(blocked, fmt.Sprintf("%s/%s uses emptyDir volumes", pod.Namespace, pod.Name))
+				continue
+			}
+		}
+
+		evict = append(evict, pod)
+	}
+
+	return evict, blocked
+}
+
+func hasEmptyDirVolume(pod *corev1.Pod) bool {
+	for _, volume := range pod.Spec.Volumes {
+		if volume.EmptyDir != nil {
+			return true
+		}
+	}
+	return false
+}
+
+func finishNodeDrain(op *apiv2.NodeDrainOperation, phase apiv2.NodeDrainPhase, message string, now time.Time) {
+	completionTime := apiv1.NewTime(now)
+	op.Status.Phase = phase
+	op.Status.Message = message
+	op.Status.CompletionTime = &completionTime
+}
+
+func getNodeDrainOperation(ctx context.Context, client ctrlruntimeclient.Client, nodeName string) (*apiv2.NodeDrainOperation, *corev1.ConfigMap, error) {
+	cm := &corev1.ConfigMap{}
+	if err := client.Get(ctx, types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: nodeDrainConfigMapPrefix + nodeName}, cm); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil, nil
+		}
+		return nil, nil, err
+	}
+
+	op := &apiv2.NodeDrainOperation{}
+	if err := json.Unmarshal([]byte(cm.Data[nodeDrainOperationKey]), op); err != nil {
+		return nil, nil, fmt.Errorf("failed to decode node drain operation: %w", err)
+	}
+
+	return op, cm, nil
+}
+
+// saveNodeDrainOperation stores the operation in the ConfigMap it was read from, so that concurrent changes fail with
+// a conflict instead of being lost.
+func saveNodeDrainOperation(ctx context.Context, client ctrlruntimeclient.Client, nodeName string, cm *corev1.ConfigMap, op *apiv2.NodeDrainOperation) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+
+	if cm == nil {
+		return client.Create(ctx, &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      nodeDrainConfigMapPrefix + nodeName,
+				Namespace: metav1.NamespaceSystem,
+			},
+			Data: map[string]string{nodeDrainOperationKey: string(data)},
+		})
+	}
+
+	cm.Data = map[string]string{nodeDrainOperationKey: string(data)}
+	return client.Update(ctx, cm)
+}
@@ -0,0 +1,269 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"errors"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+	ctrlruntimefake "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+)
+
+func drainTestPod(name, controllerKind string, mutate func(*corev1.Pod)) *corev1.Pod {
+	pod := &corev1.Pod{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: "default"},
+		Spec:       corev1.PodSpec{NodeName: "worker-1"},
+		Status:     corev1.PodStatus{Phase: corev1.PodRunning},
+	}
+	if controllerKind != "" {
+		pod.OwnerReferences = []metav1.OwnerReference{{
+			APIVersion: "apps/v1",
+			Kind:       controllerKind,
+			Name:       name + "-owner",
+			Controller: ptr.To(true),
+		}}
+	}
+	if mutate != nil {
+		mutate(pod)
+	}
+	return pod
+}
+
+func TestNodeDrainPods(t *testing.T) {
+	t.Parallel()
+
+	emptyDir := func(pod *corev1.Pod) {
+		pod.Spec.Volumes = []corev1.Volume{{Name: "cache", VolumeSource: corev1.VolumeSource{EmptyDir: &corev1.EmptyDirVolumeSource{}}}}
+	}
+
+	testCases := []struct {
+		name            string
+		pod             *corev1.Pod
+		options         apiv2.NodeDrainOptions
+		expectedEvicted bool
+		expectedBlocked bool
+	}{
+		{
+			name:            "replicated pod is evicted",
+			pod:             drainTestPod("web", "ReplicaSet", nil),
+			expectedEvicted: true,
+		},
+		{
+			name: "mirror pod is skipped",
+			pod: drainTestPod("etcd", "", func(pod *corev1.Pod) {
+				pod.Annotations = map[string]string{corev1.MirrorPodAnnotationKey: "hash"}
+			}),
+		},
+		{
+			name:            "daemonset pod blocks the drain",
+			pod:             drainTestPod("agent", "DaemonSet", nil),
+			expectedBlocked: true,
+		},
+		{
+			name:    "daemonset pod is skipped when ignored",
+			pod:     drainTestPod("agent", "DaemonSet", nil),
+			options: apiv2.NodeDrainOptions{IgnoreDaemonSets: true},
+		},
+		{
+			name:            "unmanaged pod blocks the drain",
+			pod:             drainTestPod("debug", "", nil),
+			expectedBlocked: true,
+		},
+		{
+			name: "finished unmanaged pod is evicted",
+			pod: drainTestPod("job", "", func(pod *corev1.Pod) {
+				pod.Status.Phase = corev1.PodSucceeded
+			}),
+			expectedEvicted: true,
+		},
+		{
+			name:            "emptyDir pod blocks the drain",
+			pod:             drainTestPod("cache", "ReplicaSet", emptyDir),
+			expectedBlocked: true,
+		},
+		{
+			name:            "emptyDir pod is evicted when its data may be deleted",
+			pod:             drainTestPod("cache", "ReplicaSet", emptyDir),
+			options:         apiv2.NodeDrainOptions{DeleteEmptyDirData: true},
+			expectedEvicted: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			evict, blocked := nodeDrainPods([]corev1.Pod{*tc.pod}, tc.options)
+			require.Equal(t, tc.expectedEvicted, len(evict) == 1)
+			require.Equal(t, tc.expectedBlocked, len(blocked) == 1)
+		})
+	}
+}
+
+func newNodeDrainTestClient(t *testing.T, denyEviction func(name string) bool, objects ...ctrlruntimeclient.Object) ctrlruntimeclient.Client {
+	t.Helper()
+
+	return ctrlruntimefake.NewClientBuilder().
+		WithObjects(objects...).
+		WithIndex(&corev1.Pod{}, "spec.nodeName", func(o ctrlruntimeclient.Object) []string {
+			return []string{o.(*corev1.Pod).Spec.NodeName}
+		}).
+		WithInterceptorFuncs(interceptor.Funcs{
+			SubResourceCreate: func(ctx context.Context, client ctrlruntimeclient.Client, subResourceName string, obj ctrlruntimeclient.Object, subResource ctrlruntimeclient.Object, opts ...ctrlruntimeclient.SubResourceCreateOption) error {
+				if subResourceName == "eviction" && denyEviction(obj.GetName()) {
+					return apierrors.NewTooManyRequests("Cannot evict pod as it would violate the pod's disruption budget.", 10)
+				}
+				return client.SubResource(subResourceName).Create(ctx, obj, subResource, opts...)
+			},
+		}).
+		Build()
+}
+
+func startTestNodeDrain(t *testing.T, client ctrlruntimeclient.Client, start time.Time) {
+	t.Helper()
+
+	_, err := CordonNode(context.Background(), client, "worker-1", true)
+	require.NoError(t, err)
+	require.NoError(t, saveNodeDrainOperation(context.Background(), client, "worker-1", nil, &apiv2.NodeDrainOperation{
+		Node:   "worker-1",
+		Spec:   apiv2.NodeDrainOptions{TimeoutSeconds: 60},
+		Status: apiv2.NodeDrainStatus{Phase: apiv2.NodeDrainRunning, StartTime: apiv1.NewTime(start)},
+	}))
+}
+
+func TestReconcileNodeDrain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	start := time.Now()
+	protected := true
+	client := newNodeDrainTestClient(t,
+		func(name string) bool { return name == "db" && protected },
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+		drainTestPod("web", "ReplicaSet", nil),
+		drainTestPod("db", "StatefulSet", nil),
+	)
+	startTestNodeDrain(t, client, start)
+
+	node := &corev1.Node{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Name: "worker-1"}, node))
+	require.True(t, node.Spec.Unschedulable)
+
+	// the PodDisruptionBudget holds back one of the pods
+	done, err := reconcileNodeDrain(ctx, client, "worker-1", start.Add(5*time.Second))
+	require.NoError(t, err)
+	require.False(t, done)
+
+	op, _, err := getNodeDrainOperation(ctx, client, "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, apiv2.NodeDrainRunning, op.Status.Phase)
+	require.Equal(t, 1, op.Status.EvictedPods)
+	require.Contains(t, op.Status.Message, "default/db")
+
+	protected = false
+	done, err = reconcileNodeDrain(ctx, client, "worker-1", start.Add(10*time.Second))
+	require.NoError(t, err)
+	require.False(t, done)
+
+	done, err = reconcileNodeDrain(ctx, client, "worker-1", start.Add(15*time.Second))
+	require.NoError(t, err)
+	require.True(t, done)
+
+	op, _, err = getNodeDrainOperation(ctx, client, "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, apiv2.NodeDrainCompleted, op.Status.Phase)
+	require.Equal(t, 2, op.Status.EvictedPods)
+	require.Empty(t, op.Status.RemainingPods)
+	require.NotNil(t, op.Status.CompletionTime)
+}
+
+func TestReconcileNodeDrainTimeout(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	start := time.Now()
+	client := newNodeDrainTestClient(t,
+		func(string) bool { return true },
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+		drainTestPod("db", "StatefulSet", nil),
+	)
+	startTestNodeDrain(t, client, start)
+
+	done, err := reconcileNodeDrain(ctx, client, "worker-1", start.Add(2*time.Minute))
+	require.NoError(t, err)
+	require.True(t, done)
+
+	op, _, err := getNodeDrainOperation(ctx, client, "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, apiv2.NodeDrainFailed, op.Status.Phase)
+	require.Equal(t, []string{"default/db"}, op.Status.RemainingPods)
+	require.Contains(t, op.Status.Message, "within 60s")
+}
+
+func TestUncordonAbortsNodeDrain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newNodeDrainTestClient(t,
+		func(string) bool { return true },
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+	)
+	startTestNodeDrain(t, client, time.Now())
+
+	node, err := CordonNode(ctx, client, "worker-1", false)
+	require.NoError(t, err)
+	require.False(t, node.Spec.Unschedulable)
+
+	op, _, err := getNodeDrainOperation(ctx, client, "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, apiv2.NodeDrainFailed, op.Status.Phase)
+	require.Equal(t, "the node was uncordoned", op.Status.Message)
+}
+
+func TestFailNodeDrain(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := newNodeDrainTestClient(t,
+		func(string) bool { return true },
+		&corev1.Node{ObjectMeta: metav1.ObjectMeta{Name: "worker-1"}},
+	)
+	startTestNodeDrain(t, client, time.Now())
+
+	clientGetter := func(context.Context) (ctrlruntimeclient.Client, error) { return client, nil }
+	require.NoError(t, failNodeDrain(ctx, clientGetter, "worker-1", errors.New("the cluster is unreachable")))
+
+	op, _, err := getNodeDrainOperation(ctx, client, "worker-1")
+	require.NoError(t, err)
+	require.Equal(t, apiv2.NodeDrainFailed, op.Status.Phase)
+	require.Equal(t, "the drain was given up: the cluster is unreachable", op.Status.Message)
+	require.NotNil(t, op.Status.CompletionTime)
+}
@@ -0,0 +1,175 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/hex"
+	"net/http"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+)
+
+// GetPersonalAccessTokenSettings returns the settings of the personal access tokens, which are kept in the
+// annotations of the global settings. Nil is returned for the defaults.
+func GetPersonalAccessTokenSettings(settings *kubermaticv1.KubermaticSetting) *apiv2.PersonalAccessTokenSettings {
+	tokenSettings := apiv2.PersonalAccessTokenSettings{
+		Disabled:    handlerauth.PersonalAccessTokensDisabled(settings),
+		MaxLifetime: settings.Annotations[handlerauth.PersonalAccessTokenMaxLifetimeAnnotation],
+	}
+	if tokenSettings == (apiv2.PersonalAccessTokenSettings{}) {
+		return nil
+	}
+	return &tokenSettings
+}
+
+// ValidatePersonalAccessTokenSettings returns a bad request error for a maximum lifetime which is not a positive
+// duration. The empty maximum lifetime does not cap the lifetime.
+func ValidatePersonalAccessTokenSettings(settings *apiv2.PersonalAccessTokenSettings) error {
+	if settings == nil || settings.MaxLifetime == "" {
+		return nil
+	}
+	if maxLifetime, err := time.ParseDuration(settings.MaxLifetime); err != nil || maxLifetime <= 0 {
+		return utilerrors.NewBadRequest("the maximum lifetime of personal access tokens must be a positive duration like 720h, got %q", settings.MaxLifetime)
+	}
+	return nil
+}
+
+// CreatePersonalAccessTokenEndpoint creates a personal access token of the user. The value of the token is only
+// returned here.
+func CreatePersonalAccessTokenEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider,
+	tokenProvider provider.PersonalAccessTokenProvider, name string, readOnly bool, expiry *apiv1.Time) (*apiv2.PersonalAccessToken, error) {
+	if strings.TrimSpace(name) == "" {
+		return nil, utilerrors.NewBadRequest("the token name is required")
+	}
+	// a token must not be able to outlive itself by creating new tokens
+	if rawToken, _ := ctx.Value(middleware.RawTokenContextKey).(string); strings.HasPrefix(rawToken, provider.PersonalAccessTokenPrefix) {
+		return nil, utilerrors.New(http.StatusForbidden, "personal access tokens cannot be created with a personal access token")
+	}
+
+	settings, err := settingsProvider.GetGlobalSettings(ctx)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+	if handlerauth.PersonalAccessTokensDisabled(settings) {
+		return nil, utilerrors.New(http.StatusForbidden, "personal access tokens are disabled")
+	}
+	maxLifetime, err := handlerauth.PersonalAccessTokenMaxLifetime(settings)
+	if err != nil {
+		return nil, err
+	}
+
+	var expiryTime time.Time
+	if expiry != nil && !expiry.IsZero() {
+		expiryTime = expiry.Time
+	}
+	now := time.Now()
+	switch {
+	case !expiryTime.IsZero() && !expiryTime.After(now):
+		return nil, utilerrors.NewBadRequest("the token expiry must be in the future")
+	case maxLifetime > 0 && expiryTime.IsZero():
+		return nil, utilerrors.NewBadRequest("the token expiry is required, tokens must expire within %v", maxLifetime)
+	case maxLifetime > 0 && expiryTime.After(now.Add(maxLifetime)):
+		return nil, utilerrors.NewBadRequest("the token expiry is too late, tokens must expire within %v", maxLifetime)
+	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	value := make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return nil, err
+	}
+	secret := hex.EncodeToString(value)
+	token := &provider.PersonalAccessToken{
+		ID:         utilrand.String(10),
+		Name:       name,
+		UserEmail:  userInfo.Email,
+		ReadOnly:   readOnly,
+		SecretHash: provider.PersonalAccessTokenSecretHash(secret),
+		Expiry:     expiryTime,
+	}
+	if err := tokenProvider.Create(ctx, token); err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	token.CreationTimestamp = now
+	result := convertPersonalAccessToken(*token)
+	result.Token = provider.NewPersonalAccessTokenValue(token.ID, secret)
+	return &result, nil
+}
+
+// ListPersonalAccessTokensEndpoint returns the personal access tokens of the user without their values.
+func ListPersonalAccessTokensEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, tokenProvider provider.PersonalAccessTokenProvider) ([]apiv2.PersonalAccessToken, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	tokens, err := tokenProvider.List(ctx, userInfo.Email)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	result := make([]apiv2.PersonalAccessToken, 0, len(tokens))
+	for _, token := range tokens {
+		result = append(result, convertPersonalAccessToken(token))
+	}
+	return result, nil
+}
+
+// RevokePersonalAccessTokenEndpoint revokes a personal access token of the user.
+func RevokePersonalAccessTokenEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, tokenProvider provider.PersonalAccessTokenProvider, tokenID string) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	return common.KubernetesErrorToHTTPError(tokenProvider.Revoke(ctx, userInfo.Email, tokenID))
+}
+
+func convertPersonalAccessToken(token provider.PersonalAccessToken) apiv2.PersonalAccessToken {
+	result := apiv2.PersonalAccessToken{
+		ID:                token.ID,
+		Name:              token.Name,
+		ReadOnly:          token.ReadOnly,
+		CreationTimestamp: apiv1.NewTime(token.CreationTimestamp),
+	}
+	if !token.LastUsed.IsZero() {
+		lastUsed := apiv1.NewTime(token.LastUsed)
+		result.LastUsed = &lastUsed
+	}
+	if !token.Expiry.IsZero() {
+		expiry := apiv1.NewTime(token.Expiry)
+		result.Expiry = &expiry
+	}
+	return result
+}
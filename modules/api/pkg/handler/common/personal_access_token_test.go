@@ -0,0 +1,122 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/provider/memory"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestPersonalAccessTokenEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	tokenProvider := memory.NewPersonalAccessTokenProvider()
+	userInfoGetter := func(email string) provider.UserInfoGetter {
+		return func(context.Context, string) (*provider.UserInfo, error) {
+			return &provider.UserInfo{Email: email}, nil
+		}
+	}
+	john := userInfoGetter("john@acme.com")
+	settingsFor := func(annotations map[string]string) provider.SettingsProvider {
+		return kubernetesprovider.NewSettingsProvider(fake.NewClientBuilder().WithObjects(&kubermaticv1.KubermaticSetting{
+			ObjectMeta: metav1.ObjectMeta{Name: kubermaticv1.GlobalSettingsName, Annotations: annotations},
+		}).Build())
+	}
+	defaultSettings := settingsFor(nil)
+	timeIn := func(d time.Duration) *apiv1.Time {
+		t := apiv1.NewTime(time.Now().Add(d))
+		return &t
+	}
+
+	_, err := CreatePersonalAccessTokenEndpoint(ctx, john, defaultSettings, tokenProvider, " ", false, nil)
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+	_, err = CreatePersonalAccessTokenEndpoint(ctx, john, defaultSettings, tokenProvider, "ci", false, timeIn(-time.Hour))
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+
+	created, err := CreatePersonalAccessTokenEndpoint(ctx, john, defaultSettings, tokenProvider, "ci", true, nil)
+	require.NoError(t, err)
+	require.True(t, created.ReadOnly)
+	require.Nil(t, created.Expiry)
+
+	// the returned value authenticates as the user
+	claims, err := handlerauth.NewPersonalAccessTokenVerifier(tokenProvider, defaultSettings).Verify(ctx, created.Token)
+	require.NoError(t, err)
+	require.Equal(t, "john@acme.com", claims.Email)
+	require.True(t, claims.ReadOnly)
+
+	// tokens cannot create further tokens
+	tokenCtx := context.WithValue(ctx, middleware.RawTokenContextKey, created.Token)
+	_, err = CreatePersonalAccessTokenEndpoint(tokenCtx, john, defaultSettings, tokenProvider, "renewed", false, nil)
+	requireHTTPStatus(t, http.StatusForbidden, err)
+
+	_, err = CreatePersonalAccessTokenEndpoint(ctx, john, settingsFor(map[string]string{handlerauth.PersonalAccessTokensDisabledAnnotation: "true"}), tokenProvider, "ci", false, nil)
+	requireHTTPStatus(t, http.StatusForbidden, err)
+
+	// a capped lifetime requires an expiry within it
+	capped := settingsFor(map[string]string{handlerauth.PersonalAccessTokenMaxLifetimeAnnotation: "24h"})
+	_, err = CreatePersonalAccessTokenEndpoint(ctx, john, capped, tokenProvider, "ci", false, nil)
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+	_, err = CreatePersonalAccessTokenEndpoint(ctx, john, capped, tokenProvider, "ci", false, timeIn(48*time.Hour))
+	requireHTTPStatus(t, http.StatusBadRequest, err)
+	_, err = CreatePersonalAccessTokenEndpoint(ctx, john, capped, tokenProvider, "deploy", false, timeIn(time.Hour))
+	require.NoError(t, err)
+
+	_, err = CreatePersonalAccessTokenEndpoint(ctx, userInfoGetter("jane@acme.com"), defaultSettings, tokenProvider, "jane", false, nil)
+	require.NoError(t, err)
+
+	tokens, err := ListPersonalAccessTokensEndpoint(ctx, john, tokenProvider)
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	for _, token := range tokens {
+		require.Empty(t, token.Token)
+	}
+
+	// the token of another user cannot be revoked
+	janes, err := ListPersonalAccessTokensEndpoint(ctx, userInfoGetter("jane@acme.com"), tokenProvider)
+	require.NoError(t, err)
+	requireHTTPStatus(t, http.StatusNotFound, RevokePersonalAccessTokenEndpoint(ctx, john, tokenProvider, janes[0].ID))
+
+	require.NoError(t, RevokePersonalAccessTokenEndpoint(ctx, john, tokenProvider, created.ID))
+	_, err = handlerauth.NewPersonalAccessTokenVerifier(tokenProvider, defaultSettings).Verify(ctx, created.Token)
+	require.Error(t, err)
+}
+
+func TestValidatePersonalAccessTokenSettings(t *testing.T) {
+	t.Parallel()
+
+	for maxLifetime, valid := range map[string]bool{"": true, "720h": true, "30d": false, "-1h": false, "0s": false} {
+		err := ValidatePersonalAccessTokenSettings(&apiv2.PersonalAccessTokenSettings{MaxLifetime: maxLifetime})
+		require.Equal(t, valid, err == nil, maxLifetime)
+	}
+}
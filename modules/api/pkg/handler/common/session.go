@@ -0,0 +1,162 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+const sessionCleanupInterval = time.Hour
+
+// ListSessionsEndpoint returns the login sessions of the user. The session with the given key, the
+// session of the request, is marked as current.
+func ListSessionsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sessionProvider provider.SessionProvider, currentSessionKey string) ([]apiv2.Session, error) {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	sessions, err := sessionProvider.List(ctx, userInfo.Email)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	currentSessionID := ""
+	if currentSessionKey != "" {
+		currentSessionID = provider.SessionID(currentSessionKey)
+	}
+
+	result := make([]apiv2.Session, 0, len(sessions))
+	for _, session := range sessions {
+		apiSession := convertSession(session)
+		apiSession.Current = session.ID == currentSessionID
+		result = append(result, apiSession)
+	}
+	return result, nil
+}
+
+// RevokeSessionEndpoint revokes a login session of the user.
+func RevokeSessionEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, sessionProvider provider.SessionProvider, sessionID string) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	return common.KubernetesErrorToHTTPError(sessionProvider.Revoke(ctx, userInfo.Email, sessionID))
+}
+
+// RevokeUserSessionsEndpoint revokes all login sessions of the given user. Only admins are allowed to.
+func RevokeUserSessionsEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, userProvider provider.UserProvider, sessionProvider provider.SessionProvider, userID string) error {
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+	if !userInfo.IsAdmin {
+		return utilerrors.New(http.StatusForbidden, fmt.Sprintf("forbidden: \"%s\" doesn't have admin rights", userInfo.Email))
+	}
+
+	user, err := userProvider.UserByID(ctx, userID)
+	if err != nil {
+		return common.KubernetesErrorToHTTPError(err)
+	}
+
+	return common.KubernetesErrorToHTTPError(sessionProvider.RevokeAll(ctx, user.Spec.Email))
+}
+
+// RunSessionCleanup removes the expired login sessions until the context is done. Users which do
+// not log out leave their sessions behind, they would pile up in the session store otherwise.
+func RunSessionCleanup(ctx context.Context, sessionProvider provider.SessionProvider, log *zap.SugaredLogger) {
+	wait.UntilWithContext(ctx, func(ctx context.Context) {
+		if err := sessionProvider.DeleteExpired(ctx); err != nil {
+			log.Warnw("Failed to delete expired sessions", zap.Error(err))
+		}
+	}, sessionCleanupInterval)
+}
+
+func convertSession(session provider.Session) apiv2.Session {
+	return apiv2.Session{
+		ID:                session.ID,
+		Device:            describeDevice(session.UserAgent),
+		UserAgent:         session.UserAgent,
+		IP:                session.IP,
+		Issuer:            session.Issuer,
+		CreationTimestamp: apiv1.NewTime(session.CreationTimestamp),
+		LastSeen:          apiv1.NewTime(session.LastSeen),
+		Expiry:            apiv1.NewTime(session.Expiry),
+	}
+}
+
+// describeDevice returns a short description like "Firefox on Linux" of the browser and operating
+// system of the given user agent. The user agent is only matched against the common browsers,
+// unknown parts are left out.
+func describeDevice(userAgent string) string {
+	// the order matters, most browsers also claim to be the browsers they are derived from
+	browsers := []struct{ token, name string }{
+		{"Edg/", "Edge"},
+		{"OPR/", "Opera"},
+		{"Firefox/", "Firefox"},
+		{"Chrome/", "Chrome"},
+		{"Safari/", "Safari"},
+		{"curl/", "curl"},
+	}
+	systems := []struct{ token, name string }{
+		{"Android", "Android"},
+		{"iPhone", "iOS"},
+		{"iPad", "iPadOS"},
+		{"Windows", "Windows"},
+		{"Mac OS X", "macOS"},
+		{"CrOS", "ChromeOS"},
+		{"Linux", "Linux"},
+	}
+
+	browser, system := "", ""
+	for _, b := range browsers {
+		if strings.Contains(userAgent, b.token) {
+			browser = b.name
+			break
+		}
+	}
+	for _, s := range systems {
+		if strings.Contains(userAgent, s.token) {
+			system = s.name
+			break
+		}
+	}
+
+	switch {
+	case browser != "" && system != "":
+		return browser + " on " + system
+	case browser != "":
+		return browser
+	default:
+		return system
+	}
+}
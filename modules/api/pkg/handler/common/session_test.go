@@ -0,0 +1,96 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/dashboard/v2/pkg/provider/memory"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+func TestSessionEndpoints(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	sessionProvider := memory.NewSessionProvider()
+	userProvider := kubernetesprovider.NewUserProvider(fake.NewClientBuilder().
+		WithObjects(&kubermaticv1.User{ObjectMeta: metav1.ObjectMeta{Name: "john"}, Spec: kubermaticv1.UserSpec{Email: "john@acme.com"}}).
+		Build())
+	userInfoGetter := func(email string, isAdmin bool) provider.UserInfoGetter {
+		return func(context.Context, string) (*provider.UserInfo, error) {
+			return &provider.UserInfo{Email: email, IsAdmin: isAdmin}, nil
+		}
+	}
+	john := userInfoGetter("john@acme.com", false)
+
+	expiry := time.Now().Add(time.Hour)
+	require.NoError(t, sessionProvider.Create(ctx, "laptop", &provider.Session{
+		UserEmail: "john@acme.com",
+		UserAgent: "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0",
+		IP:        "192.0.2.1",
+		LastSeen:  time.Now(),
+		Expiry:    expiry,
+	}))
+	require.NoError(t, sessionProvider.Create(ctx, "phone", &provider.Session{
+		UserEmail: "john@acme.com",
+		UserAgent: "Mozilla/5.0 (iPhone; CPU iPhone OS 17_0 like Mac OS X) AppleWebKit/605.1.15 (KHTML, like Gecko) Version/17.0 Mobile/15E148 Safari/604.1",
+		LastSeen:  time.Now().Add(-time.Hour),
+		Expiry:    expiry,
+	}))
+	require.NoError(t, sessionProvider.Create(ctx, "other", &provider.Session{UserEmail: "jane@acme.com", Expiry: expiry}))
+
+	sessions, err := ListSessionsEndpoint(ctx, john, sessionProvider, "laptop")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.True(t, sessions[0].Current)
+	require.Equal(t, "Firefox on Linux", sessions[0].Device)
+	require.Equal(t, "192.0.2.1", sessions[0].IP)
+	require.False(t, sessions[1].Current)
+	require.Equal(t, "Safari on iOS", sessions[1].Device)
+
+	// the session of another user cannot be revoked
+	otherID := provider.SessionID("other")
+	requireHTTPStatus(t, http.StatusNotFound, RevokeSessionEndpoint(ctx, john, sessionProvider, otherID))
+
+	require.NoError(t, RevokeSessionEndpoint(ctx, john, sessionProvider, sessions[1].ID))
+	sessions, err = ListSessionsEndpoint(ctx, john, sessionProvider, "")
+	require.NoError(t, err)
+	require.Len(t, sessions, 1)
+	require.False(t, sessions[0].Current)
+
+	// only admins can revoke the sessions of a user
+	requireHTTPStatus(t, http.StatusForbidden, RevokeUserSessionsEndpoint(ctx, john, userProvider, sessionProvider, "john"))
+	admin := userInfoGetter("admin@acme.com", true)
+	require.NoError(t, RevokeUserSessionsEndpoint(ctx, admin, userProvider, sessionProvider, "john"))
+	sessions, err = ListSessionsEndpoint(ctx, john, sessionProvider, "")
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	_, err = sessionProvider.Get(ctx, "other")
+	require.NoError(t, err)
+}
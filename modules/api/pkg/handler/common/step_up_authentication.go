@@ -0,0 +1,48 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"time"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+// GetStepUpAuthenticationSettings returns the settings of the step-up authentication, which are kept in the
+// annotations of the global settings. Nil is returned if it is disabled.
+func GetStepUpAuthenticationSettings(settings *kubermaticv1.KubermaticSetting) *apiv2.StepUpAuthenticationSettings {
+	maxAge := settings.Annotations[middleware.StepUpMaxAgeAnnotation]
+	if maxAge == "" {
+		return nil
+	}
+	return &apiv2.StepUpAuthenticationSettings{MaxAge: maxAge}
+}
+
+// ValidateStepUpAuthenticationSettings returns a bad request error for a max age which is not a positive
+// duration. The empty max age disables the step-up authentication.
+func ValidateStepUpAuthenticationSettings(settings *apiv2.StepUpAuthenticationSettings) error {
+	if settings == nil || settings.MaxAge == "" {
+		return nil
+	}
+	if maxAge, err := time.ParseDuration(settings.MaxAge); err != nil || maxAge <= 0 {
+		return utilerrors.NewBadRequest("the max age of the step-up authentication must be a positive duration like 15m, got %q", settings.MaxAge)
+	}
+	return nil
+}
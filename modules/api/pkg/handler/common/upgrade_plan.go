@@ -0,0 +1,345 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package common
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	semverlib "github.com/Masterminds/semver/v3"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubermaticv1helper "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1/helper"
+	ksemver "k8c.io/kubermatic/sdk/v2/semver"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	"k8c.io/kubermatic/v2/pkg/validation/nodeupdate"
+	"k8c.io/kubermatic/v2/pkg/version"
+	clusterversion "k8c.io/kubermatic/v2/pkg/version/cluster"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// KubernetesVersionConstraintAnnotation can be set on AddonConfigs and ApplicationDefinitions
+// to restrict the user cluster versions they support, e.g. ">= 1.30, < 1.33".
+const KubernetesVersionConstraintAnnotation = "kubermatic.io/kubernetes-version-constraint"
+
+// GetUpgradePlanEndpoint computes the ordered control plane upgrades needed to move the cluster to the
+// target version, together with everything that blocks each of them.
+func GetUpgradePlanEndpoint(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID, clusterID, targetVersion string, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, configGetter provider.KubermaticConfigurationGetter, addonConfigProvider provider.AddonConfigProvider, applicationDefinitionProvider provider.ApplicationDefinitionProvider) (interface{}, error) {
+	clusterProvider := ctx.Value(middleware.ClusterProviderContextKey).(provider.ClusterProvider)
+
+	target, err := ksemver.NewSemver(targetVersion)
+	if err != nil {
+		return nil, utilerrors.NewBadRequest("invalid target version %q: %v", targetVersion, err)
+	}
+
+	cluster, err := GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, projectID, clusterID, nil)
+	if err != nil {
+		return nil, err
+	}
+
+	if !cluster.Spec.Version.Semver().LessThan(target.Semver()) {
+		return nil, utilerrors.NewBadRequest("target version %s must be greater than the current cluster version %s", target, cluster.Spec.Version.String())
+	}
+
+	config, err := configGetter(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	versionManager := version.NewFromConfiguration(config)
+	if _, err := versionManager.GetVersion(target.String()); err != nil {
+		return nil, utilerrors.NewBadRequest("target version %s is not a supported version", target)
+	}
+
+	providerName, err := kubermaticv1helper.ClusterCloudProviderName(cluster.Spec.Cloud)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get the cloud provider name: %w", err)
+	}
+	providerType := kubermaticv1.ProviderType(providerName)
+	conditions := clusterversion.GetVersionConditions(&cluster.Spec)
+
+	client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	machineDeployments := &clusterv1alpha1.MachineDeploymentList{}
+	if err := client.List(ctx, machineDeployments, ctrlruntimeclient.InNamespace(metav1.NamespaceSystem)); err != nil && !meta.IsNoMatchError(err) {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	applications := &appskubermaticv1.ApplicationInstallationList{}
+	if err := client.List(ctx, applications); err != nil && !meta.IsNoMatchError(err) {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	addons, err := listAddons(ctx, userInfoGetter, cluster, projectID)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	addonConstraints, err := getAddonVersionConstraints(ctx, addonConfigProvider, addons)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	applicationConstraints, err := getApplicationVersionConstraints(ctx, applicationDefinitionProvider, applications.Items)
+	if err != nil {
+		return nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	// The path is computed without provider incompatibilities, so they can be reported as blockers
+	// instead of silently removing the hop from the plan.
+	pathConfig := config.DeepCopy()
+	pathConfig.Spec.Versions.ProviderIncompatibilities = nil
+
+	hops, complete, err := planUpgradeHops(version.NewFromConfiguration(pathConfig), cluster.Spec.Version.Semver(), target.Semver())
+	if err != nil {
+		return nil, err
+	}
+
+	plan := &apiv2.ClusterUpgradePlan{
+		CurrentVersion: cluster.Spec.Version,
+		TargetVersion:  *target,
+		Steps:          []apiv2.ClusterUpgradePlanStep{},
+	}
+
+	for _, hop := range hops {
+		var blockers []apiv2.ClusterUpgradeBlocker
+
+		incompatibilityBlockers, err := providerIncompatibilityBlockers(hop, providerType, versionManager.GetIncompatibilities(), conditions)
+		if err != nil {
+			return nil, err
+		}
+		blockers = append(blockers, incompatibilityBlockers...)
+
+		kubeletBlockers, err := kubeletVersionSkewBlockers(hop, machineDeployments.Items)
+		if err != nil {
+			return nil, err
+		}
+		blockers = append(blockers, kubeletBlockers...)
+		blockers = append(blockers, versionConstraintBlockers(hop, apiv2.UpgradeBlockerAddon, "addon", addonConstraints)...)
+		blockers = append(blockers, versionConstraintBlockers(hop, apiv2.UpgradeBlockerApplication, "application", applicationConstraints)...)
+
+		plan.Steps = append(plan.Steps, apiv2.ClusterUpgradePlanStep{
+			Version:  *ksemver.NewSemverOrDie(hop.String()),
+			Blockers: blockers,
+		})
+	}
+
+	if !complete {
+		from := cluster.Spec.Version.Semver()
+		if len(hops) > 0 {
+			from = hops[len(hops)-1]
+		}
+		plan.Steps = append(plan.Steps, apiv2.ClusterUpgradePlanStep{
+			Version: *target,
+			Blockers: []apiv2.ClusterUpgradeBlocker{
+				{
+					Type:    apiv2.UpgradeBlockerNoUpdatePath,
+					Message: fmt.Sprintf("no configured update leads from %s towards %s", from, target),
+				},
+			},
+		})
+	}
+
+	for _, step := range plan.Steps {
+		if len(step.Blockers) > 0 {
+			plan.Blocked = true
+		}
+	}
+
+	return plan, nil
+}
+
+// planUpgradeHops walks the configured updates from the current version to the target, never skipping a
+// minor version. It returns false if the target cannot be reached.
+func planUpgradeHops(manager *version.Manager, from, to *semverlib.Version) ([]*semverlib.Version, bool, error) {
+	var hops []*semverlib.Version
+
+	current := from
+	for current.LessThan(to) {
+		updates, err := manager.GetPossibleUpdates(current.String(), "")
+		if err != nil {
+			return nil, false, err
+		}
+
+		var next *semverlib.Version
+		for _, u := range updates {
+			v := u.Version
+			if !v.GreaterThan(current) || v.GreaterThan(to) || v.Minor() > current.Minor()+1 {
+				continue
+			}
+			if next == nil || v.GreaterThan(next) {
+				next = v
+			}
+		}
+
+		if next == nil {
+			return hops, false, nil
+		}
+
+		hops = append(hops, next)
+		current = next
+	}
+
+	return hops, true, nil
+}
+
+func providerIncompatibilityBlockers(v *semverlib.Version, providerType kubermaticv1.ProviderType, incompatibilities []*version.ProviderIncompatibility, conditions []kubermaticv1.ConditionType) ([]apiv2.ClusterUpgradeBlocker, error) {
+	var blockers []apiv2.ClusterUpgradeBlocker
+
+	for _, pi := range incompatibilities {
+		// NB: pi.Provider == "" applies the incompatibility to all providers.
+		if (pi.Provider != providerType && pi.Provider != "") || pi.Operation != kubermaticv1.UpdateOperation {
+			continue
+		}
+		if pi.Condition != kubermaticv1.AlwaysCondition && !slices.Contains(conditions, pi.Condition) && !slices.Contains(conditions, kubermaticv1.AlwaysCondition) {
+			continue
+		}
+
+		compatible, err := version.CheckUnconstrained(v, pi.Version)
+		if err != nil {
+			return nil, err
+		}
+		if !compatible {
+			blockers = append(blockers, apiv2.ClusterUpgradeBlocker{
+				Type:    apiv2.UpgradeBlockerProviderIncompatibility,
+				Name:    string(providerType),
+				Message: fmt.Sprintf("version %s is incompatible with the %s provider (%s)", v, providerType, pi.Version),
+			})
+		}
+	}
+
+	return blockers, nil
+}
+
+// kubeletVersionSkewBlockers reports every machine deployment whose current kubelet would be out of the
+// supported skew for the given control plane version, as isRestrictedByKubeletVersions does.
+func kubeletVersionSkewBlockers(controlPlaneVersion *semverlib.Version, mds []clusterv1alpha1.MachineDeployment) ([]apiv2.ClusterUpgradeBlocker, error) {
+	var blockers []apiv2.ClusterUpgradeBlocker
+
+	for _, md := range mds {
+		kubeletVersion, err := semverlib.NewVersion(md.Spec.Template.Spec.Versions.Kubelet)
+		if err != nil {
+			return nil, err
+		}
+
+		if err := nodeupdate.EnsureVersionCompatible(controlPlaneVersion, kubeletVersion); err != nil {
+			blockers = append(blockers, apiv2.ClusterUpgradeBlocker{
+				Type:    apiv2.UpgradeBlockerKubeletVersionSkew,
+				Name:    md.Name,
+				Message: fmt.Sprintf("machine deployment %s with kubelet %s has to be upgraded first: %v", md.Name, kubeletVersion, err),
+			})
+		}
+	}
+
+	return blockers, nil
+}
+
+func versionConstraintBlockers(v *semverlib.Version, blockerType apiv2.UpgradeBlockerType, kind string, constraints map[string]string) []apiv2.ClusterUpgradeBlocker {
+	var blockers []apiv2.ClusterUpgradeBlocker
+
+	names := make([]string, 0, len(constraints))
+	for name := range constraints {
+		names = append(names, name)
+	}
+	slices.Sort(names)
+
+	for _, name := range names {
+		constraint, err := semverlib.NewConstraint(constraints[name])
+		if err != nil {
+			blockers = append(blockers, apiv2.ClusterUpgradeBlocker{
+				Type:    blockerType,
+				Name:    name,
+				Message: fmt.Sprintf("%s %s has an invalid Kubernetes version constraint %q: %v", kind, name, constraints[name], err),
+			})
+			continue
+		}
+
+		if !constraint.Check(v) {
+			blockers = append(blockers, apiv2.ClusterUpgradeBlocker{
+				Type:    blockerType,
+				Name:    name,
+				Message: fmt.Sprintf("%s %s only supports Kubernetes %s", kind, name, constraints[name]),
+			})
+		}
+	}
+
+	return blockers
+}
+
+// getAddonVersionConstraints returns the Kubernetes version constraints of the installed addons, keyed by addon name.
+func getAddonVersionConstraints(ctx context.Context, addonConfigProvider provider.AddonConfigProvider, addons []*kubermaticv1.Addon) (map[string]string, error) {
+	constraints := map[string]string{}
+
+	for _, addon := range addons {
+		addonConfig, err := addonConfigProvider.Get(ctx, addon.Name)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+
+		if constraint, ok := addonConfig.Annotations[KubernetesVersionConstraintAnnotation]; ok {
+			constraints[addon.Name] = constraint
+		}
+	}
+
+	return constraints, nil
+}
+
+// getApplicationVersionConstraints returns the Kubernetes version constraints of the installed applications,
+// keyed by namespace/name of the ApplicationInstallation.
+func getApplicationVersionConstraints(ctx context.Context, applicationDefinitionProvider provider.ApplicationDefinitionProvider, applications []appskubermaticv1.ApplicationInstallation) (map[string]string, error) {
+	constraints := map[string]string{}
+	if len(applications) == 0 {
+		return constraints, nil
+	}
+
+	definitions, err := applicationDefinitionProvider.ListUnsecured(ctx)
+	if err != nil {
+		return nil, err
+	}
+
+	definitionConstraints := map[string]string{}
+	for _, def := range definitions.Items {
+		if constraint, ok := def.Annotations[KubernetesVersionConstraintAnnotation]; ok {
+			definitionConstraints[def.Name] = constraint
+		}
+	}
+
+	for _, app := range applications {
+		if constraint, ok := definitionConstraints[app.Spec.ApplicationRef.Name]; ok {
+			constraints[ctrlruntimeclient.ObjectKeyFromObject(&app).String()] = constraint
+		}
+	}
+
+	return constraints, nil
+}
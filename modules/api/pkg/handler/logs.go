@@ -0,0 +1,134 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"bufio"
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+	"time"
+
+	"github.com/gorilla/websocket"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/kubermatic/v2/pkg/log"
+)
+
+const contentTypeText = "text/plain; charset=utf-8"
+
+type logStreamRequestContextKey struct{}
+
+// LogStreamRequest keeps the request in the context, so that EncodeLogStream can upgrade it to a websocket.
+func LogStreamRequest(ctx context.Context, r *http.Request) context.Context {
+	return context.WithValue(ctx, logStreamRequestContextKey{}, r)
+}
+
+// EncodeLogStream writes a handlercommon.LogStream to the client as it is read. Websocket requests receive every
+// log line as a text message, all other requests receive the log as a chunked plain text response.
+func EncodeLogStream(ctx context.Context, w http.ResponseWriter, response interface{}) error {
+	stream, ok := response.(*handlercommon.LogStream)
+	if !ok {
+		return fmt.Errorf("unexpected response type %T", response)
+	}
+	defer stream.Close()
+
+	if r, ok := ctx.Value(logStreamRequestContextKey{}).(*http.Request); ok && websocket.IsWebSocketUpgrade(r) {
+		return writeLogStreamToWebsocket(w, r, stream)
+	}
+
+	w.Header().Set(headerContentType, contentTypeText)
+	w.Header().Set("X-Content-Type-Options", "nosniff")
+	w.WriteHeader(http.StatusOK)
+
+	controller := http.NewResponseController(w)
+	return readLogLines(stream, func(line []byte) error {
+		if _, err := w.Write(line); err != nil {
+			return err
+		}
+		if err := controller.Flush(); err != nil && !errors.Is(err, http.ErrNotSupported) {
+			return err
+		}
+		return nil
+	})
+}
+
+func writeLogStreamToWebsocket(w http.ResponseWriter, r *http.Request, stream *handlercommon.LogStream) error {
+	ws, err := upgrader.Upgrade(w, r, nil)
+	if err != nil {
+		// the upgrader already replied with an error
+		log.Logger.Debug(err)
+		return nil
+	}
+	defer ws.Close()
+
+	// Stop following the log once the client goes away.
+	go func() {
+		for {
+			if _, _, err := ws.ReadMessage(); err != nil {
+				stream.Close()
+				return
+			}
+		}
+	}()
+
+	err = readLogLines(stream, func(line []byte) error {
+		return ws.WriteMessage(websocket.TextMessage, bytes.TrimSuffix(line, []byte("\n")))
+	})
+	if err != nil {
+		log.Logger.Debug(err)
+	}
+
+	message := websocket.FormatCloseMessage(websocket.CloseNormalClosure, "")
+	_ = ws.WriteControl(websocket.CloseMessage, message, time.Now().Add(time.Second))
+
+	return nil
+}
+
+// readLogLines passes every line of the stream that matches its filter to write.
+func readLogLines(stream *handlercommon.LogStream, write func(line []byte) error) error {
+	reader := bufio.NewReader(stream)
+	for {
+		line, err := reader.ReadBytes('\n')
+		if len(line) > 0 && matchesLogFilter(line, stream.Filter) {
+			if err := write(line); err != nil {
+				return err
+			}
+		}
+		if err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+	}
+}
+
+func matchesLogFilter(line []byte, filter []string) bool {
+	if len(filter) == 0 {
+		return true
+	}
+	for _, s := range filter {
+		if bytes.Contains(line, []byte(s)) {
+			return true
+		}
+	}
+	return false
+}
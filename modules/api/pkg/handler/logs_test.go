@@ -0,0 +1,105 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package handler
+
+import (
+	"context"
+	"io"
+	"net/http"
+	"net/http/httptest"
+	"strings"
+	"testing"
+
+	"github.com/gorilla/websocket"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+)
+
+const testLog = "I0101 machine worker-abc created\nI0101 reconciling other-machine\nE0101 worker-abc failed to provision\nunterminated worker-abc"
+
+func TestEncodeLogStream(t *testing.T) {
+	testcases := []struct {
+		name     string
+		filter   []string
+		expected string
+	}{
+		{
+			name:     "whole log",
+			expected: testLog,
+		},
+		{
+			name:     "filtered log",
+			filter:   []string{"worker-abc"},
+			expected: "I0101 machine worker-abc created\nE0101 worker-abc failed to provision\nunterminated worker-abc",
+		},
+	}
+
+	for _, testcase := range testcases {
+		t.Run(testcase.name, func(t *testing.T) {
+			writer := httptest.NewRecorder()
+			stream := &handlercommon.LogStream{ReadCloser: io.NopCloser(strings.NewReader(testLog)), Filter: testcase.filter}
+
+			if err := EncodeLogStream(context.Background(), writer, stream); err != nil {
+				t.Fatalf("failed to encode log stream: %v", err)
+			}
+
+			if contentType := writer.Header().Get(headerContentType); contentType != contentTypeText {
+				t.Errorf("expected content type %q, got %q", contentTypeText, contentType)
+			}
+			if !writer.Flushed {
+				t.Error("expected the log to be flushed while it is streamed")
+			}
+			if body := writer.Body.String(); body != testcase.expected {
+				t.Errorf("expected log %q, got %q", testcase.expected, body)
+			}
+		})
+	}
+}
+
+func TestEncodeLogStreamWebsocket(t *testing.T) {
+	server := httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		ctx := LogStreamRequest(r.Context(), r)
+		stream := &handlercommon.LogStream{ReadCloser: io.NopCloser(strings.NewReader(testLog)), Filter: []string{"worker-abc"}}
+		if err := EncodeLogStream(ctx, w, stream); err != nil {
+			t.Errorf("failed to encode log stream: %v", err)
+		}
+	}))
+	defer server.Close()
+
+	ws, _, err := websocket.DefaultDialer.Dial("ws"+strings.TrimPrefix(server.URL, "http"), nil)
+	if err != nil {
+		t.Fatalf("failed to connect: %v", err)
+	}
+	defer ws.Close()
+
+	var lines []string
+	for {
+		_, message, err := ws.ReadMessage()
+		if err != nil {
+			if !websocket.IsCloseError(err, websocket.CloseNormalClosure) {
+				t.Fatalf("unexpected error: %v", err)
+			}
+			break
+		}
+		lines = append(lines, string(message))
+	}
+
+	expected := []string{"I0101 machine worker-abc created", "E0101 worker-abc failed to provision", "unterminated worker-abc"}
+	if strings.Join(lines, "|") != strings.Join(expected, "|") {
+		t.Errorf("expected messages %q, got %q", expected, lines)
+	}
+}
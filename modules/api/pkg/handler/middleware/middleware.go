@@ -25,6 +25,7 @@ import (
 
 	"github.com/go-kit/kit/endpoint"
 	transporthttp "github.com/go-kit/kit/transport/http"
+	"github.com/gorilla/mux"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
@@ -49,9 +50,18 @@ const (
 	// TokenExpiryContextKey key under which the current token expiry (OpenID ID Token) is kept in the ctx.
 	TokenExpiryContextKey kubermaticcontext.Key = "auth-token-expiry"
 
+	// AuthTimeContextKey key under which the time the user last authenticated at the issuer is kept in the ctx.
+	AuthTimeContextKey kubermaticcontext.Key = "auth-time"
+
 	// noTokenFoundKey key under which an error is kept when no suitable token has been found in a request.
 	noTokenFoundKey kubermaticcontext.Key = "no-token-found"
 
+	// requestMethodKey key under which the HTTP method of the request is kept in the ctx.
+	requestMethodKey kubermaticcontext.Key = "request-method"
+
+	// requestRouteKey key under which the path template of the matched route is kept in the ctx.
+	requestRouteKey kubermaticcontext.Key = "request-route"
+
 	// ClusterProviderContextKey key under which the current ClusterProvider is kept in the ctx.
 	ClusterProviderContextKey kubermaticcontext.Key = "cluster-provider"
 
@@ -291,6 +301,10 @@ func TokenVerifier(tokenVerifier authtypes.TokenVerifier, userProvider provider.
 				return nil, utilerrors.NewNotAuthorized()
 			}
 
+			if claims.ReadOnly && !isReadOnlyRequest(ctx) {
+				return nil, utilerrors.New(http.StatusForbidden, "forbidden: the token is read-only")
+			}
+
 			user := apiv1.User{
 				ObjectMeta: apiv1.ObjectMeta{
 					Name: claims.Name,
@@ -304,6 +318,9 @@ func TokenVerifier(tokenVerifier authtypes.TokenVerifier, userProvider provider.
 			}
 
 			ctx = context.WithValue(ctx, TokenExpiryContextKey, claims.Expiry)
+			if !claims.AuthTime.IsZero() {
+				ctx = context.WithValue(ctx, AuthTimeContextKey, claims.AuthTime.Time)
+			}
 			return next(context.WithValue(ctx, AuthenticatedUserContextKey, user), request)
 		}
 	}
@@ -376,6 +393,12 @@ func getAddonProvider(ctx context.Context, clusterProviderGetter provider.Cluste
 // TokenExtractor knows how to extract a token from the incoming request.
 func TokenExtractor(o authtypes.TokenExtractor) transporthttp.RequestFunc {
 	return func(ctx context.Context, r *http.Request) context.Context {
+		ctx = context.WithValue(ctx, requestMethodKey, r.Method)
+		if route := mux.CurrentRoute(r); route != nil {
+			if template, err := route.GetPathTemplate(); err == nil {
+				ctx = context.WithValue(ctx, requestRouteKey, template)
+			}
+		}
 		token, err := o.Extract(r)
 		if err != nil {
 			return context.WithValue(ctx, noTokenFoundKey, err)
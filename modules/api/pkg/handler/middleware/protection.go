@@ -0,0 +1,334 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+	clusterv1alpha1 "k8c.io/machine-controller/sdk/apis/cluster/v1alpha1"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+const (
+	// DeletionProtectionLabel protects a project, cluster, external cluster, machine deployment, cluster template
+	// or seed from deletion when set to "true". The label has to be removed before the object can be deleted.
+	// The machine deployments of external clusters are node pools of the cloud provider without labels, only
+	// the step-up authentication applies to them.
+	DeletionProtectionLabel = "dashboard.k8c.io/deletion-protection"
+
+	// StepUpMaxAgeAnnotation enables the step-up authentication of destructive requests when set on the global
+	// settings. The value is the duration, like 15m, within which the user must have authenticated at the issuer.
+	StepUpMaxAgeAnnotation = "dashboard.k8c.io/step-up-max-age"
+
+	// DeletionProtectedErrorCode is the detail of the error returned for the deletion of a protected object.
+	DeletionProtectedErrorCode = "deletion_protected"
+
+	// InsufficientUserAuthenticationErrorCode is the detail of the error returned for destructive requests of
+	// users who did not authenticate recently enough, see RFC 9470. The dashboard logs the user in again with
+	// the reauthenticate parameter of the login endpoint.
+	InsufficientUserAuthenticationErrorCode = "insufficient_user_authentication"
+)
+
+type clusterIDGetter interface {
+	GetClusterID() string
+}
+
+type seedNameGetter interface {
+	GetSeedName() string
+}
+
+type machineDeploymentIDGetter interface {
+	GetMachineDeploymentID() string
+}
+
+type clusterTemplateIDGetter interface {
+	GetClusterTemplateID() string
+}
+
+// StepUpMaxAge returns the duration within which users must have authenticated to send destructive requests,
+// zero if the step-up authentication is disabled.
+func StepUpMaxAge(settings *kubermaticv1.KubermaticSetting) (time.Duration, error) {
+	value := settings.Annotations[StepUpMaxAgeAnnotation]
+	if value == "" {
+		return 0, nil
+	}
+	maxAge, err := time.ParseDuration(value)
+	if err != nil {
+		return 0, fmt.Errorf("invalid step-up authentication max age %q: %w", value, err)
+	}
+	if maxAge <= 0 {
+		return 0, fmt.Errorf("invalid step-up authentication max age %q: must be positive", value)
+	}
+	return maxAge, nil
+}
+
+// RecentAuthentication rejects the request if the step-up authentication is enabled and the user did not
+// authenticate at the issuer within its max age. Tokens which do not carry the time of the authentication,
+// like service account and personal access tokens, are rejected as well.
+func RecentAuthentication(settingsProvider provider.SettingsProvider) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			settings, err := settingsProvider.GetGlobalSettings(ctx)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			maxAge, err := StepUpMaxAge(settings)
+			if err != nil {
+				return nil, err
+			}
+			if maxAge == 0 {
+				return next(ctx, request)
+			}
+
+			details := []string{InsufficientUserAuthenticationErrorCode, fmt.Sprintf("max_age=%d", int64(maxAge.Seconds()))}
+			authTime, ok := ctx.Value(AuthTimeContextKey).(time.Time)
+			if !ok {
+				return nil, utilerrors.NewWithDetails(http.StatusUnauthorized, "a recent authentication is required, but the token does not tell when the user authenticated", details)
+			}
+			if time.Since(authTime) > maxAge {
+				return nil, utilerrors.NewWithDetails(http.StatusUnauthorized, fmt.Sprintf("a recent authentication is required, log in again, the last one is older than %v", maxAge), details)
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// ProjectDeletionProtection rejects the deletion of projects protected by DeletionProtectionLabel.
+func ProjectDeletionProtection(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			projectIDGetter, ok := request.(common.ProjectIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ProjectDeletionProtection for endpoints that accept a project ID")
+			}
+			project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectIDGetter.GetProjectID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if err := checkDeletionProtection("project", project); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// ClusterDeletionProtection rejects the deletion of clusters protected by DeletionProtectionLabel. It requires the
+// PrivilegedClusterProvider in the ctx.
+func ClusterDeletionProtection(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			projectIDGetter, ok := request.(common.ProjectIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ClusterDeletionProtection for endpoints that accept a project ID")
+			}
+			clusterIDGetter, ok := request.(clusterIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ClusterDeletionProtection for endpoints that accept a cluster ID")
+			}
+			privilegedClusterProvider, ok := ctx.Value(PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+			if !ok {
+				return nil, utilerrors.New(http.StatusInternalServerError, "no privileged cluster provider in the request context")
+			}
+
+			project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectIDGetter.GetProjectID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			cluster, err := privilegedClusterProvider.GetUnsecured(ctx, project, clusterIDGetter.GetClusterID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if err := checkDeletionProtection("cluster", cluster); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// ExternalClusterDeletionProtection rejects the deletion of external clusters protected by DeletionProtectionLabel.
+// Clusters which do not belong to the project are left to the endpoint, which rejects them.
+func ExternalClusterDeletionProtection(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, privilegedExternalClusterProvider provider.PrivilegedExternalClusterProvider) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			projectIDGetter, ok := request.(common.ProjectIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ExternalClusterDeletionProtection for endpoints that accept a project ID")
+			}
+			clusterIDGetter, ok := request.(clusterIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ExternalClusterDeletionProtection for endpoints that accept a cluster ID")
+			}
+
+			project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectIDGetter.GetProjectID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			cluster, err := privilegedExternalClusterProvider.GetUnsecured(ctx, clusterIDGetter.GetClusterID())
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if cluster.Labels[kubermaticv1.ProjectIDLabelKey] == project.Name {
+				if err := checkDeletionProtection("external cluster", cluster); err != nil {
+					return nil, err
+				}
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// MachineDeploymentDeletionProtection rejects the deletion of machine deployments protected by
+// DeletionProtectionLabel. It requires the ClusterProvider and the PrivilegedClusterProvider in the ctx.
+func MachineDeploymentDeletionProtection(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			projectIDGetter, ok := request.(common.ProjectIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use MachineDeploymentDeletionProtection for endpoints that accept a project ID")
+			}
+			clusterIDGetter, ok := request.(clusterIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use MachineDeploymentDeletionProtection for endpoints that accept a cluster ID")
+			}
+			machineDeploymentIDGetter, ok := request.(machineDeploymentIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use MachineDeploymentDeletionProtection for endpoints that accept a machine deployment ID")
+			}
+			clusterProvider, ok := ctx.Value(ClusterProviderContextKey).(provider.ClusterProvider)
+			if !ok {
+				return nil, utilerrors.New(http.StatusInternalServerError, "no cluster provider in the request context")
+			}
+			privilegedClusterProvider, ok := ctx.Value(PrivilegedClusterProviderContextKey).(provider.PrivilegedClusterProvider)
+			if !ok {
+				return nil, utilerrors.New(http.StatusInternalServerError, "no privileged cluster provider in the request context")
+			}
+
+			project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectIDGetter.GetProjectID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			cluster, err := privilegedClusterProvider.GetUnsecured(ctx, project, clusterIDGetter.GetClusterID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			client, err := common.GetClusterClient(ctx, userInfoGetter, clusterProvider, cluster, project.Name)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+
+			machineDeployment := &clusterv1alpha1.MachineDeployment{}
+			key := types.NamespacedName{Namespace: metav1.NamespaceSystem, Name: machineDeploymentIDGetter.GetMachineDeploymentID()}
+			if err := client.Get(ctx, key, machineDeployment); err != nil {
+				if apierrors.IsNotFound(err) {
+					return next(ctx, request)
+				}
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if err := checkDeletionProtection("machine deployment", machineDeployment); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// ClusterTemplateDeletionProtection rejects the deletion of cluster templates protected by DeletionProtectionLabel.
+func ClusterTemplateDeletionProtection(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterTemplateProvider provider.ClusterTemplateProvider) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			projectIDGetter, ok := request.(common.ProjectIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ClusterTemplateDeletionProtection for endpoints that accept a project ID")
+			}
+			clusterTemplateIDGetter, ok := request.(clusterTemplateIDGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use ClusterTemplateDeletionProtection for endpoints that accept a cluster template ID")
+			}
+
+			project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectIDGetter.GetProjectID(), nil)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			userInfo, err := userInfoGetter(ctx, project.Name)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			template, err := clusterTemplateProvider.Get(ctx, userInfo, project.Name, clusterTemplateIDGetter.GetClusterTemplateID())
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if err := checkDeletionProtection("cluster template", template); err != nil {
+				return nil, err
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+// SeedDeletionProtection rejects the deletion of seeds protected by DeletionProtectionLabel. Requests of users
+// who are not admins are left to the endpoint, which rejects them.
+func SeedDeletionProtection(userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter) endpoint.Middleware {
+	return func(next endpoint.Endpoint) endpoint.Endpoint {
+		return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+			seedNameGetter, ok := request.(seedNameGetter)
+			if !ok {
+				return nil, utilerrors.NewBadRequest("you can only use SeedDeletionProtection for endpoints that accept a seed name")
+			}
+			userInfo, err := userInfoGetter(ctx, "")
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			if !userInfo.IsAdmin {
+				return next(ctx, request)
+			}
+
+			seeds, err := seedsGetter()
+			if err != nil {
+				return nil, err
+			}
+			if seed, found := seeds[seedNameGetter.GetSeedName()]; found {
+				if err := checkDeletionProtection("seed", seed); err != nil {
+					return nil, err
+				}
+			}
+			return next(ctx, request)
+		}
+	}
+}
+
+func checkDeletionProtection(kind string, object metav1.Object) error {
+	if object.GetLabels()[DeletionProtectionLabel] != "true" {
+		return nil
+	}
+	return utilerrors.NewWithDetails(http.StatusConflict,
+		fmt.Sprintf("the %s %s is protected from deletion, remove its label %s first", kind, object.GetName(), DeletionProtectionLabel),
+		[]string{DeletionProtectedErrorCode})
+}
@@ -0,0 +1,116 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware_test
+
+import (
+	"context"
+	"errors"
+	"net/http"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+)
+
+func TestRecentAuthentication(t *testing.T) {
+	t.Parallel()
+
+	testCases := []struct {
+		name           string
+		maxAge         string
+		authTime       *time.Time
+		expectedStatus int
+		expectedMaxAge string
+	}{
+		{
+			name: "step-up authentication disabled",
+		},
+		{
+			name:     "recent authentication",
+			maxAge:   "15m",
+			authTime: ptr.To(time.Now().Add(-time.Minute)),
+		},
+		{
+			name:           "authentication too long ago",
+			maxAge:         "15m",
+			authTime:       ptr.To(time.Now().Add(-time.Hour)),
+			expectedStatus: http.StatusUnauthorized,
+			expectedMaxAge: "max_age=900",
+		},
+		{
+			name:           "token without the time of the authentication",
+			maxAge:         "15m",
+			expectedStatus: http.StatusUnauthorized,
+			expectedMaxAge: "max_age=900",
+		},
+		{
+			name:     "authentication within a custom max age",
+			maxAge:   "2h",
+			authTime: ptr.To(time.Now().Add(-time.Hour)),
+		},
+		{
+			name:           "authentication older than a custom max age",
+			maxAge:         "5m",
+			authTime:       ptr.To(time.Now().Add(-10 * time.Minute)),
+			expectedStatus: http.StatusUnauthorized,
+			expectedMaxAge: "max_age=300",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+
+			settings := &kubermaticv1.KubermaticSetting{ObjectMeta: metav1.ObjectMeta{Name: kubermaticv1.GlobalSettingsName}}
+			if tc.maxAge != "" {
+				settings.Annotations = map[string]string{middleware.StepUpMaxAgeAnnotation: tc.maxAge}
+			}
+			settingsProvider := kubernetesprovider.NewSettingsProvider(fake.NewClientBuilder().WithObjects(settings).Build())
+
+			ctx := context.Background()
+			if tc.authTime != nil {
+				ctx = context.WithValue(ctx, middleware.AuthTimeContextKey, *tc.authTime)
+			}
+			called := false
+			next := func(context.Context, interface{}) (interface{}, error) {
+				called = true
+				return nil, nil
+			}
+
+			_, err := middleware.RecentAuthentication(settingsProvider)(next)(ctx, nil)
+			if tc.expectedStatus == 0 {
+				require.NoError(t, err)
+				require.True(t, called)
+				return
+			}
+			var httpErr utilerrors.HTTPError
+			require.True(t, errors.As(err, &httpErr), "expected an HTTP error, got %v", err)
+			require.Equal(t, tc.expectedStatus, httpErr.StatusCode())
+			require.Equal(t, []string{middleware.InsufficientUserAuthenticationErrorCode, tc.expectedMaxAge}, httpErr.Details())
+			require.False(t, called)
+		})
+	}
+}
@@ -0,0 +1,423 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package middleware
+
+import (
+	"context"
+	"net/http"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+)
+
+// readOnlyRoutes are the path templates of the GET endpoints which read-only tokens can call. They neither
+// change anything nor hand out credentials, like kubeconfigs, OIDC client secrets or presigned URLs. GET
+// endpoints which are not listed here refuse read-only tokens, so new endpoints have to be added once it is
+// clear that they are safe for them.
+var readOnlyRoutes = sets.New(
+	"/api/v1/addonconfigs",
+	"/api/v1/addonconfigs/{addon_id}",
+	"/api/v1/addons",
+	"/api/v1/admin",
+	"/api/v1/admin/admission/plugins",
+	"/api/v1/admin/admission/plugins/{name}",
+	"/api/v1/admin/metering/configurations/reports",
+	"/api/v1/admin/metering/configurations/reports/{name}",
+	"/api/v1/admin/metering/reports",
+	"/api/v1/admin/seeds",
+	"/api/v1/admin/seeds/{seed_name}",
+	"/api/v1/admin/settings",
+	"/api/v1/admin/settings/customlinks",
+	"/api/v1/admission/plugins/{version}",
+	"/api/v1/dc",
+	"/api/v1/dc/{dc}",
+	"/api/v1/healthz",
+	"/api/v1/labels/system",
+	"/api/v1/me",
+	"/api/v1/me/settings",
+	"/api/v1/projects",
+	"/api/v1/projects/{project_id}",
+	"/api/v1/projects/{project_id}/clusters",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/addons",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/addons/{addon_id}",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/bindings",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/clusterrolenames",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/clusterroles",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/clusterroles/{role_id}",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/events",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/health",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/installableaddons",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/metrics",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/namespaces",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments/{nodedeployment_id}",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments/{nodedeployment_id}/nodes",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments/{nodedeployment_id}/nodes/events",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/nodedeployments/{nodedeployment_id}/nodes/metrics",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/rolenames",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/roles",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/roles/{namespace}/{role_id}",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/sshkeys",
+	"/api/v1/projects/{project_id}/dc/{dc}/clusters/{cluster_id}/upgrades",
+	"/api/v1/projects/{project_id}/serviceaccounts",
+	"/api/v1/projects/{project_id}/serviceaccounts/{serviceaccount_id}/tokens",
+	"/api/v1/projects/{project_id}/sshkeys",
+	"/api/v1/projects/{project_id}/users",
+	"/api/v1/providers/{provider_name}/dc",
+	"/api/v1/providers/{provider_name}/dc/{dc}",
+	"/api/v1/providers/{provider_name}/presets/credentials",
+	"/api/v1/seed",
+	"/api/v1/seed/{seed_name}/dc",
+	"/api/v1/seed/{seed_name}/dc/{dc}",
+	"/api/v1/upgrades/cluster",
+	"/api/v1/upgrades/node",
+	"/api/v1/version",
+	"/api/v2/allowedregistries",
+	"/api/v2/allowedregistries/{allowed_registry}",
+	"/api/v2/applicationcatalogsources",
+	"/api/v2/applicationcatalogsources/{source_name}",
+	"/api/v2/applicationdefinitions",
+	"/api/v2/applicationdefinitions/{appdef_name}",
+	"/api/v2/applicationsettings",
+	"/api/v2/clusteragent/connect",
+	"/api/v2/cni/{cni_plugin_type}/versions",
+	"/api/v2/constraints",
+	"/api/v2/constraints/{constraint_name}",
+	"/api/v2/constrainttemplates",
+	"/api/v2/constrainttemplates/{ct_name}",
+	"/api/v2/featuregates",
+	"/api/v2/me/sessions",
+	"/api/v2/me/tokens",
+	"/api/v2/policytemplates",
+	"/api/v2/policytemplates/{template_name}",
+	"/api/v2/presets",
+	"/api/v2/presets/{preset_name}/linkages",
+	"/api/v2/presets/{preset_name}/stats",
+	"/api/v2/projects/{project_id}/applicationrollouts",
+	"/api/v2/projects/{project_id}/applicationrollouts/{rollout_name}",
+	"/api/v2/projects/{project_id}/clusteragenttokens",
+	"/api/v2/projects/{project_id}/clusterbackupstoragelocation",
+	"/api/v2/projects/{project_id}/clusterbackupstoragelocation/{cbsl_name}",
+	"/api/v2/projects/{project_id}/clusterbackupstoragelocation/{cbsl_name}/bucketobjects",
+	"/api/v2/projects/{project_id}/clusters",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/addons",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/drift",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/revisions",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/alertmanager/config",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/apiresources",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/revisions",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/backupdestinations",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/backupstoragelocation",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/backupstoragelocation/{bsl_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/bindings",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterbackup",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterbackup/{clusterBackup}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterbackupschedule",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterbackupschedule/{clusterBackupSchedule}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterbindings",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterrestore",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterrestore/{clusterrestore}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterrolenames",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/clusterroles",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/cniversions",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/constraints",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/controlplane/components",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/controlplane/components/{component}/logs",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/costestimate",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdbackupconfigs/{ebc_id}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdrestores",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/etcdrestores/{er_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/events",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/health",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/installableaddons",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/drain",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/logs",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/joiningscript",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/events",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy/history",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/metrics",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/namespaces",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/namespaces/{namespace}/pods/{pod_name}/logs",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/nodes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/operatingsystemprofiles",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/policybindings",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/policybindings/{binding_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/alibaba/instancetypes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/alibaba/vswitches",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/alibaba/zones",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/anexia/disk-types",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/anexia/templates",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/anexia/vlans",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/aws/sizes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/aws/subnets",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/azure/availabilityzones",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/azure/sizes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/digitalocean/sizes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/gcp/disktypes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/gcp/networks",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/gcp/sizes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/gcp/subnetworks",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/gcp/zones",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/hetzner/sizes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/instancetypes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/preferences",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/storageclasses",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/subnets",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/kubevirt/vpcs",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/nutanix/categories",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/nutanix/categories/{category}/values",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/nutanix/subnets",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/availabilityzones",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/networks",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/securitygroups",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/servergroups",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/sizes",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/subnets",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/openstack/tenants",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vmwareclouddirector/catalogs",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vmwareclouddirector/computepolicies",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vmwareclouddirector/networks",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vmwareclouddirector/storageprofiles",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vmwareclouddirector/templates/{catalog_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/folders",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/networks",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/tagcategories",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/tagcategories/{tag_category}/tags",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/providers/vsphere/vmgroups",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/readinesschecks",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/readinesschecks/{run_id}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/resources",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/resources/{resource_name}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/rolenames",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/roles",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/rulegroups/{rulegroup_id}",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/serviceaccount",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/serviceaccount/{namespace}/{service_account_id}/permissions",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeoperation",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeplan",
+	"/api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades",
+	"/api/v2/projects/{project_id}/clustertemplates",
+	"/api/v2/projects/{project_id}/clustertemplates/{template_id}",
+	"/api/v2/projects/{project_id}/clustertemplates/{template_id}/export",
+	"/api/v2/projects/{project_id}/costestimate",
+	"/api/v2/projects/{project_id}/etcdbackupconfigs",
+	"/api/v2/projects/{project_id}/etcdrestores",
+	"/api/v2/projects/{project_id}/groupbindings",
+	"/api/v2/projects/{project_id}/groupbindings/{binding_name}",
+	"/api/v2/projects/{project_id}/kubernetes/clusters",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/events",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/events",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/upgrades",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/metrics",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/drain",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodesmetrics",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/aks/versions",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/aks/vmsizes",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/eks/instancetypes",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/eks/noderoles",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/eks/subnets",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/eks/vpcs",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/gke/disktypes",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/gke/images",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/gke/sizes",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/providers/gke/zones",
+	"/api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/upgrades",
+	"/api/v2/projects/{project_id}/presets",
+	"/api/v2/projects/{project_id}/providers/aks/clusters",
+	"/api/v2/projects/{project_id}/providers/aks/locations",
+	"/api/v2/projects/{project_id}/providers/aks/resourcegroups",
+	"/api/v2/projects/{project_id}/providers/aks/validatecredentials",
+	"/api/v2/projects/{project_id}/providers/aks/versions",
+	"/api/v2/projects/{project_id}/providers/aks/vmsizes",
+	"/api/v2/projects/{project_id}/providers/alibaba/instancetypes",
+	"/api/v2/projects/{project_id}/providers/alibaba/vswitches",
+	"/api/v2/projects/{project_id}/providers/alibaba/zones",
+	"/api/v2/projects/{project_id}/providers/anexia/disk-types",
+	"/api/v2/projects/{project_id}/providers/anexia/templates",
+	"/api/v2/projects/{project_id}/providers/anexia/vlans",
+	"/api/v2/projects/{project_id}/providers/aws/sizes",
+	"/api/v2/projects/{project_id}/providers/aws/{dc}/securitygroups",
+	"/api/v2/projects/{project_id}/providers/aws/{dc}/subnets",
+	"/api/v2/projects/{project_id}/providers/aws/{dc}/vpcs",
+	"/api/v2/projects/{project_id}/providers/azure/availabilityzones",
+	"/api/v2/projects/{project_id}/providers/azure/resourcegroups",
+	"/api/v2/projects/{project_id}/providers/azure/routetables",
+	"/api/v2/projects/{project_id}/providers/azure/securitygroups",
+	"/api/v2/projects/{project_id}/providers/azure/sizes",
+	"/api/v2/projects/{project_id}/providers/azure/subnets",
+	"/api/v2/projects/{project_id}/providers/azure/vnets",
+	"/api/v2/projects/{project_id}/providers/digitalocean/sizes",
+	"/api/v2/projects/{project_id}/providers/eks/amitypes",
+	"/api/v2/projects/{project_id}/providers/eks/capacitytypes",
+	"/api/v2/projects/{project_id}/providers/eks/clusterroles",
+	"/api/v2/projects/{project_id}/providers/eks/clusters",
+	"/api/v2/projects/{project_id}/providers/eks/regions",
+	"/api/v2/projects/{project_id}/providers/eks/securitygroups",
+	"/api/v2/projects/{project_id}/providers/eks/subnets",
+	"/api/v2/projects/{project_id}/providers/eks/validatecredentials",
+	"/api/v2/projects/{project_id}/providers/eks/versions",
+	"/api/v2/projects/{project_id}/providers/eks/vpcs",
+	"/api/v2/projects/{project_id}/providers/gcp/disktypes",
+	"/api/v2/projects/{project_id}/providers/gcp/networks",
+	"/api/v2/projects/{project_id}/providers/gcp/sizes",
+	"/api/v2/projects/{project_id}/providers/gcp/{dc}/subnetworks",
+	"/api/v2/projects/{project_id}/providers/gcp/{dc}/zones",
+	"/api/v2/projects/{project_id}/providers/gke/clusters",
+	"/api/v2/projects/{project_id}/providers/gke/disktypes",
+	"/api/v2/projects/{project_id}/providers/gke/images",
+	"/api/v2/projects/{project_id}/providers/gke/validatecredentials",
+	"/api/v2/projects/{project_id}/providers/gke/versions",
+	"/api/v2/projects/{project_id}/providers/gke/vmsizes",
+	"/api/v2/projects/{project_id}/providers/gke/zones",
+	"/api/v2/projects/{project_id}/providers/hetzner/sizes",
+	"/api/v2/projects/{project_id}/providers/kubevirt/instancetypes",
+	"/api/v2/projects/{project_id}/providers/kubevirt/preferences",
+	"/api/v2/projects/{project_id}/providers/kubevirt/storageclasses",
+	"/api/v2/projects/{project_id}/providers/kubevirt/subnets",
+	"/api/v2/projects/{project_id}/providers/kubevirt/vpcs",
+	"/api/v2/projects/{project_id}/providers/nutanix/{dc}/categories",
+	"/api/v2/projects/{project_id}/providers/nutanix/{dc}/categories/{category}/values",
+	"/api/v2/projects/{project_id}/providers/nutanix/{dc}/clusters",
+	"/api/v2/projects/{project_id}/providers/nutanix/{dc}/projects",
+	"/api/v2/projects/{project_id}/providers/nutanix/{dc}/subnets",
+	"/api/v2/projects/{project_id}/providers/openstack/availabilityzones",
+	"/api/v2/projects/{project_id}/providers/openstack/membersubnets",
+	"/api/v2/projects/{project_id}/providers/openstack/networks",
+	"/api/v2/projects/{project_id}/providers/openstack/securitygroups",
+	"/api/v2/projects/{project_id}/providers/openstack/servergroups",
+	"/api/v2/projects/{project_id}/providers/openstack/sizes",
+	"/api/v2/projects/{project_id}/providers/openstack/subnetpools",
+	"/api/v2/projects/{project_id}/providers/openstack/subnets",
+	"/api/v2/projects/{project_id}/providers/openstack/tenants",
+	"/api/v2/projects/{project_id}/providers/vmwareclouddirector/{dc}/catalogs",
+	"/api/v2/projects/{project_id}/providers/vmwareclouddirector/{dc}/computepolicies",
+	"/api/v2/projects/{project_id}/providers/vmwareclouddirector/{dc}/networks",
+	"/api/v2/projects/{project_id}/providers/vmwareclouddirector/{dc}/storageprofiles",
+	"/api/v2/projects/{project_id}/providers/vmwareclouddirector/{dc}/templates/{catalog_name}",
+	"/api/v2/projects/{project_id}/providers/vsphere/datastores",
+	"/api/v2/projects/{project_id}/providers/vsphere/folders",
+	"/api/v2/projects/{project_id}/providers/vsphere/networks",
+	"/api/v2/projects/{project_id}/providers/vsphere/tagcategories",
+	"/api/v2/projects/{project_id}/providers/vsphere/tagcategories/{tag_category}/tags",
+	"/api/v2/projects/{project_id}/providers/vsphere/vmgroups",
+	"/api/v2/projects/{project_id}/providers/{provider_name}/presets",
+	"/api/v2/projects/{project_id}/quota",
+	"/api/v2/projects/{project_id}/quotahistory",
+	"/api/v2/providers/aks/locations",
+	"/api/v2/providers/aks/modes",
+	"/api/v2/providers/aks/resourcegroups",
+	"/api/v2/providers/aks/validatecredentials",
+	"/api/v2/providers/aks/versions",
+	"/api/v2/providers/aks/vmsizes",
+	"/api/v2/providers/azure/resourcegroups",
+	"/api/v2/providers/azure/routetables",
+	"/api/v2/providers/azure/securitygroups",
+	"/api/v2/providers/azure/subnets",
+	"/api/v2/providers/azure/vnets",
+	"/api/v2/providers/baremetal/tinkerbell/dc/{dc}/images",
+	"/api/v2/providers/eks/amitypes",
+	"/api/v2/providers/eks/capacitytypes",
+	"/api/v2/providers/eks/clusterroles",
+	"/api/v2/providers/eks/regions",
+	"/api/v2/providers/eks/securitygroups",
+	"/api/v2/providers/eks/subnets",
+	"/api/v2/providers/eks/validatecredentials",
+	"/api/v2/providers/eks/versions",
+	"/api/v2/providers/eks/vpcs",
+	"/api/v2/providers/gke/disktypes",
+	"/api/v2/providers/gke/images",
+	"/api/v2/providers/gke/validatecredentials",
+	"/api/v2/providers/gke/versions",
+	"/api/v2/providers/gke/vmsizes",
+	"/api/v2/providers/gke/zones",
+	"/api/v2/providers/kubevirt/dc/{dc}/images",
+	"/api/v2/providers/kubevirt/instancetypes",
+	"/api/v2/providers/kubevirt/preferences",
+	"/api/v2/providers/kubevirt/storageclasses",
+	"/api/v2/providers/nutanix/{dc}/categories",
+	"/api/v2/providers/nutanix/{dc}/categories/{category}/values",
+	"/api/v2/providers/nutanix/{dc}/clusters",
+	"/api/v2/providers/nutanix/{dc}/projects",
+	"/api/v2/providers/nutanix/{dc}/subnets",
+	"/api/v2/providers/openstack/servergroups",
+	"/api/v2/providers/openstack/subnetpools",
+	"/api/v2/providers/vmwareclouddirector/{dc}/catalogs",
+	"/api/v2/providers/vmwareclouddirector/{dc}/networks",
+	"/api/v2/providers/vmwareclouddirector/{dc}/storageprofiles",
+	"/api/v2/providers/vmwareclouddirector/{dc}/templates/{catalog_name}",
+	"/api/v2/providers/vsphere/datastores",
+	"/api/v2/providers/vsphere/vmgroups",
+	"/api/v2/providers/{provider_name}/dc/{dc}/defaultcluster",
+	"/api/v2/providers/{provider_name}/dc/{dc}/networkdefaults",
+	"/api/v2/providers/{provider_name}/presets",
+	"/api/v2/providers/{provider_name}/versions",
+	"/api/v2/quotas",
+	"/api/v2/quotas/{quota_name}",
+	"/api/v2/quotas/{quota_name}/notifications",
+	"/api/v2/quotas/{quota_name}/notifications/deliveries",
+	"/api/v2/seeds/status",
+	"/api/v2/seeds/{seed_name}/ipampools",
+	"/api/v2/seeds/{seed_name}/ipampools/{ipampool_name}",
+	"/api/v2/seeds/{seed_name}/operatingsystemprofiles",
+	"/api/v2/seeds/{seed_name}/overview",
+	"/api/v2/seeds/{seed_name}/rulegroups",
+	"/api/v2/seeds/{seed_name}/rulegroups/{rulegroup_id}",
+	"/api/v2/seeds/{seed_name}/settings",
+	"/api/v2/userclusterconfig/admissionplugins",
+	"/api/v2/users",
+)
+
+// isReadOnlyRequest tells whether the request in the ctx can be served for a read-only token. Requests of
+// other methods than GET, HEAD and OPTIONS and requests of unknown route are treated as changing requests.
+func isReadOnlyRequest(ctx context.Context) bool {
+	switch ctx.Value(requestMethodKey) {
+	case http.MethodGet, http.MethodHead, http.MethodOptions:
+	default:
+		return false
+	}
+
+	route, ok := ctx.Value(requestRouteKey).(string)
+	if !ok {
+		return false
+	}
+
+	return readOnlyRoutes.Has(route)
+}
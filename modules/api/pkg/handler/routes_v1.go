@@ -951,11 +951,14 @@ func (r Routing) updateProject() http.Handler {
 //	   200: empty
 //	   401: empty
 //	   403: empty
+//	   409: errorResponse
 func (r Routing) deleteProject() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ProjectDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(project.DeleteEndpoint(r.projectProvider, r.settingsProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		project.DecodeDelete,
 		EncodeJSON,
@@ -1188,6 +1191,7 @@ func (r Routing) getOidcClusterKubeconfig() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
+//	  409: errorResponse
 func (r Routing) deleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
@@ -1195,6 +1199,8 @@ func (r Routing) deleteCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.ClusterDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		cluster.DecodeDeleteReq,
 		EncodeJSON,
@@ -2108,6 +2114,7 @@ func (r Routing) patchNodeDeployment() http.Handler {
 //	   200: empty
 //	   401: empty
 //	   403: empty
+//	   409: errorResponse
 func (r Routing) deleteNodeDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
@@ -2115,6 +2122,8 @@ func (r Routing) deleteNodeDeployment() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.MachineDeploymentDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(node.DeleteNodeDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		node.DecodeDeleteNodeDeployment,
 		EncodeJSON,
@@ -467,11 +467,14 @@ func (r Routing) updateSeed() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
+//	  409: errorResponse
 func (r Routing) deleteSeed() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.SeedDeletionProtection(r.userInfoGetter, r.seedsGetter),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(admin.DeleteSeedEndpoint(r.userInfoGetter, r.seedsGetter, r.masterClient)),
 		admin.DecodeSeedReq,
 		EncodeJSON,
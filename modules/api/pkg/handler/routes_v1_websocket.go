@@ -116,7 +116,7 @@ func getProviders(r Routing) watcher.Providers {
 
 func getSettingsWatchHandler(writer WebsocketSettingsWriter, providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
 	return func(w http.ResponseWriter, req *http.Request) {
-		_, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		_, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors, true)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
@@ -135,7 +135,7 @@ func getSettingsWatchHandler(writer WebsocketSettingsWriter, providers watcher.P
 
 func getUserWatchHandler(writer WebsocketUserWriter, providers watcher.Providers, routing Routing) func(w http.ResponseWriter, req *http.Request) {
 	return func(w http.ResponseWriter, req *http.Request) {
-		user, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		user, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors, true)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
@@ -233,7 +233,7 @@ func getTerminalWatchHandler(writer WebsocketTerminalWriter, providers watcher.P
 			return
 		}
 
-		authenticatedUser, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors)
+		authenticatedUser, err := verifyAuthorizationToken(req, routing.tokenVerifiers, routing.tokenExtractors, false)
 		if err != nil {
 			log.Logger.Debug(err)
 			return
@@ -341,7 +341,8 @@ func (req terminalReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
-func verifyAuthorizationToken(req *http.Request, tokenVerifier authtypes.TokenVerifier, tokenExtractor authtypes.TokenExtractor) (*apiv1.User, error) {
+// verifyAuthorizationToken verifies the token of the request, read-only tokens are rejected unless allowReadOnly is set.
+func verifyAuthorizationToken(req *http.Request, tokenVerifier authtypes.TokenVerifier, tokenExtractor authtypes.TokenExtractor, allowReadOnly bool) (*apiv1.User, error) {
 	token, err := tokenExtractor.Extract(req)
 	if err != nil {
 		return nil, err
@@ -356,6 +357,10 @@ func verifyAuthorizationToken(req *http.Request, tokenVerifier authtypes.TokenVe
 		return nil, utilerrors.NewNotAuthorized()
 	}
 
+	if claims.ReadOnly && !allowReadOnly {
+		return nil, utilerrors.New(http.StatusForbidden, "forbidden: the token is read-only")
+	}
+
 	user := &apiv1.User{
 		ObjectMeta: apiv1.ObjectMeta{
 			Name: claims.Name,
@@ -29,7 +29,9 @@ import (
 	prometheusapi "github.com/prometheus/client_golang/api"
 	"go.uber.org/zap"
 
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
@@ -229,13 +231,25 @@ type RoutingParams struct {
 	PrivilegedMLAAdminSettingProviderGetter        provider.PrivilegedMLAAdminSettingProviderGetter
 	SeedProvider                                   provider.SeedProvider
 	ResourceQuotaProvider                          provider.ResourceQuotaProvider
+	ResourceQuotaUsageHistoryProvider              provider.ResourceQuotaUsageHistoryProvider
+	ClusterAgentTokenProvider                      provider.ClusterAgentTokenProvider
+	SessionProvider                                provider.SessionProvider
+	DeviceAuthorizationProvider                    provider.DeviceAuthorizationProvider
+	PersonalAccessTokenProvider                    provider.PersonalAccessTokenProvider
+	ResourceQuotaNotificationProvider              provider.ResourceQuotaNotificationProvider
 	GroupProjectBindingProvider                    provider.GroupProjectBindingProvider
 	PrivilegedIPAMPoolProviderGetter               provider.PrivilegedIPAMPoolProviderGetter
 	ApplicationDefinitionProvider                  provider.ApplicationDefinitionProvider
+	ApplicationCatalogSourceProvider               provider.ApplicationCatalogSourceProvider
+	ApplicationRolloutProvider                     provider.ApplicationRolloutProvider
+	BackgroundOperationProvider                    provider.BackgroundOperationProvider
 	PrivilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter
 	OIDCIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
 	OIDCIssuerVerifier                             authtypes.OIDCIssuerVerifier
+	AdditionalOIDCIssuerVerifiers                  []authtypes.OIDCIssuerVerifier
+	TrustedProxies                                 handlerauth.TrustedProxies
 	Versions                                       kubermatic.Versions
 	CABundle                                       *x509.CertPool
 	Features                                       features.FeatureGate
+	PriceCatalog                                   pricing.Catalog
 }
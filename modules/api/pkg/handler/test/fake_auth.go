@@ -37,8 +37,10 @@ const (
 	// IDToken represents a shared fake token.
 	IDToken       = "fakeTokenId"
 	IDViewerToken = "fakeViewerTokenId"
-	refreshToken  = "fakeRefreshToken"
-	tokenURL      = "url:tokenURL"
+	// ReadOnlyIDToken represents a shared fake token which is verified as a read-only token.
+	ReadOnlyIDToken = "fakeReadOnlyTokenId"
+	refreshToken    = "fakeRefreshToken"
+	tokenURL        = "url:tokenURL"
 
 	// IssuerURL holds test issuer URL.
 	IssuerURL = "url://dex"
@@ -89,7 +91,10 @@ type IssuerVerifier struct {
 }
 
 // Extractor knows how to extract the ID token from the request.
-func (o *IssuerVerifier) Extract(_ *http.Request) (string, error) {
+func (o *IssuerVerifier) Extract(r *http.Request) (string, error) {
+	if r.Header.Get("Authorization") == "Bearer "+ReadOnlyIDToken {
+		return ReadOnlyIDToken, nil
+	}
 	return IDToken, nil
 }
 
@@ -160,14 +165,15 @@ func (o *IssuerVerifier) Verify(ctx context.Context, token string) (authtypes.To
 	if ctx == nil {
 		return authtypes.TokenClaims{}, nil
 	}
-	if token != IDToken {
+	if token != IDToken && token != ReadOnlyIDToken {
 		return authtypes.TokenClaims{}, errors.New("incorrect code")
 	}
 	return authtypes.TokenClaims{
-		Email:   o.user.Email,
-		Subject: o.user.Email,
-		Name:    o.user.Name,
-		Groups:  []string{},
+		Email:    o.user.Email,
+		Subject:  o.user.Email,
+		Name:     o.user.Name,
+		Groups:   []string{},
+		ReadOnly: token == ReadOnlyIDToken,
 	}, nil
 }
 
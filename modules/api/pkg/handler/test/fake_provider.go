@@ -34,6 +34,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/rest"
 	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -177,6 +178,10 @@ func (p *FakeExternalClusterProvider) GetVersion(ctx context.Context, masterClie
 	return defaulting.DefaultKubernetesVersioning.Default, nil
 }
 
+func (p *FakeExternalClusterProvider) GetRestConfig(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*rest.Config, error) {
+	return p.Provider.GetRestConfig(ctx, masterClient, cluster)
+}
+
 func (p *FakeExternalClusterProvider) VersionsEndpoint(ctx context.Context, configGetter provider.KubermaticConfigurationGetter, providerType kubermaticv1.ExternalClusterProviderType) ([]apiv1.MasterVersion, error) {
 	return p.Provider.VersionsEndpoint(ctx, configGetter, providerType)
 }
@@ -17,6 +17,7 @@ limitations under the License.
 package hack
 
 import (
+	"context"
 	"net/http"
 
 	"github.com/gorilla/mux"
@@ -162,6 +163,7 @@ func NewTestRouting(
 		PrivilegedIPAMPoolProviderGetter:               privilegedIPAMPoolProviderGetter,
 		PrivilegedOperatingSystemProfileProviderGetter: privilegedOperatingSystemProfileProviderGetter,
 		OIDCIssuerVerifierProviderGetter:               fakeOIDCVerifierIssuerGetter,
+		BackgroundOperationProvider:                    kubernetes.NewBackgroundOperationProvider(context.Background(), masterClient, masterClient, kubermaticlog.Logger),
 	}
 
 	r := handler.NewRouting(routingParams, masterClient)
@@ -77,6 +77,7 @@ import (
 	fakerestclient "k8s.io/client-go/kubernetes/fake"
 	"k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
 	"k8s.io/client-go/tools/reference"
 	"k8s.io/metrics/pkg/apis/metrics/v1beta1"
 	"k8s.io/utils/ptr"
@@ -471,7 +472,14 @@ func initTestEndpoint(user apiv1.User, seedsGetter provider.SeedsGetter, kubeObj
 		return nil, fmt.Errorf("can not find constraintprovider for cluster %q", seed.Name)
 	}
 
-	alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, fakeSeedClient)
+	// the Cortex Alertmanager in the seed accepts all alerts
+	fakeSeedRESTClient := &fakerest.RESTClient{
+		NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+		Client: fakerest.CreateHTTPClient(func(*http.Request) (*http.Response, error) {
+			return &http.Response{StatusCode: http.StatusOK, Body: io.NopCloser(strings.NewReader(""))}, nil
+		}),
+	}
+	alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, fakeSeedClient, fakeSeedRESTClient)
 	alertmanagerProviders := map[string]provider.AlertmanagerProvider{"us-central1": alertmanagerProvider}
 	alertmanagerProviderGetter := func(seed *kubermaticv1.Seed) (provider.AlertmanagerProvider, error) {
 		if alertmanager, exists := alertmanagerProviders[seed.Name]; exists {
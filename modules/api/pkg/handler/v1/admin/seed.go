@@ -274,6 +274,11 @@ type seedReq struct {
 	Name string `json:"seed_name"`
 }
 
+// GetSeedName returns the name of the requested seed.
+func (req seedReq) GetSeedName() string {
+	return req.Name
+}
+
 // updateSeedReq defines HTTP request for updateSeed
 // swagger:parameters updateSeed
 type updateSeedReq struct {
@@ -30,6 +30,9 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -48,7 +51,7 @@ func KubermaticSettingsEndpoint(settingsProvider provider.SettingsProvider) endp
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		return ConvertCRDSettingsToAPISettingsSpec(&globalSettings.Spec), nil
+		return ConvertCRDSettingsToAPISettings(globalSettings), nil
 	}
 }
 
@@ -78,7 +81,7 @@ func UpdateKubermaticSettingsEndpoint(userInfoGetter provider.UserInfoGetter, se
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		existingGlobalSettingsSpecJSON, err := json.Marshal(ConvertCRDSettingsToAPISettingsSpec(&existingGlobalSettings.Spec))
+		existingGlobalSettingsSpecJSON, err := json.Marshal(ConvertCRDSettingsToAPISettings(existingGlobalSettings))
 		if err != nil {
 			return nil, utilerrors.NewBadRequest("cannot decode existing settings: %v", err)
 		}
@@ -105,12 +108,24 @@ func UpdateKubermaticSettingsEndpoint(userInfoGetter provider.UserInfoGetter, se
 		if err != nil {
 			return nil, utilerrors.NewBadRequest("cannot convert API settings to CRD settings: %v", err)
 		}
+		if err := handlercommon.ValidateDashboardProxyTarget(patchedGlobalSettingsSpec.DashboardProxyTarget); err != nil {
+			return nil, err
+		}
+		setDashboardProxyTarget(existingGlobalSettings, patchedGlobalSettingsSpec.DashboardProxyTarget)
+		if err := handlercommon.ValidatePersonalAccessTokenSettings(patchedGlobalSettingsSpec.PersonalAccessTokens); err != nil {
+			return nil, err
+		}
+		setPersonalAccessTokenSettings(existingGlobalSettings, patchedGlobalSettingsSpec.PersonalAccessTokens)
+		if err := handlercommon.ValidateStepUpAuthenticationSettings(patchedGlobalSettingsSpec.StepUpAuthentication); err != nil {
+			return nil, err
+		}
+		setStepUpAuthenticationSettings(existingGlobalSettings, patchedGlobalSettingsSpec.StepUpAuthentication)
 		globalSettings, err := settingsProvider.UpdateGlobalSettings(ctx, userInfo, existingGlobalSettings)
 		if err != nil {
 			return nil, common.KubernetesErrorToHTTPError(err)
 		}
 
-		return ConvertCRDSettingsToAPISettingsSpec(&globalSettings.Spec), nil
+		return ConvertCRDSettingsToAPISettings(globalSettings), nil
 	}
 }
 
@@ -182,6 +197,59 @@ func convertAPISettingsToSettingsSpec(settings *apiv2.GlobalSettings) (kubermati
 	return s, nil
 }
 
+// ConvertCRDSettingsToAPISettings converts the global settings including the settings kept in their annotations.
+func ConvertCRDSettingsToAPISettings(settings *kubermaticv1.KubermaticSetting) apiv2.GlobalSettings {
+	s := ConvertCRDSettingsToAPISettingsSpec(&settings.Spec)
+	s.DashboardProxyTarget = apiv2.DashboardProxyTarget(settings.Annotations[handlercommon.DashboardProxyTargetAnnotation])
+	s.PersonalAccessTokens = handlercommon.GetPersonalAccessTokenSettings(settings)
+	s.StepUpAuthentication = handlercommon.GetStepUpAuthenticationSettings(settings)
+	return s
+}
+
+// setDashboardProxyTarget stores the target in the annotations of the settings, the empty target removes it.
+func setDashboardProxyTarget(settings *kubermaticv1.KubermaticSetting, target apiv2.DashboardProxyTarget) {
+	if target == "" {
+		delete(settings.Annotations, handlercommon.DashboardProxyTargetAnnotation)
+		return
+	}
+	if settings.Annotations == nil {
+		settings.Annotations = map[string]string{}
+	}
+	settings.Annotations[handlercommon.DashboardProxyTargetAnnotation] = string(target)
+}
+
+// setPersonalAccessTokenSettings stores the settings of the personal access tokens in the annotations of the
+// settings, the defaults remove them.
+func setPersonalAccessTokenSettings(settings *kubermaticv1.KubermaticSetting, tokenSettings *apiv2.PersonalAccessTokenSettings) {
+	delete(settings.Annotations, handlerauth.PersonalAccessTokensDisabledAnnotation)
+	delete(settings.Annotations, handlerauth.PersonalAccessTokenMaxLifetimeAnnotation)
+	if tokenSettings == nil || (!tokenSettings.Disabled && tokenSettings.MaxLifetime == "") {
+		return
+	}
+	if settings.Annotations == nil {
+		settings.Annotations = map[string]string{}
+	}
+	if tokenSettings.Disabled {
+		settings.Annotations[handlerauth.PersonalAccessTokensDisabledAnnotation] = "true"
+	}
+	if tokenSettings.MaxLifetime != "" {
+		settings.Annotations[handlerauth.PersonalAccessTokenMaxLifetimeAnnotation] = tokenSettings.MaxLifetime
+	}
+}
+
+// setStepUpAuthenticationSettings stores the max age of the step-up authentication in the annotations of the
+// settings, the empty max age removes it.
+func setStepUpAuthenticationSettings(settings *kubermaticv1.KubermaticSetting, stepUpSettings *apiv2.StepUpAuthenticationSettings) {
+	if stepUpSettings == nil || stepUpSettings.MaxAge == "" {
+		delete(settings.Annotations, middleware.StepUpMaxAgeAnnotation)
+		return
+	}
+	if settings.Annotations == nil {
+		settings.Annotations = map[string]string{}
+	}
+	settings.Annotations[middleware.StepUpMaxAgeAnnotation] = stepUpSettings.MaxAge
+}
+
 func ConvertCRDSettingsToAPISettingsSpec(settings *kubermaticv1.SettingSpec) apiv2.GlobalSettings {
 	enableShareCluster := true
 	if settings.EnableShareCluster != nil {
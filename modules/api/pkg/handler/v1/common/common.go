@@ -40,6 +40,7 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/util/httpstream"
+	"k8s.io/client-go/kubernetes"
 	corev1interface "k8s.io/client-go/kubernetes/typed/core/v1"
 	"k8s.io/client-go/rest"
 	"k8s.io/client-go/tools/portforward"
@@ -295,6 +296,24 @@ func GetClusterClient(ctx context.Context, userInfoGetter provider.UserInfoGette
 	return clusterProvider.GetClientForUserCluster(ctx, userInfo, cluster)
 }
 
+// GetClusterK8sClient returns a k8s go client for the user cluster. Unless the user is an admin, the client impersonates
+// the user.
+func GetClusterK8sClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, clusterProvider provider.ClusterProvider, cluster *kubermaticv1.Cluster, projectID string) (kubernetes.Interface, error) {
+	adminUserInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user information: %w", err)
+	}
+	if adminUserInfo.IsAdmin {
+		return clusterProvider.GetAdminK8sClientForUserCluster(ctx, cluster)
+	}
+
+	userInfo, err := userInfoGetter(ctx, projectID)
+	if err != nil {
+		return nil, fmt.Errorf("failed to get user information: %w", err)
+	}
+	return clusterProvider.GetK8sClientForUserCluster(ctx, userInfo, cluster)
+}
+
 // checks whether a user is global admin, project admin or has valid roles to modify a project.
 func ValidateUserCanModifyProject(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectID string) error {
 	userInfo, err := userInfoGetter(ctx, projectID)
@@ -55,7 +55,7 @@ type ProjectIDGetter interface {
 }
 
 // GetProjectRq defines HTTP request for getProject endpoint
-// swagger:parameters getProject getUsersForProject listClustersForProject listServiceAccounts getProjectQuota listGroupProjectBinding
+// swagger:parameters getProject getUsersForProject listClustersForProject listServiceAccounts getProjectQuota getProjectQuotaUsageHistory listGroupProjectBinding
 type GetProjectRq struct {
 	ProjectReq
 }
@@ -104,6 +104,11 @@ type GetClusterReq struct {
 	ClusterID string `json:"cluster_id"`
 }
 
+// GetClusterID returns the ID of the requested cluster.
+func (req GetClusterReq) GetClusterID() string {
+	return req.ClusterID
+}
+
 func DecodeGetClusterReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req GetClusterReq
 	clusterID, err := DecodeClusterID(c, r)
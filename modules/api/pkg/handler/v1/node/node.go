@@ -322,6 +322,11 @@ func DecodeDeleteNodeDeployment(c context.Context, r *http.Request) (interface{}
 	return req, nil
 }
 
+// GetMachineDeploymentID returns the ID of the node deployment to delete.
+func (req deleteNodeDeploymentReq) GetMachineDeploymentID() string {
+	return req.NodeDeploymentID
+}
+
 func DeleteNodeDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(deleteNodeDeploymentReq)
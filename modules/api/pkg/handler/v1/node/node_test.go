@@ -26,6 +26,7 @@ import (
 	"testing"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -1257,6 +1258,28 @@ func TestDeleteNodeDeployment(t *testing.T) {
 			ExpectedHTTPStatusOnGet:     http.StatusForbidden,
 			ExpectedNodeDeploymentCount: 2,
 		},
+		// scenario 4
+		{
+			Name:            "scenario 4: a node deployment protected from deletion cannot be deleted",
+			HTTPStatus:      http.StatusConflict,
+			NodeIDToDelete:  "venus",
+			ClusterIDToSync: test.GenDefaultCluster().Name,
+			ProjectIDToSync: test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser: test.GenDefaultAPIUser(),
+			ExistingMachineDeployments: []*clusterv1alpha1.MachineDeployment{
+				func() *clusterv1alpha1.MachineDeployment {
+					md := genTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+					md.Labels = map[string]string{middleware.DeletionProtectionLabel: "true"}
+					return md
+				}(),
+				genTestMachineDeployment("mars", `{"cloudProvider":"aws","cloudProviderSpec":{"token":"dummy-token","region":"eu-central-1","availabilityZone":"eu-central-1a","vpcId":"vpc-819f62e9","subnetId":"subnet-2bff4f43","instanceType":"t2.micro","diskSize":50}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":false}}`, nil, false),
+			},
+			ExpectedNodeDeploymentCount: 2,
+		},
 	}
 
 	for _, tc := range testcases {
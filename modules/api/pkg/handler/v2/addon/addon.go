@@ -26,13 +26,14 @@ import (
 	"github.com/gorilla/mux"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 )
 
-// addonReq defines HTTP request for getAddonV2 and deleteAddonV2
-// swagger:parameters getAddonV2 deleteAddonV2
+// addonReq defines HTTP request for getAddonV2, deleteAddonV2, listAddonRevisionsV2 and getAddonDriftV2
+// swagger:parameters getAddonV2 deleteAddonV2 listAddonRevisionsV2 getAddonDriftV2
 type addonReq struct {
 	common.ProjectReq
 	// in: path
@@ -70,6 +71,22 @@ type patchReq struct {
 	Body apiv1.Addon
 }
 
+// revertReq defines HTTP request for revertAddonV2 endpoint
+// swagger:parameters revertAddonV2
+type revertReq struct {
+	addonReq
+	// in: body
+	// required: true
+	Body apiv2.AddonRevertBody
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req revertReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
 // GetSeedCluster returns the SeedCluster object.
 func (req patchReq) GetSeedCluster() apiv1.SeedCluster {
 	return apiv1.SeedCluster{
@@ -181,6 +198,22 @@ func DecodePatchAddon(c context.Context, r *http.Request) (interface{}, error) {
 	return req, nil
 }
 
+func DecodeRevertAddon(c context.Context, r *http.Request) (interface{}, error) {
+	var req revertReq
+
+	gr, err := DecodeGetAddon(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.addonReq = gr.(addonReq)
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
 func decodeAddonID(c context.Context, r *http.Request) (string, error) {
 	addonID := mux.Vars(r)["addon_id"]
 	if addonID == "" {
@@ -231,3 +264,24 @@ func DeleteAddonEndpoint(projectProvider provider.ProjectProvider, privilegedPro
 		return handlercommon.DeleteAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AddonID)
 	}
 }
+
+func ListAddonRevisionsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addonReq)
+		return handlercommon.ListAddonRevisionsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AddonID)
+	}
+}
+
+func RevertAddonEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(revertReq)
+		return handlercommon.RevertAddonEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.Body, req.ProjectID, req.ClusterID, req.AddonID)
+	}
+}
+
+func GetAddonDriftEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(addonReq)
+		return handlercommon.GetAddonDriftEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.AddonID)
+	}
+}
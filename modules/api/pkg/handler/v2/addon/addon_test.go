@@ -25,6 +25,7 @@ import (
 	"testing"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -701,3 +702,63 @@ func TestCreatePatchGetAddon(t *testing.T) {
 		})
 	}
 }
+
+func TestRevertAddon(t *testing.T) {
+	t.Parallel()
+	cluster := test.GenDefaultCluster()
+	cluster.Status.NamespaceName = fmt.Sprintf("cluster-%s", cluster.Name)
+
+	ep, err := test.CreateTestEndpoint(*test.GenAPIUser("john", "john@acme.com"), []ctrlruntimeclient.Object{}, []ctrlruntimeclient.Object{
+		test.GenTestSeed(),
+		test.GenProject("my-first-project", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+		test.GenBinding("my-first-project-ID", "john@acme.com", "owners"),
+		test.GenUser("", "john", "john@acme.com"),
+		cluster,
+	}, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	addonURL := fmt.Sprintf("/api/v2/projects/%s/clusters/%s/addons", "my-first-project-ID", cluster.Name)
+	serve := func(method, url, body string, expectedStatus int) *httptest.ResponseRecorder {
+		t.Helper()
+		req := httptest.NewRequest(method, url, strings.NewReader(body))
+		res := httptest.NewRecorder()
+		ep.ServeHTTP(res, req)
+		if res.Code != expectedStatus {
+			t.Fatalf("Expected %s %s HTTP status code %d, got %d: %s", method, url, expectedStatus, res.Code, res.Body.String())
+		}
+		return res
+	}
+	listRevisions := func() []apiv2.AddonRevision {
+		t.Helper()
+		res := serve(http.MethodGet, addonURL+"/addon1/revisions", "", http.StatusOK)
+		var revisions []apiv2.AddonRevision
+		if err := json.Unmarshal(res.Body.Bytes(), &revisions); err != nil {
+			t.Fatalf("failed to decode revisions: %v", err)
+		}
+		return revisions
+	}
+
+	serve(http.MethodPost, addonURL, `{"name": "addon1", "spec": {"variables": {"foo": "bar"}}}`, http.StatusCreated)
+	if revisions := listRevisions(); len(revisions) != 1 || revisions[0].Revision != 1 || revisions[0].Variables["foo"] != "bar" {
+		t.Fatalf("expected the variables of the installation as only revision, got %v", revisions)
+	}
+
+	// changes of other fields than the variables do not create revisions
+	serve(http.MethodPatch, addonURL+"/addon1", `{"name": "addon1", "spec": {"variables": {"foo": "baz"}}}`, http.StatusOK)
+	serve(http.MethodPatch, addonURL+"/addon1", `{"name": "addon1", "spec": {"variables": {"foo": "baz"}, "continuouslyReconcile": true}}`, http.StatusOK)
+	revisions := listRevisions()
+	if len(revisions) != 2 || revisions[0].Revision != 2 || revisions[0].Variables["foo"] != "baz" || revisions[0].Author != "john@acme.com" {
+		t.Fatalf("expected the changed variables as newest revision, got %v", revisions)
+	}
+
+	res := serve(http.MethodPost, addonURL+"/addon1/revert", `{"revision": 1}`, http.StatusOK)
+	test.CompareWithResult(t, res, `{"id":"addon1","name":"addon1","creationTimestamp":"0001-01-01T00:00:00Z","spec":{"variables":{"foo":"bar"},"continuouslyReconcile":true}}`)
+	revisions = listRevisions()
+	if len(revisions) != 3 || revisions[0].Variables["foo"] != "bar" || revisions[0].Description != "Reverted to revision 1" {
+		t.Fatalf("expected the revert as newest revision, got %v", revisions)
+	}
+
+	serve(http.MethodPost, addonURL+"/addon1/revert", `{"revision": 7}`, http.StatusNotFound)
+}
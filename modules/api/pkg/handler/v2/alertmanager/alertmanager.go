@@ -17,15 +17,17 @@ limitations under the License.
 package alertmanager
 
 import (
-	"bytes"
 	"context"
 	"encoding/json"
+	"errors"
 	"fmt"
+	"io"
 	"net/http"
+	"time"
 
 	"github.com/go-kit/kit/endpoint"
-	"gopkg.in/yaml.v3"
 
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
@@ -61,8 +63,8 @@ func UpdateEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider prov
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(updateAlertmanagerReq)
 
-		if err := req.validateUpdateAlertmanagerReq(); err != nil {
-			return nil, utilerrors.NewBadRequest("invalid alertmanager configuration: %v", err)
+		if _, errs := parseConfig(req.Body.Spec.Config); len(errs) > 0 {
+			return nil, invalidConfigError(errs)
 		}
 
 		c, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
@@ -94,6 +96,100 @@ func ResetEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provi
 	}
 }
 
+// RoutesTestEndpoint shows the routes and receivers of an alert with the given labels, either in the given or in the
+// current configuration of the cluster.
+func RoutesTestEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(routesTestReq)
+
+		c, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+
+		data := req.Body.Config
+		if len(data) == 0 {
+			_, configSecret, err := getAlertmanagerConfig(ctx, userInfoGetter, c, req.ProjectID)
+			if err != nil {
+				return nil, common.KubernetesErrorToHTTPError(err)
+			}
+			data = configSecret.Data[resources.AlertmanagerConfigSecretKey]
+		}
+
+		config, errs := parseConfig(data)
+		if len(errs) > 0 {
+			return nil, invalidConfigError(errs)
+		}
+		return testRoutes(config, req.Body.Labels), nil
+	}
+}
+
+// SendTestAlertEndpoint sends a synthetic alert to the Alertmanager of the cluster, so that the receivers can be
+// checked end to end. The alert resolves on its own after a few minutes.
+func SendTestAlertEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(sendTestAlertReq)
+
+		c, err := handlercommon.GetCluster(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, req.ProjectID, req.ClusterID, nil)
+		if err != nil {
+			return nil, err
+		}
+		// the alert reaches people outside of the project, viewers are not allowed to notify them
+		if err := common.ValidateUserCanModifyProject(ctx, userInfoGetter, req.ProjectID); err != nil {
+			return nil, err
+		}
+
+		_, configSecret, err := getAlertmanagerConfig(ctx, userInfoGetter, c, req.ProjectID)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		config, errs := parseConfig(configSecret.Data[resources.AlertmanagerConfigSecretKey])
+		if len(errs) > 0 {
+			return nil, invalidConfigError(errs)
+		}
+
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		alert := newTestAlert(req.Body, userInfo.Email, time.Now())
+		alert.Receivers = testRoutes(config, alert.Labels).Receivers
+
+		privilegedAlertmanagerProvider := ctx.Value(middleware.PrivilegedAlertmanagerProviderContextKey).(provider.PrivilegedAlertmanagerProvider)
+		if err := privilegedAlertmanagerProvider.SendTestAlertUnsecured(ctx, c, alert); err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		return alert, nil
+	}
+}
+
+const (
+	testAlertName     = "DashboardTestAlert"
+	testAlertDuration = 5 * time.Minute
+)
+
+func newTestAlert(body apiv2.AlertmanagerTestAlertBody, sender string, now time.Time) *apiv2.AlertmanagerTestAlert {
+	alert := &apiv2.AlertmanagerTestAlert{
+		Labels:      map[string]string{"alertname": testAlertName},
+		Annotations: map[string]string{"summary": fmt.Sprintf("Test alert sent by %s", sender)},
+		StartsAt:    apiv1.NewTime(now),
+		EndsAt:      apiv1.NewTime(now.Add(testAlertDuration)),
+	}
+	for name, value := range body.Labels {
+		alert.Labels[name] = value
+	}
+	for name, value := range body.Annotations {
+		alert.Annotations[name] = value
+	}
+	return alert
+}
+
+func invalidConfigError(errs []string) error {
+	return utilerrors.NewWithDetails(http.StatusBadRequest, "invalid alertmanager configuration, please examine details field for more info", errs)
+}
+
 // getAlertmanagerReq defines HTTP request for getting alertmanager
 // swagger:parameters getAlertmanager
 type getAlertmanagerReq struct {
@@ -109,15 +205,21 @@ type updateAlertmanagerReq struct {
 	Body apiv2.Alertmanager
 }
 
-func (req *updateAlertmanagerReq) validateUpdateAlertmanagerReq() error {
-	bodyMap := map[string]interface{}{}
-
-	decoder := yaml.NewDecoder(bytes.NewReader(req.Body.Spec.Config))
-	if err := decoder.Decode(&bodyMap); err != nil {
-		return fmt.Errorf("can not unmarshal yaml configuration: %w", err)
-	}
+// routesTestReq defines HTTP request for testing the routing tree of alertmanager
+// swagger:parameters testAlertmanagerRoutes
+type routesTestReq struct {
+	cluster.GetClusterReq
+	// in: body
+	// required: true
+	Body apiv2.AlertmanagerRouteTestBody
+}
 
-	return nil
+// sendTestAlertReq defines HTTP request for sending a test alert to alertmanager
+// swagger:parameters sendAlertmanagerTestAlert
+type sendTestAlertReq struct {
+	cluster.GetClusterReq
+	// in: body
+	Body apiv2.AlertmanagerTestAlertBody
 }
 
 // resetAlertmanagerReq defines HTTP request for deleting alertmanager
@@ -154,6 +256,39 @@ func DecodeUpdateAlertmanagerReq(c context.Context, r *http.Request) (interface{
 	return req, nil
 }
 
+func DecodeRoutesTestReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req routesTestReq
+
+	cr, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.GetClusterReq = cr.(cluster.GetClusterReq)
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+	return req, nil
+}
+
+func DecodeSendTestAlertReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req sendTestAlertReq
+
+	cr, err := cluster.DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req.GetClusterReq = cr.(cluster.GetClusterReq)
+
+	// the body is optional, the test alert has default labels
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil && !errors.Is(err, io.EOF) {
+		return nil, err
+	}
+	return req, nil
+}
+
 func DecodeResetAlertmanagerReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req resetAlertmanagerReq
 
@@ -22,6 +22,8 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httptest"
+	"reflect"
+	"strings"
 	"testing"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
@@ -296,6 +298,32 @@ func TestUpdateEndpoint(t *testing.T) {
 				},
 			},
 		},
+		{
+			Name:      "scenario 7: update alertmanager with a route to an undefined receiver",
+			ProjectID: test.GenDefaultProject().Name,
+			ClusterID: test.GenDefaultCluster().Name,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			Body: generateRequestBody([]byte(`
+alertmanager_config: |
+  route:
+    receiver: "test"
+    routes:
+      - receiver: "pager"
+        matchers: ['severity="critical"']
+  receivers:
+    - name: "test"
+`)),
+			ExistingConfigSecret: test.GenAlertmanagerConfigSecret(testAlertmanagerConfigSecretName,
+				test.GenDefaultCluster().Status.NamespaceName,
+				[]byte("test")),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusBadRequest,
+		},
 	}
 
 	for _, tc := range testCases {
@@ -437,6 +465,214 @@ func TestDeleteEndpoint(t *testing.T) {
 	}
 }
 
+func TestRoutesTestEndpoint(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name                      string
+		Body                      apiv2.AlertmanagerRouteTestBody
+		ExistingKubermaticObjects []ctrlruntimeclient.Object
+		ExistingAPIUser           *apiv1.User
+		ExpectedResponse          string
+		ExpectedHTTPStatus        int
+	}{
+		{
+			Name: "scenario 1: route an alert through the current configuration",
+			Body: apiv2.AlertmanagerRouteTestBody{Labels: map[string]string{"alertname": "Watchdog"}},
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedResponse:   `{"receivers":["test"],"routes":[{"path":"route","receiver":"test"}]}`,
+		},
+		{
+			Name: "scenario 2: route an alert through the given configuration",
+			Body: apiv2.AlertmanagerRouteTestBody{
+				Labels: map[string]string{"severity": "critical", "team": "infra"},
+				Config: []byte(`
+alertmanager_config: |
+  route:
+    receiver: "default"
+    group_by: ["alertname"]
+    routes:
+      - receiver: "pager"
+        matchers: ['severity="critical"']
+        continue: true
+      - receiver: "infra"
+        match_re:
+          team: "infra|platform"
+      - receiver: "default"
+  receivers:
+    - name: "default"
+    - name: "pager"
+    - name: "infra"
+`),
+			},
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedResponse:   `{"receivers":["pager","infra"],"routes":[{"path":"route.routes[0]","receiver":"pager","groupBy":["alertname"]},{"path":"route.routes[1]","receiver":"infra","groupBy":["alertname"]}]}`,
+		},
+		{
+			Name: "scenario 3: the given configuration is invalid",
+			Body: apiv2.AlertmanagerRouteTestBody{
+				Labels: map[string]string{"severity": "critical"},
+				Config: []byte("alertmanager_config: |\n  receivers: []\n"),
+			},
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusBadRequest,
+		},
+		{
+			Name: "scenario 4: user john can not test the alertmanager of bob's cluster",
+			Body: apiv2.AlertmanagerRouteTestBody{Labels: map[string]string{"alertname": "Watchdog"}},
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAdminUser("John", "john@acme.com", false),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			ExistingAPIUser:    test.GenAPIUser("John", "john@acme.com"),
+			ExpectedHTTPStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			body, err := json.Marshal(tc.Body)
+			if err != nil {
+				t.Fatalf("failed to marshal request body: %v", err)
+			}
+			req := httptest.NewRequest(http.MethodPost, requestURL(test.GenDefaultProject().Name, test.GenDefaultCluster().Name)+"/routes/test", bytes.NewBuffer(body))
+			resp := httptest.NewRecorder()
+			kubernetesObjs := []ctrlruntimeclient.Object{
+				test.GenAlertmanagerConfigSecret(testAlertmanagerConfigSecretName,
+					test.GenDefaultCluster().Status.NamespaceName,
+					[]byte(testAlertmanagerConfig)),
+			}
+
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, kubernetesObjs, tc.ExistingKubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+			ep.ServeHTTP(resp, req)
+
+			if resp.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, resp.Code, resp.Body.String())
+			}
+			if resp.Code == http.StatusOK {
+				test.CompareWithResult(t, resp, tc.ExpectedResponse)
+			}
+		})
+	}
+}
+
+func TestSendTestAlertEndpoint(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		Name                      string
+		Body                      string
+		ExistingKubermaticObjects []ctrlruntimeclient.Object
+		ExistingAPIUser           *apiv1.User
+		ExpectedLabels            map[string]string
+		ExpectedHTTPStatus        int
+	}{
+		{
+			Name: "scenario 1: send a test alert with the default labels",
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedLabels:     map[string]string{"alertname": "DashboardTestAlert"},
+		},
+		{
+			Name: "scenario 2: send a test alert with additional labels",
+			Body: `{"labels":{"severity":"critical"}}`,
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusOK,
+			ExpectedLabels:     map[string]string{"alertname": "DashboardTestAlert", "severity": "critical"},
+		},
+		{
+			Name: "scenario 3: viewers can not send test alerts",
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenBinding(test.GenDefaultProject().Name, "john@acme.com", "viewers"),
+				test.GenAlertmanager(test.GenDefaultCluster().Status.NamespaceName,
+					testAlertmanagerConfigSecretName),
+			),
+			ExistingAPIUser:    test.GenAPIUser("john", "john@acme.com"),
+			ExpectedHTTPStatus: http.StatusForbidden,
+		},
+		{
+			Name: "scenario 4: alertmanager is not found",
+			ExistingKubermaticObjects: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenDefaultCluster(),
+			),
+			ExistingAPIUser:    test.GenDefaultAPIUser(),
+			ExpectedHTTPStatus: http.StatusNotFound,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/alertmanager/test", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
+			resp := httptest.NewRecorder()
+			kubernetesObjs := []ctrlruntimeclient.Object{
+				test.GenAlertmanagerConfigSecret(testAlertmanagerConfigSecretName,
+					test.GenDefaultCluster().Status.NamespaceName,
+					[]byte(testAlertmanagerConfig)),
+			}
+
+			ep, err := test.CreateTestEndpoint(*tc.ExistingAPIUser, kubernetesObjs, tc.ExistingKubermaticObjects, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+			ep.ServeHTTP(resp, req)
+
+			if resp.Code != tc.ExpectedHTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.ExpectedHTTPStatus, resp.Code, resp.Body.String())
+			}
+			if resp.Code == http.StatusOK {
+				alert := &apiv2.AlertmanagerTestAlert{}
+				if err := json.Unmarshal(resp.Body.Bytes(), alert); err != nil {
+					t.Fatalf("failed to unmarshal response: %v", err)
+				}
+				if !reflect.DeepEqual(alert.Labels, tc.ExpectedLabels) {
+					t.Fatalf("Expected labels %v, got %v", tc.ExpectedLabels, alert.Labels)
+				}
+				if !reflect.DeepEqual(alert.Receivers, []string{"test"}) {
+					t.Fatalf("Expected the alert to be routed to the receiver test, got %v", alert.Receivers)
+				}
+			}
+		})
+	}
+}
+
 func requestURL(projectID, clusterID string) string {
 	return fmt.Sprintf("/api/v2/projects/%s/clusters/%s/alertmanager/config", projectID, clusterID)
 }
@@ -0,0 +1,149 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alertmanager
+
+import (
+	"bytes"
+	"errors"
+	"fmt"
+	"io"
+	"regexp"
+	"slices"
+	"strings"
+
+	"github.com/prometheus/alertmanager/config"
+	"github.com/prometheus/alertmanager/dispatch"
+	"github.com/prometheus/common/model"
+	yamlv2 "gopkg.in/yaml.v2"
+	"gopkg.in/yaml.v3"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+)
+
+// cortexConfig is the configuration format of the Cortex Alertmanager, which wraps the configuration of the
+// Alertmanager of a tenant together with its template files.
+type cortexConfig struct {
+	TemplateFiles      map[string]string `yaml:"template_files"`
+	AlertmanagerConfig string            `yaml:"alertmanager_config"`
+}
+
+// typeErrorSuffix is cut from decoding errors, the Go types of the configuration mean nothing to the user.
+var typeErrorSuffix = regexp.MustCompile(` (in type|into) \S+$`)
+
+// parseConfig parses the configuration of the Cortex Alertmanager and loads the Alertmanager configuration it
+// contains with the configuration loader of the Alertmanager v0.28, which the bundled Cortex v1.20 is built with.
+// The problems that are found are returned as errors.
+func parseConfig(data []byte) (*config.Config, []string) {
+	cortex := &cortexConfig{}
+	if errs := decodeStrict(data, cortex); len(errs) > 0 {
+		return nil, errs
+	}
+	if strings.TrimSpace(cortex.AlertmanagerConfig) == "" {
+		return nil, []string{"alertmanager_config: must not be empty"}
+	}
+
+	alertmanagerConfig, err := config.Load(cortex.AlertmanagerConfig)
+	if err != nil {
+		// the Alertmanager decodes with yaml.v2, which lists all fields that could not be decoded
+		var typeErr *yamlv2.TypeError
+		if errors.As(err, &typeErr) {
+			errs := make([]string, 0, len(typeErr.Errors))
+			for _, e := range typeErr.Errors {
+				errs = append(errs, "alertmanager_config: "+typeErrorSuffix.ReplaceAllString(e, ""))
+			}
+			return nil, errs
+		}
+		return nil, []string{fmt.Sprintf("alertmanager_config: %v", err)}
+	}
+	return alertmanagerConfig, nil
+}
+
+// decodeStrict decodes YAML and rejects unknown fields, which are mostly typos that the Alertmanager refuses.
+func decodeStrict(data []byte, out interface{}) []string {
+	decoder := yaml.NewDecoder(bytes.NewReader(data))
+	decoder.KnownFields(true)
+
+	err := decoder.Decode(out)
+	if err == nil {
+		return nil
+	}
+	if errors.Is(err, io.EOF) {
+		return []string{"configuration must not be empty"}
+	}
+
+	var typeErr *yaml.TypeError
+	if errors.As(err, &typeErr) {
+		errs := make([]string, 0, len(typeErr.Errors))
+		for _, e := range typeErr.Errors {
+			errs = append(errs, typeErrorSuffix.ReplaceAllString(e, ""))
+		}
+		return errs
+	}
+	return []string{fmt.Sprintf("can not unmarshal yaml configuration: %v", err)}
+}
+
+// testRoutes routes an alert with the given labels through the routing tree of a loaded configuration, like the
+// dispatcher of the Alertmanager does.
+func testRoutes(alertmanagerConfig *config.Config, labels map[string]string) *apiv2.AlertmanagerRouteTestResult {
+	root := dispatch.NewRoute(alertmanagerConfig.Route, nil)
+	paths := map[*dispatch.Route]string{}
+	collectRoutePaths(root, "route", paths)
+
+	labelSet := model.LabelSet{}
+	for name, value := range labels {
+		labelSet[model.LabelName(name)] = model.LabelValue(value)
+	}
+
+	result := &apiv2.AlertmanagerRouteTestResult{Receivers: []string{}, Routes: []apiv2.AlertmanagerMatchedRoute{}}
+	for _, route := range root.Match(labelSet) {
+		result.Routes = append(result.Routes, apiv2.AlertmanagerMatchedRoute{
+			Path:     paths[route],
+			Receiver: route.RouteOpts.Receiver,
+			GroupBy:  routeGroupBy(route.RouteOpts),
+		})
+		if !slices.Contains(result.Receivers, route.RouteOpts.Receiver) {
+			result.Receivers = append(result.Receivers, route.RouteOpts.Receiver)
+		}
+	}
+	return result
+}
+
+// collectRoutePaths records the path of every route of the tree in the configuration, e.g. route.routes[0].
+func collectRoutePaths(route *dispatch.Route, path string, paths map[*dispatch.Route]string) {
+	paths[route] = path
+	for i, child := range route.Routes {
+		collectRoutePaths(child, fmt.Sprintf("%s.routes[%d]", path, i), paths)
+	}
+}
+
+// routeGroupBy returns the labels the route groups alerts by in alphabetical order, or '...' if it groups by all
+// labels.
+func routeGroupBy(opts dispatch.RouteOpts) []string {
+	if opts.GroupByAll {
+		return []string{"..."}
+	}
+	if len(opts.GroupBy) == 0 {
+		return nil
+	}
+
+	groupBy := make([]string, 0, len(opts.GroupBy))
+	for label := range opts.GroupBy {
+		groupBy = append(groupBy, string(label))
+	}
+	slices.Sort(groupBy)
+	return groupBy
+}
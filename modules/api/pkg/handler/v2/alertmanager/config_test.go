@@ -0,0 +1,271 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package alertmanager
+
+import (
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/kubermatic/v2/pkg/resources"
+)
+
+func TestParseConfig(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name           string
+		config         string
+		expectedErrors []string
+	}{
+		{
+			name:   "default configuration",
+			config: resources.DefaultAlertmanagerConfig,
+		},
+		{
+			name: "valid configuration",
+			config: `
+template_files:
+  default.tmpl: '{{ define "title" }}{{ .CommonLabels.alertname }}{{ end }}'
+alertmanager_config: |
+  global:
+    resolve_timeout: 5m
+  route:
+    receiver: default
+    group_by: [alertname, cluster]
+    group_interval: 5m
+    routes:
+      - receiver: pager
+        matchers: ['severity=~"critical|page"', 'team!=""']
+        mute_time_intervals: [weekends]
+  inhibit_rules:
+    - source_matchers: [severity="critical"]
+      target_matchers: [severity="warning"]
+      equal: [alertname]
+  receivers:
+    - name: default
+    - name: pager
+      webhook_configs:
+        - url: http://pager.example.org
+  time_intervals:
+    - name: weekends
+      time_intervals:
+        - weekdays: [saturday, sunday]
+`,
+		},
+		{
+			name:           "not yaml",
+			config:         "bad-request",
+			expectedErrors: []string{"line 1: cannot unmarshal !!str `bad-req...`"},
+		},
+		{
+			name:           "missing alertmanager configuration",
+			config:         "template_files: {}",
+			expectedErrors: []string{"alertmanager_config: must not be empty"},
+		},
+		{
+			name: "unknown fields",
+			config: `
+alertmanager_config: |
+  route:
+    reciever: default
+  receivers:
+    - name: default
+`,
+			expectedErrors: []string{"alertmanager_config: line 2: field reciever not found"},
+		},
+		{
+			name: "undefined receiver",
+			config: `
+alertmanager_config: |
+  route:
+    receiver: default
+    routes:
+      - receiver: pager
+  receivers:
+    - name: default
+`,
+			expectedErrors: []string{`alertmanager_config: undefined receiver "pager" used in route`},
+		},
+		{
+			name: "invalid matcher",
+			config: `
+alertmanager_config: |
+  route:
+    receiver: default
+    routes:
+      - match_re:
+          severity: "critical|("
+  receivers:
+    - name: default
+`,
+			expectedErrors: []string{"alertmanager_config: error parsing regexp: missing closing ): `^(?:critical|()$`"},
+		},
+		{
+			name: "root route with matchers",
+			config: `
+alertmanager_config: |
+  route:
+    receiver: default
+    matchers: [severity="critical"]
+  receivers:
+    - name: default
+`,
+			expectedErrors: []string{`alertmanager_config: root route must not have any matchers`},
+		},
+		{
+			name: "invalid duration",
+			config: `
+alertmanager_config: |
+  global:
+    resolve_timeout: 5 minutes
+  route:
+    receiver: default
+  receivers:
+    - name: default
+`,
+			expectedErrors: []string{`alertmanager_config: unknown unit " minutes" in duration "5 minutes"`},
+		},
+		{
+			name: "missing integration field",
+			config: `
+alertmanager_config: |
+  route:
+    receiver: default
+  receivers:
+    - name: default
+      webhook_configs:
+        - send_resolved: true
+`,
+			expectedErrors: []string{`alertmanager_config: one of url or url_file must be configured`},
+		},
+		{
+			name: "unknown integration",
+			config: `
+alertmanager_config: |
+  route:
+    receiver: default
+  receivers:
+    - name: default
+      sms_configs: []
+`,
+			expectedErrors: []string{`alertmanager_config: line 5: field sms_configs not found`},
+		},
+		{
+			name: "undefined time interval",
+			config: `
+alertmanager_config: |
+  route:
+    receiver: default
+    routes:
+      - matchers: [severity="critical"]
+        active_time_intervals: [business-hours]
+  receivers:
+    - name: default
+`,
+			expectedErrors: []string{`alertmanager_config: undefined time interval "business-hours" used in route`},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			_, errs := parseConfig([]byte(tc.config))
+			require.Equal(t, tc.expectedErrors, errs)
+		})
+	}
+}
+
+func TestTestRoutes(t *testing.T) {
+	t.Parallel()
+
+	config, errs := parseConfig([]byte(`
+alertmanager_config: |
+  route:
+    receiver: default
+    group_by: [alertname]
+    routes:
+      - matchers: [team="db"]
+        group_by: [instance]
+        routes:
+          - receiver: db-pager
+            matchers: [severity="critical"]
+      - receiver: audit
+        match:
+          audit: "true"
+        continue: true
+      - receiver: chat
+        matchers: ['severity=~"warning|critical"']
+      - receiver: never
+  receivers:
+    - name: default
+    - name: db-pager
+    - name: audit
+    - name: chat
+    - name: never
+`))
+	require.Empty(t, errs)
+
+	testCases := []struct {
+		name           string
+		labels         map[string]string
+		expectedResult *apiv2.AlertmanagerRouteTestResult
+	}{
+		{
+			name:   "no child route matches",
+			labels: map[string]string{"team": "web"},
+			expectedResult: &apiv2.AlertmanagerRouteTestResult{
+				Receivers: []string{"never"},
+				Routes:    []apiv2.AlertmanagerMatchedRoute{{Path: "route.routes[3]", Receiver: "never", GroupBy: []string{"alertname"}}},
+			},
+		},
+		{
+			name:   "child routes inherit the receiver and grouping",
+			labels: map[string]string{"team": "db", "severity": "warning"},
+			expectedResult: &apiv2.AlertmanagerRouteTestResult{
+				Receivers: []string{"default"},
+				Routes:    []apiv2.AlertmanagerMatchedRoute{{Path: "route.routes[0]", Receiver: "default", GroupBy: []string{"instance"}}},
+			},
+		},
+		{
+			name:   "nested route",
+			labels: map[string]string{"team": "db", "severity": "critical"},
+			expectedResult: &apiv2.AlertmanagerRouteTestResult{
+				Receivers: []string{"db-pager"},
+				Routes:    []apiv2.AlertmanagerMatchedRoute{{Path: "route.routes[0].routes[0]", Receiver: "db-pager", GroupBy: []string{"instance"}}},
+			},
+		},
+		{
+			name:   "continue to the next route",
+			labels: map[string]string{"audit": "true", "severity": "warning"},
+			expectedResult: &apiv2.AlertmanagerRouteTestResult{
+				Receivers: []string{"audit", "chat"},
+				Routes: []apiv2.AlertmanagerMatchedRoute{
+					{Path: "route.routes[1]", Receiver: "audit", GroupBy: []string{"alertname"}},
+					{Path: "route.routes[2]", Receiver: "chat", GroupBy: []string{"alertname"}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			t.Parallel()
+			require.Equal(t, tc.expectedResult, testRoutes(config, tc.labels))
+		})
+	}
+}
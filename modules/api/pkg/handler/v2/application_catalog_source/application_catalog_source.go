@@ -0,0 +1,142 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationcatalogsource
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func ListEndpoint(userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return handlercommon.ListApplicationCatalogSourcesEndpoint(ctx, userInfoGetter, sourceProvider)
+	}
+}
+
+func GetEndpoint(userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(sourceReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, sourceReq{})
+		}
+		return handlercommon.GetApplicationCatalogSourceEndpoint(ctx, userInfoGetter, sourceProvider, req.SourceName)
+	}
+}
+
+func CreateEndpoint(userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(createSourceReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, createSourceReq{})
+		}
+		return handlercommon.CreateApplicationCatalogSourceEndpoint(ctx, userInfoGetter, sourceProvider, req.Body)
+	}
+}
+
+func UpdateEndpoint(userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updateSourceReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, updateSourceReq{})
+		}
+		return handlercommon.UpdateApplicationCatalogSourceEndpoint(ctx, userInfoGetter, sourceProvider, req.SourceName, req.Body)
+	}
+}
+
+func DeleteEndpoint(userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(sourceReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, sourceReq{})
+		}
+		return nil, handlercommon.DeleteApplicationCatalogSourceEndpoint(ctx, userInfoGetter, sourceProvider, req.SourceName)
+	}
+}
+
+func SyncEndpoint(userInfoGetter provider.UserInfoGetter, sourceProvider provider.ApplicationCatalogSourceProvider, appDefProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(sourceReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, sourceReq{})
+		}
+		return handlercommon.SyncApplicationCatalogSourceEndpoint(ctx, userInfoGetter, sourceProvider, appDefProvider, req.SourceName)
+	}
+}
+
+// sourceReq defines HTTP request for getApplicationCatalogSource, deleteApplicationCatalogSource and
+// syncApplicationCatalogSource
+// swagger:parameters getApplicationCatalogSource deleteApplicationCatalogSource syncApplicationCatalogSource
+type sourceReq struct {
+	// in: path
+	// required: true
+	SourceName string `json:"source_name"`
+}
+
+func DecodeSourceReq(_ context.Context, r *http.Request) (interface{}, error) {
+	sourceName := mux.Vars(r)["source_name"]
+	if sourceName == "" {
+		return nil, fmt.Errorf("'source_name' parameter is required but was not provided")
+	}
+	return sourceReq{SourceName: sourceName}, nil
+}
+
+// createSourceReq defines HTTP request for createApplicationCatalogSource
+// swagger:parameters createApplicationCatalogSource
+type createSourceReq struct {
+	// in: body
+	// required: true
+	Body apiv2.ApplicationCatalogSourceBody
+}
+
+func DecodeCreateSourceReq(_ context.Context, r *http.Request) (interface{}, error) {
+	req := createSourceReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	return req, nil
+}
+
+// updateSourceReq defines HTTP request for updateApplicationCatalogSource
+// swagger:parameters updateApplicationCatalogSource
+type updateSourceReq struct {
+	sourceReq
+	// in: body
+	// required: true
+	Body apiv2.ApplicationCatalogSourceBody
+}
+
+func DecodeUpdateSourceReq(c context.Context, r *http.Request) (interface{}, error) {
+	source, err := DecodeSourceReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req := updateSourceReq{sourceReq: source.(sourceReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	return req, nil
+}
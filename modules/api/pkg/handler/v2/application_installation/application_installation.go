@@ -213,6 +213,50 @@ func UpdateApplicationInstallation(userInfoGetter provider.UserInfoGetter, proje
 	}
 }
 
+func PreviewApplicationInstallation(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, appDefProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(previewApplicationInstallationReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		return handlercommon.PreviewApplicationInstallationEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, appDefProvider,
+			req.ProjectID, req.ClusterID, req.Namespace, req.ApplicationInstallationName, req.Body)
+	}
+}
+
+func ListApplicationInstallationRevisions(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, appDefProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(listApplicationInstallationRevisionsReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		return handlercommon.ListApplicationInstallationRevisionsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, appDefProvider,
+			req.ProjectID, req.ClusterID, req.Namespace, req.ApplicationInstallationName)
+	}
+}
+
+func RollbackApplicationInstallation(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, appDefProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (response interface{}, err error) {
+		req, ok := request.(rollbackApplicationInstallationReq)
+		if !ok {
+			return nil, utilerrors.NewBadRequest("invalid request")
+		}
+
+		appInstall, err := handlercommon.RollbackApplicationInstallationEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, appDefProvider,
+			req.ProjectID, req.ClusterID, req.Namespace, req.ApplicationInstallationName, req.Body)
+		if err != nil {
+			return nil, err
+		}
+
+		return convertInternalToAPIApplicationInstallation(appInstall), nil
+	}
+}
+
 func genericNamespaceReconciler(name string) reconciling.NamedNamespaceReconcilerFactory {
 	return func() (string, reconciling.NamespaceReconciler) {
 		return name, func(n *corev1.Namespace) (*corev1.Namespace, error) {
@@ -96,6 +96,48 @@ type updateApplicationInstallationReq struct {
 	Body apiv2.ApplicationInstallationBody
 }
 
+// previewApplicationInstallationReq defines HTTP request for previewApplicationInstallation
+// swagger:parameters previewApplicationInstallation
+type previewApplicationInstallationReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+
+	// in: path
+	Namespace string `json:"namespace"`
+
+	// in: path
+	ApplicationInstallationName string `json:"appinstall_name"`
+
+	// in: body
+	// required: true
+	Body apiv2.ApplicationInstallationPreviewBody
+}
+
+// listApplicationInstallationRevisionsReq defines HTTP request for listApplicationInstallationRevisions
+// swagger:parameters listApplicationInstallationRevisions
+type listApplicationInstallationRevisionsReq struct {
+	getApplicationInstallationReq
+}
+
+// rollbackApplicationInstallationReq defines HTTP request for rollbackApplicationInstallation
+// swagger:parameters rollbackApplicationInstallation
+type rollbackApplicationInstallationReq struct {
+	common.ProjectReq
+	// in: path
+	ClusterID string `json:"cluster_id"`
+
+	// in: path
+	Namespace string `json:"namespace"`
+
+	// in: path
+	ApplicationInstallationName string `json:"appinstall_name"`
+
+	// in: body
+	// required: true
+	Body apiv2.ApplicationInstallationRollbackBody
+}
+
 func DecodeListApplicationInstallations(c context.Context, r *http.Request) (interface{}, error) {
 	var req listApplicationInstallationsReq
 
@@ -259,6 +301,67 @@ func (req updateApplicationInstallationReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
+func DecodePreviewApplicationInstallation(c context.Context, r *http.Request) (interface{}, error) {
+	var req previewApplicationInstallationReq
+
+	getReq, err := DecodeGetApplicationInstallation(c, r)
+	if err != nil {
+		return nil, err
+	}
+	appInstallReq := getReq.(getApplicationInstallationReq)
+	req.ProjectReq = appInstallReq.ProjectReq
+	req.ClusterID = appInstallReq.ClusterID
+	req.Namespace = appInstallReq.Namespace
+	req.ApplicationInstallationName = appInstallReq.ApplicationInstallationName
+
+	if err = json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (req previewApplicationInstallationReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeListApplicationInstallationRevisions(c context.Context, r *http.Request) (interface{}, error) {
+	req, err := DecodeGetApplicationInstallation(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return listApplicationInstallationRevisionsReq{req.(getApplicationInstallationReq)}, nil
+}
+
+func DecodeRollbackApplicationInstallation(c context.Context, r *http.Request) (interface{}, error) {
+	var req rollbackApplicationInstallationReq
+
+	getReq, err := DecodeGetApplicationInstallation(c, r)
+	if err != nil {
+		return nil, err
+	}
+	appInstallReq := getReq.(getApplicationInstallationReq)
+	req.ProjectReq = appInstallReq.ProjectReq
+	req.ClusterID = appInstallReq.ClusterID
+	req.Namespace = appInstallReq.Namespace
+	req.ApplicationInstallationName = appInstallReq.ApplicationInstallationName
+
+	if err = json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+func (req rollbackApplicationInstallationReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
 func DecodeApplicationInstallationName(c context.Context, r *http.Request) (string, error) {
 	appInstallName := mux.Vars(r)["appinstall_name"]
 	if appInstallName == "" {
@@ -0,0 +1,181 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package applicationrollout
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func ListEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	rolloutProvider provider.ApplicationRolloutProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(listRolloutsReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, listRolloutsReq{})
+		}
+		return handlercommon.ListApplicationRolloutsEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, rolloutProvider, req.ProjectID)
+	}
+}
+
+func GetEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	rolloutProvider provider.ApplicationRolloutProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(rolloutReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, rolloutReq{})
+		}
+		return handlercommon.GetApplicationRolloutEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, rolloutProvider, req.ProjectID, req.RolloutName)
+	}
+}
+
+func CreateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	rolloutProvider provider.ApplicationRolloutProvider, appDefProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(createRolloutReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, createRolloutReq{})
+		}
+		return handlercommon.CreateApplicationRolloutEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, rolloutProvider, appDefProvider, req.ProjectID, req.Body)
+	}
+}
+
+func UpdateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	rolloutProvider provider.ApplicationRolloutProvider, appDefProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updateRolloutReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, updateRolloutReq{})
+		}
+		return handlercommon.UpdateApplicationRolloutEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, rolloutProvider, appDefProvider, req.ProjectID, req.RolloutName, req.Body)
+	}
+}
+
+func DeleteEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	rolloutProvider provider.ApplicationRolloutProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(rolloutReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, rolloutReq{})
+		}
+		return nil, handlercommon.DeleteApplicationRolloutEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, rolloutProvider, req.ProjectID, req.RolloutName)
+	}
+}
+
+func RetryEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	rolloutProvider provider.ApplicationRolloutProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(rolloutReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, rolloutReq{})
+		}
+		return handlercommon.RetryApplicationRolloutEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, rolloutProvider, req.ProjectID, req.RolloutName)
+	}
+}
+
+// listRolloutsReq defines HTTP request for listApplicationRollouts
+// swagger:parameters listApplicationRollouts
+type listRolloutsReq struct {
+	common.ProjectReq
+}
+
+func DecodeListRolloutsReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return listRolloutsReq{ProjectReq: pr.(common.ProjectReq)}, nil
+}
+
+// rolloutReq defines HTTP request for getApplicationRollout, deleteApplicationRollout and retryApplicationRollout
+// swagger:parameters getApplicationRollout deleteApplicationRollout retryApplicationRollout
+type rolloutReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	RolloutName string `json:"rollout_name"`
+}
+
+func DecodeRolloutReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	rolloutName := mux.Vars(r)["rollout_name"]
+	if rolloutName == "" {
+		return nil, fmt.Errorf("'rollout_name' parameter is required but was not provided")
+	}
+
+	return rolloutReq{ProjectReq: pr.(common.ProjectReq), RolloutName: rolloutName}, nil
+}
+
+// createRolloutReq defines HTTP request for createApplicationRollout
+// swagger:parameters createApplicationRollout
+type createRolloutReq struct {
+	common.ProjectReq
+	// in: body
+	// required: true
+	Body apiv2.ApplicationRolloutBody
+}
+
+func DecodeCreateRolloutReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := createRolloutReq{ProjectReq: pr.(common.ProjectReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	return req, nil
+}
+
+// updateRolloutReq defines HTTP request for updateApplicationRollout
+// swagger:parameters updateApplicationRollout
+type updateRolloutReq struct {
+	rolloutReq
+	// in: body
+	// required: true
+	Body apiv2.ApplicationRolloutBody
+}
+
+func DecodeUpdateRolloutReq(c context.Context, r *http.Request) (interface{}, error) {
+	rollout, err := DecodeRolloutReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateRolloutReq{rolloutReq: rollout.(rolloutReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	return req, nil
+}
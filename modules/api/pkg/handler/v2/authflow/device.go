@@ -0,0 +1,573 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authflow
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/json"
+	"fmt"
+	"html/template"
+	"math/big"
+	"net/http"
+	"net/url"
+	"strings"
+	"sync"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/kubermatic/v2/pkg/log"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/client-go/tools/clientcmd"
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+	"k8s.io/client-go/util/flowcontrol"
+)
+
+// The device authorization grant (RFC 8628) lets CLIs on hosts without a browser log in:
+//
+//  1. The device asks /auth/device/code for a device code and a user code.
+//  2. The user enters the user code on the verification page /auth/device, which posts it to
+//     /auth/device/approve. The user logs in with the default issuer once more, the callback hands
+//     the tokens of this login over to the device authorization.
+//  3. The device polls /auth/device/token with its device code until it receives the tokens.
+//
+// The device gets its own tokens, so neither refreshing nor ending the browser session affects it.
+const (
+	// deviceVerificationPath is the page on which users enter the user code.
+	deviceVerificationPath = "/api/v2/auth/device"
+	deviceCodeLifetime     = 10 * time.Minute
+	// devicePollInterval is the minimum time between two polls of a device.
+	devicePollInterval = 5 * time.Second
+
+	// The device code endpoint is not authenticated and every device code is stored, so the codes a
+	// client can ask for are limited by its IP and the stored authorizations are capped.
+	deviceCodeClientQPS     = 0.1
+	deviceCodeClientBurst   = 5
+	maxDeviceAuthorizations = 500
+
+	deviceCodeGrantType   = "urn:ietf:params:oauth:grant-type:device_code"
+	refreshTokenGrantType = "refresh_token"
+
+	// userCodeAlphabet has no vowels, so user codes do not form words, and no characters which are
+	// easily confused.
+	userCodeAlphabet = "BCDFGHJKLMNPQRSTVWXZ"
+	userCodeLength   = 8
+)
+
+// DeviceKubeconfigGetter returns an OIDC kubeconfig of a cluster for the user who approved a device
+// authorization. The kubeconfig authenticates with the given tokens.
+type DeviceKubeconfigGetter func(ctx context.Context, userEmail, projectID, clusterID string, oidcTokens authtypes.OIDCToken) (*clientcmdapi.Config, error)
+
+type deviceCodeResponse struct {
+	DeviceCode              string `json:"device_code"`
+	UserCode                string `json:"user_code"`
+	VerificationURI         string `json:"verification_uri"`
+	VerificationURIComplete string `json:"verification_uri_complete"`
+	ExpiresIn               int64  `json:"expires_in"`
+	Interval                int64  `json:"interval"`
+}
+
+type deviceTokenResponse struct {
+	// AccessToken is the id_token of the user, the API accepts it as bearer token.
+	AccessToken  string `json:"access_token"`
+	TokenType    string `json:"token_type"`
+	ExpiresIn    int64  `json:"expires_in"`
+	RefreshToken string `json:"refresh_token,omitempty"`
+	IDToken      string `json:"id_token"`
+	// Kubeconfig is the OIDC kubeconfig of the cluster the device asked for.
+	Kubeconfig string `json:"kubeconfig,omitempty"`
+}
+
+type deviceErrorResponse struct {
+	Error            string `json:"error"`
+	ErrorDescription string `json:"error_description,omitempty"`
+}
+
+// deviceCodeHandler starts a device authorization. The device can ask for an OIDC kubeconfig of a
+// cluster by passing project_id and cluster_id.
+func (a *authHandler) deviceCodeHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !a.deviceCodeLimiter.allow(a.trustedProxies.ClientIP(r)) {
+			writeDeviceError(w, http.StatusTooManyRequests, "slow_down", "too many device codes were requested from this address")
+			return
+		}
+		if err := r.ParseForm(); err != nil {
+			writeDeviceError(w, http.StatusBadRequest, "invalid_request", fmt.Sprintf("failed to parse the form: %v", err))
+			return
+		}
+		projectID := r.PostForm.Get("project_id")
+		clusterID := r.PostForm.Get("cluster_id")
+		if (projectID == "") != (clusterID == "") {
+			writeDeviceError(w, http.StatusBadRequest, "invalid_request", "project_id and cluster_id must be passed together")
+			return
+		}
+
+		count, err := a.deviceAuthorizationProvider.Count(r.Context())
+		if err != nil {
+			writeDeviceError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("failed to count device authorizations: %v", err))
+			return
+		}
+		if count >= maxDeviceAuthorizations {
+			writeDeviceError(w, http.StatusTooManyRequests, "slow_down", "too many device authorizations are pending")
+			return
+		}
+
+		deviceCode, err := randomURLSafeString(32)
+		if err != nil {
+			writeDeviceError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("failed to generate device code: %v", err))
+			return
+		}
+		userCode, err := a.newUserCode(r.Context())
+		if err != nil {
+			writeDeviceError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("failed to generate user code: %v", err))
+			return
+		}
+
+		authorization := &provider.DeviceAuthorization{
+			UserCode:  userCode,
+			ProjectID: projectID,
+			ClusterID: clusterID,
+			Status:    provider.DeviceAuthorizationPending,
+			Expiry:    time.Now().Add(deviceCodeLifetime),
+		}
+		if err := a.deviceAuthorizationProvider.Create(r.Context(), deviceCode, authorization); err != nil {
+			writeDeviceError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("failed to create device authorization: %v", err))
+			return
+		}
+
+		verificationURI := a.getBaseURL(r) + deviceVerificationPath
+		writeDeviceJSON(w, http.StatusOK, deviceCodeResponse{
+			DeviceCode:              deviceCode,
+			UserCode:                formatUserCode(userCode),
+			VerificationURI:         verificationURI,
+			VerificationURIComplete: verificationURI + "?" + url.Values{"user_code": {formatUserCode(userCode)}}.Encode(),
+			ExpiresIn:               int64(deviceCodeLifetime.Seconds()),
+			Interval:                int64(devicePollInterval.Seconds()),
+		})
+	})
+}
+
+// deviceVerificationPage is rendered by the API, so the device flow does not depend on the dashboard.
+var deviceVerificationPage = template.Must(template.New("device").Parse(`<!DOCTYPE html>
+<html lang="en">
+<head>
+<meta charset="utf-8">
+<meta name="viewport" content="width=device-width, initial-scale=1">
+<title>Device Login</title>
+</head>
+<body>
+<main>
+<h1>Device Login</h1>
+{{- if eq .Status "approved"}}
+<p>The device has been logged in, you can close this page.</p>
+{{- else if eq .Status "denied"}}
+<p>The device has been denied, you can close this page.</p>
+{{- else if not .Email}}
+<p>Log in to approve a device.</p>
+<p><a href="{{.LoginURL}}">Log in</a></p>
+{{- else}}
+<p>Logged in as {{.Email}}. Enter the code shown on the device and only approve devices you are logging in yourself.</p>
+<form method="post" action="{{.ApproveURL}}">
+<label for="user_code">Code</label>
+<input id="user_code" name="user_code" value="{{.UserCode}}" autocomplete="off" required>
+<button type="submit">Approve</button>
+<button type="submit" formaction="{{.DenyURL}}">Deny</button>
+</form>
+{{- end}}
+</main>
+</body>
+</html>
+`))
+
+type deviceVerificationPageData struct {
+	Status     string
+	Email      string
+	UserCode   string
+	LoginURL   string
+	ApproveURL string
+	DenyURL    string
+}
+
+// deviceVerificationHandler renders the page on which the logged in user approves or denies a device. Users
+// which are not logged in are sent back to the page after the login.
+func (a *authHandler) deviceVerificationHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		userCode := r.URL.Query().Get("user_code")
+		data := deviceVerificationPageData{
+			Status:     r.URL.Query().Get("status"),
+			UserCode:   userCode,
+			ApproveURL: deviceVerificationPath + "/approve",
+			DenyURL:    deviceVerificationPath + "/deny",
+		}
+
+		if tokenValue, err := a.tokenExtractor.Extract(r); err == nil {
+			if claims, err := a.tokenVerifier.Verify(r.Context(), tokenValue); err == nil {
+				data.Email = claims.Email
+			}
+		}
+		if data.Email == "" {
+			returnTo := deviceVerificationPath
+			if userCode != "" {
+				returnTo += "?" + url.Values{"user_code": {userCode}}.Encode()
+			}
+			data.LoginURL = loginPath + "?" + url.Values{"return_to": {returnTo}}.Encode()
+		}
+
+		w.Header().Set("Content-Type", "text/html; charset=utf-8")
+		w.Header().Set("Cache-Control", "no-store")
+		// the approval must not be clicked through a frame of another site
+		w.Header().Set("X-Frame-Options", "DENY")
+		w.Header().Set("Content-Security-Policy", "default-src 'none'; frame-ancestors 'none'")
+		if err := deviceVerificationPage.Execute(w, data); err != nil {
+			log.Logger.Debugw("Failed to render the device verification page", "error", err)
+		}
+	})
+}
+
+// deviceReturnPath returns the device verification page of the given path to return to after the login.
+// Other paths are not accepted, the login must not redirect to arbitrary sites.
+func deviceReturnPath(returnTo string) string {
+	u, err := url.Parse(returnTo)
+	if err != nil || u.Scheme != "" || u.Host != "" || u.Path != deviceVerificationPath {
+		return ""
+	}
+	return (&url.URL{Path: deviceVerificationPath, RawQuery: u.Query().Encode()}).String()
+}
+
+// deviceDecisionHandler lets the logged in user approve or deny the device authorization of the posted user
+// code. The user must be logged in with a cookie or bearer token, both cannot be sent with cross-site forms.
+func (a *authHandler) deviceDecisionHandler(approve bool) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		tokenValue, err := a.tokenExtractor.Extract(r)
+		if err != nil {
+			http.Error(w, "not logged in", http.StatusUnauthorized)
+			return
+		}
+		claims, err := a.tokenVerifier.Verify(r.Context(), tokenValue)
+		if err != nil || claims.Email == "" {
+			http.Error(w, "invalid token", http.StatusUnauthorized)
+			return
+		}
+
+		authorization, ok := a.pendingDeviceAuthorization(w, r, normalizeUserCode(r.PostFormValue("user_code")))
+		if !ok {
+			return
+		}
+
+		// The device gets tokens of its own, so the user logs in once more to approve it.
+		if approve {
+			a.redirectToIssuer(w, r, a.oidcIssuerVerifier, oauthStateCookie{
+				DeviceUserCode: authorization.UserCode,
+				DeviceApprover: claims.Email,
+			}, false)
+			return
+		}
+
+		authorization.Status = provider.DeviceAuthorizationDenied
+		authorization.UserEmail = claims.Email
+		if err := a.deviceAuthorizationProvider.Update(r.Context(), authorization); err != nil {
+			http.Error(w, fmt.Sprintf("failed to deny device authorization: %v", err), http.StatusInternalServerError)
+			return
+		}
+		http.Redirect(w, r, deviceVerificationPath+"?status=denied", http.StatusSeeOther)
+	})
+}
+
+// completeDeviceApproval hands the tokens of the login that approved a device authorization over to the device.
+func (a *authHandler) completeDeviceApproval(w http.ResponseWriter, r *http.Request, state oauthStateCookie, claims authtypes.TokenClaims, oidcTokens authtypes.OIDCToken) {
+	// The approver could have logged in as another user with the issuer, the device must not get their tokens.
+	if !strings.EqualFold(claims.Email, state.DeviceApprover) {
+		http.Error(w, "the device must be approved by the logged in user", http.StatusForbidden)
+		return
+	}
+
+	authorization, ok := a.pendingDeviceAuthorization(w, r, state.DeviceUserCode)
+	if !ok {
+		return
+	}
+
+	authorization.Status = provider.DeviceAuthorizationApproved
+	authorization.UserEmail = claims.Email
+	authorization.IDToken = oidcTokens.IDToken
+	authorization.RefreshToken = oidcTokens.RefreshToken
+	if err := a.deviceAuthorizationProvider.Update(r.Context(), authorization); err != nil {
+		http.Error(w, fmt.Sprintf("failed to approve device authorization: %v", err), http.StatusInternalServerError)
+		return
+	}
+	http.Redirect(w, r, deviceVerificationPath+"?status=approved", http.StatusSeeOther)
+}
+
+// pendingDeviceAuthorization returns the device authorization of the user code which has not been decided on yet.
+func (a *authHandler) pendingDeviceAuthorization(w http.ResponseWriter, r *http.Request, userCode string) (*provider.DeviceAuthorization, bool) {
+	if userCode == "" {
+		http.Error(w, "missing user_code", http.StatusBadRequest)
+		return nil, false
+	}
+	authorization, err := a.deviceAuthorizationProvider.GetByUserCode(r.Context(), userCode)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			http.Error(w, "unknown or expired user code", http.StatusNotFound)
+			return nil, false
+		}
+		http.Error(w, fmt.Sprintf("failed to get device authorization: %v", err), http.StatusInternalServerError)
+		return nil, false
+	}
+	if authorization.Status != provider.DeviceAuthorizationPending {
+		http.Error(w, "the device authorization has already been decided on", http.StatusConflict)
+		return nil, false
+	}
+	return authorization, true
+}
+
+// deviceTokenHandler is the token endpoint of the device. Besides redeeming the device code it refreshes the
+// tokens of the device.
+func (a *authHandler) deviceTokenHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if err := r.ParseForm(); err != nil {
+			writeDeviceError(w, http.StatusBadRequest, "invalid_request", fmt.Sprintf("failed to parse the form: %v", err))
+			return
+		}
+
+		switch grantType := r.PostForm.Get("grant_type"); grantType {
+		case deviceCodeGrantType:
+			a.redeemDeviceCode(w, r)
+		case refreshTokenGrantType:
+			a.refreshDeviceTokens(w, r)
+		default:
+			writeDeviceError(w, http.StatusBadRequest, "unsupported_grant_type", fmt.Sprintf("unsupported grant type %q", grantType))
+		}
+	})
+}
+
+func (a *authHandler) redeemDeviceCode(w http.ResponseWriter, r *http.Request) {
+	deviceCode := r.PostForm.Get("device_code")
+	if deviceCode == "" {
+		writeDeviceError(w, http.StatusBadRequest, "invalid_request", "missing device_code")
+		return
+	}
+
+	authorization, err := a.deviceAuthorizationProvider.Poll(r.Context(), deviceCode)
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			writeDeviceError(w, http.StatusBadRequest, "expired_token", "the device code is unknown or expired")
+			return
+		}
+		writeDeviceError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("failed to get device authorization: %v", err))
+		return
+	}
+
+	switch authorization.Status {
+	case provider.DeviceAuthorizationApproved:
+		response, err := a.deviceTokenResponse(r.Context(), authorization.UserEmail, authorization.ProjectID, authorization.ClusterID, authtypes.OIDCToken{
+			IDToken:      authorization.IDToken,
+			RefreshToken: authorization.RefreshToken,
+		})
+		if err != nil {
+			writeDeviceError(w, http.StatusInternalServerError, "server_error", err.Error())
+			return
+		}
+		// The device code can only be redeemed once.
+		if err := a.deviceAuthorizationProvider.Delete(r.Context(), authorization.ID); err != nil {
+			writeDeviceError(w, http.StatusInternalServerError, "server_error", fmt.Sprintf("failed to delete device authorization: %v", err))
+			return
+		}
+		writeDeviceJSON(w, http.StatusOK, response)
+
+	case provider.DeviceAuthorizationDenied:
+		if err := a.deviceAuthorizationProvider.Delete(r.Context(), authorization.ID); err != nil {
+			log.Logger.Debugw("Failed to delete the denied device authorization", "authorization", authorization.ID, "error", err)
+		}
+		writeDeviceError(w, http.StatusBadRequest, "access_denied", "the user denied the device authorization")
+
+	default:
+		if time.Since(authorization.LastPoll) < devicePollInterval {
+			writeDeviceError(w, http.StatusBadRequest, "slow_down", "")
+			return
+		}
+		writeDeviceError(w, http.StatusBadRequest, "authorization_pending", "")
+	}
+}
+
+func (a *authHandler) refreshDeviceTokens(w http.ResponseWriter, r *http.Request) {
+	refreshToken := r.PostForm.Get("refresh_token")
+	if refreshToken == "" {
+		writeDeviceError(w, http.StatusBadRequest, "invalid_request", "missing refresh_token")
+		return
+	}
+
+	oidcTokens, err := a.oidcIssuerVerifier.RefreshAccessToken(r.Context(), refreshToken)
+	if err != nil {
+		writeDeviceError(w, http.StatusBadRequest, "invalid_grant", "token refresh failed")
+		return
+	}
+	// the refresh token is kept unless the issuer rotated it
+	if oidcTokens.RefreshToken == "" {
+		oidcTokens.RefreshToken = refreshToken
+	}
+
+	response, err := a.deviceTokenResponse(r.Context(), "", "", "", oidcTokens)
+	if err != nil {
+		writeDeviceError(w, http.StatusBadRequest, "invalid_grant", err.Error())
+		return
+	}
+	writeDeviceJSON(w, http.StatusOK, response)
+}
+
+// deviceTokenResponse returns the token response with the given tokens of the user. A kubeconfig is added if a
+// cluster is given.
+func (a *authHandler) deviceTokenResponse(ctx context.Context, userEmail, projectID, clusterID string, oidcTokens authtypes.OIDCToken) (*deviceTokenResponse, error) {
+	claims, err := a.oidcIssuerVerifier.Verify(ctx, oidcTokens.IDToken)
+	if err != nil {
+		return nil, fmt.Errorf("failed to verify id_token: %w", err)
+	}
+	if claims.Email == "" {
+		return nil, fmt.Errorf("email claim is missing from id_token")
+	}
+	if userEmail != "" && !strings.EqualFold(claims.Email, userEmail) {
+		return nil, fmt.Errorf("the id_token belongs to another user")
+	}
+
+	response := &deviceTokenResponse{
+		AccessToken:  oidcTokens.IDToken,
+		TokenType:    "Bearer",
+		ExpiresIn:    int64(time.Until(claims.Expiry.Time).Seconds()),
+		RefreshToken: oidcTokens.RefreshToken,
+		IDToken:      oidcTokens.IDToken,
+	}
+
+	if clusterID == "" {
+		return response, nil
+	}
+	if a.deviceKubeconfigGetter == nil {
+		return nil, fmt.Errorf("kubeconfigs are not available for devices")
+	}
+	kubeconfig, err := a.deviceKubeconfigGetter(ctx, claims.Email, projectID, clusterID, oidcTokens)
+	if err != nil {
+		return nil, fmt.Errorf("failed to create kubeconfig: %w", err)
+	}
+	rawKubeconfig, err := clientcmd.Write(*kubeconfig)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode kubeconfig: %w", err)
+	}
+	response.Kubeconfig = string(rawKubeconfig)
+	return response, nil
+}
+
+// newUserCode returns a random user code which is not used by another device authorization.
+func (a *authHandler) newUserCode(ctx context.Context) (string, error) {
+	for range 3 {
+		code := make([]byte, userCodeLength)
+		for i := range code {
+			n, err := rand.Int(rand.Reader, big.NewInt(int64(len(userCodeAlphabet))))
+			if err != nil {
+				return "", err
+			}
+			code[i] = userCodeAlphabet[n.Int64()]
+		}
+
+		_, err := a.deviceAuthorizationProvider.GetByUserCode(ctx, string(code))
+		if apierrors.IsNotFound(err) {
+			return string(code), nil
+		}
+		if err != nil {
+			return "", err
+		}
+	}
+	return "", fmt.Errorf("failed to find an unused user code")
+}
+
+// formatUserCode splits the user code in two halves for readability.
+func formatUserCode(userCode string) string {
+	return userCode[:userCodeLength/2] + "-" + userCode[userCodeLength/2:]
+}
+
+// normalizeUserCode accepts user codes as users type them, in lower case and with or without dashes.
+func normalizeUserCode(userCode string) string {
+	return strings.Map(func(r rune) rune {
+		if r == '-' || r == ' ' {
+			return -1
+		}
+		return r
+	}, strings.ToUpper(strings.TrimSpace(userCode)))
+}
+
+// clientRateLimiter limits the requests of each client. The limiters of clients which have not sent
+// a request for a while are dropped, their buckets would be full again anyway.
+type clientRateLimiter struct {
+	qps   float32
+	burst int
+
+	lock      sync.Mutex
+	clients   map[string]*clientLimiter
+	lastPrune time.Time
+}
+
+type clientLimiter struct {
+	limiter  flowcontrol.RateLimiter
+	lastSeen time.Time
+}
+
+func newClientRateLimiter(qps float32, burst int) *clientRateLimiter {
+	return &clientRateLimiter{
+		qps:       qps,
+		burst:     burst,
+		clients:   map[string]*clientLimiter{},
+		lastPrune: time.Now(),
+	}
+}
+
+// allow reports whether the client may send another request.
+func (l *clientRateLimiter) allow(client string) bool {
+	l.lock.Lock()
+	defer l.lock.Unlock()
+
+	now := time.Now()
+	// the time in which an unused bucket fills up again
+	idle := time.Duration(float64(l.burst) / float64(l.qps) * float64(time.Second))
+	if now.Sub(l.lastPrune) > idle {
+		for address, c := range l.clients {
+			if now.Sub(c.lastSeen) > idle {
+				delete(l.clients, address)
+			}
+		}
+		l.lastPrune = now
+	}
+
+	c, ok := l.clients[client]
+	if !ok {
+		c = &clientLimiter{limiter: flowcontrol.NewTokenBucketRateLimiter(l.qps, l.burst)}
+		l.clients[client] = c
+	}
+	c.lastSeen = now
+	return c.limiter.TryAccept()
+}
+
+func writeDeviceError(w http.ResponseWriter, status int, code, description string) {
+	writeDeviceJSON(w, status, deviceErrorResponse{Error: code, ErrorDescription: description})
+}
+
+// writeDeviceJSON writes a response of the device endpoints, which must not be cached as they carry tokens.
+func writeDeviceJSON(w http.ResponseWriter, status int, response interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	w.Header().Set("Cache-Control", "no-store")
+	w.WriteHeader(status)
+	if err := json.NewEncoder(w).Encode(response); err != nil {
+		log.Logger.Debugw("Failed to encode the device response", "error", err)
+	}
+}
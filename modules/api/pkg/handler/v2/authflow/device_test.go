@@ -0,0 +1,422 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package authflow
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	"k8c.io/dashboard/v2/pkg/provider"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
+)
+
+const (
+	testDeviceCodeURL    = "http://localhost/api/v2/auth/device/code"
+	testDeviceApproveURL = "http://localhost/api/v2/auth/device/approve"
+	testDeviceDenyURL    = "http://localhost/api/v2/auth/device/deny"
+	testDeviceTokenURL   = "http://localhost/api/v2/auth/device/token"
+)
+
+// postForm posts the form to the handler with the given cookies.
+func postForm(handler http.Handler, target string, form url.Values, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+	req := httptest.NewRequest(http.MethodPost, target, strings.NewReader(form.Encode()))
+	req.Header.Set("Content-Type", "application/x-www-form-urlencoded")
+	for _, c := range cookies {
+		req.AddCookie(c)
+	}
+	rec := httptest.NewRecorder()
+	handler.ServeHTTP(rec, req)
+	return rec
+}
+
+// requestDeviceCode starts a device authorization with the given form.
+func requestDeviceCode(t *testing.T, h *authHandler, form url.Values) deviceCodeResponse {
+	t.Helper()
+	rec := postForm(h.deviceCodeHandler(), testDeviceCodeURL, form)
+	if rec.Code != http.StatusOK {
+		t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+	}
+	var response deviceCodeResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	return response
+}
+
+// pollDeviceToken redeems the device code once and returns the recorded response.
+func pollDeviceToken(h *authHandler, deviceCode string) *httptest.ResponseRecorder {
+	return postForm(h.deviceTokenHandler(), testDeviceTokenURL, url.Values{
+		"grant_type":  {deviceCodeGrantType},
+		"device_code": {deviceCode},
+	})
+}
+
+// assertDeviceError fails the test if the response is not the given error of the token endpoint.
+func assertDeviceError(t *testing.T, rec *httptest.ResponseRecorder, wantError string) {
+	t.Helper()
+	var response deviceErrorResponse
+	if err := json.NewDecoder(rec.Body).Decode(&response); err != nil {
+		t.Fatalf("failed to decode response: %v", err)
+	}
+	if response.Error != wantError {
+		t.Errorf("expected error %q, got %q (%s)", wantError, response.Error, response.ErrorDescription)
+	}
+}
+
+func TestDeviceCodeHandler(t *testing.T) {
+	h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+
+	response := requestDeviceCode(t, h, url.Values{"project_id": {"my-project"}, "cluster_id": {"my-cluster"}})
+	if response.DeviceCode == "" {
+		t.Error("expected a device code")
+	}
+	if len(response.UserCode) != userCodeLength+1 || strings.Trim(response.UserCode, userCodeAlphabet+"-") != "" {
+		t.Errorf("expected a user code of the user code alphabet, got %q", response.UserCode)
+	}
+	if response.VerificationURI != "http://localhost/api/v2/auth/device" {
+		t.Errorf("expected verification URI %q, got %q", "http://localhost/api/v2/auth/device", response.VerificationURI)
+	}
+	if want := "http://localhost/api/v2/auth/device?user_code=" + response.UserCode; response.VerificationURIComplete != want {
+		t.Errorf("expected complete verification URI %q, got %q", want, response.VerificationURIComplete)
+	}
+	if response.ExpiresIn != int64(deviceCodeLifetime.Seconds()) || response.Interval != int64(devicePollInterval.Seconds()) {
+		t.Errorf("unexpected expiry %d or interval %d", response.ExpiresIn, response.Interval)
+	}
+
+	authorization, err := h.deviceAuthorizationProvider.GetByUserCode(context.Background(), normalizeUserCode(response.UserCode))
+	if err != nil {
+		t.Fatalf("failed to get the device authorization: %v", err)
+	}
+	if authorization.ProjectID != "my-project" || authorization.ClusterID != "my-cluster" {
+		t.Errorf("expected the cluster of the request, got %q/%q", authorization.ProjectID, authorization.ClusterID)
+	}
+
+	rec := postForm(h.deviceCodeHandler(), testDeviceCodeURL, url.Values{"cluster_id": {"my-cluster"}})
+	if rec.Code != http.StatusBadRequest {
+		t.Errorf("expected status %d for a cluster without project, got %d", http.StatusBadRequest, rec.Code)
+	}
+	assertDeviceError(t, rec, "invalid_request")
+}
+
+func TestDeviceCodeLimits(t *testing.T) {
+	t.Run("clients are rate limited by their address", func(t *testing.T) {
+		h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+
+		// the requests before the parameter check count as well
+		for range deviceCodeClientBurst {
+			postForm(h.deviceCodeHandler(), testDeviceCodeURL, url.Values{"cluster_id": {"my-cluster"}})
+		}
+		rec := postForm(h.deviceCodeHandler(), testDeviceCodeURL, nil)
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+		}
+		assertDeviceError(t, rec, "slow_down")
+
+		req := httptest.NewRequest(http.MethodPost, testDeviceCodeURL, nil)
+		req.RemoteAddr = "198.51.100.7:4711"
+		rec = httptest.NewRecorder()
+		h.deviceCodeHandler().ServeHTTP(rec, req)
+		if rec.Code != http.StatusOK {
+			t.Errorf("expected another client to get a device code, got status %d", rec.Code)
+		}
+	})
+
+	t.Run("pending authorizations are capped", func(t *testing.T) {
+		h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+		for i := range maxDeviceAuthorizations {
+			if err := h.deviceAuthorizationProvider.Create(context.Background(), fmt.Sprintf("device-code-%d", i), &provider.DeviceAuthorization{
+				UserCode: fmt.Sprintf("%08d", i),
+				Expiry:   time.Now().Add(deviceCodeLifetime),
+			}); err != nil {
+				t.Fatalf("failed to create device authorization: %v", err)
+			}
+		}
+
+		rec := postForm(h.deviceCodeHandler(), testDeviceCodeURL, nil)
+		if rec.Code != http.StatusTooManyRequests {
+			t.Fatalf("expected status %d, got %d", http.StatusTooManyRequests, rec.Code)
+		}
+		assertDeviceError(t, rec, "slow_down")
+	})
+}
+
+func TestDeviceVerificationHandler(t *testing.T) {
+	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
+	getPage := func(h *authHandler, query string, cookies ...*http.Cookie) *httptest.ResponseRecorder {
+		req := httptest.NewRequest(http.MethodGet, "http://localhost"+deviceVerificationPath+"?"+query, nil)
+		for _, c := range cookies {
+			req.AddCookie(c)
+		}
+		rec := httptest.NewRecorder()
+		h.deviceVerificationHandler().ServeHTTP(rec, req)
+		return rec
+	}
+
+	t.Run("logged in user is asked for the user code", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+		session := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "browserIDToken"})
+
+		rec := getPage(h, "user_code=BCDF-GHJK", session)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		if rec.Header().Get("X-Frame-Options") != "DENY" {
+			t.Errorf("expected the page to deny framing, got X-Frame-Options %q", rec.Header().Get("X-Frame-Options"))
+		}
+		body := rec.Body.String()
+		for _, want := range []string{"john@acme.com", `value="BCDF-GHJK"`, `action="/api/v2/auth/device/approve"`, `formaction="/api/v2/auth/device/deny"`} {
+			if !strings.Contains(body, want) {
+				t.Errorf("expected the page to contain %q, got %q", want, body)
+			}
+		}
+	})
+
+	t.Run("user logs in and returns to the page", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		verifier := &fakeVerifier{secureCookie: sc, exchangeToken: authtypes.OIDCToken{IDToken: "fakeTokenId"}}
+		h := newTestHandler(verifier, nil, nil)
+
+		rec := getPage(h, "user_code=BCDF-GHJK")
+		if want := `href="/api/v2/auth/login?return_to=%2Fapi%2Fv2%2Fauth%2Fdevice%3Fuser_code%3DBCDF-GHJK"`; !strings.Contains(rec.Body.String(), want) {
+			t.Fatalf("expected the page to link the login with %q, got %q", want, rec.Body.String())
+		}
+
+		login := func(returnTo string) oauthStateCookie {
+			req := httptest.NewRequest(http.MethodGet, testLoginURL+"?"+url.Values{"return_to": {returnTo}}.Encode(), nil)
+			rec := httptest.NewRecorder()
+			h.loginHandler().ServeHTTP(rec, req)
+			var state oauthStateCookie
+			if err := sc.Decode(oauthStateCookieName, findSetCookie(rec.Result().Cookies(), oauthStateCookieName).Value, &state); err != nil {
+				t.Fatalf("failed to decode state cookie: %v", err)
+			}
+			return state
+		}
+		if state := login("https://evil.example.com/api/v2/auth/device"); state.ReturnTo != "" {
+			t.Errorf("expected the login not to return to another site, got %q", state.ReturnTo)
+		}
+		state := login("/api/v2/auth/device?user_code=BCDF-GHJK")
+		if state.ReturnTo != "/api/v2/auth/device?user_code=BCDF-GHJK" {
+			t.Fatalf("expected the login to return to the page, got %q", state.ReturnTo)
+		}
+
+		verifier.verifyClaims = authtypes.TokenClaims{Email: "john@acme.com", Nonce: state.Nonce, Expiry: futureExpiry}
+		rec = runCallback(h, encodeStateCookie(t, sc, state), url.Values{"state": {state.State}, "code": {"fakeCode"}}.Encode())
+		if rec.Code != http.StatusSeeOther || rec.Header().Get("Location") != state.ReturnTo {
+			t.Errorf("expected redirect to %q, got %d to %q (body: %q)", state.ReturnTo, rec.Code, rec.Header().Get("Location"), rec.Body.String())
+		}
+	})
+}
+
+func TestDeviceFlow(t *testing.T) {
+	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
+
+	newHandler := func(verifier *fakeVerifier) *authHandler {
+		h := newTestHandler(verifier, nil, nil)
+		h.deviceKubeconfigGetter = func(_ context.Context, userEmail, projectID, clusterID string, oidcTokens authtypes.OIDCToken) (*clientcmdapi.Config, error) {
+			config := clientcmdapi.NewConfig()
+			config.Clusters[clusterID] = &clientcmdapi.Cluster{Server: "https://" + projectID + ".example.com"}
+			config.AuthInfos[userEmail] = &clientcmdapi.AuthInfo{Token: oidcTokens.IDToken}
+			config.CurrentContext = clusterID
+			return config, nil
+		}
+		return h
+	}
+
+	t.Run("approved device receives its tokens and kubeconfig once", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie:  newTestSecureCookie(),
+			exchangeToken: authtypes.OIDCToken{IDToken: "deviceIDToken", RefreshToken: "deviceRefreshToken"},
+		}
+		h := newHandler(verifier)
+		session := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "browserIDToken"})
+
+		codes := requestDeviceCode(t, h, url.Values{"project_id": {"my-project"}, "cluster_id": {"my-cluster"}})
+
+		assertDeviceError(t, pollDeviceToken(h, codes.DeviceCode), "authorization_pending")
+		assertDeviceError(t, pollDeviceToken(h, codes.DeviceCode), "slow_down")
+
+		// The callback hands the nonce of the state cookie back, like the issuer would.
+		verifier.verifyClaims = authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry}
+		rec := postForm(h.deviceDecisionHandler(true), testDeviceApproveURL, url.Values{"user_code": {strings.ToLower(codes.UserCode)}}, session)
+		if rec.Code != http.StatusSeeOther {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusSeeOther, rec.Code, rec.Body.String())
+		}
+		stateCookie := findSetCookie(rec.Result().Cookies(), oauthStateCookieName)
+		var state oauthStateCookie
+		if err := verifier.secureCookie.Decode(oauthStateCookieName, stateCookie.Value, &state); err != nil {
+			t.Fatalf("failed to decode state cookie: %v", err)
+		}
+		if state.DeviceUserCode != normalizeUserCode(codes.UserCode) || state.DeviceApprover != "john@acme.com" {
+			t.Errorf("expected the state to carry the device authorization, got %+v", state)
+		}
+		verifier.verifyClaims.Nonce = state.Nonce
+
+		rec = runCallback(h, stateCookie, url.Values{"state": {state.State}, "code": {"fakeCode"}}.Encode())
+		if rec.Code != http.StatusSeeOther || rec.Header().Get("Location") != "/api/v2/auth/device?status=approved" {
+			t.Fatalf("expected redirect to the approved page, got %d to %q (body: %q)", rec.Code, rec.Header().Get("Location"), rec.Body.String())
+		}
+		// The browser keeps its session, the tokens go to the device.
+		if c := findCookie(rec.Result().Cookies(), handlerauth.SessionCookieName); c != nil {
+			t.Errorf("expected the session cookie to be untouched, got %+v", c)
+		}
+
+		rec = pollDeviceToken(h, codes.DeviceCode)
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if rec.Header().Get("Cache-Control") != "no-store" {
+			t.Errorf("expected the tokens not to be cached, got Cache-Control %q", rec.Header().Get("Cache-Control"))
+		}
+		var tokens deviceTokenResponse
+		if err := json.NewDecoder(rec.Body).Decode(&tokens); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if tokens.AccessToken != "deviceIDToken" || tokens.IDToken != "deviceIDToken" || tokens.RefreshToken != "deviceRefreshToken" || tokens.TokenType != "Bearer" {
+			t.Errorf("expected the tokens of the approval, got %+v", tokens)
+		}
+		if tokens.ExpiresIn <= 0 {
+			t.Errorf("expected a positive expires_in, got %d", tokens.ExpiresIn)
+		}
+		if !strings.Contains(tokens.Kubeconfig, "https://my-project.example.com") || !strings.Contains(tokens.Kubeconfig, "deviceIDToken") {
+			t.Errorf("expected the kubeconfig of the cluster, got %q", tokens.Kubeconfig)
+		}
+
+		assertDeviceError(t, pollDeviceToken(h, codes.DeviceCode), "expired_token")
+	})
+
+	t.Run("approval with another user at the issuer is rejected", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie:  newTestSecureCookie(),
+			exchangeToken: authtypes.OIDCToken{IDToken: "deviceIDToken"},
+			verifyClaims:  authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newHandler(verifier)
+		session := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "browserIDToken"})
+		codes := requestDeviceCode(t, h, nil)
+
+		rec := postForm(h.deviceDecisionHandler(true), testDeviceApproveURL, url.Values{"user_code": {codes.UserCode}}, session)
+		stateCookie := findSetCookie(rec.Result().Cookies(), oauthStateCookieName)
+		var state oauthStateCookie
+		if err := verifier.secureCookie.Decode(oauthStateCookieName, stateCookie.Value, &state); err != nil {
+			t.Fatalf("failed to decode state cookie: %v", err)
+		}
+		verifier.verifyClaims = authtypes.TokenClaims{Email: "jane@acme.com", Nonce: state.Nonce, Expiry: futureExpiry}
+
+		rec = runCallback(h, stateCookie, url.Values{"state": {state.State}, "code": {"fakeCode"}}.Encode())
+		if rec.Code != http.StatusForbidden {
+			t.Errorf("expected status %d, got %d (body: %q)", http.StatusForbidden, rec.Code, rec.Body.String())
+		}
+		assertDeviceError(t, pollDeviceToken(h, codes.DeviceCode), "authorization_pending")
+	})
+
+	t.Run("denied device is told so", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newHandler(verifier)
+		session := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "browserIDToken"})
+		codes := requestDeviceCode(t, h, nil)
+
+		rec := postForm(h.deviceDecisionHandler(false), testDeviceDenyURL, url.Values{"user_code": {codes.UserCode}}, session)
+		if rec.Code != http.StatusSeeOther || rec.Header().Get("Location") != "/api/v2/auth/device?status=denied" {
+			t.Fatalf("expected redirect to the denied page, got %d to %q (body: %q)", rec.Code, rec.Header().Get("Location"), rec.Body.String())
+		}
+		// a decided authorization cannot be approved anymore
+		rec = postForm(h.deviceDecisionHandler(true), testDeviceApproveURL, url.Values{"user_code": {codes.UserCode}}, session)
+		if rec.Code != http.StatusConflict {
+			t.Errorf("expected status %d, got %d", http.StatusConflict, rec.Code)
+		}
+
+		assertDeviceError(t, pollDeviceToken(h, codes.DeviceCode), "access_denied")
+		assertDeviceError(t, pollDeviceToken(h, codes.DeviceCode), "expired_token")
+	})
+
+	t.Run("decisions need a logged in user and a known user code", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newHandler(verifier)
+		codes := requestDeviceCode(t, h, nil)
+
+		rec := postForm(h.deviceDecisionHandler(true), testDeviceApproveURL, url.Values{"user_code": {codes.UserCode}})
+		if rec.Code != http.StatusUnauthorized {
+			t.Errorf("expected status %d without login, got %d", http.StatusUnauthorized, rec.Code)
+		}
+
+		session := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "browserIDToken"})
+		rec = postForm(h.deviceDecisionHandler(true), testDeviceApproveURL, url.Values{"user_code": {"BBBB-BBBB"}}, session)
+		if rec.Code != http.StatusNotFound {
+			t.Errorf("expected status %d for an unknown user code, got %d", http.StatusNotFound, rec.Code)
+		}
+	})
+}
+
+func TestDeviceTokenHandler(t *testing.T) {
+	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
+
+	t.Run("refresh token grant returns new tokens", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			refreshToken: authtypes.OIDCToken{IDToken: "refreshedIDToken"},
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		rec := postForm(h.deviceTokenHandler(), testDeviceTokenURL, url.Values{
+			"grant_type":    {refreshTokenGrantType},
+			"refresh_token": {"deviceRefreshToken"},
+		})
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var tokens deviceTokenResponse
+		if err := json.NewDecoder(rec.Body).Decode(&tokens); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		// the refresh token is kept as the issuer did not rotate it
+		if tokens.AccessToken != "refreshedIDToken" || tokens.RefreshToken != "deviceRefreshToken" {
+			t.Errorf("expected the refreshed tokens, got %+v", tokens)
+		}
+	})
+
+	t.Run("unknown grant type is rejected", func(t *testing.T) {
+		h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+
+		rec := postForm(h.deviceTokenHandler(), testDeviceTokenURL, url.Values{"grant_type": {"password"}})
+		if rec.Code != http.StatusBadRequest {
+			t.Errorf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+		assertDeviceError(t, rec, "unsupported_grant_type")
+	})
+}
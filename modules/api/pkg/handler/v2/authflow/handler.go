@@ -29,6 +29,9 @@ import (
 
 	"golang.org/x/oauth2"
 
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	"k8c.io/dashboard/v2/pkg/provider"
+	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/log"
 )
@@ -39,6 +42,7 @@ const (
 	oauthStateCookieName   = "_oauth_state"
 	oauthStateCookieMaxAge = 300 // 5 minutes
 	callbackPath           = "/api/v2/auth/callback"
+	loginPath              = "/api/v2/auth/login"
 )
 
 // oauthStateCookie is the payload stored in the encrypted _oauth_state cookie.
@@ -46,6 +50,14 @@ type oauthStateCookie struct {
 	State        string
 	Nonce        string
 	CodeVerifier string
+	// Issuer is the name of the issuer the user logs in with, empty for the default issuer.
+	Issuer string
+	// DeviceUserCode is the user code of the device authorization the user approves, empty for a login.
+	DeviceUserCode string
+	// DeviceApprover is the email of the logged in user who approves the device authorization.
+	DeviceApprover string
+	// ReturnTo is the device verification page the user returns to after the login, empty for the landing page.
+	ReturnTo string
 }
 
 func randomURLSafeString(nBytes int) (string, error) {
@@ -98,72 +110,110 @@ func (a *authHandler) getCallbackURI(r *http.Request) string {
 	return fmt.Sprintf("%s://%s%s", scheme, host, callbackPath)
 }
 
+// issuer returns the issuer of the given name, the empty name selects the default issuer.
+func (a *authHandler) issuer(name string) (authtypes.OIDCIssuerVerifier, error) {
+	if name == "" || name == handlerauth.DefaultOIDCIssuerName {
+		return a.oidcIssuerVerifier, nil
+	}
+	for _, issuer := range a.issuers {
+		if issuer.OIDCConfig().Name == name {
+			return issuer, nil
+		}
+	}
+	return nil, fmt.Errorf("unknown issuer %q", name)
+}
+
 func (a *authHandler) loginHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
-		oidcConfig := a.oidcIssuerVerifier.OIDCConfig()
-
-		nonce, err := randomURLSafeString(32)
+		issuerName := r.URL.Query().Get("issuer")
+		issuer, err := a.issuer(issuerName)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to generate nonce: %v", err), http.StatusInternalServerError)
-			return
-		}
-		state, err := randomURLSafeString(32)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to generate state: %v", err), http.StatusInternalServerError)
+			http.Error(w, err.Error(), http.StatusBadRequest)
 			return
 		}
 
-		codeVerifier := oauth2.GenerateVerifier()
+		// the dashboard asks for a fresh authentication when a destructive request requires a recent one
+		reauthenticate := r.URL.Query().Get("reauthenticate") == "true"
+		a.redirectToIssuer(w, r, issuer, oauthStateCookie{
+			Issuer:   issuerName,
+			ReturnTo: deviceReturnPath(r.URL.Query().Get("return_to")),
+		}, reauthenticate)
+	})
+}
 
-		scopes := []string{"openid", "email", "profile", "groups"}
-		if oidcConfig.OfflineAccessAsScope {
-			scopes = append(scopes, "offline_access")
-		}
+// redirectToIssuer starts an authorization code flow with the issuer. The given state cookie is completed
+// with the CSRF state, nonce and PKCE verifier and carries the state of the flow to the callback. With
+// reauthenticate, the issuer authenticates the user again even if it still has a session of the user.
+func (a *authHandler) redirectToIssuer(w http.ResponseWriter, r *http.Request, issuer authtypes.OIDCIssuerVerifier, stateCookie oauthStateCookie, reauthenticate bool) {
+	oidcConfig := a.oidcIssuerVerifier.OIDCConfig()
+	offlineAccessAsScope := issuer.OIDCConfig().OfflineAccessAsScope
+
+	nonce, err := randomURLSafeString(32)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to generate nonce: %v", err), http.StatusInternalServerError)
+		return
+	}
+	state, err := randomURLSafeString(32)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to generate state: %v", err), http.StatusInternalServerError)
+		return
+	}
 
-		redirectURI := a.getCallbackURI(r)
-		authURL := a.oidcIssuerVerifier.AuthCodeURL(state, oidcConfig.OfflineAccessAsScope, redirectURI, scopes...)
-		u, err := url.Parse(authURL)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to parse auth URL: %v", err), http.StatusInternalServerError)
-			return
-		}
-		q := u.Query()
-		q.Set("code_challenge", oauth2.S256ChallengeFromVerifier(codeVerifier))
-		q.Set("code_challenge_method", "S256")
-		q.Set("nonce", nonce)
-		u.RawQuery = q.Encode()
-
-		// Encode state, nonce, and PKCE verifier into a single signed cookie.
-		encodedStateCookie, err := oidcConfig.SecureCookie.Encode(oauthStateCookieName, oauthStateCookie{
-			State:        state,
-			Nonce:        nonce,
-			CodeVerifier: codeVerifier,
-		})
-		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to encode state cookie: %v", err), http.StatusInternalServerError)
-			return
-		}
+	codeVerifier := oauth2.GenerateVerifier()
 
-		http.SetCookie(w, &http.Cookie{
-			Name:     oauthStateCookieName,
-			Value:    encodedStateCookie,
-			MaxAge:   oauthStateCookieMaxAge,
-			HttpOnly: true,
-			Secure:   oidcConfig.CookieSecureMode,
-			SameSite: http.SameSiteLaxMode,
-			Path:     "/",
-		})
+	scopes := []string{"openid", "email", "profile", "groups"}
+	if offlineAccessAsScope {
+		scopes = append(scopes, "offline_access")
+	}
+
+	redirectURI := a.getCallbackURI(r)
+	authURL := issuer.AuthCodeURL(state, offlineAccessAsScope, redirectURI, scopes...)
+	u, err := url.Parse(authURL)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to parse auth URL: %v", err), http.StatusInternalServerError)
+		return
+	}
+	q := u.Query()
+	q.Set("code_challenge", oauth2.S256ChallengeFromVerifier(codeVerifier))
+	q.Set("code_challenge_method", "S256")
+	q.Set("nonce", nonce)
+	if reauthenticate {
+		q.Set("prompt", "login")
+		q.Set("max_age", "0")
+	}
+	u.RawQuery = q.Encode()
+
+	// Encode state, nonce, and PKCE verifier into a single signed cookie.
+	stateCookie.State = state
+	stateCookie.Nonce = nonce
+	stateCookie.CodeVerifier = codeVerifier
+	encodedStateCookie, err := oidcConfig.SecureCookie.Encode(oauthStateCookieName, stateCookie)
+	if err != nil {
+		http.Error(w, fmt.Sprintf("failed to encode state cookie: %v", err), http.StatusInternalServerError)
+		return
+	}
 
-		http.Redirect(w, r, u.String(), http.StatusSeeOther)
+	http.SetCookie(w, &http.Cookie{
+		Name:     oauthStateCookieName,
+		Value:    encodedStateCookie,
+		MaxAge:   oauthStateCookieMaxAge,
+		HttpOnly: true,
+		Secure:   oidcConfig.CookieSecureMode,
+		SameSite: http.SameSiteLaxMode,
+		Path:     "/",
 	})
+
+	http.Redirect(w, r, u.String(), http.StatusSeeOther)
 }
 
 const (
-	idTokenCookieName = "token"
-	// Max characters per cookie (staying under the 4KB browser limit).
-	maxCookieSize            = 3800
-	refreshTokenCookieName   = "refresh_token"
-	refreshTokenCookieMaxAge = 2592000 // 30 days
+	// The former cookie-based sessions kept the tokens in these cookies, they are cleared on login and logout.
+	idTokenCookieName      = "token"
+	refreshTokenCookieName = "refresh_token"
+	issuerCookieName       = "oidc_issuer"
+
+	// sessionMaxAge is the lifetime of a session which can be refreshed, it is renewed by every refresh.
+	sessionMaxAge = 2592000 // 30 days
 )
 
 func (a *authHandler) callbackHandler() http.Handler {
@@ -208,16 +258,22 @@ func (a *authHandler) callbackHandler() http.Handler {
 			Path:     "/",
 		})
 
+		issuer, err := a.issuer(storedState.Issuer)
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusBadRequest)
+			return
+		}
+
 		// 4. Exchange authorization code for tokens with PKCE code_verifier from the cookie.
 		redirectURI := a.getCallbackURI(r)
-		oidcTokens, err := a.oidcIssuerVerifier.Exchange(r.Context(), code, redirectURI, storedState.CodeVerifier)
+		oidcTokens, err := issuer.Exchange(r.Context(), code, redirectURI, storedState.CodeVerifier)
 		if err != nil {
 			http.Error(w, fmt.Sprintf("failed to exchange code for tokens: %v", err), http.StatusInternalServerError)
 			return
 		}
 
 		// 5. Verify id_token and check email claim.
-		claims, err := a.oidcIssuerVerifier.Verify(r.Context(), oidcTokens.IDToken)
+		claims, err := issuer.Verify(r.Context(), oidcTokens.IDToken)
 		if err != nil {
 			http.Error(w, fmt.Sprintf("failed to verify id_token: %v", err), http.StatusInternalServerError)
 			return
@@ -234,33 +290,50 @@ func (a *authHandler) callbackHandler() http.Handler {
 			return
 		}
 
-		// 6. Set id_token cookie with Max-Age matching the token's expiry.
-		tokenMaxAge := int(time.Until(claims.Expiry.Time).Seconds())
-		if tokenMaxAge <= 0 {
+		// The tokens of a device approval go to the device, the session of the browser stays as it is.
+		if storedState.DeviceUserCode != "" {
+			a.completeDeviceApproval(w, r, storedState, claims, oidcTokens)
+			return
+		}
+
+		// 6. Store the tokens in a new server-side session, the browser only gets the session key.
+		if !claims.Expiry.After(time.Now()) {
 			http.Error(w, "received an already expired id_token", http.StatusInternalServerError)
 			return
 		}
 
 		clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
 
-		tokenValue := oidcTokens.IDToken
-		if len(tokenValue) <= maxCookieSize {
-			// Standard single cookie
-			setNamedCookie(w, idTokenCookieName, tokenValue, "/", tokenMaxAge, oidcConfig.CookieSecureMode)
-		} else {
-			// Chunked cookies: token-1, token-2, etc.
-			chunks := chunkString(tokenValue, maxCookieSize)
-			for i, chunk := range chunks {
-				setNamedCookie(w, fmt.Sprintf("%s-%d", idTokenCookieName, i+1), chunk, "/", tokenMaxAge, oidcConfig.CookieSecureMode)
-			}
+		sessionKey, err := a.sessionProvider.NewKey()
+		if err != nil {
+			http.Error(w, fmt.Sprintf("failed to generate session key: %v", err), http.StatusInternalServerError)
+			return
 		}
-
-		// 7. Set refresh_token cookie (if present).
-		if oidcTokens.RefreshToken != "" {
-			setNamedCookie(w, refreshTokenCookieName, oidcTokens.RefreshToken, "/api/v2/auth", refreshTokenCookieMaxAge, oidcConfig.CookieSecureMode)
+		now := time.Now()
+		session := &provider.Session{
+			UserEmail:         claims.Email,
+			Issuer:            storedState.Issuer,
+			IDToken:           oidcTokens.IDToken,
+			RefreshToken:      oidcTokens.RefreshToken,
+			UserAgent:         r.UserAgent(),
+			IP:                a.trustedProxies.ClientIP(r),
+			CreationTimestamp: now,
+			LastSeen:          now,
+			Expiry:            sessionExpiry(now, claims, oidcTokens.RefreshToken),
+		}
+		if err := a.sessionProvider.Create(r.Context(), sessionKey, session); err != nil {
+			http.Error(w, fmt.Sprintf("failed to create session: %v", err), http.StatusInternalServerError)
+			return
 		}
 
-		// 8. Redirect to frontend landing page.
+		// 7. Set the session cookie.
+		setSessionCookie(w, sessionKey, session.Expiry, oidcConfig.CookieSecureMode)
+
+		// 8. Redirect to the page the login was started from or to the frontend landing page.
+		if storedState.ReturnTo != "" {
+			http.Redirect(w, r, storedState.ReturnTo, http.StatusSeeOther)
+			return
+		}
 		userInfo, err := a.userProvider.UserByEmail(r.Context(), claims.Email)
 		if err != nil {
 			log.Logger.Errorf("failed to get user info: %v", err)
@@ -286,63 +359,88 @@ func (a *authHandler) refreshHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		oidcConfig := a.oidcIssuerVerifier.OIDCConfig()
 
-		// 1. Read refresh_token from cookie.
-		refreshCookie, err := r.Cookie(refreshTokenCookieName)
+		// 1. Look up the session of the session cookie.
+		sessionCookie, err := r.Cookie(handlerauth.SessionCookieName)
 		if err != nil {
 			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
-			http.Error(w, "missing refresh_token cookie", http.StatusUnauthorized)
+			http.Error(w, "missing session cookie", http.StatusUnauthorized)
+			return
+		}
+		session, err := a.sessionProvider.Get(r.Context(), sessionCookie.Value)
+		if err != nil {
+			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
+			http.Error(w, "unknown session", http.StatusUnauthorized)
+			return
+		}
+
+		// endSession revokes a session which cannot be refreshed anymore.
+		endSession := func(message string) {
+			if err := a.sessionProvider.Delete(r.Context(), sessionCookie.Value); err != nil {
+				log.Logger.Debugw("Failed to delete the session", "session", session.ID, "error", err)
+			}
+			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
+			http.Error(w, message, http.StatusUnauthorized)
+		}
+
+		if session.RefreshToken == "" {
+			endSession("the session cannot be refreshed")
+			return
+		}
+
+		// The refresh token can only be redeemed at the issuer that issued it.
+		issuer, err := a.issuer(session.Issuer)
+		if err != nil {
+			endSession(err.Error())
 			return
 		}
 
 		// 2. Refresh tokens.
-		oidcTokens, err := a.oidcIssuerVerifier.RefreshAccessToken(r.Context(), refreshCookie.Value)
+		oidcTokens, err := issuer.RefreshAccessToken(r.Context(), session.RefreshToken)
 		if err != nil {
-			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
-			http.Error(w, "token refresh failed", http.StatusUnauthorized)
+			endSession("token refresh failed")
 			return
 		}
 
 		// 3. Verify new id_token.
-		claims, err := a.oidcIssuerVerifier.Verify(r.Context(), oidcTokens.IDToken)
+		claims, err := issuer.Verify(r.Context(), oidcTokens.IDToken)
 		if err != nil {
-			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
-			http.Error(w, "failed to verify refreshed id_token", http.StatusUnauthorized)
+			endSession("failed to verify refreshed id_token")
 			return
 		}
 
 		if claims.Email == "" {
-			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
-			http.Error(w, "email claim is missing from refreshed id_token", http.StatusUnauthorized)
+			endSession("email claim is missing from refreshed id_token")
 			return
 		}
-
-		// 4. Set new id_token cookie with Max-Age matching the token's expiry.
-		tokenMaxAge := int(time.Until(claims.Expiry.Time).Seconds())
-		if tokenMaxAge <= 0 {
-			clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
-			http.Error(w, "received an already expired id_token from refresh", http.StatusUnauthorized)
+		if !strings.EqualFold(claims.Email, session.UserEmail) {
+			endSession("the refreshed id_token belongs to another user")
 			return
 		}
 
-		clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
-
-		tokenValue := oidcTokens.IDToken
-		if len(tokenValue) <= maxCookieSize {
-			// Standard single cookie
-			setNamedCookie(w, idTokenCookieName, tokenValue, "/", tokenMaxAge, oidcConfig.CookieSecureMode)
-		} else {
-			// Chunked cookies: token-1, token-2, etc.
-			chunks := chunkString(tokenValue, maxCookieSize)
-			for i, chunk := range chunks {
-				setNamedCookie(w, fmt.Sprintf("%s-%d", idTokenCookieName, i+1), chunk, "/", tokenMaxAge, oidcConfig.CookieSecureMode)
-			}
+		now := time.Now()
+		if !claims.Expiry.After(now) {
+			endSession("received an already expired id_token from refresh")
+			return
 		}
 
-		// 5. Set new refresh_token cookie (if rotated).
+		// 4. Store the new tokens in the session and renew the session cookie.
+		session.IDToken = oidcTokens.IDToken
+		// the refresh token is kept unless the issuer rotated it
 		if oidcTokens.RefreshToken != "" {
-			setNamedCookie(w, refreshTokenCookieName, oidcTokens.RefreshToken, "/api/v2/auth", refreshTokenCookieMaxAge, oidcConfig.CookieSecureMode)
+			session.RefreshToken = oidcTokens.RefreshToken
+		}
+		session.LastSeen = now
+		session.UserAgent = r.UserAgent()
+		session.IP = a.trustedProxies.ClientIP(r)
+		session.Expiry = sessionExpiry(now, claims, session.RefreshToken)
+		if err := a.sessionProvider.Update(r.Context(), sessionCookie.Value, session); err != nil {
+			http.Error(w, fmt.Sprintf("failed to update session: %v", err), http.StatusInternalServerError)
+			return
 		}
 
+		clearAuthCookies(w, r, oidcConfig.CookieSecureMode)
+		setSessionCookie(w, sessionCookie.Value, session.Expiry, oidcConfig.CookieSecureMode)
+
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(authStatusResponse{
 			ExpiresAt: claims.Expiry.Unix(),
@@ -352,6 +450,19 @@ func (a *authHandler) refreshHandler() http.Handler {
 	})
 }
 
+// sessionExpiry returns the expiry of a session. A session with a refresh token lasts until it is not
+// refreshed for sessionMaxAge, otherwise it ends with its id_token.
+func sessionExpiry(now time.Time, claims authtypes.TokenClaims, refreshToken string) time.Time {
+	if refreshToken == "" {
+		return claims.Expiry.Time
+	}
+	return now.Add(sessionMaxAge * time.Second)
+}
+
+func setSessionCookie(w http.ResponseWriter, sessionKey string, expiry time.Time, secureMode bool) {
+	setNamedCookie(w, handlerauth.SessionCookieName, sessionKey, "/", int(time.Until(expiry).Seconds()), secureMode)
+}
+
 func setNamedCookie(w http.ResponseWriter, name, value, path string, maxAge int, secureMode bool) {
 	http.SetCookie(w, &http.Cookie{
 		Name:     name,
@@ -368,26 +479,20 @@ func (a *authHandler) logoutHandler() http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
 		clearAuthCookies(w, r, a.oidcIssuerVerifier.OIDCConfig().CookieSecureMode)
 
-		tokenValue, err := a.tokenExtractor.Extract(r)
+		sessionCookie, err := r.Cookie(handlerauth.SessionCookieName)
 		if err != nil {
-			http.Error(w, "missing token cookie", http.StatusUnauthorized)
+			http.Error(w, "missing session cookie", http.StatusUnauthorized)
 			return
 		}
-
-		claims, err := a.oidcIssuerVerifier.Verify(r.Context(), tokenValue)
+		session, err := a.sessionProvider.Get(r.Context(), sessionCookie.Value)
 		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to verify id_token: %v", err), http.StatusUnauthorized)
+			http.Error(w, "unknown session", http.StatusUnauthorized)
 			return
 		}
 
-		if claims.Email == "" {
-			http.Error(w, "email claim is missing from id_token", http.StatusInternalServerError)
-			return
-		}
-
-		userInfo, err := a.userProvider.UserByEmail(r.Context(), claims.Email)
-		if err != nil {
-			http.Error(w, fmt.Sprintf("failed to get user info: %v", err), http.StatusInternalServerError)
+		// Deleting the session revokes its tokens, they have never been handed out to the browser.
+		if err := a.sessionProvider.Delete(r.Context(), sessionCookie.Value); err != nil {
+			http.Error(w, fmt.Sprintf("failed to delete session: %v", err), http.StatusInternalServerError)
 			return
 		}
 
@@ -398,13 +503,9 @@ func (a *authHandler) logoutHandler() http.Handler {
 		if err != nil {
 			log.Logger.Errorf("failed to get UI configurations: %v", err)
 		} else {
-			redirectPath = getOIDCProviderLogoutURL(kubermaticConfig, tokenValue, a.getBaseURL(r))
+			redirectPath = getOIDCProviderLogoutURL(kubermaticConfig, session.IDToken, a.getBaseURL(r))
 		}
 
-		if err := a.userProvider.InvalidateToken(r.Context(), userInfo, tokenValue, claims.Expiry); err != nil {
-			http.Error(w, fmt.Sprintf("failed to invalidate token: %v", err), http.StatusInternalServerError)
-			return
-		}
 		w.Header().Set("Content-Type", "application/json")
 		if err := json.NewEncoder(w).Encode(map[string]string{
 			"redirect": redirectPath,
@@ -415,8 +516,10 @@ func (a *authHandler) logoutHandler() http.Handler {
 }
 
 func clearAuthCookies(w http.ResponseWriter, r *http.Request, secureMode bool) {
+	clearNamedCookie(w, handlerauth.SessionCookieName, "/", secureMode)
 	clearNamedCookie(w, idTokenCookieName, "/", secureMode)
 	clearNamedCookie(w, refreshTokenCookieName, "/api/v2/auth", secureMode)
+	clearNamedCookie(w, issuerCookieName, "/api/v2/auth", secureMode)
 	chunkPrefix := idTokenCookieName + "-"
 	for _, c := range r.Cookies() {
 		if strings.HasPrefix(c.Name, chunkPrefix) {
@@ -454,7 +557,7 @@ func (a *authHandler) statusHandler() http.Handler {
 			return
 		}
 
-		claims, err := a.oidcIssuerVerifier.Verify(r.Context(), tokenValue)
+		claims, err := a.tokenVerifier.Verify(r.Context(), tokenValue)
 		if err != nil {
 			http.Error(w, "invalid token", http.StatusUnauthorized)
 			return
@@ -469,16 +572,30 @@ func (a *authHandler) statusHandler() http.Handler {
 	})
 }
 
-func chunkString(token string, chunkSize int) []string {
-	var chunks []string
-	for i := 0; i < len(token); i += chunkSize {
-		end := i + chunkSize
-		if end > len(token) {
-			end = len(token)
+// oidcIssuer is an issuer users can log in with.
+type oidcIssuer struct {
+	Name        string `json:"name"`
+	DisplayName string `json:"displayName,omitempty"`
+}
+
+// issuersHandler lists the issuers for the login page, which passes the name of the selected issuer to the login.
+func (a *authHandler) issuersHandler() http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		issuers := make([]oidcIssuer, 0, len(a.issuers))
+		for _, issuer := range a.issuers {
+			config := issuer.OIDCConfig()
+			if config.Name == "" {
+				issuers = append(issuers, oidcIssuer{Name: handlerauth.DefaultOIDCIssuerName})
+				continue
+			}
+			issuers = append(issuers, oidcIssuer{Name: config.Name, DisplayName: config.DisplayName})
 		}
-		chunks = append(chunks, token[i:end])
-	}
-	return chunks
+
+		w.Header().Set("Content-Type", "application/json")
+		if err := json.NewEncoder(w).Encode(issuers); err != nil {
+			http.Error(w, fmt.Sprintf("failed to encode response: %v", err), http.StatusInternalServerError)
+		}
+	})
 }
 
 // getBaseURL returns the scheme+host used for logout redirect URIs.
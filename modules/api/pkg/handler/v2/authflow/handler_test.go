@@ -20,7 +20,6 @@ import (
 	"context"
 	"encoding/json"
 	"errors"
-	"fmt"
 	"net/http"
 	"net/http/httptest"
 	"net/url"
@@ -32,8 +31,10 @@ import (
 	"golang.org/x/oauth2"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
+	"k8c.io/dashboard/v2/pkg/provider/memory"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 )
 
@@ -48,6 +49,7 @@ const (
 // fakeVerifier implements authtypes.OIDCIssuerVerifier with per-test behavior
 // set via its fields.
 type fakeVerifier struct {
+	name             string
 	secureCookie     *securecookie.SecureCookie
 	cookieSecureMode bool
 	offlineAsScope   bool
@@ -68,6 +70,7 @@ var _ authtypes.OIDCIssuerVerifier = &fakeVerifier{}
 
 func (f *fakeVerifier) OIDCConfig() *authtypes.OIDCConfiguration {
 	return &authtypes.OIDCConfiguration{
+		Name:                 f.name,
 		URL:                  "https://dex.example.com",
 		ClientID:             "kubermaticIssuer",
 		SecureCookie:         f.secureCookie,
@@ -108,13 +111,11 @@ func (f *fakeVerifier) Extract(_ *http.Request) (string, error) {
 	return "", nil
 }
 
-// fakeUserProvider implements provider.UserProvider but only the two methods the
-// auth handlers call (UserByEmail, InvalidateToken).
+// fakeUserProvider implements provider.UserProvider but only the method the
+// auth handlers call (UserByEmail).
 type fakeUserProvider struct {
-	usersByEmail      map[string]*kubermaticv1.User
-	userByEmailErr    error
-	invalidateErr     error
-	invalidatedTokens []string
+	usersByEmail   map[string]*kubermaticv1.User
+	userByEmailErr error
 }
 
 var _ provider.UserProvider = &fakeUserProvider{}
@@ -129,15 +130,10 @@ func (f *fakeUserProvider) UserByEmail(_ context.Context, email string) (*kuberm
 	return &kubermaticv1.User{}, nil
 }
 
-func (f *fakeUserProvider) InvalidateToken(_ context.Context, _ *kubermaticv1.User, token string, _ apiv1.Time) error {
-	if f.invalidateErr != nil {
-		return f.invalidateErr
-	}
-	f.invalidatedTokens = append(f.invalidatedTokens, token)
+// Unused interface methods — no-op stubs so the type satisfies the interface.
+func (f *fakeUserProvider) InvalidateToken(context.Context, *kubermaticv1.User, string, apiv1.Time) error {
 	return nil
 }
-
-// Unused interface methods — no-op stubs so the type satisfies the interface.
 func (f *fakeUserProvider) CreateUser(context.Context, string, string, []string) (*kubermaticv1.User, error) {
 	return nil, nil
 }
@@ -173,7 +169,38 @@ func newTestHandler(verifier *fakeVerifier, userProvider provider.UserProvider,
 			return &kubermaticv1.KubermaticConfiguration{}, nil
 		}
 	}
-	return NewAuthHandler(verifier, userProvider, configGetter)
+	return NewAuthHandler(verifier, userProvider, memory.NewSessionProvider(), memory.NewDeviceAuthorizationProvider(), nil, configGetter, nil)
+}
+
+// createTestSession stores the given session in the session store of the handler and returns the
+// session cookie the browser would send.
+func createTestSession(t *testing.T, h *authHandler, session provider.Session) *http.Cookie {
+	t.Helper()
+	key, err := randomURLSafeString(32)
+	if err != nil {
+		t.Fatalf("failed to generate session key: %v", err)
+	}
+	if session.Expiry.IsZero() {
+		session.Expiry = time.Now().Add(time.Hour)
+	}
+	if err := h.sessionProvider.Create(context.Background(), key, &session); err != nil {
+		t.Fatalf("failed to create session: %v", err)
+	}
+	return &http.Cookie{Name: handlerauth.SessionCookieName, Value: key}
+}
+
+// sessionOfResponse returns the stored session of the session cookie set by a response.
+func sessionOfResponse(t *testing.T, h *authHandler, rec *httptest.ResponseRecorder) *provider.Session {
+	t.Helper()
+	c := findSetCookie(rec.Result().Cookies(), handlerauth.SessionCookieName)
+	if c == nil {
+		t.Fatalf("expected %q cookie to be set", handlerauth.SessionCookieName)
+	}
+	session, err := h.sessionProvider.Get(context.Background(), c.Value)
+	if err != nil {
+		t.Fatalf("failed to get the session of the session cookie: %v", err)
+	}
+	return session
 }
 
 // findCookie returns the named cookie from a response, or nil if absent.
@@ -278,6 +305,33 @@ func TestLoginHandler(t *testing.T) {
 	if got, want := q.Get("code_challenge"), oauth2.S256ChallengeFromVerifier(stored.CodeVerifier); got != want {
 		t.Errorf("code_challenge %q does not match S256(verifier) %q", got, want)
 	}
+	if q.Has("prompt") || q.Has("max_age") {
+		t.Errorf("expected no forced reauthentication, got %q", u.RawQuery)
+	}
+}
+
+func TestLoginHandlerReauthenticate(t *testing.T) {
+	verifier := &fakeVerifier{secureCookie: newTestSecureCookie()}
+	h := newTestHandler(verifier, nil, nil)
+
+	req := httptest.NewRequest(http.MethodGet, testLoginURL+"?reauthenticate=true", nil)
+	rec := httptest.NewRecorder()
+	h.loginHandler().ServeHTTP(rec, req)
+
+	if rec.Code != http.StatusSeeOther {
+		t.Fatalf("expected status %d, got %d (body: %q)", http.StatusSeeOther, rec.Code, rec.Body.String())
+	}
+	u, err := url.Parse(rec.Header().Get("Location"))
+	if err != nil {
+		t.Fatalf("failed to parse redirect Location: %v", err)
+	}
+	// The issuer must authenticate the user again, so that the new token carries a recent auth_time.
+	if got := u.Query().Get("prompt"); got != "login" {
+		t.Errorf("expected prompt=login, got %q", got)
+	}
+	if got := u.Query().Get("max_age"); got != "0" {
+		t.Errorf("expected max_age=0, got %q", got)
+	}
 }
 
 // -----------------------------------------------------------------------------
@@ -346,6 +400,41 @@ func TestStatusHandler(t *testing.T) {
 			}
 		})
 	}
+
+	t.Run("session cookie is resolved to the token of the session", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+		sessionCookie := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "fakeTokenId"})
+
+		req := httptest.NewRequest(http.MethodGet, testStatusURL, nil)
+		req.AddCookie(sessionCookie)
+		req.Header.Set("User-Agent", "Mozilla/5.0 (X11; Linux x86_64; rv:128.0) Gecko/20100101 Firefox/128.0")
+		rec := httptest.NewRecorder()
+		h.statusHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		var resp authStatusResponse
+		if err := json.Unmarshal(rec.Body.Bytes(), &resp); err != nil {
+			t.Fatalf("failed to decode response body: %v", err)
+		}
+		if resp.ExpiresAt != futureExpiry.Unix() {
+			t.Errorf("expected expires_at %d, got %d", futureExpiry.Unix(), resp.ExpiresAt)
+		}
+
+		// Using the session records where it was used from.
+		session, err := h.sessionProvider.Get(context.Background(), sessionCookie.Value)
+		if err != nil {
+			t.Fatalf("failed to get session: %v", err)
+		}
+		if session.LastSeen.IsZero() || !strings.Contains(session.UserAgent, "Firefox") {
+			t.Errorf("expected last seen and user agent to be recorded, got %+v", session)
+		}
+	})
 }
 
 // -----------------------------------------------------------------------------
@@ -353,20 +442,12 @@ func TestStatusHandler(t *testing.T) {
 // -----------------------------------------------------------------------------
 
 func TestLogoutHandler(t *testing.T) {
-	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
-
-	t.Run("happy path invalidates token and clears cookies", func(t *testing.T) {
-		userProvider := &fakeUserProvider{
-			usersByEmail: map[string]*kubermaticv1.User{"john@acme.com": {}},
-		}
-		verifier := &fakeVerifier{
-			secureCookie: newTestSecureCookie(),
-			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
-		}
-		h := newTestHandler(verifier, userProvider, nil)
+	t.Run("happy path deletes the session and clears cookies", func(t *testing.T) {
+		h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+		sessionCookie := createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "fakeTokenId"})
 
 		req := httptest.NewRequest(http.MethodPost, testLogoutURL, nil)
-		req.AddCookie(&http.Cookie{Name: idTokenCookieName, Value: "fakeTokenId"})
+		req.AddCookie(sessionCookie)
 		rec := httptest.NewRecorder()
 		h.logoutHandler().ServeHTTP(rec, req)
 
@@ -383,18 +464,19 @@ func TestLogoutHandler(t *testing.T) {
 			t.Error("expected a non-empty redirect in the response")
 		}
 
-		// The token must have been invalidated server-side.
-		if len(userProvider.invalidatedTokens) != 1 || userProvider.invalidatedTokens[0] != "fakeTokenId" {
-			t.Errorf("expected token to be invalidated, got %v", userProvider.invalidatedTokens)
+		// The session must have been revoked server-side.
+		if _, err := h.sessionProvider.Get(context.Background(), sessionCookie.Value); err == nil {
+			t.Error("expected the session to be deleted")
 		}
 
 		// Auth cookies must be cleared.
 		cookies := rec.Result().Cookies()
+		assertCookieCleared(t, cookies, handlerauth.SessionCookieName)
 		assertCookieCleared(t, cookies, idTokenCookieName)
 		assertCookieCleared(t, cookies, refreshTokenCookieName)
 	})
 
-	t.Run("missing token still clears cookies and returns 401", func(t *testing.T) {
+	t.Run("missing session cookie still clears cookies and returns 401", func(t *testing.T) {
 		verifier := &fakeVerifier{secureCookie: newTestSecureCookie()}
 		h := newTestHandler(verifier, nil, nil)
 
@@ -405,83 +487,23 @@ func TestLogoutHandler(t *testing.T) {
 		if rec.Code != http.StatusUnauthorized {
 			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
 		}
-		// Logout is idempotent: cookies are cleared even when there is no token.
-		assertCookieCleared(t, rec.Result().Cookies(), idTokenCookieName)
+		// Logout is idempotent: cookies are cleared even when there is no session.
+		assertCookieCleared(t, rec.Result().Cookies(), handlerauth.SessionCookieName)
 	})
 
-	t.Run("invalid token still clears cookies and returns 401", func(t *testing.T) {
-		verifier := &fakeVerifier{
-			secureCookie: newTestSecureCookie(),
-			verifyErr:    errors.New("bad token"),
-		}
+	t.Run("unknown session still clears cookies and returns 401", func(t *testing.T) {
+		verifier := &fakeVerifier{secureCookie: newTestSecureCookie()}
 		h := newTestHandler(verifier, nil, nil)
 
 		req := httptest.NewRequest(http.MethodPost, testLogoutURL, nil)
-		req.AddCookie(&http.Cookie{Name: idTokenCookieName, Value: "fakeInvalidToken"})
+		req.AddCookie(&http.Cookie{Name: handlerauth.SessionCookieName, Value: "revoked-session"})
 		rec := httptest.NewRecorder()
 		h.logoutHandler().ServeHTTP(rec, req)
 
 		if rec.Code != http.StatusUnauthorized {
 			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
 		}
-		assertCookieCleared(t, rec.Result().Cookies(), idTokenCookieName)
-	})
-
-	t.Run("token invalidation failure returns 500 but still clears cookies", func(t *testing.T) {
-		userProvider := &fakeUserProvider{
-			usersByEmail:  map[string]*kubermaticv1.User{"john@acme.com": {}},
-			invalidateErr: errors.New("db down"),
-		}
-		verifier := &fakeVerifier{
-			secureCookie: newTestSecureCookie(),
-			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
-		}
-		h := newTestHandler(verifier, userProvider, nil)
-
-		req := httptest.NewRequest(http.MethodPost, testLogoutURL, nil)
-		req.AddCookie(&http.Cookie{Name: idTokenCookieName, Value: "fakeTokenId"})
-		rec := httptest.NewRecorder()
-		h.logoutHandler().ServeHTTP(rec, req)
-
-		if rec.Code != http.StatusInternalServerError {
-			t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
-		}
-		assertCookieCleared(t, rec.Result().Cookies(), idTokenCookieName)
-	})
-
-	t.Run("missing email claim returns 500", func(t *testing.T) {
-		verifier := &fakeVerifier{
-			secureCookie: newTestSecureCookie(),
-			verifyClaims: authtypes.TokenClaims{Expiry: futureExpiry}, // verified, but no email
-		}
-		h := newTestHandler(verifier, nil, nil)
-
-		req := httptest.NewRequest(http.MethodPost, testLogoutURL, nil)
-		req.AddCookie(&http.Cookie{Name: idTokenCookieName, Value: "fakeTokenId"})
-		rec := httptest.NewRecorder()
-		h.logoutHandler().ServeHTTP(rec, req)
-
-		if rec.Code != http.StatusInternalServerError {
-			t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
-		}
-	})
-
-	t.Run("user lookup failure returns 500", func(t *testing.T) {
-		userProvider := &fakeUserProvider{userByEmailErr: errors.New("lookup failed")}
-		verifier := &fakeVerifier{
-			secureCookie: newTestSecureCookie(),
-			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
-		}
-		h := newTestHandler(verifier, userProvider, nil)
-
-		req := httptest.NewRequest(http.MethodPost, testLogoutURL, nil)
-		req.AddCookie(&http.Cookie{Name: idTokenCookieName, Value: "fakeTokenId"})
-		rec := httptest.NewRecorder()
-		h.logoutHandler().ServeHTTP(rec, req)
-
-		if rec.Code != http.StatusInternalServerError {
-			t.Fatalf("expected status %d, got %d", http.StatusInternalServerError, rec.Code)
-		}
+		assertCookieCleared(t, rec.Result().Cookies(), handlerauth.SessionCookieName)
 	})
 }
 
@@ -493,16 +515,19 @@ func TestRefreshHandler(t *testing.T) {
 	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
 	pastExpiry := apiv1.NewTime(time.Now().Add(-time.Hour))
 
-	t.Run("happy path issues new token and refresh cookies", func(t *testing.T) {
+	testSession := provider.Session{UserEmail: "john@acme.com", IDToken: "fakeTokenId", RefreshToken: "fakeRefreshToken"}
+
+	t.Run("happy path stores new tokens and renews the session cookie", func(t *testing.T) {
 		verifier := &fakeVerifier{
 			secureCookie: newTestSecureCookie(),
 			refreshToken: authtypes.OIDCToken{IDToken: "fakeRefreshedTokenId", RefreshToken: "fakeRotatedRefreshToken"},
 			verifyClaims: authtypes.TokenClaims{Email: "john@acme.com", Expiry: futureExpiry},
 		}
 		h := newTestHandler(verifier, nil, nil)
+		sessionCookie := createTestSession(t, h, testSession)
 
 		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
-		req.AddCookie(&http.Cookie{Name: refreshTokenCookieName, Value: "fakeRefreshToken"})
+		req.AddCookie(sessionCookie)
 		rec := httptest.NewRecorder()
 		h.refreshHandler().ServeHTTP(rec, req)
 
@@ -518,16 +543,16 @@ func TestRefreshHandler(t *testing.T) {
 			t.Errorf("expected expires_at %d, got %d", futureExpiry.Unix(), resp.ExpiresAt)
 		}
 
-		cookies := rec.Result().Cookies()
-		if c := findSetCookie(cookies, idTokenCookieName); c == nil || c.Value != "fakeRefreshedTokenId" {
-			t.Errorf("expected new id_token cookie %q, got %+v", "fakeRefreshedTokenId", c)
+		session := sessionOfResponse(t, h, rec)
+		if session.IDToken != "fakeRefreshedTokenId" {
+			t.Errorf("expected session id_token %q, got %q", "fakeRefreshedTokenId", session.IDToken)
 		}
-		if c := findSetCookie(cookies, refreshTokenCookieName); c == nil || c.Value != "fakeRotatedRefreshToken" {
-			t.Errorf("expected rotated refresh_token cookie %q, got %+v", "fakeRotatedRefreshToken", c)
+		if session.RefreshToken != "fakeRotatedRefreshToken" {
+			t.Errorf("expected rotated session refresh_token %q, got %q", "fakeRotatedRefreshToken", session.RefreshToken)
 		}
 	})
 
-	t.Run("missing refresh_token cookie returns 401 and clears cookies", func(t *testing.T) {
+	t.Run("missing session cookie returns 401 and clears cookies", func(t *testing.T) {
 		verifier := &fakeVerifier{secureCookie: newTestSecureCookie()}
 		h := newTestHandler(verifier, nil, nil)
 
@@ -538,25 +563,29 @@ func TestRefreshHandler(t *testing.T) {
 		if rec.Code != http.StatusUnauthorized {
 			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
 		}
-		assertCookieCleared(t, rec.Result().Cookies(), idTokenCookieName)
+		assertCookieCleared(t, rec.Result().Cookies(), handlerauth.SessionCookieName)
 	})
 
-	t.Run("refresh failure returns 401 and clears cookies", func(t *testing.T) {
+	t.Run("refresh failure returns 401 and ends the session", func(t *testing.T) {
 		verifier := &fakeVerifier{
 			secureCookie: newTestSecureCookie(),
 			refreshErr:   errors.New("refresh failed"),
 		}
 		h := newTestHandler(verifier, nil, nil)
+		sessionCookie := createTestSession(t, h, testSession)
 
 		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
-		req.AddCookie(&http.Cookie{Name: refreshTokenCookieName, Value: "fakeRefreshToken"})
+		req.AddCookie(sessionCookie)
 		rec := httptest.NewRecorder()
 		h.refreshHandler().ServeHTTP(rec, req)
 
 		if rec.Code != http.StatusUnauthorized {
 			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
 		}
-		assertCookieCleared(t, rec.Result().Cookies(), idTokenCookieName)
+		assertCookieCleared(t, rec.Result().Cookies(), handlerauth.SessionCookieName)
+		if _, err := h.sessionProvider.Get(context.Background(), sessionCookie.Value); err == nil {
+			t.Error("expected the session to be deleted")
+		}
 	})
 
 	t.Run("verify failure returns 401", func(t *testing.T) {
@@ -568,7 +597,38 @@ func TestRefreshHandler(t *testing.T) {
 		h := newTestHandler(verifier, nil, nil)
 
 		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
-		req.AddCookie(&http.Cookie{Name: refreshTokenCookieName, Value: "fakeRefreshToken"})
+		req.AddCookie(createTestSession(t, h, testSession))
+		rec := httptest.NewRecorder()
+		h.refreshHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("token of another user returns 401", func(t *testing.T) {
+		verifier := &fakeVerifier{
+			secureCookie: newTestSecureCookie(),
+			refreshToken: authtypes.OIDCToken{IDToken: "fakeRefreshedTokenId"},
+			verifyClaims: authtypes.TokenClaims{Email: "jane@acme.com", Expiry: futureExpiry},
+		}
+		h := newTestHandler(verifier, nil, nil)
+
+		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
+		req.AddCookie(createTestSession(t, h, testSession))
+		rec := httptest.NewRecorder()
+		h.refreshHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+	})
+
+	t.Run("session without refresh token returns 401", func(t *testing.T) {
+		h := newTestHandler(&fakeVerifier{secureCookie: newTestSecureCookie()}, nil, nil)
+
+		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
+		req.AddCookie(createTestSession(t, h, provider.Session{UserEmail: "john@acme.com", IDToken: "fakeTokenId"}))
 		rec := httptest.NewRecorder()
 		h.refreshHandler().ServeHTTP(rec, req)
 
@@ -586,7 +646,7 @@ func TestRefreshHandler(t *testing.T) {
 		h := newTestHandler(verifier, nil, nil)
 
 		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
-		req.AddCookie(&http.Cookie{Name: refreshTokenCookieName, Value: "fakeRefreshToken"})
+		req.AddCookie(createTestSession(t, h, testSession))
 		rec := httptest.NewRecorder()
 		h.refreshHandler().ServeHTTP(rec, req)
 
@@ -627,7 +687,7 @@ func TestCallbackHandler(t *testing.T) {
 	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
 	pastExpiry := apiv1.NewTime(time.Now().Add(-time.Hour))
 
-	t.Run("happy path sets token cookies and redirects", func(t *testing.T) {
+	t.Run("happy path creates a session and redirects", func(t *testing.T) {
 		sc := newTestSecureCookie()
 		verifier := &fakeVerifier{
 			secureCookie:  sc,
@@ -647,12 +707,17 @@ func TestCallbackHandler(t *testing.T) {
 		if rec.Code != http.StatusSeeOther {
 			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusSeeOther, rec.Code, rec.Body.String())
 		}
-		cookies := rec.Result().Cookies()
-		if c := findSetCookie(cookies, idTokenCookieName); c == nil || c.Value != "fakeTokenId" {
-			t.Errorf("expected token cookie %q, got %+v", "fakeTokenId", c)
+		// The tokens are kept in the session, the browser only gets the session key.
+		session := sessionOfResponse(t, h, rec)
+		if session.IDToken != "fakeTokenId" || session.RefreshToken != "fakeRefreshToken" {
+			t.Errorf("expected session tokens %q and %q, got %q and %q", "fakeTokenId", "fakeRefreshToken", session.IDToken, session.RefreshToken)
+		}
+		if session.UserEmail != "john@acme.com" {
+			t.Errorf("expected session of %q, got %q", "john@acme.com", session.UserEmail)
 		}
-		if c := findSetCookie(cookies, refreshTokenCookieName); c == nil || c.Value != "fakeRefreshToken" {
-			t.Errorf("expected refresh_token cookie %q, got %+v", "fakeRefreshToken", c)
+		cookies := rec.Result().Cookies()
+		if c := findSetCookie(cookies, idTokenCookieName); c != nil {
+			t.Errorf("expected no token cookie, got %+v", c)
 		}
 		// The one-time state cookie must be cleared.
 		assertCookieCleared(t, cookies, oauthStateCookieName)
@@ -752,9 +817,9 @@ func TestCallbackHandler(t *testing.T) {
 		}
 	})
 
-	t.Run("large id_token is split into chunked cookies", func(t *testing.T) {
+	t.Run("large id_token is kept in the session", func(t *testing.T) {
 		sc := newTestSecureCookie()
-		largeToken := strings.Repeat("x", maxCookieSize+1000) // exceeds a single cookie
+		largeToken := strings.Repeat("x", 10000) // exceeds a single cookie
 		verifier := &fakeVerifier{
 			secureCookie:  sc,
 			exchangeToken: authtypes.OIDCToken{IDToken: largeToken},
@@ -772,19 +837,12 @@ func TestCallbackHandler(t *testing.T) {
 		if rec.Code != http.StatusSeeOther {
 			t.Fatalf("expected 303, got %d (body: %q)", rec.Code, rec.Body.String())
 		}
-		cookies := rec.Result().Cookies()
-
-		// Reassemble token-1, token-2, ... and verify it equals the original token.
-		var reassembled string
-		for i := 1; ; i++ {
-			c := findSetCookie(cookies, fmt.Sprintf("%s-%d", idTokenCookieName, i))
-			if c == nil {
-				break
-			}
-			reassembled += c.Value
+		if session := sessionOfResponse(t, h, rec); session.IDToken != largeToken {
+			t.Errorf("session token mismatch: got len %d, want len %d", len(session.IDToken), len(largeToken))
 		}
-		if reassembled != largeToken {
-			t.Errorf("reassembled chunked token mismatch: got len %d, want len %d", len(reassembled), len(largeToken))
+		// Without a refresh token the session ends with the id_token.
+		if c := findSetCookie(rec.Result().Cookies(), handlerauth.SessionCookieName); c.MaxAge > int(time.Hour.Seconds()) {
+			t.Errorf("expected the session cookie to expire with the id_token, got MaxAge %d", c.MaxAge)
 		}
 	})
 
@@ -836,3 +894,141 @@ func TestCallbackHandler(t *testing.T) {
 		}
 	})
 }
+
+// -----------------------------------------------------------------------------
+// additional issuers
+// -----------------------------------------------------------------------------
+
+func TestAdditionalIssuers(t *testing.T) {
+	futureExpiry := apiv1.NewTime(time.Now().Add(time.Hour))
+
+	newHandler := func(defaultVerifier, partner *fakeVerifier) *authHandler {
+		configGetter := func(context.Context) (*kubermaticv1.KubermaticConfiguration, error) {
+			return &kubermaticv1.KubermaticConfiguration{}, nil
+		}
+		return NewAuthHandler(defaultVerifier, &fakeUserProvider{}, memory.NewSessionProvider(), memory.NewDeviceAuthorizationProvider(), nil, configGetter, nil, partner)
+	}
+
+	t.Run("login stores the selected issuer in the state cookie", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		h := newHandler(&fakeVerifier{secureCookie: sc}, &fakeVerifier{name: "partner", secureCookie: sc})
+
+		req := httptest.NewRequest(http.MethodGet, testLoginURL+"?issuer=partner", nil)
+		rec := httptest.NewRecorder()
+		h.loginHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusSeeOther {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusSeeOther, rec.Code, rec.Body.String())
+		}
+		stateCookie := findSetCookie(rec.Result().Cookies(), oauthStateCookieName)
+		if stateCookie == nil {
+			t.Fatalf("expected %q cookie to be set", oauthStateCookieName)
+		}
+		var stored oauthStateCookie
+		if err := sc.Decode(oauthStateCookieName, stateCookie.Value, &stored); err != nil {
+			t.Fatalf("failed to decode state cookie: %v", err)
+		}
+		if stored.Issuer != "partner" {
+			t.Errorf("expected issuer %q in state cookie, got %q", "partner", stored.Issuer)
+		}
+	})
+
+	t.Run("login with an unknown issuer returns 400", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		h := newHandler(&fakeVerifier{secureCookie: sc}, &fakeVerifier{name: "partner", secureCookie: sc})
+
+		req := httptest.NewRequest(http.MethodGet, testLoginURL+"?issuer=unknown", nil)
+		rec := httptest.NewRecorder()
+		h.loginHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusBadRequest {
+			t.Fatalf("expected status %d, got %d", http.StatusBadRequest, rec.Code)
+		}
+	})
+
+	t.Run("callback exchanges the code at the issuer of the login", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		defaultVerifier := &fakeVerifier{secureCookie: sc, exchangeErr: errors.New("wrong issuer")}
+		partner := &fakeVerifier{
+			name:          "partner",
+			secureCookie:  sc,
+			exchangeToken: authtypes.OIDCToken{IDToken: "fakePartnerTokenId", RefreshToken: "fakePartnerRefreshToken"},
+			verifyClaims:  authtypes.TokenClaims{Email: "jane@partner.com", Nonce: "nonce-abc", Expiry: futureExpiry},
+		}
+		h := newHandler(defaultVerifier, partner)
+
+		cookie := encodeStateCookie(t, sc, oauthStateCookie{State: "state-123", Nonce: "nonce-abc", Issuer: "partner"})
+		rec := runCallback(h, cookie, "state=state-123&code=fakeCode")
+
+		if rec.Code != http.StatusSeeOther {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusSeeOther, rec.Code, rec.Body.String())
+		}
+		session := sessionOfResponse(t, h, rec)
+		if session.IDToken != "fakePartnerTokenId" {
+			t.Errorf("expected session token %q, got %q", "fakePartnerTokenId", session.IDToken)
+		}
+		if session.Issuer != "partner" {
+			t.Errorf("expected session issuer %q, got %q", "partner", session.Issuer)
+		}
+	})
+
+	t.Run("refresh redeems the token at the issuer of the session", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		defaultVerifier := &fakeVerifier{secureCookie: sc, refreshErr: errors.New("wrong issuer")}
+		partner := &fakeVerifier{
+			name:         "partner",
+			secureCookie: sc,
+			refreshToken: authtypes.OIDCToken{IDToken: "fakePartnerRefreshedTokenId"},
+			verifyClaims: authtypes.TokenClaims{Email: "jane@partner.com", Expiry: futureExpiry},
+		}
+		h := newHandler(defaultVerifier, partner)
+
+		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
+		req.AddCookie(createTestSession(t, h, provider.Session{UserEmail: "jane@partner.com", Issuer: "partner", RefreshToken: "fakePartnerRefreshToken"}))
+		rec := httptest.NewRecorder()
+		h.refreshHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d (body: %q)", http.StatusOK, rec.Code, rec.Body.String())
+		}
+		if session := sessionOfResponse(t, h, rec); session.IDToken != "fakePartnerRefreshedTokenId" {
+			t.Errorf("expected session token %q, got %q", "fakePartnerRefreshedTokenId", session.IDToken)
+		}
+	})
+
+	t.Run("refresh with an unknown issuer returns 401 and clears cookies", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		h := newHandler(&fakeVerifier{secureCookie: sc}, &fakeVerifier{name: "partner", secureCookie: sc})
+
+		req := httptest.NewRequest(http.MethodPost, testRefreshURL, nil)
+		req.AddCookie(createTestSession(t, h, provider.Session{UserEmail: "jane@partner.com", Issuer: "removed", RefreshToken: "fakeRefreshToken"}))
+		rec := httptest.NewRecorder()
+		h.refreshHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusUnauthorized {
+			t.Fatalf("expected status %d, got %d", http.StatusUnauthorized, rec.Code)
+		}
+		assertCookieCleared(t, rec.Result().Cookies(), handlerauth.SessionCookieName)
+	})
+
+	t.Run("issuers are listed with the default issuer first", func(t *testing.T) {
+		sc := newTestSecureCookie()
+		partner := &fakeVerifier{name: "partner", secureCookie: sc}
+		h := newHandler(&fakeVerifier{secureCookie: sc}, partner)
+
+		req := httptest.NewRequest(http.MethodGet, "http://localhost/api/v2/auth/issuers", nil)
+		rec := httptest.NewRecorder()
+		h.issuersHandler().ServeHTTP(rec, req)
+
+		if rec.Code != http.StatusOK {
+			t.Fatalf("expected status %d, got %d", http.StatusOK, rec.Code)
+		}
+		var issuers []oidcIssuer
+		if err := json.Unmarshal(rec.Body.Bytes(), &issuers); err != nil {
+			t.Fatalf("failed to decode response: %v", err)
+		}
+		if len(issuers) != 2 || issuers[0].Name != "default" || issuers[1].Name != "partner" {
+			t.Errorf("expected issuers [default partner], got %+v", issuers)
+		}
+	})
+}
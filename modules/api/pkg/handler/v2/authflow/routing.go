@@ -27,10 +27,24 @@ import (
 )
 
 type authHandler struct {
-	oidcIssuerVerifier       authtypes.OIDCIssuerVerifier
+	// oidcIssuerVerifier is the issuer configured with --oidc-url, its cookie settings apply to all issuers.
+	oidcIssuerVerifier authtypes.OIDCIssuerVerifier
+	// issuers are all issuers in the order they are offered for the login, the default issuer first.
+	issuers                  []authtypes.OIDCIssuerVerifier
+	tokenVerifier            authtypes.TokenVerifier
 	tokenExtractor           authtypes.TokenExtractor
 	userProvider             provider.UserProvider
+	sessionProvider          provider.SessionProvider
 	kubermaticConfigProvider provider.KubermaticConfigurationGetter
+
+	deviceAuthorizationProvider provider.DeviceAuthorizationProvider
+	// deviceKubeconfigGetter creates the kubeconfigs devices ask for.
+	deviceKubeconfigGetter DeviceKubeconfigGetter
+	// deviceCodeLimiter limits the device codes each client can ask for.
+	deviceCodeLimiter *clientRateLimiter
+
+	// trustedProxies resolve the client IPs recorded in the sessions.
+	trustedProxies handlerauth.TrustedProxies
 }
 
 func (a *authHandler) Install(router *mux.Router) {
@@ -53,17 +67,57 @@ func (a *authHandler) Install(router *mux.Router) {
 	router.Methods(http.MethodGet).
 		Path("/auth/status").
 		Handler(a.statusHandler())
+
+	router.Methods(http.MethodGet).
+		Path("/auth/issuers").
+		Handler(a.issuersHandler())
+
+	router.Methods(http.MethodGet).
+		Path("/auth/device").
+		Handler(a.deviceVerificationHandler())
+
+	router.Methods(http.MethodPost).
+		Path("/auth/device/code").
+		Handler(a.deviceCodeHandler())
+
+	router.Methods(http.MethodPost).
+		Path("/auth/device/approve").
+		Handler(a.deviceDecisionHandler(true))
+
+	router.Methods(http.MethodPost).
+		Path("/auth/device/deny").
+		Handler(a.deviceDecisionHandler(false))
+
+	router.Methods(http.MethodPost).
+		Path("/auth/device/token").
+		Handler(a.deviceTokenHandler())
 }
 
-// NewAuthHandler creates a new Handler for KKP dashboard authentication.
-func NewAuthHandler(oidcIssuerVerifier authtypes.OIDCIssuerVerifier, userProvider provider.UserProvider, kubermaticConfigProvider provider.KubermaticConfigurationGetter) *authHandler {
+// NewAuthHandler creates a new Handler for KKP dashboard authentication. Users log in with the default issuer unless
+// they select one of the additional issuers. The tokens of a login are kept in a server-side session. Devices
+// without a browser log in with the default issuer through the device authorization grant.
+func NewAuthHandler(oidcIssuerVerifier authtypes.OIDCIssuerVerifier, userProvider provider.UserProvider, sessionProvider provider.SessionProvider, deviceAuthorizationProvider provider.DeviceAuthorizationProvider, deviceKubeconfigGetter DeviceKubeconfigGetter, kubermaticConfigProvider provider.KubermaticConfigurationGetter, trustedProxies handlerauth.TrustedProxies, additionalIssuers ...authtypes.OIDCIssuerVerifier) *authHandler {
+	issuers := append([]authtypes.OIDCIssuerVerifier{oidcIssuerVerifier}, additionalIssuers...)
+	verifiers := make([]authtypes.TokenVerifier, 0, len(issuers))
+	for _, issuer := range issuers {
+		verifiers = append(verifiers, issuer)
+	}
+
 	return &authHandler{
 		oidcIssuerVerifier: oidcIssuerVerifier,
+		issuers:            issuers,
+		tokenVerifier:      handlerauth.NewTokenVerifierPlugins(verifiers),
 		tokenExtractor: handlerauth.NewCombinedExtractor(
+			handlerauth.NewSessionTokenExtractor(sessionProvider, trustedProxies),
 			handlerauth.NewCookieHeaderBearerTokenExtractor(idTokenCookieName),
 			handlerauth.NewCookieHeaderBearerMultiTokenExtractor(idTokenCookieName),
 		),
-		userProvider:             userProvider,
-		kubermaticConfigProvider: kubermaticConfigProvider,
+		userProvider:                userProvider,
+		sessionProvider:             sessionProvider,
+		kubermaticConfigProvider:    kubermaticConfigProvider,
+		deviceAuthorizationProvider: deviceAuthorizationProvider,
+		deviceKubeconfigGetter:      deviceKubeconfigGetter,
+		deviceCodeLimiter:           newClientRateLimiter(deviceCodeClientQPS, deviceCodeClientBurst),
+		trustedProxies:              trustedProxies,
 	}
 }
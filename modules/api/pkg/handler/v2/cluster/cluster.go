@@ -482,6 +482,11 @@ func (req DeleteReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
+// GetClusterID returns the ID of the cluster to delete.
+func (req DeleteReq) GetClusterID() string {
+	return req.ClusterID
+}
+
 func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req DeleteReq
 
@@ -545,7 +550,7 @@ func DecodeListClustersReq(c context.Context, r *http.Request) (interface{}, err
 }
 
 // GetClusterReq defines HTTP request for getCluster endpoint.
-// swagger:parameters getClusterV2 getClusterHealthV2 getOidcClusterKubeconfigV2 getClusterKubeconfigV2 getClusterMetricsV2 listNamespaceV2 getClusterUpgradesV2 listAWSSizesNoCredentialsV2 listAWSSubnetsNoCredentialsV2 listGCPNetworksNoCredentialsV2 listGCPZonesNoCredentialsV2 listHetznerSizesNoCredentialsV2 listDigitaloceanSizesNoCredentialsV2 migrateClusterToExternalCCM getClusterOidc listKubeVirtInstancetypesNoCredentials listKubevirtStorageClassesNoCredentials getKubevirtStorageClassesNoCredentials listKubeVirtVPCsNoCredentials listKubeVirtSubnetsNoCredentials
+// swagger:parameters getClusterV2 getClusterHealthV2 getOidcClusterKubeconfigV2 getClusterKubeconfigV2 getClusterMetricsV2 listNamespaceV2 getClusterUpgradesV2 getClusterUpgradeOperation resumeClusterUpgradeOperation abortClusterUpgradeOperation listClusterReadinessChecks listClusterAPIResources listControlPlaneLogComponents listAWSSizesNoCredentialsV2 listAWSSubnetsNoCredentialsV2 listGCPNetworksNoCredentialsV2 listGCPZonesNoCredentialsV2 listHetznerSizesNoCredentialsV2 listDigitaloceanSizesNoCredentialsV2 migrateClusterToExternalCCM getClusterOidc listKubeVirtInstancetypesNoCredentials listKubevirtStorageClassesNoCredentials getKubevirtStorageClassesNoCredentials listKubeVirtVPCsNoCredentials listKubeVirtSubnetsNoCredentials
 type GetClusterReq struct {
 	common.ProjectReq
 	// in: path
@@ -29,6 +29,7 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/middleware"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -877,6 +878,43 @@ func TestDeleteClusterEndpoint(t *testing.T) {
 			ExistingAPIUser:               test.GenAPIUser("John", "john@acme.com"),
 			ExpectedListClusterKeysStatus: http.StatusNotFound,
 		},
+		{
+			Name:             "scenario 3: a deletion-protected cluster cannot be deleted",
+			Body:             ``,
+			ExpectedResponse: `{"error":{"code":409,"message":"the cluster clusterAbcID is protected from deletion, remove its label dashboard.k8c.io/deletion-protection first","details":["deletion_protected"]}}`,
+			HTTPStatus:       http.StatusConflict,
+			ProjectToSync:    test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				func() *kubermaticv1.Cluster {
+					cluster := test.GenCluster("clusterAbcID", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC))
+					cluster.Labels[middleware.DeletionProtectionLabel] = "true"
+					return cluster
+				}(),
+			),
+			ClusterToSync:                 "clusterAbcID",
+			ExistingAPIUser:               test.GenDefaultAPIUser(),
+			ExpectedListClusterKeysStatus: http.StatusNotFound,
+		},
+		{
+			Name:             "scenario 4: the step-up authentication requires a token which tells when the user logged in",
+			Body:             ``,
+			ExpectedResponse: `{"error":{"code":401,"message":"a recent authentication is required, but the token does not tell when the user authenticated","details":["insufficient_user_authentication","max_age=900"]}}`,
+			HTTPStatus:       http.StatusUnauthorized,
+			ProjectToSync:    test.GenDefaultProject().Name,
+			ExistingKubermaticObjs: test.GenDefaultKubermaticObjects(
+				test.GenTestSeed(),
+				test.GenCluster("clusterAbcID", "clusterAbc", test.GenDefaultProject().Name, time.Date(2013, 02, 03, 19, 54, 0, 0, time.UTC)),
+				func() *kubermaticv1.KubermaticSetting {
+					settings := test.GenDefaultGlobalSettings()
+					settings.Annotations = map[string]string{middleware.StepUpMaxAgeAnnotation: "15m"}
+					return settings
+				}(),
+			),
+			ClusterToSync:                 "clusterAbcID",
+			ExistingAPIUser:               test.GenDefaultAPIUser(),
+			ExpectedListClusterKeysStatus: http.StatusNotFound,
+		},
 	}
 
 	for _, tc := range testcases {
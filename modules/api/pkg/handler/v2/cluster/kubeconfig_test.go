@@ -192,6 +192,67 @@ func TestGetMasterKubeconfig(t *testing.T) {
 	}
 }
 
+func TestGetKubeconfigWithReadOnlyToken(t *testing.T) {
+	t.Parallel()
+	testcases := []struct {
+		Name       string
+		URL        string
+		HTTPStatus int
+	}{
+		{
+			Name:       "scenario 1: a read-only token cannot get the master kubeconfig",
+			URL:        "/api/v2/projects/foo-ID/clusters/cluster-foo/kubeconfig",
+			HTTPStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "scenario 2: a read-only token cannot get a service account kubeconfig",
+			URL:        "/api/v2/projects/foo-ID/clusters/cluster-foo/serviceaccount/default/test/kubeconfig",
+			HTTPStatus: http.StatusForbidden,
+		},
+		{
+			Name:       "scenario 3: a read-only token can get the cluster",
+			URL:        "/api/v2/projects/foo-ID/clusters/cluster-foo",
+			HTTPStatus: http.StatusOK,
+		},
+		{
+			Name:       "scenario 4: a read-only token cannot get the OIDC settings, which contain the client secret",
+			URL:        "/api/v2/projects/foo-ID/clusters/cluster-foo/oidc",
+			HTTPStatus: http.StatusForbidden,
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			req := httptest.NewRequest(http.MethodGet, tc.URL, nil)
+			req.Header.Set("Authorization", "Bearer "+test.ReadOnlyIDToken)
+			res := httptest.NewRecorder()
+			kubermaticObj := []ctrlruntimeclient.Object{
+				test.GenTestSeed(),
+				test.GenProject("foo", kubermaticv1.ProjectActive, test.DefaultCreationTimestamp()),
+				test.GenBinding("foo-ID", "john@acme.com", "owners"),
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenCluster("cluster-foo", "cluster-foo", "foo-ID", test.DefaultCreationTimestamp()),
+			}
+			existingObjects := []ctrlruntimeclient.Object{
+				&corev1.Secret{
+					ObjectMeta: metav1.ObjectMeta{Namespace: "cluster-cluster-foo", Name: "admin-kubeconfig"},
+					Data:       map[string][]byte{"kubeconfig": []byte(test.GenerateTestKubeconfig("cluster-foo", test.IDToken))},
+				},
+			}
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenAPIUser("john", "john@acme.com"), nil, existingObjects, []ctrlruntimeclient.Object{}, kubermaticObj, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.HTTPStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.HTTPStatus, res.Code, res.Body.String())
+			}
+		})
+	}
+}
+
 func TestGetClusterSAKubeconfig(t *testing.T) {
 	t.Parallel()
 	testcases := []struct {
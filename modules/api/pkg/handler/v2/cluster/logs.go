@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func GetPodLogsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getPodLogsReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, getPodLogsReq{})
+		}
+		return handlercommon.GetPodLogs(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.Namespace, req.PodName, req.LogOptions)
+	}
+}
+
+func ListControlPlaneLogComponentsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetClusterReq{})
+		}
+		return handlercommon.ListControlPlaneLogComponents(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID)
+	}
+}
+
+func GetControlPlaneLogsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(getControlPlaneLogsReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, getControlPlaneLogsReq{})
+		}
+		return handlercommon.GetControlPlaneLogs(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.Component, req.Pod, req.LogOptions)
+	}
+}
+
+// getPodLogsReq defines HTTP request for getPodLogs
+// swagger:parameters getPodLogs
+type getPodLogsReq struct {
+	GetClusterReq
+	handlercommon.LogOptions
+	// in: path
+	// required: true
+	Namespace string `json:"namespace"`
+	// in: path
+	// required: true
+	PodName string `json:"pod_name"`
+}
+
+func DecodeGetPodLogsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req getPodLogsReq
+
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = clusterReq.(GetClusterReq)
+
+	req.Namespace = mux.Vars(r)["namespace"]
+	if req.Namespace == "" {
+		return nil, fmt.Errorf("'namespace' parameter is required but was not provided")
+	}
+	req.PodName = mux.Vars(r)["pod_name"]
+	if req.PodName == "" {
+		return nil, fmt.Errorf("'pod_name' parameter is required but was not provided")
+	}
+
+	req.LogOptions, err = handlercommon.DecodeLogOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
+
+// getControlPlaneLogsReq defines HTTP request for getControlPlaneLogs
+// swagger:parameters getControlPlaneLogs
+type getControlPlaneLogsReq struct {
+	GetClusterReq
+	handlercommon.LogOptions
+	// in: path
+	// required: true
+	Component string `json:"component"`
+	// The pod of the component, defaults to the most recently created one
+	// in: query
+	Pod string `json:"pod,omitempty"`
+}
+
+func DecodeGetControlPlaneLogsReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req getControlPlaneLogsReq
+
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = clusterReq.(GetClusterReq)
+
+	req.Component = mux.Vars(r)["component"]
+	if req.Component == "" {
+		return nil, fmt.Errorf("'component' parameter is required but was not provided")
+	}
+	req.Pod = r.URL.Query().Get("pod")
+
+	req.LogOptions, err = handlercommon.DecodeLogOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
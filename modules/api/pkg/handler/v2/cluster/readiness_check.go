@@ -0,0 +1,129 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"io"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func StartReadinessCheckEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, allowedRegistryProvider provider.PrivilegedAllowedRegistryProvider, backgroundOperationProvider provider.BackgroundOperationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(StartReadinessCheckReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, StartReadinessCheckReq{})
+		}
+		return handlercommon.StartClusterReadinessCheckEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Body, projectProvider, privilegedProjectProvider, allowedRegistryProvider, backgroundOperationProvider)
+	}
+}
+
+func ListReadinessChecksEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetClusterReq{})
+		}
+		return handlercommon.ListClusterReadinessChecksEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func GetReadinessCheckEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetReadinessCheckReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetReadinessCheckReq{})
+		}
+		return handlercommon.GetClusterReadinessCheckEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.RunID, projectProvider, privilegedProjectProvider)
+	}
+}
+
+// StartReadinessCheckReq defines HTTP request for startClusterReadinessCheck endpoint
+// swagger:parameters startClusterReadinessCheck
+type StartReadinessCheckReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+
+	// in: body
+	Body apiv2.ClusterReadinessCheckRunSpec
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req StartReadinessCheckReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeStartReadinessCheckReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req StartReadinessCheckReq
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	// all checks with the default image are run without a body
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil && !errors.Is(err, io.EOF) {
+		return nil, utilerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
+
+// GetReadinessCheckReq defines HTTP request for getClusterReadinessCheck endpoint
+// swagger:parameters getClusterReadinessCheck
+type GetReadinessCheckReq struct {
+	GetClusterReq
+	// in: path
+	// required: true
+	RunID string `json:"run_id"`
+}
+
+func DecodeGetReadinessCheckReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	runID := mux.Vars(r)["run_id"]
+	if runID == "" {
+		return nil, fmt.Errorf("'run_id' parameter is required but was not provided")
+	}
+
+	return GetReadinessCheckReq{GetClusterReq: clusterReq.(GetClusterReq), RunID: runID}, nil
+}
@@ -0,0 +1,54 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster_test
+
+import (
+	"fmt"
+	"net/http"
+	"net/http/httptest"
+	"testing"
+
+	"k8c.io/dashboard/v2/pkg/handler/test"
+	"k8c.io/dashboard/v2/pkg/handler/test/hack"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func TestStartReadinessCheckRequiresEditor(t *testing.T) {
+	t.Parallel()
+
+	kubermaticObjs := []ctrlruntimeclient.Object{
+		test.GenTestSeed(),
+		test.GenDefaultProject(),
+		test.GenUser("", "john", "john@acme.com"),
+		test.GenBinding(test.GenDefaultProject().Name, "john@acme.com", "viewers"),
+		test.GenDefaultCluster(),
+	}
+
+	req := httptest.NewRequest(http.MethodPost, fmt.Sprintf("/api/v2/projects/%s/clusters/%s/readinesschecks", test.GenDefaultProject().Name, test.GenDefaultCluster().Name), nil)
+	res := httptest.NewRecorder()
+	ep, err := test.CreateTestEndpoint(*test.GenAPIUser("john", "john@acme.com"), nil, kubermaticObjs, nil, hack.NewTestRouting)
+	if err != nil {
+		t.Fatalf("failed to create test endpoint: %v", err)
+	}
+
+	ep.ServeHTTP(res, req)
+
+	if res.Code != http.StatusForbidden {
+		t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusForbidden, res.Code, res.Body.String())
+	}
+}
@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package cluster
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"strconv"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+func ListAPIResourcesEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetClusterReq{})
+		}
+		return handlercommon.ListClusterAPIResourcesEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func ListResourcesEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(ListResourcesReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, ListResourcesReq{})
+		}
+		return handlercommon.ListClusterResourcesEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.groupVersionKind(), handlercommon.ClusterResourceListOptions{
+			Namespace:     req.Namespace,
+			LabelSelector: req.LabelSelector,
+			FieldSelector: req.FieldSelector,
+			Limit:         req.Limit,
+			Continue:      req.Continue,
+		}, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func GetResourceEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetResourceReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetResourceReq{})
+		}
+		return handlercommon.GetClusterResourceEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.groupVersionKind(), req.Namespace, req.Name, projectProvider, privilegedProjectProvider)
+	}
+}
+
+// resourceTypeReq selects the type of the resources to read.
+type resourceTypeReq struct {
+	// API group of the resource, empty for the core group
+	// in: query
+	Group string `json:"group,omitempty"`
+	// API version of the resource, the preferred version of the group is used if empty
+	// in: query
+	Version string `json:"version,omitempty"`
+	// in: query
+	// required: true
+	Kind string `json:"kind"`
+	// Namespace of the resources, namespaced resources of all namespaces are listed if empty
+	// in: query
+	Namespace string `json:"namespace,omitempty"`
+}
+
+func (req resourceTypeReq) groupVersionKind() schema.GroupVersionKind {
+	return schema.GroupVersionKind{Group: req.Group, Version: req.Version, Kind: req.Kind}
+}
+
+func decodeResourceTypeReq(r *http.Request) resourceTypeReq {
+	query := r.URL.Query()
+	return resourceTypeReq{
+		Group:     query.Get("group"),
+		Version:   query.Get("version"),
+		Kind:      query.Get("kind"),
+		Namespace: query.Get("namespace"),
+	}
+}
+
+// ListResourcesReq defines HTTP request for listClusterResources endpoint
+// swagger:parameters listClusterResources
+type ListResourcesReq struct {
+	GetClusterReq
+	resourceTypeReq
+	// in: query
+	LabelSelector string `json:"labelSelector,omitempty"`
+	// in: query
+	FieldSelector string `json:"fieldSelector,omitempty"`
+	// Maximum number of resources to return, defaults to 100
+	// in: query
+	Limit int64 `json:"limit,omitempty"`
+	// Token of the next page, as returned with the previous page
+	// in: query
+	Continue string `json:"continue,omitempty"`
+}
+
+func DecodeListResourcesReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := ListResourcesReq{
+		GetClusterReq:   clusterReq.(GetClusterReq),
+		resourceTypeReq: decodeResourceTypeReq(r),
+		LabelSelector:   r.URL.Query().Get("labelSelector"),
+		FieldSelector:   r.URL.Query().Get("fieldSelector"),
+		Continue:        r.URL.Query().Get("continue"),
+	}
+	if limit := r.URL.Query().Get("limit"); limit != "" {
+		req.Limit, err = strconv.ParseInt(limit, 10, 64)
+		if err != nil {
+			return nil, utilerrors.NewBadRequest("invalid limit: %v", err)
+		}
+	}
+
+	return req, nil
+}
+
+// GetResourceReq defines HTTP request for getClusterResource endpoint
+// swagger:parameters getClusterResource
+type GetResourceReq struct {
+	GetClusterReq
+	resourceTypeReq
+	// in: path
+	// required: true
+	Name string `json:"resource_name"`
+}
+
+func DecodeGetResourceReq(c context.Context, r *http.Request) (interface{}, error) {
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	name := mux.Vars(r)["resource_name"]
+	if name == "" {
+		return nil, fmt.Errorf("'resource_name' parameter is required but was not provided")
+	}
+
+	return GetResourceReq{
+		GetClusterReq:   clusterReq.(GetClusterReq),
+		resourceTypeReq: decodeResourceTypeReq(r),
+		Name:            name,
+	}, nil
+}
@@ -19,11 +19,13 @@ package cluster
 import (
 	"context"
 	"encoding/json"
+	"fmt"
 	"net/http"
 
 	"github.com/go-kit/kit/endpoint"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
@@ -40,6 +42,50 @@ func GetUpgradesEndpoint(configGetter provider.KubermaticConfigurationGetter, pr
 	}
 }
 
+func GetUpgradePlanEndpoint(configGetter provider.KubermaticConfigurationGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, addonConfigProvider provider.AddonConfigProvider, applicationDefinitionProvider provider.ApplicationDefinitionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetUpgradePlanReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetUpgradePlanReq{})
+		}
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+		return handlercommon.GetUpgradePlanEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Version, projectProvider, privilegedProjectProvider, configGetter, addonConfigProvider, applicationDefinitionProvider)
+	}
+}
+
+// GetUpgradePlanReq defines HTTP request for getClusterUpgradePlan endpoint
+// swagger:parameters getClusterUpgradePlan
+type GetUpgradePlanReq struct {
+	GetClusterReq
+	// The Kubernetes version the cluster should be upgraded to
+	// in: query
+	// required: true
+	Version string `json:"version"`
+}
+
+// Validate validates GetUpgradePlanReq request.
+func (req GetUpgradePlanReq) Validate() error {
+	if len(req.Version) == 0 {
+		return fmt.Errorf("the target version cannot be empty")
+	}
+	return nil
+}
+
+func DecodeGetUpgradePlanReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req GetUpgradePlanReq
+
+	clusterReq, err := DecodeGetClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.GetClusterReq = clusterReq.(GetClusterReq)
+	req.Version = r.URL.Query().Get("version")
+
+	return req, nil
+}
+
 func UpgradeNodeDeploymentsEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req, ok := request.(UpgradeNodeDeploymentsReq)
@@ -88,3 +134,82 @@ func DecodeUpgradeNodeDeploymentsReq(c context.Context, r *http.Request) (interf
 
 	return req, nil
 }
+
+func StartUpgradeOperationEndpoint(configGetter provider.KubermaticConfigurationGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(StartUpgradeOperationReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, StartUpgradeOperationReq{})
+		}
+		return handlercommon.StartClusterUpgradeEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, req.Body, projectProvider, privilegedProjectProvider, backgroundOperationProvider, configGetter)
+	}
+}
+
+func GetUpgradeOperationEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetClusterReq{})
+		}
+		return handlercommon.GetClusterUpgradeEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
+func ResumeUpgradeOperationEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetClusterReq{})
+		}
+		return handlercommon.ResumeClusterUpgradeEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider, backgroundOperationProvider)
+	}
+}
+
+func AbortUpgradeOperationEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(GetClusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, GetClusterReq{})
+		}
+		return handlercommon.AbortClusterUpgradeEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider)
+	}
+}
+
+// StartUpgradeOperationReq defines HTTP request for startClusterUpgradeOperation endpoint
+// swagger:parameters startClusterUpgradeOperation
+type StartUpgradeOperationReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+
+	// in: body
+	Body apiv2.ClusterUpgradeOperationSpec
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req StartUpgradeOperationReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeStartUpgradeOperationReq(c context.Context, r *http.Request) (interface{}, error) {
+	var req StartUpgradeOperationReq
+	projectReq, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ProjectReq = projectReq.(common.ProjectReq)
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+	req.ClusterID = clusterID
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the input: %v", err)
+	}
+
+	return req, nil
+}
@@ -29,6 +29,7 @@ import (
 	semverlib "github.com/Masterminds/semver/v3"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/test"
 	"k8c.io/dashboard/v2/pkg/handler/test/hack"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -287,6 +288,159 @@ func TestGetClusterUpgrades(t *testing.T) {
 	}
 }
 
+func TestGetClusterUpgradePlan(t *testing.T) {
+	t.Parallel()
+
+	versions := []k8csemver.Semver{
+		*k8csemver.NewSemverOrDie("1.6.0"),
+		*k8csemver.NewSemverOrDie("1.7.0"),
+		*k8csemver.NewSemverOrDie("1.7.1"),
+		*k8csemver.NewSemverOrDie("1.8.0"),
+		*k8csemver.NewSemverOrDie("1.8.1"),
+	}
+	updates := []kubermaticv1.Update{
+		{
+			From: "1.6.*",
+			To:   "1.7.*",
+		},
+		{
+			From: "1.7.*",
+			To:   "1.8.*",
+		},
+	}
+	genCluster := func(modifiers ...func(*kubermaticv1.Cluster)) *kubermaticv1.Cluster {
+		c := test.GenCluster("foo", "foo", "project", time.Now(), modifiers...)
+		c.Labels = map[string]string{"user": test.UserName}
+		c.Spec.Version = *k8csemver.NewSemverOrDie("1.6.0")
+		return c
+	}
+
+	tests := []struct {
+		name                       string
+		targetVersion              string
+		cluster                    *kubermaticv1.Cluster
+		existingKubermaticObjs     []ctrlruntimeclient.Object
+		existingMachineDeployments []ctrlruntimeclient.Object
+		updates                    []kubermaticv1.Update
+		incompatibilities          []kubermaticv1.Incompatibility
+		httpStatus                 int
+		expectedResponse           string
+	}{
+		{
+			name:             "plan hops over every minor version",
+			targetVersion:    "1.8.1",
+			cluster:          genCluster(),
+			updates:          updates,
+			httpStatus:       http.StatusOK,
+			expectedResponse: `{"currentVersion":"1.6.0","targetVersion":"1.8.1","steps":[{"version":"1.7.1"},{"version":"1.8.1"}],"blocked":false}`,
+		},
+		{
+			name:          "plan blocked by kubelet version skew",
+			targetVersion: "1.8.1",
+			cluster:       genCluster(),
+			existingMachineDeployments: func() []ctrlruntimeclient.Object {
+				md := test.GenTestMachineDeployment("venus", `{"cloudProvider":"digitalocean","cloudProviderSpec":{"token":"dummy-token","region":"fra1","size":"2GB"}, "operatingSystem":"ubuntu", "operatingSystemSpec":{"distUpgradeOnBoot":true}}`, nil, false)
+				md.Spec.Template.Spec.Versions.Kubelet = "v1.5.0"
+				return []ctrlruntimeclient.Object{md}
+			}(),
+			updates:          updates,
+			httpStatus:       http.StatusOK,
+			expectedResponse: `{"currentVersion":"1.6.0","targetVersion":"1.8.1","steps":[{"version":"1.7.1"},{"version":"1.8.1","blockers":[{"type":"KubeletVersionSkew","name":"venus","message":"machine deployment venus with kubelet 1.5.0 has to be upgraded first: kubelet version 1.5.0 is not compatible with control plane version 1.8.1"}]}],"blocked":true}`,
+		},
+		{
+			name:          "plan blocked by provider incompatibility",
+			targetVersion: "1.8.1",
+			cluster: genCluster(func(cluster *kubermaticv1.Cluster) {
+				cluster.Spec.Cloud.VSphere = &kubermaticv1.VSphereCloudSpec{}
+				cluster.Spec.Cloud.Fake = nil
+			}),
+			updates: updates,
+			incompatibilities: []kubermaticv1.Incompatibility{
+				{
+					Provider:  string(kubermaticv1.VSphereCloudProvider),
+					Version:   "1.8.*",
+					Condition: kubermaticv1.AlwaysCondition,
+					Operation: kubermaticv1.UpdateOperation,
+				},
+			},
+			httpStatus:       http.StatusOK,
+			expectedResponse: `{"currentVersion":"1.6.0","targetVersion":"1.8.1","steps":[{"version":"1.7.1"},{"version":"1.8.1","blockers":[{"type":"ProviderIncompatibility","name":"vsphere","message":"version 1.8.1 is incompatible with the vsphere provider (1.8.*)"}]}],"blocked":true}`,
+		},
+		{
+			name:          "plan blocked by addon version constraint",
+			targetVersion: "1.8.1",
+			cluster:       genCluster(),
+			existingKubermaticObjs: []ctrlruntimeclient.Object{
+				test.GenTestAddon("dns", nil, genCluster(), time.Now()),
+				&kubermaticv1.AddonConfig{
+					ObjectMeta: metav1.ObjectMeta{
+						Name: "dns",
+						Annotations: map[string]string{
+							handlercommon.KubernetesVersionConstraintAnnotation: "< 1.8",
+						},
+					},
+				},
+			},
+			updates:          updates,
+			httpStatus:       http.StatusOK,
+			expectedResponse: `{"currentVersion":"1.6.0","targetVersion":"1.8.1","steps":[{"version":"1.7.1"},{"version":"1.8.1","blockers":[{"type":"Addon","name":"dns","message":"addon dns only supports Kubernetes \u003c 1.8"}]}],"blocked":true}`,
+		},
+		{
+			name:             "plan without update path to the target",
+			targetVersion:    "1.8.1",
+			cluster:          genCluster(),
+			updates:          updates[:1],
+			httpStatus:       http.StatusOK,
+			expectedResponse: `{"currentVersion":"1.6.0","targetVersion":"1.8.1","steps":[{"version":"1.7.1"},{"version":"1.8.1","blockers":[{"type":"NoUpdatePath","message":"no configured update leads from 1.7.1 towards 1.8.1"}]}],"blocked":true}`,
+		},
+		{
+			name:             "target version must be newer than the cluster version",
+			targetVersion:    "1.6.0",
+			cluster:          genCluster(),
+			updates:          updates,
+			httpStatus:       http.StatusBadRequest,
+			expectedResponse: `{"error":{"code":400,"message":"target version 1.6.0 must be greater than the current cluster version 1.6.0"}}`,
+		},
+	}
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			dummyKubermaticConfiguration := kubermaticv1.KubermaticConfiguration{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      "kubermatic",
+					Namespace: resources.KubermaticNamespace,
+				},
+				Spec: kubermaticv1.KubermaticConfigurationSpec{
+					API: kubermaticv1.KubermaticAPIConfiguration{
+						AccessibleAddons: []string{"dns"},
+					},
+					Versions: kubermaticv1.KubermaticVersioningConfiguration{
+						Versions:                  versions,
+						Updates:                   tc.updates,
+						ProviderIncompatibilities: tc.incompatibilities,
+					},
+				},
+			}
+
+			req := httptest.NewRequest(http.MethodGet, fmt.Sprintf("/api/v2/projects/%s/clusters/foo/upgradeplan?version=%s", test.ProjectName, tc.targetVersion), nil)
+			res := httptest.NewRecorder()
+			kubermaticObj := []ctrlruntimeclient.Object{tc.cluster}
+			kubermaticObj = append(kubermaticObj, test.GenDefaultKubermaticObjects(test.GenTestSeed())...)
+			kubermaticObj = append(kubermaticObj, tc.existingKubermaticObjs...)
+
+			ep, _, err := test.CreateTestEndpointAndGetClients(*test.GenDefaultAPIUser(), nil, []ctrlruntimeclient.Object{}, tc.existingMachineDeployments, kubermaticObj, &dummyKubermaticConfiguration, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+			ep.ServeHTTP(res, req)
+
+			if res.Code != tc.httpStatus {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", tc.httpStatus, res.Code, res.Body.String())
+			}
+			test.CompareWithResult(t, res, tc.expectedResponse)
+		})
+	}
+}
+
 func TestUpgradeClusterNodeDeployments(t *testing.T) {
 	t.Parallel()
 
@@ -404,3 +558,52 @@ func TestUpgradeClusterNodeDeployments(t *testing.T) {
 		})
 	}
 }
+
+func TestClusterUpgradeOperationRequiresEditor(t *testing.T) {
+	t.Parallel()
+
+	testcases := []struct {
+		Name string
+		URL  string
+		Body string
+	}{
+		{
+			Name: "scenario 1: a viewer cannot start an upgrade",
+			URL:  "/api/v2/projects/%s/clusters/%s/upgradeoperation",
+			Body: `{"version":"9.9.10"}`,
+		},
+		{
+			Name: "scenario 2: a viewer cannot resume an upgrade",
+			URL:  "/api/v2/projects/%s/clusters/%s/upgradeoperation/resume",
+		},
+		{
+			Name: "scenario 3: a viewer cannot abort an upgrade",
+			URL:  "/api/v2/projects/%s/clusters/%s/upgradeoperation/abort",
+		},
+	}
+
+	for _, tc := range testcases {
+		t.Run(tc.Name, func(t *testing.T) {
+			kubermaticObjs := []ctrlruntimeclient.Object{
+				test.GenTestSeed(),
+				test.GenDefaultProject(),
+				test.GenUser("", "john", "john@acme.com"),
+				test.GenBinding(test.GenDefaultProject().Name, "john@acme.com", "viewers"),
+				test.GenDefaultCluster(),
+			}
+
+			req := httptest.NewRequest(http.MethodPost, fmt.Sprintf(tc.URL, test.GenDefaultProject().Name, test.GenDefaultCluster().Name), strings.NewReader(tc.Body))
+			res := httptest.NewRecorder()
+			ep, err := test.CreateTestEndpoint(*test.GenAPIUser("john", "john@acme.com"), nil, kubermaticObjs, nil, hack.NewTestRouting)
+			if err != nil {
+				t.Fatalf("failed to create test endpoint: %v", err)
+			}
+
+			ep.ServeHTTP(res, req)
+
+			if res.Code != http.StatusForbidden {
+				t.Fatalf("Expected HTTP status code %d, got %d: %s", http.StatusForbidden, res.Code, res.Body.String())
+			}
+		})
+	}
+}
@@ -0,0 +1,201 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusteragent
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+	"strings"
+	"time"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+	"github.com/gorilla/websocket"
+	"go.uber.org/zap"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	"k8c.io/dashboard/v2/pkg/clusteragent"
+	"k8c.io/dashboard/v2/pkg/handler"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/util/wait"
+)
+
+// upgrader accepts the agent connections. Agents are not browsers and send no Origin header, so
+// the default same-origin check of gorilla is sufficient.
+var upgrader = websocket.Upgrader{
+	ReadBufferSize:  32 * 1024,
+	WriteBufferSize: 32 * 1024,
+}
+
+// tokenRevalidationInterval is how often the token of a connected agent is checked again, so
+// tunnels of tokens revoked through another API replica are closed as well.
+const tokenRevalidationInterval = time.Minute
+
+func CreateTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	tokenProvider provider.ClusterAgentTokenProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(createTokenReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, createTokenReq{})
+		}
+		return handlercommon.CreateClusterAgentTokenEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, tokenProvider, req.ProjectID, req.Body.Name, req.Body.Expiry)
+	}
+}
+
+func ListTokensEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	tokenProvider provider.ClusterAgentTokenProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(listTokensReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, listTokensReq{})
+		}
+		return handlercommon.ListClusterAgentTokensEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, tokenProvider, req.ProjectID)
+	}
+}
+
+func DeleteTokenEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	tokenProvider provider.ClusterAgentTokenProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(tokenReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, tokenReq{})
+		}
+		return nil, handlercommon.DeleteClusterAgentTokenEndpoint(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, tokenProvider, req.ProjectID, req.TokenID)
+	}
+}
+
+// ConnectHandler accepts the websocket of a cluster agent and serves the tunnel to its cluster
+// until the agent disconnects. The agent authenticates with a registration token of the project
+// instead of a user token, so the handler is not wrapped by the user authentication middlewares.
+//
+// The tunnel is only known to the API replica the agent is connected to, so only the replica
+// holding the tunnel lease accepts agents. The others answer with 503 and the agent retries until
+// it reaches the leader, they reach the cluster through the relay of the leader.
+func ConnectHandler(log *zap.SugaredLogger, tokenProvider provider.ClusterAgentTokenProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	privilegedClusterProvider provider.PrivilegedExternalClusterProvider) http.Handler {
+	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		if !clusteragent.IsLeading() {
+			handler.ErrorEncoder(r.Context(), utilerrors.New(http.StatusServiceUnavailable, "cluster agents are served by another API replica"), w)
+			return
+		}
+
+		token, ok := strings.CutPrefix(r.Header.Get("Authorization"), "Bearer ")
+		if !ok || token == "" {
+			handler.ErrorEncoder(r.Context(), utilerrors.New(http.StatusUnauthorized, "a cluster agent token is required"), w)
+			return
+		}
+
+		cluster, err := handlercommon.RegisterClusterAgent(r.Context(), tokenProvider, privilegedProjectProvider, privilegedClusterProvider, token,
+			r.Header.Get(clusteragent.ClusterIDHeader), r.Header.Get(clusteragent.ClusterNameHeader))
+		if err != nil {
+			handler.ErrorEncoder(r.Context(), err, w)
+			return
+		}
+
+		ws, err := upgrader.Upgrade(w, r, nil)
+		if err != nil {
+			log.Debugw("Failed to upgrade cluster agent connection", "cluster", cluster.Name, zap.Error(err))
+			return
+		}
+
+		ctx, cancel := context.WithCancel(r.Context())
+		defer cancel()
+		go wait.UntilWithContext(ctx, func(ctx context.Context) {
+			_, _, err := tokenProvider.Authenticate(ctx, token, r.Header.Get(clusteragent.ClusterIDHeader))
+			if apierrors.IsUnauthorized(err) || apierrors.IsForbidden(err) {
+				log.Infow("Closing cluster agent tunnel, the token is not valid anymore", "cluster", cluster.Name, zap.Error(err))
+				cancel()
+			}
+		}, tokenRevalidationInterval)
+
+		log.Infow("Cluster agent connected", "cluster", cluster.Name)
+		if err := clusteragent.Serve(ctx, cluster.Name, clusteragent.NewWebsocketConn(ws)); err != nil {
+			log.Debugw("Cluster agent tunnel failed", "cluster", cluster.Name, zap.Error(err))
+		}
+		log.Infow("Cluster agent disconnected", "cluster", cluster.Name)
+	})
+}
+
+// listTokensReq defines HTTP request for listClusterAgentTokens
+// swagger:parameters listClusterAgentTokens
+type listTokensReq struct {
+	common.ProjectReq
+}
+
+func DecodeListTokensReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return listTokensReq{ProjectReq: pr.(common.ProjectReq)}, nil
+}
+
+// createTokenReq defines HTTP request for createClusterAgentToken
+// swagger:parameters createClusterAgentToken
+type createTokenReq struct {
+	common.ProjectReq
+	// in: body
+	Body struct {
+		Name string `json:"name"`
+		// Expiry is optional, tokens without an expiry are valid until they are deleted.
+		Expiry *apiv1.Time `json:"expiry,omitempty"`
+	}
+}
+
+func DecodeCreateTokenReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := createTokenReq{ProjectReq: pr.(common.ProjectReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	return req, nil
+}
+
+// tokenReq defines HTTP request for deleteClusterAgentToken
+// swagger:parameters deleteClusterAgentToken
+type tokenReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	TokenID string `json:"token_id"`
+}
+
+func DecodeTokenReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	tokenID := mux.Vars(r)["token_id"]
+	if tokenID == "" {
+		return nil, fmt.Errorf("'token_id' parameter is required but was not provided")
+	}
+
+	return tokenReq{ProjectReq: pr.(common.ProjectReq), TokenID: tokenID}, nil
+}
@@ -34,6 +34,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/label"
 	clusterv2 "k8c.io/dashboard/v2/pkg/handler/v2/cluster"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubernetesprovider "k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -317,15 +318,48 @@ func (req listClusterTemplatesReq) Validate() error {
 }
 
 func GetEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
-	userInfoGetter provider.UserInfoGetter, clusterTemplateProvider provider.ClusterTemplateProvider) endpoint.Endpoint {
+	userInfoGetter provider.UserInfoGetter, clusterTemplateProvider provider.ClusterTemplateProvider, seedsGetter provider.SeedsGetter, priceCatalog pricing.Catalog) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(getClusterTemplatesReq)
 		if err := req.Validate(); err != nil {
 			return nil, apierrors.NewBadRequest(err.Error())
 		}
 
-		return getClusterTemplate(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, clusterTemplateProvider, req.ProjectID, req.ClusterTemplateID)
+		template, err := getClusterTemplate(ctx, projectProvider, privilegedProjectProvider, userInfoGetter, clusterTemplateProvider, req.ProjectID, req.ClusterTemplateID)
+		if err != nil {
+			return nil, err
+		}
+
+		if priceCatalog != nil {
+			template.CostEstimate = estimateClusterTemplateCost(ctx, userInfoGetter, seedsGetter, priceCatalog, template)
+		}
+
+		return template, nil
+	}
+}
+
+// estimateClusterTemplateCost returns the estimated monthly cost of the initial machine deployment
+// of the template, or nil if the template has none. The template is returned even if it cannot be
+// estimated, the estimate lists the reason as unpriced then.
+func estimateClusterTemplateCost(ctx context.Context, userInfoGetter provider.UserInfoGetter, seedsGetter provider.SeedsGetter, priceCatalog pricing.Catalog, template *apiv2.ClusterTemplate) *apiv2.CostEstimate {
+	if template.NodeDeployment == nil || template.Annotations[kubermaticv1.InitialMachineDeploymentRequestAnnotation] == "" {
+		return nil
 	}
+
+	userInfo, err := userInfoGetter(ctx, "")
+	if err != nil {
+		return handlercommon.NewUnpricedCostEstimate(priceCatalog, fmt.Sprintf("failed to get user info: %v", err))
+	}
+
+	dcName := template.Cluster.Spec.Cloud.DatacenterName
+	_, dc, err := provider.DatacenterFromSeedMap(userInfo, seedsGetter, dcName)
+	if err != nil {
+		return handlercommon.NewUnpricedCostEstimate(priceCatalog, fmt.Sprintf("failed to get datacenter %s: %v", dcName, err))
+	}
+
+	nd := &apiv1.NodeDeployment{Spec: template.NodeDeployment.Spec}
+
+	return handlercommon.EstimateNodeDeploymentsCost(priceCatalog, dc, dcName, "", nd)
 }
 
 func getClusterTemplate(ctx context.Context, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
@@ -689,6 +723,11 @@ func (req getClusterTemplatesReq) Validate() error {
 	return nil
 }
 
+// GetClusterTemplateID returns the ID of the requested cluster template.
+func (req getClusterTemplatesReq) GetClusterTemplateID() string {
+	return req.ClusterTemplateID
+}
+
 func DecodeGetReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req getClusterTemplatesReq
 
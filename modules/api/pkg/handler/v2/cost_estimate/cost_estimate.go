@@ -0,0 +1,167 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costestimate
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/pricing"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func GetClusterEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	seedsGetter provider.SeedsGetter, catalog pricing.Catalog) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(clusterReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, clusterReq{})
+		}
+		return handlercommon.EstimateClusterCostEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, projectProvider, privilegedProjectProvider, seedsGetter, catalog)
+	}
+}
+
+func EstimateMachineDeploymentEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	seedsGetter provider.SeedsGetter, catalog pricing.Catalog) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return handlercommon.EstimateMachineDeploymentCostEndpoint(ctx, userInfoGetter, req.ProjectID, req.ClusterID, &req.Body, projectProvider, privilegedProjectProvider, seedsGetter, catalog)
+	}
+}
+
+func GetProjectEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	seedsGetter provider.SeedsGetter, clusterProviderGetter provider.ClusterProviderGetter, catalog pricing.Catalog) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(projectReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, projectReq{})
+		}
+		return handlercommon.EstimateProjectCostEndpoint(ctx, userInfoGetter, req.ProjectID, projectProvider, privilegedProjectProvider, seedsGetter, clusterProviderGetter, catalog)
+	}
+}
+
+func EstimateClusterSpecEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	seedsGetter provider.SeedsGetter, catalog pricing.Catalog) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(clusterSpecReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, clusterSpecReq{})
+		}
+		return handlercommon.EstimateClusterSpecCostEndpoint(ctx, userInfoGetter, req.ProjectID, &req.Body, projectProvider, privilegedProjectProvider, seedsGetter, catalog)
+	}
+}
+
+// projectReq defines HTTP request for getProjectCostEstimate
+// swagger:parameters getProjectCostEstimate
+type projectReq struct {
+	common.ProjectReq
+}
+
+func DecodeProjectReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return projectReq{ProjectReq: pr.(common.ProjectReq)}, nil
+}
+
+// clusterReq defines HTTP request for getClusterCostEstimate
+// swagger:parameters getClusterCostEstimate
+type clusterReq struct {
+	common.ProjectReq
+	// in: path
+	// required: true
+	ClusterID string `json:"cluster_id"`
+}
+
+// GetSeedCluster returns the SeedCluster object.
+func (req clusterReq) GetSeedCluster() apiv1.SeedCluster {
+	return apiv1.SeedCluster{
+		ClusterID: req.ClusterID,
+	}
+}
+
+func DecodeClusterReq(c context.Context, r *http.Request) (interface{}, error) {
+	pr, err := common.DecodeProjectRequest(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	clusterID, err := common.DecodeClusterID(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return clusterReq{
+		ProjectReq: pr.(common.ProjectReq),
+		ClusterID:  clusterID,
+	}, nil
+}
+
+// machineDeploymentReq defines HTTP request for estimateMachineDeploymentCost
+// swagger:parameters estimateMachineDeploymentCost
+type machineDeploymentReq struct {
+	clusterReq
+	// in: body
+	Body apiv1.NodeDeployment
+}
+
+func DecodeMachineDeploymentReq(c context.Context, r *http.Request) (interface{}, error) {
+	cr, err := DecodeClusterReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := machineDeploymentReq{clusterReq: cr.(clusterReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse machine deployment: %v", err)
+	}
+
+	return req, nil
+}
+
+// clusterSpecReq defines HTTP request for estimateClusterSpecCost
+// swagger:parameters estimateClusterSpecCost
+type clusterSpecReq struct {
+	common.ProjectReq
+	// in: body
+	Body apiv1.CreateClusterSpec
+}
+
+func DecodeClusterSpecReq(c context.Context, r *http.Request) (interface{}, error) {
+	req := clusterSpecReq{
+		ProjectReq: common.ProjectReq{ProjectID: mux.Vars(r)["project_id"]},
+	}
+
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse cluster spec: %v", err)
+	}
+
+	return req, nil
+}
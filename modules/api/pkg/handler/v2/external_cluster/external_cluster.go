@@ -376,6 +376,11 @@ type deleteClusterReq struct {
 	Action string `json:"action"`
 }
 
+// GetClusterID returns the ID of the external cluster to delete.
+func (req deleteClusterReq) GetClusterID() string {
+	return req.ClusterID
+}
+
 func DecodeDeleteReq(c context.Context, r *http.Request) (interface{}, error) {
 	var req deleteClusterReq
 
@@ -1135,6 +1140,13 @@ func convertClusterToAPIWithStatus(ctx context.Context, masterClient ctrlruntime
 	if version != nil {
 		apiCluster.Spec.Version = *version
 	}
+	// The inventory of imported clusters is collected in the background, see handlercommon.RunExternalClusterInventory.
+	if cloud.ProviderName == kubermaticv1.ExternalClusterBringYourOwnProvider {
+		inventory, err := handlercommon.GetExternalClusterInventory(internalCluster)
+		if err == nil {
+			apiCluster.Status.Inventory = inventory
+		}
+	}
 	return apiCluster
 }
 
@@ -669,7 +669,7 @@ func DecodeListMachineDeploymentReq(c context.Context, r *http.Request) (interfa
 }
 
 // getNodeReq defines HTTP request for getExternalClusterNode
-// swagger:parameters getExternalClusterNode
+// swagger:parameters getExternalClusterNode cordonExternalClusterNode uncordonExternalClusterNode getExternalClusterNodeDrain
 type getNodeReq struct {
 	common.ProjectReq
 	// in: path
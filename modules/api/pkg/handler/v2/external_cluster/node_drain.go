@@ -0,0 +1,135 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package externalcluster
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+func CordonNodeEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, unschedulable bool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getNodeReq)
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		client, _, err := getExternalClusterNodeClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, clusterProvider, privilegedClusterProvider, req.ProjectID, req.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		node, err := handlercommon.CordonNode(ctx, client, req.NodeID, unschedulable)
+		if err != nil {
+			return nil, err
+		}
+
+		return ConvertNodetoExternalClusterNode(*node)
+	}
+}
+
+func DrainNodeEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, backgroundOperationProvider provider.BackgroundOperationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(drainNodeReq)
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		client, cluster, err := getExternalClusterNodeClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, clusterProvider, privilegedClusterProvider, req.ProjectID, req.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		clientGetter := handlercommon.ExternalClusterNodeClientGetter(clusterProvider, privilegedClusterProvider, cluster)
+		return handlercommon.StartNodeDrain(ctx, client, clientGetter, backgroundOperationProvider, handlercommon.ExternalClusterNodeDrainOperationKind, cluster.Name, req.NodeID, req.Body)
+	}
+}
+
+func GetNodeDrainEndpoint(userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req := request.(getNodeReq)
+		if err := req.Validate(); err != nil {
+			return nil, utilerrors.NewBadRequest("%v", err)
+		}
+
+		client, _, err := getExternalClusterNodeClient(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, clusterProvider, privilegedClusterProvider, req.ProjectID, req.ClusterID)
+		if err != nil {
+			return nil, err
+		}
+
+		return handlercommon.GetNodeDrain(ctx, client, req.NodeID)
+	}
+}
+
+func getExternalClusterNodeClient(ctx context.Context, userInfoGetter provider.UserInfoGetter, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, clusterProvider provider.ExternalClusterProvider, privilegedClusterProvider provider.PrivilegedExternalClusterProvider, projectID, clusterID string) (ctrlruntimeclient.Client, *kubermaticv1.ExternalCluster, error) {
+	project, err := common.GetProject(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, projectID, &provider.ProjectGetOptions{IncludeUninitialized: false})
+	if err != nil {
+		return nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+	cluster, err := getCluster(ctx, userInfoGetter, clusterProvider, privilegedClusterProvider, project.Name, clusterID)
+	if err != nil {
+		return nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	masterClient, err := clusterProvider.GetUserBasedMasterClient(ctx, project.Name, userInfoGetter)
+	if err != nil {
+		return nil, nil, err
+	}
+
+	client, err := clusterProvider.GetClient(ctx, masterClient, cluster)
+	if err != nil {
+		return nil, nil, common.KubernetesErrorToHTTPError(err)
+	}
+
+	return client, cluster, nil
+}
+
+// drainNodeReq defines HTTP request for drainExternalClusterNode
+// swagger:parameters drainExternalClusterNode
+type drainNodeReq struct {
+	getNodeReq
+	// in: body
+	Body apiv2.NodeDrainOptions
+}
+
+func DecodeDrainNodeReq(c context.Context, r *http.Request) (interface{}, error) {
+	nodeReq, err := DecodeGetNodeReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := drainNodeReq{getNodeReq: nodeReq.(getNodeReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil && !errors.Is(err, io.EOF) {
+		return nil, utilerrors.NewBadRequest("unable to parse drain options: %v", err)
+	}
+
+	return req, nil
+}
@@ -21,7 +21,6 @@ import (
 	"fmt"
 	"net/http"
 	"net/http/httputil"
-	"net/url"
 	"strings"
 
 	"github.com/go-kit/kit/endpoint"
@@ -29,12 +28,10 @@ import (
 	"github.com/gorilla/mux"
 	"go.uber.org/zap"
 
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
 	"k8c.io/dashboard/v2/pkg/provider"
-	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
-	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
-	kubernetesdashboard "k8c.io/kubermatic/v2/pkg/resources/kubernetes-dashboard"
 )
 
 const tokenCookieName = "proxy"
@@ -139,8 +136,8 @@ func (h *proxyHandler) storeToken(ctx context.Context, request interface{}) (int
 // swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/dashboard/proxy
 // Implements http.Handler interface
 //
-//	Starts a simple reverse proxy to access the Kubernetes Dashboard installed inside the
-//	user cluster
+//	Starts a simple reverse proxy to access the web UI of the user cluster, the Kubernetes Dashboard
+//	or the UI selected by the dashboard proxy target of the cluster or the global settings
 //
 //	Responses:
 //		default: empty
@@ -186,7 +183,17 @@ func (h *proxyHandler) proxy(w http.ResponseWriter, request *http.Request) endpo
 			return nil, err
 		}
 
-		proxyURL, closeChan, err := h.getProxyURL(ctx, clusterProvider, userCluster)
+		settings, err := h.settingsProvider.GetGlobalSettings(ctx)
+		if err != nil {
+			return nil, common.KubernetesErrorToHTTPError(err)
+		}
+		targetName, err := handlercommon.GetDashboardProxyTarget(settings, userCluster)
+		if err != nil {
+			return nil, err
+		}
+		target := newProxyTarget(h.logger, targetName)
+
+		proxyURL, closeChan, err := target.connect(ctx, clusterProvider, userCluster)
 		if err != nil {
 			return nil, err
 		}
@@ -200,7 +207,7 @@ func (h *proxyHandler) proxy(w http.ResponseWriter, request *http.Request) endpo
 
 		// Proxy the request
 		proxy := httputil.NewSingleHostReverseProxy(proxyURL)
-		proxy.Rewrite = newDashboardProxyDirector(proxyURL, token, request).rewrite()
+		proxy.Rewrite = target.rewrite(proxyURL, token, request)
 		proxy.ServeHTTP(w, request)
 
 		return nil, nil
@@ -216,40 +223,6 @@ func (h *proxyHandler) getCookie(request *http.Request, name string) (string, er
 	return cookie.Value, nil
 }
 
-func (h *proxyHandler) getProxyURL(ctx context.Context, clusterProvider *kubernetes.ClusterProvider, userCluster *kubermaticv1.Cluster) (proxyURL *url.URL, closeChan chan struct{}, err error) {
-	// Ideally we would cache these to not open a port for every single request
-	portforwarder, closeChan, err := common.GetPortForwarder(
-		ctx,
-		clusterProvider.GetSeedClusterAdminClient().CoreV1(),
-		clusterProvider.SeedAdminConfig(),
-		userCluster.Status.NamespaceName,
-		kubernetesdashboard.AppLabel,
-		kubernetesdashboard.ContainerPort)
-	if err != nil {
-		return proxyURL, closeChan, fmt.Errorf("failed to get portforwarder for console: %w", err)
-	}
-
-	if err = common.ForwardPort(h.logger, portforwarder); err != nil {
-		return
-	}
-
-	ports, err := portforwarder.GetPorts()
-	if err != nil {
-		return proxyURL, closeChan, fmt.Errorf("failed to get backend port: %w", err)
-	}
-
-	if len(ports) != 1 {
-		return proxyURL, closeChan, fmt.Errorf("didn't get exactly one port but %d", len(ports))
-	}
-
-	proxyURL = &url.URL{
-		Scheme: "http",
-		Host:   fmt.Sprintf("127.0.0.1:%d", ports[0].Local),
-	}
-
-	return
-}
-
 func NewProxyHandler(
 	logger *zap.SugaredLogger,
 	settingsProvider provider.SettingsProvider,
@@ -0,0 +1,153 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesdashboard
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+	"net/http/httputil"
+	"net/url"
+	"strings"
+
+	"go.uber.org/zap"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/handler/v1/common"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	kubernetesdashboard "k8c.io/kubermatic/v2/pkg/resources/kubernetes-dashboard"
+
+	corev1interface "k8s.io/client-go/kubernetes/typed/core/v1"
+	"k8s.io/client-go/rest"
+)
+
+const (
+	proxyPathSeparator = "proxy"
+
+	headlampNamespace     = "headlamp"
+	headlampAppLabel      = "app.kubernetes.io/name=headlamp"
+	headlampContainerPort = 4466
+)
+
+// proxyTarget is a web UI of the user cluster that is served by the proxy.
+type proxyTarget interface {
+	// connect forwards a local port to the web UI and returns its URL. Closing the channel stops the forwarding.
+	connect(ctx context.Context, clusterProvider *kubernetes.ClusterProvider, userCluster *kubermaticv1.Cluster) (*url.URL, chan struct{}, error)
+	// rewrite adjusts the proxied request, it injects the token of the user and strips the path of the proxy endpoint.
+	rewrite(proxyURL *url.URL, token string, originalRequest *http.Request) func(*httputil.ProxyRequest)
+}
+
+func newProxyTarget(logger *zap.SugaredLogger, target apiv2.DashboardProxyTarget) proxyTarget {
+	if target == apiv2.DashboardProxyTargetHeadlamp {
+		return &headlampTarget{logger: logger}
+	}
+	return &kubernetesDashboardTarget{logger: logger}
+}
+
+// kubernetesDashboardTarget is the Kubernetes Dashboard that runs in the cluster namespace on the seed.
+type kubernetesDashboardTarget struct {
+	logger *zap.SugaredLogger
+}
+
+func (t *kubernetesDashboardTarget) connect(ctx context.Context, clusterProvider *kubernetes.ClusterProvider, userCluster *kubermaticv1.Cluster) (*url.URL, chan struct{}, error) {
+	return forwardPort(ctx, t.logger,
+		clusterProvider.GetSeedClusterAdminClient().CoreV1(),
+		clusterProvider.SeedAdminConfig(),
+		userCluster.Status.NamespaceName,
+		kubernetesdashboard.AppLabel,
+		kubernetesdashboard.ContainerPort)
+}
+
+func (t *kubernetesDashboardTarget) rewrite(proxyURL *url.URL, token string, originalRequest *http.Request) func(*httputil.ProxyRequest) {
+	return func(preq *httputil.ProxyRequest) {
+		preq.Out.URL.Scheme = proxyURL.Scheme
+		preq.Out.URL.Host = proxyURL.Host
+		preq.Out.Host = proxyURL.Host
+		preq.Out.URL.Path, _ = splitProxyPath(originalRequest.URL.Path)
+		preq.Out.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		preq.Out.Header.Set("X-Forwarded-Host", originalRequest.Header.Get("Host"))
+	}
+}
+
+// headlampTarget is Headlamp installed in the user cluster. Headlamp uses the injected token for the requests to the
+// API server, the path of the proxy endpoint is passed as X-Forwarded-Prefix so that links point back to the proxy.
+type headlampTarget struct {
+	logger *zap.SugaredLogger
+}
+
+func (t *headlampTarget) connect(ctx context.Context, clusterProvider *kubernetes.ClusterProvider, userCluster *kubermaticv1.Cluster) (*url.URL, chan struct{}, error) {
+	cfg, err := clusterProvider.GetAdminClientConfigForUserCluster(ctx, userCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	client, err := clusterProvider.GetAdminK8sClientForUserCluster(ctx, userCluster)
+	if err != nil {
+		return nil, nil, err
+	}
+	return forwardPort(ctx, t.logger, client.CoreV1(), cfg, headlampNamespace, headlampAppLabel, headlampContainerPort)
+}
+
+func (t *headlampTarget) rewrite(proxyURL *url.URL, token string, originalRequest *http.Request) func(*httputil.ProxyRequest) {
+	return func(preq *httputil.ProxyRequest) {
+		var prefix string
+		preq.Out.URL.Scheme = proxyURL.Scheme
+		preq.Out.URL.Host = proxyURL.Host
+		preq.Out.Host = proxyURL.Host
+		preq.Out.URL.Path, prefix = splitProxyPath(originalRequest.URL.Path)
+		preq.Out.Header.Set("Authorization", fmt.Sprintf("Bearer %s", token))
+		preq.Out.Header.Set("X-Forwarded-Host", originalRequest.Header.Get("Host"))
+		preq.Out.Header.Set("X-Forwarded-Prefix", prefix)
+	}
+}
+
+// splitProxyPath splits the path of a proxy request into the path of the web UI and the path of the proxy endpoint
+// in the KKP API.
+func splitProxyPath(path string) (basePath, prefix string) {
+	parts := strings.Split(path, proxyPathSeparator)
+	if len(parts) != 2 {
+		return "/", ""
+	}
+	return parts[1], parts[0] + proxyPathSeparator
+}
+
+func forwardPort(ctx context.Context, logger *zap.SugaredLogger, coreClient corev1interface.CoreV1Interface, cfg *rest.Config, namespace, labelSelector string, containerPort int) (proxyURL *url.URL, closeChan chan struct{}, err error) {
+	// Ideally we would cache these to not open a port for every single request
+	portforwarder, closeChan, err := common.GetPortForwarder(ctx, coreClient, cfg, namespace, labelSelector, containerPort)
+	if err != nil {
+		return proxyURL, closeChan, fmt.Errorf("failed to get portforwarder for console: %w", err)
+	}
+
+	if err = common.ForwardPort(logger, portforwarder); err != nil {
+		return
+	}
+
+	ports, err := portforwarder.GetPorts()
+	if err != nil {
+		return proxyURL, closeChan, fmt.Errorf("failed to get backend port: %w", err)
+	}
+	if len(ports) != 1 {
+		return proxyURL, closeChan, fmt.Errorf("didn't get exactly one port but %d", len(ports))
+	}
+
+	proxyURL = &url.URL{
+		Scheme: "http",
+		Host:   fmt.Sprintf("127.0.0.1:%d", ports[0].Local),
+	}
+
+	return
+}
@@ -0,0 +1,69 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetesdashboard
+
+import (
+	"net/http"
+	"net/http/httptest"
+	"net/http/httputil"
+	"net/url"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+)
+
+func TestProxyTargetRewrite(t *testing.T) {
+	t.Parallel()
+
+	const prefix = "/api/v2/projects/p/clusters/c/dashboard/proxy"
+	proxyURL := &url.URL{Scheme: "http", Host: "127.0.0.1:30000"}
+
+	testCases := []struct {
+		target         apiv2.DashboardProxyTarget
+		expectedPrefix string
+	}{
+		{target: apiv2.DashboardProxyTargetKubernetesDashboard},
+		{target: apiv2.DashboardProxyTargetHeadlamp, expectedPrefix: prefix},
+	}
+
+	for _, tc := range testCases {
+		t.Run(string(tc.target), func(t *testing.T) {
+			t.Parallel()
+
+			in := httptest.NewRequest(http.MethodGet, prefix+"/assets/main.js", nil)
+			preq := &httputil.ProxyRequest{In: in, Out: in.Clone(in.Context())}
+			newProxyTarget(nil, tc.target).rewrite(proxyURL, "secret", in)(preq)
+
+			require.Equal(t, "http://127.0.0.1:30000/assets/main.js", preq.Out.URL.String())
+			require.Equal(t, "Bearer secret", preq.Out.Header.Get("Authorization"))
+			require.Equal(t, tc.expectedPrefix, preq.Out.Header.Get("X-Forwarded-Prefix"))
+		})
+	}
+}
+
+func TestSplitProxyPath(t *testing.T) {
+	t.Parallel()
+
+	basePath, prefix := splitProxyPath("/api/v2/projects/p/clusters/c/dashboard/proxy/")
+	require.Equal(t, "/", basePath)
+	require.Equal(t, "/api/v2/projects/p/clusters/c/dashboard/proxy", prefix)
+
+	basePath, _ = splitProxyPath("/api/v2/projects/p/clusters/c/dashboard")
+	require.Equal(t, "/", basePath)
+}
@@ -202,7 +202,7 @@ func (req machineDeploymentReq) GetSeedCluster() apiv1.SeedCluster {
 }
 
 // machineDeploymentReq defines HTTP request for getMachineDeployment
-// swagger:parameters getMachineDeployment restartMachineDeployment getMachineDeploymentJoinScript
+// swagger:parameters getMachineDeployment restartMachineDeployment getMachineDeploymentJoinScript getMachineDeploymentScalingPolicy deleteMachineDeploymentScalingPolicy listMachineDeploymentScalingHistory getMachineDeploymentRollout promoteMachineDeploymentRollout rollbackMachineDeploymentRollout
 type machineDeploymentReq struct {
 	common.ProjectReq
 	// in: path
@@ -499,6 +499,16 @@ func (req deleteMachineDeploymentReq) GetSeedCluster() apiv1.SeedCluster {
 	}
 }
 
+// GetClusterID returns the ID of the cluster of the machine deployment to delete.
+func (req deleteMachineDeploymentReq) GetClusterID() string {
+	return req.ClusterID
+}
+
+// GetMachineDeploymentID returns the ID of the machine deployment to delete.
+func (req deleteMachineDeploymentReq) GetMachineDeploymentID() string {
+	return req.MachineDeploymentID
+}
+
 func DeleteMachineDeployment(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
 		req := request.(deleteMachineDeploymentReq)
@@ -0,0 +1,100 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"io"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func CordonMachineDeploymentNode(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter, unschedulable bool) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentNodeReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentNodeReq{})
+		}
+		return handlercommon.CordonMachineNode(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID, unschedulable)
+	}
+}
+
+func DrainMachineDeploymentNode(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(drainMachineDeploymentNodeReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, drainMachineDeploymentNodeReq{})
+		}
+		return handlercommon.DrainMachineNode(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, backgroundOperationProvider, req.ProjectID, req.ClusterID, req.NodeID, req.Body)
+	}
+}
+
+func GetMachineDeploymentNodeDrain(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentNodeReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentNodeReq{})
+		}
+		return handlercommon.GetMachineNodeDrain(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID)
+	}
+}
+
+// machineDeploymentNodeReq defines HTTP request for cordonMachineDeploymentNode, uncordonMachineDeploymentNode and
+// getMachineDeploymentNodeDrain
+// swagger:parameters cordonMachineDeploymentNode uncordonMachineDeploymentNode getMachineDeploymentNodeDrain
+type machineDeploymentNodeReq struct {
+	deleteMachineDeploymentNodeReq
+}
+
+func DecodeMachineDeploymentNodeReq(c context.Context, r *http.Request) (interface{}, error) {
+	req, err := DecodeDeleteMachineDeploymentNode(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	return machineDeploymentNodeReq{deleteMachineDeploymentNodeReq: req.(deleteMachineDeploymentNodeReq)}, nil
+}
+
+// drainMachineDeploymentNodeReq defines HTTP request for drainMachineDeploymentNode
+// swagger:parameters drainMachineDeploymentNode
+type drainMachineDeploymentNodeReq struct {
+	machineDeploymentNodeReq
+	// in: body
+	Body apiv2.NodeDrainOptions
+}
+
+func DecodeDrainMachineDeploymentNodeReq(c context.Context, r *http.Request) (interface{}, error) {
+	nodeReq, err := DecodeMachineDeploymentNodeReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := drainMachineDeploymentNodeReq{machineDeploymentNodeReq: nodeReq.(machineDeploymentNodeReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil && !errors.Is(err, io.EOF) {
+		return nil, utilerrors.NewBadRequest("unable to parse drain options: %v", err)
+	}
+
+	return req, nil
+}
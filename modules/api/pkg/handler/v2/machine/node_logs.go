@@ -0,0 +1,67 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func GetMachineDeploymentNodeLogs(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentNodeLogsReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentNodeLogsReq{})
+		}
+		return handlercommon.GetMachineNodeLogs(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.NodeID, req.Component, req.LogOptions)
+	}
+}
+
+// machineDeploymentNodeLogsReq defines HTTP request for getMachineDeploymentNodeLogs
+// swagger:parameters getMachineDeploymentNodeLogs
+type machineDeploymentNodeLogsReq struct {
+	machineDeploymentNodeReq
+	handlercommon.LogOptions
+	// The control plane component to stream the log of, machine-controller or operating-system-manager.
+	// Defaults to machine-controller.
+	// in: query
+	Component string `json:"component,omitempty"`
+}
+
+func DecodeMachineDeploymentNodeLogsReq(c context.Context, r *http.Request) (interface{}, error) {
+	nodeReq, err := DecodeMachineDeploymentNodeReq(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := machineDeploymentNodeLogsReq{
+		machineDeploymentNodeReq: nodeReq.(machineDeploymentNodeReq),
+		Component:                r.URL.Query().Get("component"),
+	}
+	req.LogOptions, err = handlercommon.DecodeLogOptions(r)
+	if err != nil {
+		return nil, err
+	}
+
+	return req, nil
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func StartMachineDeploymentRollout(sshKeyProvider provider.SSHKeyProvider, projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, seedsGetter provider.SeedsGetter, userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, backgroundOperationProvider provider.BackgroundOperationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(startMachineDeploymentRolloutReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, startMachineDeploymentRolloutReq{})
+		}
+		return handlercommon.StartMachineDeploymentRollout(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, sshKeyProvider, seedsGetter, settingsProvider, backgroundOperationProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Body)
+	}
+}
+
+func GetMachineDeploymentRollout(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return handlercommon.GetMachineDeploymentRollout(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+func PromoteMachineDeploymentRollout(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, backgroundOperationProvider provider.BackgroundOperationProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return handlercommon.PromoteMachineDeploymentRollout(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, backgroundOperationProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+func RollbackMachineDeploymentRollout(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return handlercommon.RollbackMachineDeploymentRollout(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+// startMachineDeploymentRolloutReq defines HTTP request for startMachineDeploymentRollout
+// swagger:parameters startMachineDeploymentRollout
+type startMachineDeploymentRolloutReq struct {
+	machineDeploymentReq
+	// in: body
+	// required: true
+	Body apiv2.MachineDeploymentRolloutSpec
+}
+
+func DecodeStartMachineDeploymentRolloutReq(c context.Context, r *http.Request) (interface{}, error) {
+	mdReq, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := startMachineDeploymentRolloutReq{machineDeploymentReq: mdReq.(machineDeploymentReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse rollout spec: %v", err)
+	}
+
+	return req, nil
+}
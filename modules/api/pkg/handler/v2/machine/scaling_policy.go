@@ -0,0 +1,93 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machine
+
+import (
+	"context"
+	"encoding/json"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func GetMachineDeploymentScalingPolicy(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return handlercommon.GetMachineDeploymentScalingPolicy(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+func UpdateMachineDeploymentScalingPolicy(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(updateMachineDeploymentScalingPolicyReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, updateMachineDeploymentScalingPolicyReq{})
+		}
+		return handlercommon.UpdateMachineDeploymentScalingPolicy(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID, req.Body)
+	}
+}
+
+func DeleteMachineDeploymentScalingPolicy(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return nil, handlercommon.DeleteMachineDeploymentScalingPolicy(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+func ListMachineDeploymentScalingHistory(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(machineDeploymentReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, machineDeploymentReq{})
+		}
+		return handlercommon.ListMachineDeploymentScalingHistory(ctx, userInfoGetter, projectProvider, privilegedProjectProvider, req.ProjectID, req.ClusterID, req.MachineDeploymentID)
+	}
+}
+
+// updateMachineDeploymentScalingPolicyReq defines HTTP request for updateMachineDeploymentScalingPolicy
+// swagger:parameters updateMachineDeploymentScalingPolicy
+type updateMachineDeploymentScalingPolicyReq struct {
+	machineDeploymentReq
+	// in: body
+	// required: true
+	Body apiv2.MachineDeploymentScalingPolicy
+}
+
+func DecodeUpdateMachineDeploymentScalingPolicyReq(c context.Context, r *http.Request) (interface{}, error) {
+	mdReq, err := DecodeGetMachineDeployment(c, r)
+	if err != nil {
+		return nil, err
+	}
+
+	req := updateMachineDeploymentScalingPolicyReq{machineDeploymentReq: mdReq.(machineDeploymentReq)}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse scaling policy: %v", err)
+	}
+
+	return req, nil
+}
@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package personalaccesstoken
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func CreateEndpoint(userInfoGetter provider.UserInfoGetter, settingsProvider provider.SettingsProvider, tokenProvider provider.PersonalAccessTokenProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(createTokenReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, createTokenReq{})
+		}
+		return handlercommon.CreatePersonalAccessTokenEndpoint(ctx, userInfoGetter, settingsProvider, tokenProvider, req.Body.Name, req.Body.ReadOnly, req.Body.Expiry)
+	}
+}
+
+func ListEndpoint(userInfoGetter provider.UserInfoGetter, tokenProvider provider.PersonalAccessTokenProvider) endpoint.Endpoint {
+	return func(ctx context.Context, _ interface{}) (interface{}, error) {
+		return handlercommon.ListPersonalAccessTokensEndpoint(ctx, userInfoGetter, tokenProvider)
+	}
+}
+
+func RevokeEndpoint(userInfoGetter provider.UserInfoGetter, tokenProvider provider.PersonalAccessTokenProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(tokenReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, tokenReq{})
+		}
+		return nil, handlercommon.RevokePersonalAccessTokenEndpoint(ctx, userInfoGetter, tokenProvider, req.TokenID)
+	}
+}
+
+// createTokenReq defines HTTP request for createPersonalAccessToken
+// swagger:parameters createPersonalAccessToken
+type createTokenReq struct {
+	// in: body
+	Body struct {
+		Name string `json:"name"`
+		// ReadOnly tokens can only be used for requests which do not change anything.
+		ReadOnly bool `json:"readOnly,omitempty"`
+		// Expiry is optional unless the admins capped the lifetime of tokens.
+		Expiry *apiv1.Time `json:"expiry,omitempty"`
+	}
+}
+
+func DecodeCreateTokenReq(_ context.Context, r *http.Request) (interface{}, error) {
+	req := createTokenReq{}
+	if err := json.NewDecoder(r.Body).Decode(&req.Body); err != nil {
+		return nil, utilerrors.NewBadRequest("unable to parse the request body: %v", err)
+	}
+	return req, nil
+}
+
+// tokenReq defines HTTP request for revokePersonalAccessToken
+// swagger:parameters revokePersonalAccessToken
+type tokenReq struct {
+	// in: path
+	// required: true
+	TokenID string `json:"token_id"`
+}
+
+func DecodeTokenReq(_ context.Context, r *http.Request) (interface{}, error) {
+	tokenID := mux.Vars(r)["token_id"]
+	if tokenID == "" {
+		return nil, fmt.Errorf("'token_id' parameter is required but was not provided")
+	}
+	return tokenReq{TokenID: tokenID}, nil
+}
@@ -35,6 +35,13 @@ func GetForProjectEndpoint(projectProvider provider.ProjectProvider, privilegedP
 	}
 }
 
+func GetUsageHistoryForProjectEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
+	userInfoGetter provider.UserInfoGetter, quotaProvider provider.ResourceQuotaProvider, historyProvider provider.ResourceQuotaUsageHistoryProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		return getResourceQuotaUsageHistoryForProject(ctx, request, projectProvider, privilegedProjectProvider, userInfoGetter, quotaProvider, historyProvider)
+	}
+}
+
 func CalculateProjectQuotaUpdateEndpoint(projectProvider provider.ProjectProvider, privilegedProjectProvider provider.PrivilegedProjectProvider,
 	userInfoGetter provider.UserInfoGetter, quotaProvider provider.ResourceQuotaProvider) endpoint.Endpoint {
 	return func(ctx context.Context, request interface{}) (interface{}, error) {
@@ -133,3 +140,49 @@ func DeleteResourceQuotaEndpoint(userInfoGetter provider.UserInfoGetter, provide
 		return nil, nil
 	}
 }
+
+func GetNotificationsEndpoint(userInfoGetter provider.UserInfoGetter, quotaProvider provider.ResourceQuotaProvider, notificationProvider provider.ResourceQuotaNotificationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if !userInfo.IsAdmin {
+			return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%s doesn't have admin rights", userInfo.Email))
+		}
+
+		return getResourceQuotaNotifications(ctx, req, quotaProvider, notificationProvider)
+	}
+}
+
+func PutNotificationsEndpoint(userInfoGetter provider.UserInfoGetter, notificationProvider provider.ResourceQuotaNotificationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if !userInfo.IsAdmin {
+			return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%s doesn't have admin rights", userInfo.Email))
+		}
+
+		err = putResourceQuotaNotifications(ctx, req, notificationProvider)
+		if err != nil {
+			return nil, err
+		}
+		return nil, nil
+	}
+}
+
+func ListNotificationDeliveriesEndpoint(userInfoGetter provider.UserInfoGetter, quotaProvider provider.ResourceQuotaProvider, notificationProvider provider.ResourceQuotaNotificationProvider) endpoint.Endpoint {
+	return func(ctx context.Context, req interface{}) (interface{}, error) {
+		userInfo, err := userInfoGetter(ctx, "")
+		if err != nil {
+			return nil, err
+		}
+		if !userInfo.IsAdmin {
+			return nil, apierrors.NewForbidden(schema.GroupResource{}, userInfo.Email, fmt.Errorf("%s doesn't have admin rights", userInfo.Email))
+		}
+
+		return listResourceQuotaNotificationDeliveries(ctx, req, quotaProvider, notificationProvider)
+	}
+}
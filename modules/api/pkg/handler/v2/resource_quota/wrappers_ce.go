@@ -31,6 +31,11 @@ func getResourceQuotaForProject(_ context.Context, _ interface{}, _ provider.Pro
 	return nil, nil
 }
 
+func getResourceQuotaUsageHistoryForProject(_ context.Context, _ interface{}, _ provider.ProjectProvider,
+	_ provider.PrivilegedProjectProvider, _ provider.UserInfoGetter, _ provider.ResourceQuotaProvider, _ provider.ResourceQuotaUsageHistoryProvider) (*apiv2.ResourceQuotaUsageHistory, error) {
+	return nil, nil
+}
+
 func calculateResourceQuotaUpdateForProject(_ context.Context, _ interface{}, _ provider.ProjectProvider,
 	_ provider.PrivilegedProjectProvider, _ provider.UserInfoGetter, _ provider.ResourceQuotaProvider) (*apiv2.ResourceQuota, error) {
 	return nil, nil
@@ -56,6 +61,20 @@ func deleteResourceQuota(_ context.Context, _ interface{}, _ provider.ResourceQu
 	return nil
 }
 
+func getResourceQuotaNotifications(_ context.Context, _ interface{}, _ provider.ResourceQuotaProvider,
+	_ provider.ResourceQuotaNotificationProvider) (*apiv2.ResourceQuotaNotificationConfig, error) {
+	return nil, nil
+}
+
+func putResourceQuotaNotifications(_ context.Context, _ interface{}, _ provider.ResourceQuotaNotificationProvider) error {
+	return nil
+}
+
+func listResourceQuotaNotificationDeliveries(_ context.Context, _ interface{}, _ provider.ResourceQuotaProvider,
+	_ provider.ResourceQuotaNotificationProvider) ([]apiv2.ResourceQuotaNotificationDelivery, error) {
+	return nil, nil
+}
+
 func DecodeResourceQuotasReq(_ context.Context, _ *http.Request) (interface{}, error) {
 	return nil, nil
 }
@@ -72,6 +91,10 @@ func DecodePutResourceQuotasReq(_ context.Context, _ *http.Request) (interface{}
 	return nil, nil
 }
 
+func DecodePutResourceQuotaNotificationsReq(_ context.Context, _ *http.Request) (interface{}, error) {
+	return nil, nil
+}
+
 func DecodeCalculateProjectResourceQuotaUpdateReq(_ context.Context, _ *http.Request) (interface{}, error) {
 	return nil, nil
 }
@@ -33,6 +33,12 @@ func getResourceQuotaForProject(ctx context.Context, request interface{}, projec
 	return resourcequota.GetResourceQuotaForProject(ctx, request, projectProvider, privilegedProjectProvider, userInfoGetter, quotaProvider)
 }
 
+func getResourceQuotaUsageHistoryForProject(ctx context.Context, request interface{}, projectProvider provider.ProjectProvider,
+	privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
+	quotaProvider provider.ResourceQuotaProvider, historyProvider provider.ResourceQuotaUsageHistoryProvider) (*apiv2.ResourceQuotaUsageHistory, error) {
+	return resourcequota.GetResourceQuotaUsageHistoryForProject(ctx, request, projectProvider, privilegedProjectProvider, userInfoGetter, quotaProvider, historyProvider)
+}
+
 func calculateResourceQuotaUpdateForProject(ctx context.Context, request interface{}, projectProvider provider.ProjectProvider,
 	privilegedProjectProvider provider.PrivilegedProjectProvider, userInfoGetter provider.UserInfoGetter,
 	quotaProvider provider.ResourceQuotaProvider) (*apiv2.ResourceQuotaUpdateCalculation, error) {
@@ -59,6 +65,20 @@ func deleteResourceQuota(ctx context.Context, request interface{}, provider prov
 	return resourcequota.DeleteResourceQuota(ctx, request, provider)
 }
 
+func getResourceQuotaNotifications(ctx context.Context, request interface{}, quotaProvider provider.ResourceQuotaProvider,
+	notificationProvider provider.ResourceQuotaNotificationProvider) (*apiv2.ResourceQuotaNotificationConfig, error) {
+	return resourcequota.GetResourceQuotaNotifications(ctx, request, quotaProvider, notificationProvider)
+}
+
+func putResourceQuotaNotifications(ctx context.Context, request interface{}, notificationProvider provider.ResourceQuotaNotificationProvider) error {
+	return resourcequota.PutResourceQuotaNotifications(ctx, request, notificationProvider)
+}
+
+func listResourceQuotaNotificationDeliveries(ctx context.Context, request interface{}, quotaProvider provider.ResourceQuotaProvider,
+	notificationProvider provider.ResourceQuotaNotificationProvider) ([]apiv2.ResourceQuotaNotificationDelivery, error) {
+	return resourcequota.ListResourceQuotaNotificationDeliveries(ctx, request, quotaProvider, notificationProvider)
+}
+
 func DecodeResourceQuotasReq(_ context.Context, r *http.Request) (interface{}, error) {
 	return resourcequota.DecodeResourceQuotaReq(r)
 }
@@ -75,6 +95,10 @@ func DecodePutResourceQuotasReq(_ context.Context, r *http.Request) (interface{}
 	return resourcequota.DecodePutResourceQuotaReq(r)
 }
 
+func DecodePutResourceQuotaNotificationsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	return resourcequota.DecodePutResourceQuotaNotificationsReq(r)
+}
+
 func DecodeCalculateProjectResourceQuotaUpdateReq(c context.Context, r *http.Request) (interface{}, error) {
 	return resourcequota.DecodeCalculateProjectResourceQuotaUpdateReq(c, r)
 }
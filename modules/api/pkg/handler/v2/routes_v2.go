@@ -30,13 +30,16 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/addon"
 	"k8c.io/dashboard/v2/pkg/handler/v2/alertmanager"
 	allowedregistry "k8c.io/dashboard/v2/pkg/handler/v2/allowed_registry"
+	applicationcatalogsource "k8c.io/dashboard/v2/pkg/handler/v2/application_catalog_source"
 	applicationdefinition "k8c.io/dashboard/v2/pkg/handler/v2/application_definition"
 	applicationinstallation "k8c.io/dashboard/v2/pkg/handler/v2/application_installation"
+	applicationrollout "k8c.io/dashboard/v2/pkg/handler/v2/application_rollout"
 	applicationsettings "k8c.io/dashboard/v2/pkg/handler/v2/application_settings"
 	"k8c.io/dashboard/v2/pkg/handler/v2/authflow"
 	"k8c.io/dashboard/v2/pkg/handler/v2/backupcredentials"
 	"k8c.io/dashboard/v2/pkg/handler/v2/backupdestinations"
 	"k8c.io/dashboard/v2/pkg/handler/v2/cluster"
+	clusteragent "k8c.io/dashboard/v2/pkg/handler/v2/cluster_agent"
 	clusterdefault "k8c.io/dashboard/v2/pkg/handler/v2/cluster_default"
 	clustertemplate "k8c.io/dashboard/v2/pkg/handler/v2/cluster_template"
 	clusterbackup "k8c.io/dashboard/v2/pkg/handler/v2/clusterbackup/backup"
@@ -47,6 +50,7 @@ import (
 	"k8c.io/dashboard/v2/pkg/handler/v2/cniversion"
 	"k8c.io/dashboard/v2/pkg/handler/v2/constraint"
 	constrainttemplate "k8c.io/dashboard/v2/pkg/handler/v2/constraint_template"
+	costestimate "k8c.io/dashboard/v2/pkg/handler/v2/cost_estimate"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdbackupconfig"
 	"k8c.io/dashboard/v2/pkg/handler/v2/etcdrestore"
 	externalcluster "k8c.io/dashboard/v2/pkg/handler/v2/external_cluster"
@@ -61,6 +65,7 @@ import (
 	mlaadminsetting "k8c.io/dashboard/v2/pkg/handler/v2/mla_admin_setting"
 	"k8c.io/dashboard/v2/pkg/handler/v2/networkdefaults"
 	operatingsystemprofile "k8c.io/dashboard/v2/pkg/handler/v2/operatingsystemprofile"
+	personalaccesstoken "k8c.io/dashboard/v2/pkg/handler/v2/personal_access_token"
 	"k8c.io/dashboard/v2/pkg/handler/v2/preset"
 	"k8c.io/dashboard/v2/pkg/handler/v2/provider"
 	resourcequota "k8c.io/dashboard/v2/pkg/handler/v2/resource_quota"
@@ -68,6 +73,7 @@ import (
 	rulegroupadmin "k8c.io/dashboard/v2/pkg/handler/v2/rulegroup_admin"
 	"k8c.io/dashboard/v2/pkg/handler/v2/seedoverview"
 	"k8c.io/dashboard/v2/pkg/handler/v2/seedsettings"
+	"k8c.io/dashboard/v2/pkg/handler/v2/session"
 	"k8c.io/dashboard/v2/pkg/handler/v2/user"
 	userclusterconfig "k8c.io/dashboard/v2/pkg/handler/v2/user_cluster_config"
 	"k8c.io/dashboard/v2/pkg/handler/v2/version"
@@ -276,10 +282,88 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/namespaces").
 		Handler(r.listNamespace())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/apiresources").
+		Handler(r.listClusterAPIResources())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/resources").
+		Handler(r.listClusterResources())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/resources/{resource_name}").
+		Handler(r.getClusterResource())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/manifests/apply").
+		Handler(r.applyClusterManifest())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/namespaces/{namespace}/pods/{pod_name}/logs").
+		Handler(r.getPodLogs())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/controlplane/components").
+		Handler(r.listControlPlaneLogComponents())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/controlplane/components/{component}/logs").
+		Handler(r.getControlPlaneLogs())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/upgrades").
 		Handler(r.getClusterUpgrades())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgradeplan").
+		Handler(r.getClusterUpgradePlan())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgradeoperation").
+		Handler(r.startClusterUpgradeOperation())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgradeoperation").
+		Handler(r.getClusterUpgradeOperation())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgradeoperation/resume").
+		Handler(r.resumeClusterUpgradeOperation())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/upgradeoperation/abort").
+		Handler(r.abortClusterUpgradeOperation())
+
+	// Defines a set of HTTP endpoints for cluster readiness checks
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/readinesschecks").
+		Handler(r.startClusterReadinessCheck())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/readinesschecks").
+		Handler(r.listClusterReadinessChecks())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/readinesschecks/{run_id}").
+		Handler(r.getClusterReadinessCheck())
+
+	// Defines a set of HTTP endpoints for cost estimates
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/costestimate").
+		Handler(r.getProjectCostEstimate())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/costestimate").
+		Handler(r.estimateClusterSpecCost())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/costestimate").
+		Handler(r.getClusterCostEstimate())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/costestimate/machinedeployment").
+		Handler(r.estimateMachineDeploymentCost())
+
 	mux.Methods(http.MethodPut).
 		Path("/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades").
 		Handler(r.upgradeClusterNodeDeployments())
@@ -333,6 +417,26 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}").
 		Handler(r.deleteMachineDeploymentNode())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/cordon").
+		Handler(r.cordonMachineDeploymentNode())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/uncordon").
+		Handler(r.uncordonMachineDeploymentNode())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/drain").
+		Handler(r.drainMachineDeploymentNode())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/drain").
+		Handler(r.getMachineDeploymentNodeDrain())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/logs").
+		Handler(r.getMachineDeploymentNodeLogs())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments").
 		Handler(r.listMachineDeployments())
@@ -365,6 +469,38 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/restart").
 		Handler(r.restartMachineDeployment())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy").
+		Handler(r.getMachineDeploymentScalingPolicy())
+
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy").
+		Handler(r.updateMachineDeploymentScalingPolicy())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy").
+		Handler(r.deleteMachineDeploymentScalingPolicy())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy/history").
+		Handler(r.listMachineDeploymentScalingHistory())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout").
+		Handler(r.startMachineDeploymentRollout())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout").
+		Handler(r.getMachineDeploymentRollout())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout/promote").
+		Handler(r.promoteMachineDeploymentRollout())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout/rollback").
+		Handler(r.rollbackMachineDeploymentRollout())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/events").
 		Handler(r.listMachineDeploymentNodesEvents())
@@ -463,6 +599,22 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}").
 		Handler(r.getExternalClusterNode())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/cordon").
+		Handler(r.cordonExternalClusterNode())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/uncordon").
+		Handler(r.uncordonExternalClusterNode())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/drain").
+		Handler(r.drainExternalClusterNode())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/drain").
+		Handler(r.getExternalClusterNodeDrain())
+
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodesmetrics").
 		Handler(r.listExternalClusterNodesMetrics())
@@ -475,6 +627,23 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/kubernetes/clusters/{cluster_id}/kubeconfig").
 		Handler(r.getExternalClusterKubeconfig())
 
+	// Defines a set of HTTP endpoints for the agents which connect external clusters that cannot be reached from the outside.
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusteragenttokens").
+		Handler(r.createClusterAgentToken())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusteragenttokens").
+		Handler(r.listClusterAgentTokens())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/clusteragenttokens/{token_id}").
+		Handler(r.deleteClusterAgentToken())
+
+	mux.Methods(http.MethodGet).
+		Path("/clusteragent/connect").
+		Handler(clusteragent.ConnectHandler(r.log, r.clusterAgentTokenProvider, r.privilegedProjectProvider, r.privilegedExternalClusterProvider))
+
 	// Defines a set of HTTP endpoint for ApplicationInstallations that belong to a cluster
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/applicationinstallations").
@@ -496,6 +665,18 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}").
 		Handler(r.updateApplicationInstallation())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/preview").
+		Handler(r.previewApplicationInstallation())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/revisions").
+		Handler(r.listApplicationInstallationRevisions())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/rollback").
+		Handler(r.rollbackApplicationInstallation())
+
 	// Defines a set of HTTP endpoint for ApplicationDefinitions which are available in the KKP installation
 	mux.Methods(http.MethodGet).
 		Path("/applicationdefinitions").
@@ -521,6 +702,56 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/applicationdefinitions/{appdef_name}").
 		Handler(r.deleteApplicationDefinition())
 
+	// Defines a set of HTTP endpoints for the Helm chart repositories and OCI registries which ApplicationDefinitions are synced from
+	mux.Methods(http.MethodGet).
+		Path("/applicationcatalogsources").
+		Handler(r.listApplicationCatalogSources())
+
+	mux.Methods(http.MethodPost).
+		Path("/applicationcatalogsources").
+		Handler(r.createApplicationCatalogSource())
+
+	mux.Methods(http.MethodGet).
+		Path("/applicationcatalogsources/{source_name}").
+		Handler(r.getApplicationCatalogSource())
+
+	mux.Methods(http.MethodPut).
+		Path("/applicationcatalogsources/{source_name}").
+		Handler(r.updateApplicationCatalogSource())
+
+	mux.Methods(http.MethodDelete).
+		Path("/applicationcatalogsources/{source_name}").
+		Handler(r.deleteApplicationCatalogSource())
+
+	mux.Methods(http.MethodPost).
+		Path("/applicationcatalogsources/{source_name}/sync").
+		Handler(r.syncApplicationCatalogSource())
+
+	// Defines a set of HTTP endpoints for rolling applications out to the clusters of a project
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/applicationrollouts").
+		Handler(r.listApplicationRollouts())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/applicationrollouts").
+		Handler(r.createApplicationRollout())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/applicationrollouts/{rollout_name}").
+		Handler(r.getApplicationRollout())
+
+	mux.Methods(http.MethodPut).
+		Path("/projects/{project_id}/applicationrollouts/{rollout_name}").
+		Handler(r.updateApplicationRollout())
+
+	mux.Methods(http.MethodDelete).
+		Path("/projects/{project_id}/applicationrollouts/{rollout_name}").
+		Handler(r.deleteApplicationRollout())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/applicationrollouts/{rollout_name}/retry").
+		Handler(r.retryApplicationRollout())
+
 	// Defines a set of endpoints for application settings
 	mux.Methods(http.MethodGet).
 		Path("/applicationsettings").
@@ -631,6 +862,18 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}").
 		Handler(r.deleteAddon())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/revisions").
+		Handler(r.listAddonRevisions())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/revert").
+		Handler(r.revertAddon())
+
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/drift").
+		Handler(r.getAddonDrift())
+
 	// Defines a set of HTTP endpoints for managing alertmanager
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/clusters/{cluster_id}/alertmanager/config").
@@ -644,6 +887,14 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/clusters/{cluster_id}/alertmanager/config").
 		Handler(r.resetAlertmanager())
 
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/alertmanager/config/routes/test").
+		Handler(r.testAlertmanagerRoutes())
+
+	mux.Methods(http.MethodPost).
+		Path("/projects/{project_id}/clusters/{cluster_id}/alertmanager/test").
+		Handler(r.sendAlertmanagerTestAlert())
+
 	// Defines a set of HTTP endpoints for various cloud providers
 	// These endpoints are required to use project-scoped presets as credentials
 
@@ -1137,7 +1388,7 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Handler(r.listVMwareCloudDirectorComputePoliciesNoCredentials())
 
 	authflow.
-		NewAuthHandler(r.oidcIssuerVerifier, r.userProvider, r.kubermaticConfigGetter).
+		NewAuthHandler(r.oidcIssuerVerifier, r.userProvider, r.sessionProvider, r.deviceAuthorizationProvider, r.deviceOIDCKubeconfig, r.kubermaticConfigGetter, r.trustedProxies, r.additionalOIDCIssuerVerifiers...).
 		Install(mux)
 
 	kubernetesdashboard.
@@ -1532,6 +1783,32 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/users").
 		Handler(r.listUser())
 
+	mux.Methods(http.MethodDelete).
+		Path("/users/{user_id}/sessions").
+		Handler(r.revokeUserSessions())
+
+	// Defines a set of HTTP endpoints for managing the login sessions of the user
+	mux.Methods(http.MethodGet).
+		Path("/me/sessions").
+		Handler(r.listSessions())
+
+	mux.Methods(http.MethodDelete).
+		Path("/me/sessions/{session_id}").
+		Handler(r.revokeSession())
+
+	// Defines a set of HTTP endpoints for managing the personal access tokens of the user
+	mux.Methods(http.MethodGet).
+		Path("/me/tokens").
+		Handler(r.listPersonalAccessTokens())
+
+	mux.Methods(http.MethodPost).
+		Path("/me/tokens").
+		Handler(r.createPersonalAccessToken())
+
+	mux.Methods(http.MethodDelete).
+		Path("/me/tokens/{token_id}").
+		Handler(r.revokePersonalAccessToken())
+
 	// Defines a set of HTTP endpoints for managing rule groups for admins
 	mux.Methods(http.MethodGet).
 		Path("/seeds/{seed_name}/rulegroups/{rulegroup_id}").
@@ -1630,6 +1907,10 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/projects/{project_id}/quotacalculation").
 		Handler(r.calculateProjectResourceQuotaUpdate())
 
+	mux.Methods(http.MethodGet).
+		Path("/projects/{project_id}/quotahistory").
+		Handler(r.getProjectQuotaUsageHistory())
+
 	mux.Methods(http.MethodGet).
 		Path("/quotas/{quota_name}").
 		Handler(r.getResourceQuota())
@@ -1650,6 +1931,18 @@ func (r Routing) RegisterV2(mux *mux.Router, oidcKubeConfEndpoint bool) {
 		Path("/quotas/{quota_name}").
 		Handler(r.deleteResourceQuota())
 
+	mux.Methods(http.MethodGet).
+		Path("/quotas/{quota_name}/notifications").
+		Handler(r.getResourceQuotaNotifications())
+
+	mux.Methods(http.MethodPut).
+		Path("/quotas/{quota_name}/notifications").
+		Handler(r.putResourceQuotaNotifications())
+
+	mux.Methods(http.MethodGet).
+		Path("/quotas/{quota_name}/notifications/deliveries").
+		Handler(r.listResourceQuotaNotificationDeliveries())
+
 	// Defines endpoints to interact with group project bindings
 	mux.Methods(http.MethodGet).
 		Path("/projects/{project_id}/groupbindings").
@@ -2066,6 +2359,7 @@ func (r Routing) getCluster() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
+//	  409: errorResponse
 func (r Routing) deleteCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
@@ -2073,6 +2367,8 @@ func (r Routing) deleteCluster() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.ClusterDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(cluster.DeleteEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		cluster.DecodeDeleteReq,
 		handler.EncodeJSON,
@@ -2293,120 +2589,87 @@ func (r Routing) listNamespace() http.Handler {
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades project getClusterUpgradesV2
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/apiresources project listClusterAPIResources
 //
-//	Gets possible cluster upgrades
+//	Lists the resource types served by the cluster in their preferred version
 //
-//	 Produces:
-//	 - application/json
+//	Produces:
+//	- application/json
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: []MasterVersion
-//	   401: empty
-//	   403: empty
-func (r Routing) getClusterUpgrades() http.Handler {
+//	Responses:
+//	  default: errorResponse
+//	  200: []ClusterAPIResource
+//	  401: empty
+//	  403: empty
+func (r Routing) listClusterAPIResources() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(cluster.ListAPIResourcesEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades project upgradeClusterNodeDeploymentsV2
-//
-//	Upgrades node deployments in a cluster
-//
-//	 Produces:
-//	 - application/json
-//
-//	 Responses:
-//	   default: errorResponse
-//	   200: empty
-//	   401: empty
-//	   403: empty
-func (r Routing) upgradeClusterNodeDeployments() http.Handler {
-	return httptransport.NewServer(
-		endpoint.Chain(
-			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
-			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeUpgradeNodeDeploymentsReq,
-		handler.EncodeJSON,
-		r.defaultServerOptions()...,
-	)
-}
-
-// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project assignSSHKeyToClusterV2
-//
-//	Assigns an existing ssh key to the given cluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/resources project listClusterResources
 //
-//	Consumes:
-//	- application/json
+//	Lists resources of any type in the cluster, as permitted by the RBAC of the cluster
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: SSHKey
+//	  200: ClusterResourceList
 //	  401: empty
 //	  403: empty
-func (r Routing) assignSSHKeyToCluster() http.Handler {
+func (r Routing) listClusterResources() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeAssignSSHKeyReq,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		)(cluster.ListResourcesEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeListResourcesReq,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project detachSSHKeyFromClusterV2
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/resources/{resource_name} project getClusterResource
 //
-//	Unassignes an ssh key from the given cluster
-//
-//	Consumes:
-//	- application/json
+//	Gets a resource of any type in the cluster, as permitted by the RBAC of the cluster
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: ClusterResource
 //	  401: empty
 //	  403: empty
-func (r Routing) detachSSHKeyFromCluster() http.Handler {
+func (r Routing) getClusterResource() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeAssignSSHKeyReq,
+		)(cluster.GetResourceEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetResourceReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys project listSSHKeysAssignedToClusterV2
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/manifests/apply project applyClusterManifest
 //
-//	Lists ssh keys that are assigned to the cluster
-//	The returned collection is sorted by creation timestamp.
+//	Applies the objects of a manifest to the cluster with server-side apply, as permitted by the RBAC of the cluster
 //
 //	Consumes:
 //	- application/json
@@ -2416,127 +2679,1093 @@ func (r Routing) detachSSHKeyFromCluster() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []SSHKey
+//	  200: ManifestApplyResult
 //	  401: empty
 //	  403: empty
-func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
+func (r Routing) applyClusterManifest() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(cluster.ListSSHKeysEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		cluster.DecodeListSSHKeysReq,
+		)(cluster.ApplyManifestEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeApplyManifestReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters project createExternalCluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/namespaces/{namespace}/pods/{pod_name}/logs project getPodLogs
 //
-//	Creates an external cluster for the given project.
-//
-//	Consumes:
-//	- application/json
+//	Streams the log of a pod in the cluster, as permitted by the RBAC of the cluster. Websocket requests receive
+//	every line as a message.
 //
 //	Produces:
-//	- application/json
+//	- text/plain
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: ExternalCluster
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) createExternalCluster() http.Handler {
+func (r Routing) getPodLogs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.presetProvider)),
-		externalcluster.DecodeCreateReq,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
-		r.defaultServerOptions()...,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetPodLogsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetPodLogsReq,
+		handler.EncodeLogStream,
+		append(r.defaultServerOptions(), httptransport.ServerBefore(handler.LogStreamRequest))...,
 	)
 }
 
-// Delete the external cluster
-// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project deleteExternalCluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/controlplane/components project listControlPlaneLogComponents
 //
-//	Deletes the specified external cluster
+//	Lists the control plane components of the cluster whose logs can be streamed.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: []ControlPlaneLogComponent
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteExternalCluster() http.Handler {
+func (r Routing) listControlPlaneLogComponents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeDeleteReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListControlPlaneLogComponentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters project listExternalClusters
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/controlplane/components/{component}/logs project getControlPlaneLogs
 //
-//	Lists external clusters for the specified project.
+//	Streams the log of a control plane component of the cluster from the seed. Websocket requests receive every
+//	line as a message.
 //
 //	Produces:
-//	- application/json
+//	- text/plain
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ExternalCluster
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusters() http.Handler {
+func (r Routing) getControlPlaneLogs() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListReq,
-		handler.EncodeJSON,
-		r.defaultServerOptions()...,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetControlPlaneLogsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetControlPlaneLogsReq,
+		handler.EncodeLogStream,
+		append(r.defaultServerOptions(), httptransport.ServerBefore(handler.LogStreamRequest))...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project getExternalCluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgrades project getClusterUpgradesV2
 //
-//	Gets an external cluster for the given project.
+//	Gets possible cluster upgrades
 //
+//	 Produces:
+//	 - application/json
 //
-//	Produces:
-//	- application/json
+//	 Responses:
+//	   default: errorResponse
+//	   200: []MasterVersion
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterUpgrades() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeplan project getClusterUpgradePlan
+//
+//	Gets the ordered minor version upgrades needed to reach the given version, together with their blockers
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterUpgradePlan
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterUpgradePlan() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		)(cluster.GetUpgradePlanEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.addonConfigProvider, r.applicationDefinitionProvider)),
+		cluster.DecodeGetUpgradePlanReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeoperation project startClusterUpgradeOperation
+//
+//	Starts a server-side upgrade of the control plane followed by all machine deployments
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   201: ClusterUpgradeOperation
+//	   401: empty
+//	   403: empty
+func (r Routing) startClusterUpgradeOperation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.StartUpgradeOperationEndpoint(r.kubermaticConfigGetter, r.projectProvider, r.privilegedProjectProvider, r.backgroundOperationProvider, r.userInfoGetter)),
+		cluster.DecodeStartUpgradeOperationReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeoperation project getClusterUpgradeOperation
+//
+//	Gets the status of the current or last upgrade operation
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterUpgradeOperation
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterUpgradeOperation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetUpgradeOperationEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeoperation/resume project resumeClusterUpgradeOperation
+//
+//	Resumes a paused upgrade operation
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterUpgradeOperation
+//	   401: empty
+//	   403: empty
+func (r Routing) resumeClusterUpgradeOperation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ResumeUpgradeOperationEndpoint(r.projectProvider, r.privilegedProjectProvider, r.backgroundOperationProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/upgradeoperation/abort project abortClusterUpgradeOperation
+//
+//	Aborts a running or paused upgrade operation, already upgraded components are not rolled back
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterUpgradeOperation
+//	   401: empty
+//	   403: empty
+func (r Routing) abortClusterUpgradeOperation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.AbortUpgradeOperationEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/readinesschecks project startClusterReadinessCheck
+//
+//	Starts a run of end-to-end checks of DNS, networking, storage, load balancers and image pulls in the cluster
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   201: ClusterReadinessCheckRun
+//	   401: empty
+//	   403: empty
+//	   409: errorResponse
+func (r Routing) startClusterReadinessCheck() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.StartReadinessCheckEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.privilegedAllowedRegistryProvider, r.backgroundOperationProvider)),
+		cluster.DecodeStartReadinessCheckReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/readinesschecks project listClusterReadinessChecks
+//
+//	Lists the recent readiness check runs of the cluster, newest first
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: []ClusterReadinessCheckRun
+//	   401: empty
+//	   403: empty
+func (r Routing) listClusterReadinessChecks() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListReadinessChecksEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/readinesschecks/{run_id} project getClusterReadinessCheck
+//
+//	Gets a readiness check run with the results of its checks
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ClusterReadinessCheckRun
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterReadinessCheck() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.GetReadinessCheckEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeGetReadinessCheckReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/costestimate project getProjectCostEstimate
+//
+//	Estimates the monthly cost of all machine deployments in all clusters of the project
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: CostEstimate
+//	   401: empty
+//	   403: empty
+func (r Routing) getProjectCostEstimate() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(costestimate.GetProjectEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter, r.clusterProviderGetter, r.priceCatalog)),
+		costestimate.DecodeProjectReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/costestimate project estimateClusterSpecCost
+//
+//	Estimates the monthly cost of the initial machine deployment of a cluster creation request without creating the cluster
+//
+//	 Consumes:
+//	 - application/json
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: CostEstimate
+//	   401: empty
+//	   403: empty
+func (r Routing) estimateClusterSpecCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(costestimate.EstimateClusterSpecEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter, r.priceCatalog)),
+		costestimate.DecodeClusterSpecReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/costestimate project getClusterCostEstimate
+//
+//	Estimates the monthly cost of all machine deployments of the cluster
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: CostEstimate
+//	   401: empty
+//	   403: empty
+func (r Routing) getClusterCostEstimate() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(costestimate.GetClusterEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter, r.priceCatalog)),
+		costestimate.DecodeClusterReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/costestimate/machinedeployment project estimateMachineDeploymentCost
+//
+//	Estimates the monthly cost of a machine deployment before it is created in the cluster
+//
+//	 Consumes:
+//	 - application/json
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: CostEstimate
+//	   401: empty
+//	   403: empty
+func (r Routing) estimateMachineDeploymentCost() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(costestimate.EstimateMachineDeploymentEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.seedsGetter, r.priceCatalog)),
+		costestimate.DecodeMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes/upgrades project upgradeClusterNodeDeploymentsV2
+//
+//	Upgrades node deployments in a cluster
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) upgradeClusterNodeDeployments() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.UpgradeNodeDeploymentsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeUpgradeNodeDeploymentsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project assignSSHKeyToClusterV2
+//
+//	Assigns an existing ssh key to the given cluster
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: SSHKey
+//	  401: empty
+//	  403: empty
+func (r Routing) assignSSHKeyToCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.AssignSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAssignSSHKeyReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/projects/{project_id}/clusters/{cluster_id}/sshkeys/{key_id} project detachSSHKeyFromClusterV2
+//
+//	Unassignes an ssh key from the given cluster
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) detachSSHKeyFromCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.DetachSSHKeyEndpoint(r.sshKeyProvider, r.privilegedSSHKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeAssignSSHKeyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/sshkeys project listSSHKeysAssignedToClusterV2
+//
+//	Lists ssh keys that are assigned to the cluster
+//	The returned collection is sorted by creation timestamp.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []SSHKey
+//	  401: empty
+//	  403: empty
+func (r Routing) listSSHKeysAssignedToCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(cluster.ListSSHKeysEndpoint(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		cluster.DecodeListSSHKeysReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters project createExternalCluster
+//
+//	Creates an external cluster for the given project.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) createExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider, r.presetProvider)),
+		externalcluster.DecodeCreateReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// Delete the external cluster
+// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project deleteExternalCluster
+//
+//	Deletes the specified external cluster
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+//	  409: errorResponse
+func (r Routing) deleteExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.ExternalClusterDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.privilegedExternalClusterProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
+		)(externalcluster.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeDeleteReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters project listExternalClusters
+//
+//	Lists external clusters for the specified project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusters() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project getExternalCluster
+//
+//	Gets an external cluster for the given project.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project patchExternalCluster
+//
+//	Patches the given cluster using JSON Merge Patch method (https://tools.ietf.org/html/rfc7396).
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) patchExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodePatchReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project updateExternalCluster
+//
+//	Updates an external cluster for the given project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalCluster
+//	  401: empty
+//	  403: empty
+func (r Routing) updateExternalCluster() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeUpdateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/upgrades project getExternalClusterUpgrades
+//
+//	Gets an external cluster upgrades.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []MasterVersion
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterUpgrades() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project listExternalClusterMachineDeployments
+//
+//	Gets an external cluster machine deployments.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ExternalClusterMachineDeployment
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusterMachineDeployments() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeListMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project createExternalClusterMachineDeployment
+//
+//	Create an external cluster machine deployments.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterMachineDeployment
+//	  401: empty
+//	  403: empty
+func (r Routing) createExternalClusterMachineDeployment() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.CreateMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeCreateMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project deleteExternalClusterMachineDeployment
+//
+//	Delete an external cluster machine deployment.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteExternalClusterMachineDeployment() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
+		)(externalcluster.DeleteMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeGetMachineDeploymentReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes project listExternalClusterNodes
+//
+//	Gets an external cluster nodes.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ExternalClusterNode
+//	  401: empty
+//	  403: empty
+func (r Routing) listExternalClusterNodes() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.ListNodesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListNodesReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id} project getExternalClusterNode
+//
+//	Gets an external cluster node.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterNode
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetNodeReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/cordon project cordonExternalClusterNode
+//
+//	Marks an external cluster node as unschedulable.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterNode
+//	  401: empty
+//	  403: empty
+func (r Routing) cordonExternalClusterNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.CordonNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, true)),
+		externalcluster.DecodeGetNodeReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/uncordon project uncordonExternalClusterNode
+//
+//	Marks an external cluster node as schedulable again. A running drain of the node is stopped.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ExternalClusterNode
+//	  401: empty
+//	  403: empty
+func (r Routing) uncordonExternalClusterNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.CordonNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, false)),
+		externalcluster.DecodeGetNodeReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/drain project drainExternalClusterNode
+//
+//	Cordons an external cluster node and evicts its pods in the background, respecting PodDisruptionBudgets.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: NodeDrainOperation
+//	  401: empty
+//	  403: empty
+func (r Routing) drainExternalClusterNode() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.DrainNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.backgroundOperationProvider)),
+		externalcluster.DecodeDrainNodeReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id}/drain project getExternalClusterNodeDrain
+//
+//	Gets the progress of the current or last drain of an external cluster node.
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: NodeDrainOperation
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterNodeDrain() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetNodeDrainEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
+		externalcluster.DecodeGetNodeReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusteragenttokens project createClusterAgentToken
+//
+//	Creates a token cluster agents use to register the cluster they run in with the project.
+//	The token is only returned in this response.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ClusterAgentToken
+//	  401: empty
+//	  403: empty
+func (r Routing) createClusterAgentToken() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(clusteragent.CreateTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterAgentTokenProvider)),
+		clusteragent.DecodeCreateTokenReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusteragenttokens project listClusterAgentTokens
+//
+//	Lists the cluster agent tokens of the project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ClusterAgentToken
+//	  401: empty
+//	  403: empty
+func (r Routing) listClusterAgentTokens() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(clusteragent.ListTokensEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterAgentTokenProvider)),
+		clusteragent.DecodeListTokensReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/clusteragenttokens/{token_id} project deleteClusterAgentToken
+//
+//	Revokes a cluster agent token. Agents which are already connected stay connected until they reconnect.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteClusterAgentToken() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(clusteragent.DeleteTokenEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterAgentTokenProvider)),
+		clusteragent.DecodeTokenReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/metrics project getExternalClusterMetrics
+//
+//	Gets cluster metrics
+//
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ClusterMetrics
+//	  401: empty
+//	  403: empty
+func (r Routing) getExternalClusterMetrics() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(externalcluster.GetMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodesmetrics project listExternalClusterNodesMetrics
+//
+//	Gets an external cluster nodes metrics.
+//
+//
+//	Produces:
+//	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalCluster
+//	  200: []NodeMetric
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalCluster() http.Handler {
+func (r Routing) listExternalClusterNodesMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+		)(externalcluster.ListNodesMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListNodesReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project patchExternalCluster
+// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/events project listExternalClusterEvents
 //
-//	Patches the given cluster using JSON Merge Patch method (https://tools.ietf.org/html/rfc7396).
+//	Gets an external cluster events.
 //
 //
 //	Produces:
@@ -2544,48 +3773,49 @@ func (r Routing) getExternalCluster() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalCluster
+//	  200: []Event
 //	  401: empty
 //	  403: empty
-func (r Routing) patchExternalCluster() http.Handler {
+func (r Routing) listExternalClusterEvents() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodePatchReq,
+		)(externalcluster.ListEventsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
+		externalcluster.DecodeListEventsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id} project updateExternalCluster
+// swagger:route GET /api/v2/constrainttemplates constrainttemplates listConstraintTemplates
+//
+//	List constraint templates.
 //
-//	Updates an external cluster for the given project.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalCluster
+//	  200: []ConstraintTemplate
 //	  401: empty
 //	  403: empty
-func (r Routing) updateExternalCluster() http.Handler {
+func (r Routing) listConstraintTemplates() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeUpdateReq,
+		)(constrainttemplate.ListEndpoint(r.constraintTemplateProvider)),
+		common.DecodeEmptyReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/upgrades project getExternalClusterUpgrades
+// swagger:route GET /api/v2/constrainttemplates/{ct_name} constrainttemplates getConstraintTemplate
 //
-//	Gets an external cluster upgrades.
+//	Get constraint templates specified by name
 //
 //
 //	Produces:
@@ -2593,75 +3823,78 @@ func (r Routing) updateExternalCluster() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []MasterVersion
+//	  200: ConstraintTemplate
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalClusterUpgrades() http.Handler {
+func (r Routing) getConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetUpgradesEndpoint(r.kubermaticConfigGetter, r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+		)(constrainttemplate.GetEndpoint(r.constraintTemplateProvider)),
+		constrainttemplate.DecodeConstraintTemplateRequest,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project listExternalClusterMachineDeployments
+// swagger:route POST /api/v2/constrainttemplates constrainttemplates createConstraintTemplate
 //
-//	Gets an external cluster machine deployments.
+//	Create constraint template
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ExternalClusterMachineDeployment
+//	  200: ConstraintTemplate
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterMachineDeployments() http.Handler {
+func (r Routing) createConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
-		externalcluster.DecodeListMachineDeploymentReq,
+		)(constrainttemplate.CreateEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
+		constrainttemplate.DecodeCreateConstraintTemplateRequest,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments project createExternalClusterMachineDeployment
+// swagger:route PATCH /api/v2/constrainttemplates/{ct_name} constrainttemplates patchConstraintTemplate
 //
-//	Create an external cluster machine deployments.
+//	Patch a specified constraint template
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalClusterMachineDeployment
+//	  200: ConstraintTemplate
 //	  401: empty
 //	  403: empty
-func (r Routing) createExternalClusterMachineDeployment() http.Handler {
+func (r Routing) patchConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.CreateMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
-		externalcluster.DecodeCreateMachineDeploymentReq,
+		)(constrainttemplate.PatchEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
+		constrainttemplate.DecodePatchConstraintTemplateReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project deleteExternalClusterMachineDeployment
-//
-//	Delete an external cluster machine deployment.
+// swagger:route DELETE /api/v2/constrainttemplates/{ct_name} constrainttemplates deleteConstraintTemplate
 //
+//	Deletes the specified cluster
 //
 //	Produces:
 //	- application/json
@@ -2671,21 +3904,48 @@ func (r Routing) createExternalClusterMachineDeployment() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteExternalClusterMachineDeployment() http.Handler {
+func (r Routing) deleteConstraintTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.DeleteMachineDeploymentEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider)),
-		externalcluster.DecodeGetMachineDeploymentReq,
+		)(constrainttemplate.DeleteEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
+		constrainttemplate.DecodeConstraintTemplateRequest,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes project listExternalClusterNodes
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project listConstraints
 //
-//	Gets an external cluster nodes.
+//	Lists constraints for the specified cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) listConstraints() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeListConstraintsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project getConstraint
+//
+//	Gets an specified constraint for the given cluster.
 //
 //
 //	Produces:
@@ -2693,24 +3953,27 @@ func (r Routing) deleteExternalClusterMachineDeployment() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ExternalClusterNode
+//	  200: Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterNodes() http.Handler {
+func (r Routing) getConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListNodesEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListNodesReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodes/{node_id} project getExternalClusterNode
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project deleteConstraint
 //
-//	Gets an external cluster node.
+//	Deletes a specified constraint for the given cluster.
 //
 //
 //	Produces:
@@ -2718,24 +3981,28 @@ func (r Routing) listExternalClusterNodes() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ExternalClusterNode
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalClusterNode() http.Handler {
+func (r Routing) deleteConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetNodeEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetNodeReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		constraint.DecodeConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/metrics project getExternalClusterMetrics
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project createConstraint
 //
-//	Gets cluster metrics
+//	Creates a given constraint for the specified cluster.
 //
 //
 //	Produces:
@@ -2743,24 +4010,52 @@ func (r Routing) getExternalClusterNode() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ClusterMetrics
+//	  200: Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) getExternalClusterMetrics() http.Handler {
+func (r Routing) createConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.GetMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeGetReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
+		constraint.DecodeCreateConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/nodesmetrics project listExternalClusterNodesMetrics
+// swagger:route POST /api/v2/constraints constraint createDefaultConstraint
 //
-//	Gets an external cluster nodes metrics.
+//	Creates default constraint
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Constraint
+//	  401: empty
+//	  403: empty
+func (r Routing) createDefaultConstraint() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(constraint.CreateDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
+		constraint.DecodeCreateDefaultConstraintReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/constraints constraint listDefaultConstraint
+//
+//	List default constraint.
 //
 //
 //	Produces:
@@ -2768,24 +4063,24 @@ func (r Routing) getExternalClusterMetrics() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []NodeMetric
+//	  200: []Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterNodesMetrics() http.Handler {
+func (r Routing) listDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListNodesMetricsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListNodesReq,
+		)(constraint.ListDefaultEndpoint(r.defaultConstraintProvider)),
+		common.DecodeEmptyReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/kubernetes/clusters/{cluster_id}/events project listExternalClusterEvents
+// swagger:route GET /api/v2/constraints/{constraint_name} constraint getDefaultConstraint
 //
-//	Gets an external cluster events.
+//	Gets an specified default constraint
 //
 //
 //	Produces:
@@ -2793,24 +4088,24 @@ func (r Routing) listExternalClusterNodesMetrics() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Event
+//	  200: Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) listExternalClusterEvents() http.Handler {
+func (r Routing) getDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(externalcluster.ListEventsEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.externalClusterProvider, r.privilegedExternalClusterProvider, r.settingsProvider)),
-		externalcluster.DecodeListEventsReq,
+		)(constraint.GetDefaultEndpoint(r.defaultConstraintProvider)),
+		constraint.DecodeDefaultConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constrainttemplates constrainttemplates listConstraintTemplates
+// swagger:route DELETE /api/v2/constraints/{constraint_name} constraints deleteDefaultConstraint
 //
-//	List constraint templates.
+//	Deletes a specified default constraint.
 //
 //
 //	Produces:
@@ -2818,103 +4113,108 @@ func (r Routing) listExternalClusterEvents() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []ConstraintTemplate
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) listConstraintTemplates() http.Handler {
+func (r Routing) deleteDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.ListEndpoint(r.constraintTemplateProvider)),
-		common.DecodeEmptyReq,
+		)(constraint.DeleteDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider)),
+		constraint.DecodeDefaultConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constrainttemplates/{ct_name} constrainttemplates getConstraintTemplate
+// swagger:route PATCH /api/v2/constraints/{constraint_name} constraint patchDefaultConstraint
 //
-//	Get constraint templates specified by name
+//	Patch a specified default constraint
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ConstraintTemplate
+//	  200: Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) getConstraintTemplate() http.Handler {
+func (r Routing) patchDefaultConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.GetEndpoint(r.constraintTemplateProvider)),
-		constrainttemplate.DecodeConstraintTemplateRequest,
+		)(constraint.PatchDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
+		constraint.DecodePatchDefaultConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/constrainttemplates constrainttemplates createConstraintTemplate
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project patchConstraint
 //
-//	Create constraint template
+//	Patches a given constraint for the specified cluster.
 //
-//	Consumes:
-//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ConstraintTemplate
+//	  200: Constraint
 //	  401: empty
 //	  403: empty
-func (r Routing) createConstraintTemplate() http.Handler {
+func (r Routing) patchConstraint() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.CreateEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
-		constrainttemplate.DecodeCreateConstraintTemplateRequest,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
+		)(constraint.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
+		constraint.DecodePatchConstraintReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/constrainttemplates/{ct_name} constrainttemplates patchConstraintTemplate
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project getGatekeeperConfig
 //
-//	Patch a specified constraint template
+//	Gets the gatekeeper sync config for the specified cluster.
 //
-//	Consumes:
-//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: ConstraintTemplate
+//	  200: GatekeeperConfig
 //	  401: empty
 //	  403: empty
-func (r Routing) patchConstraintTemplate() http.Handler {
+func (r Routing) getGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.PatchEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
-		constrainttemplate.DecodePatchConstraintTemplateReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(gatekeeperconfig.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodeGatkeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/constrainttemplates/{ct_name} constrainttemplates deleteConstraintTemplate
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project deleteGatekeeperConfig
+//
+//	Deletes the gatekeeper sync config for the specified cluster.
 //
-//	Deletes the specified cluster
 //
 //	Produces:
 //	- application/json
@@ -2924,48 +4224,52 @@ func (r Routing) patchConstraintTemplate() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteConstraintTemplate() http.Handler {
+func (r Routing) deleteGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constrainttemplate.DeleteEndpoint(r.userInfoGetter, r.constraintTemplateProvider)),
-		constrainttemplate.DecodeConstraintTemplateRequest,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(gatekeeperconfig.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodeGatkeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project listConstraints
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project createGatekeeperConfig
 //
-//	Lists constraints for the specified cluster.
+//	Creates a gatekeeper config for the given cluster
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Constraint
+//	  201: GatekeeperConfig
 //	  401: empty
 //	  403: empty
-func (r Routing) listConstraints() http.Handler {
+func (r Routing) createGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.ListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		constraint.DecodeListConstraintsReq,
+		)(gatekeeperconfig.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodeCreateGatkeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project getConstraint
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project patchGatekeeperConfig
 //
-//	Gets an specified constraint for the given cluster.
+//	Patches the gatekeeper config for the specified cluster.
 //
 //
 //	Produces:
@@ -2973,612 +4277,636 @@ func (r Routing) listConstraints() http.Handler {
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: GatekeeperConfig
 //	  401: empty
 //	  403: empty
-func (r Routing) getConstraint() http.Handler {
+func (r Routing) patchGatekeeperConfig() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		constraint.DecodeConstraintReq,
+		)(gatekeeperconfig.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		gatekeeperconfig.DecodePatchGatekeeperConfigReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project deleteConstraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project createMachineDeployment
 //
-//	Deletes a specified constraint for the given cluster.
+//	Creates a machine deployment that will belong to the given cluster
 //
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  201: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteConstraint() http.Handler {
+func (r Routing) createMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		constraint.DecodeConstraintReq,
-		handler.EncodeJSON,
+		)(machine.CreateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
+		machine.DecodeCreateMachineDeployment,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints project createConstraint
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id} project deleteMachineDeploymentNode
 //
-//	Creates a given constraint for the specified cluster.
+//	Deletes the given node that belongs to the machine deployment.
 //
 //
-//	Produces:
-//	- application/json
+//	 Produces:
+//	 - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: Constraint
-//	  401: empty
-//	  403: empty
-func (r Routing) createConstraint() http.Handler {
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) deleteMachineDeploymentNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
-		constraint.DecodeCreateConstraintReq,
+		)(machine.DeleteMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeDeleteMachineDeploymentNode,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/constraints constraint createDefaultConstraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/cordon project cordonMachineDeploymentNode
 //
-//	Creates default constraint
+//	Marks the given node as unschedulable.
 //
-//	Produces:
-//	- application/json
+//	 Produces:
+//	 - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: Constraint
-//	  401: empty
-//	  403: empty
-func (r Routing) createDefaultConstraint() http.Handler {
+//	 Responses:
+//	   default: errorResponse
+//	   200: Node
+//	   401: empty
+//	   403: empty
+func (r Routing) cordonMachineDeploymentNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.CreateDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
-		constraint.DecodeCreateDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.CordonMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, true)),
+		machine.DecodeMachineDeploymentNodeReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constraints constraint listDefaultConstraint
-//
-//	List default constraint.
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/uncordon project uncordonMachineDeploymentNode
 //
+//	Marks the given node as schedulable again. A running drain of the node is stopped.
 //
-//	Produces:
-//	- application/json
+//	 Produces:
+//	 - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: []Constraint
-//	  401: empty
-//	  403: empty
-func (r Routing) listDefaultConstraint() http.Handler {
+//	 Responses:
+//	   default: errorResponse
+//	   200: Node
+//	   401: empty
+//	   403: empty
+func (r Routing) uncordonMachineDeploymentNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.ListDefaultEndpoint(r.defaultConstraintProvider)),
-		common.DecodeEmptyReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.CordonMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, false)),
+		machine.DecodeMachineDeploymentNodeReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/constraints/{constraint_name} constraint getDefaultConstraint
-//
-//	Gets an specified default constraint
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/drain project drainMachineDeploymentNode
 //
+//	Cordons the given node and evicts its pods in the background, respecting PodDisruptionBudgets.
 //
-//	Produces:
-//	- application/json
+//	 Produces:
+//	 - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: Constraint
-//	  401: empty
-//	  403: empty
-func (r Routing) getDefaultConstraint() http.Handler {
+//	 Responses:
+//	   default: errorResponse
+//	   200: NodeDrainOperation
+//	   401: empty
+//	   403: empty
+func (r Routing) drainMachineDeploymentNode() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.GetDefaultEndpoint(r.defaultConstraintProvider)),
-		constraint.DecodeDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.DrainMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.backgroundOperationProvider, r.userInfoGetter)),
+		machine.DecodeDrainMachineDeploymentNodeReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/constraints/{constraint_name} constraints deleteDefaultConstraint
-//
-//	Deletes a specified default constraint.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/drain project getMachineDeploymentNodeDrain
 //
+//	Gets the progress of the current or last drain of the given node.
 //
-//	Produces:
-//	- application/json
+//	 Produces:
+//	 - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  200: empty
-//	  401: empty
-//	  403: empty
-func (r Routing) deleteDefaultConstraint() http.Handler {
+//	 Responses:
+//	   default: errorResponse
+//	   200: NodeDrainOperation
+//	   401: empty
+//	   403: empty
+func (r Routing) getMachineDeploymentNodeDrain() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.DeleteDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider)),
-		constraint.DecodeDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.GetMachineDeploymentNodeDrain(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeMachineDeploymentNodeReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/constraints/{constraint_name} constraint patchDefaultConstraint
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id}/logs project getMachineDeploymentNodeLogs
 //
-//	Patch a specified default constraint
+//	Streams the lines of the machine-controller or operating-system-manager log that mention the given node or its
+//	machine. Websocket requests receive every line as a message.
 //
-//	Consumes:
-//	- application/json
+//	 Produces:
+//	 - text/plain
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) getMachineDeploymentNodeLogs() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.GetMachineDeploymentNodeLogs(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeMachineDeploymentNodeLogsReq,
+		handler.EncodeLogStream,
+		append(r.defaultServerOptions(), httptransport.ServerBefore(handler.LogStreamRequest))...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project listMachineDeployments
+//
+//	Lists machine deployments that belong to the given cluster
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: []NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) patchDefaultConstraint() http.Handler {
+func (r Routing) listMachineDeployments() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(constraint.PatchDefaultEndpoint(r.userInfoGetter, r.defaultConstraintProvider, r.constraintTemplateProvider)),
-		constraint.DecodePatchDefaultConstraintReq,
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(machine.ListMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListMachineDeployments,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/constraints/{constraint_name} project patchConstraint
-//
-//	Patches a given constraint for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project getMachineDeployment
 //
+//	Gets a machine deployment that is assigned to the given cluster.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Constraint
+//	  200: NodeDeployment
 //	  401: empty
 //	  403: empty
-func (r Routing) patchConstraint() http.Handler {
+func (r Routing) getMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.Constraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-			middleware.PrivilegedConstraints(r.clusterProviderGetter, r.constraintProviderGetter, r.seedsGetter),
-		)(constraint.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.constraintTemplateProvider)),
-		constraint.DecodePatchConstraintReq,
+		)(machine.GetMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project getGatekeeperConfig
-//
-//	Gets the gatekeeper sync config for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/joiningscript project getMachineDeploymentJoinScript
 //
+//	Gets a machine deployment joining script for the edge provider.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: GatekeeperConfig
+//	  200: JoiningScript
 //	  401: empty
 //	  403: empty
-func (r Routing) getGatekeeperConfig() http.Handler {
+func (r Routing) getMachineDeploymentJoinScript() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodeGatkeeperConfigReq,
+		)(machine.GetMachineDeploymentJoiningScript(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project deleteGatekeeperConfig
-//
-//	Deletes the gatekeeper sync config for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes project listMachineDeploymentNodes
 //
+//	Lists nodes that belong to the given machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: empty
+//	  200: []Node
 //	  401: empty
 //	  403: empty
-func (r Routing) deleteGatekeeperConfig() http.Handler {
+func (r Routing) listMachineDeploymentNodes() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodeGatkeeperConfigReq,
+		)(machine.ListMachineDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListMachineDeploymentNodes,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project createGatekeeperConfig
-//
-//	Creates a gatekeeper config for the given cluster
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes project listNodesForCluster
 //
-//	Consumes:
-//	- application/json
+//	This endpoint is used for kubeadm cluster.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: GatekeeperConfig
+//	  200: []Node
 //	  401: empty
 //	  403: empty
-func (r Routing) createGatekeeperConfig() http.Handler {
+func (r Routing) listNodesForCluster() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodeCreateGatkeeperConfigReq,
+		)(machine.ListNodesForCluster(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListNodesForCluster,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/gatekeeper/config project patchGatekeeperConfig
-//
-//	Patches the gatekeeper config for the specified cluster.
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics metric listMachineDeploymentMetrics
 //
+//	Lists metrics that belong to the given machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: GatekeeperConfig
+//	  200: []NodeMetric
 //	  401: empty
 //	  403: empty
-func (r Routing) patchGatekeeperConfig() http.Handler {
+func (r Routing) listMachineDeploymentMetrics() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(gatekeeperconfig.PatchEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		gatekeeperconfig.DecodePatchGatekeeperConfigReq,
+		)(machine.ListMachineDeploymentMetrics(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeListMachineDeploymentMetrics,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project createMachineDeployment
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project patchMachineDeployment
 //
-//	Creates a machine deployment that will belong to the given cluster
+//	    Patches a machine deployment that is assigned to the given cluster. Please note that at the moment only
+//		   node deployment's spec can be updated by a patch, no other fields can be changed using this endpoint.
 //
-//	Consumes:
-//	- application/json
+//	    Consumes:
+//	    - application/json
 //
-//	Produces:
-//	- application/json
+//	    Produces:
+//	    - application/json
 //
-//	Responses:
-//	  default: errorResponse
-//	  201: NodeDeployment
-//	  401: empty
-//	  403: empty
-func (r Routing) createMachineDeployment() http.Handler {
+//	    Responses:
+//	      default: errorResponse
+//	      200: NodeDeployment
+//	      401: empty
+//	      403: empty
+func (r Routing) patchMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.CreateMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
-		machine.DecodeCreateMachineDeployment,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		)(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
+		machine.DecodePatchMachineDeployment,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/nodes/{node_id} project deleteMachineDeploymentNode
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project restartMachineDeployment
 //
-//	Deletes the given node that belongs to the machine deployment.
+//	Schedules rolling restart of a machine deployment that is assigned to the given cluster.
 //
+//	Consumes:
+//	- application/json
 //
-//	 Produces:
-//	 - application/json
+//	Produces:
+//	- application/json
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: empty
-//	   401: empty
-//	   403: empty
-func (r Routing) deleteMachineDeploymentNode() http.Handler {
+//	Responses:
+//	  default: errorResponse
+//	  200: NodeDeployment
+//	  401: empty
+//	  403: empty
+func (r Routing) restartMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.DeleteMachineDeploymentNode(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeDeleteMachineDeploymentNode,
+		)(machine.RestartMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments project listMachineDeployments
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy project getMachineDeploymentScalingPolicy
 //
-//	Lists machine deployments that belong to the given cluster
+//	Gets the schedule-based scaling policy of a machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []NodeDeployment
+//	  200: MachineDeploymentScalingPolicy
 //	  401: empty
 //	  403: empty
-func (r Routing) listMachineDeployments() http.Handler {
+func (r Routing) getMachineDeploymentScalingPolicy() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListMachineDeployments(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListMachineDeployments,
+		)(machine.GetMachineDeploymentScalingPolicy(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project getMachineDeployment
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy project updateMachineDeploymentScalingPolicy
 //
-//	Gets a machine deployment that is assigned to the given cluster.
+//	Sets the schedule-based scaling policy of a machine deployment. Rules fire for schedule times after the
+//	policy was set.
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: NodeDeployment
+//	  200: MachineDeploymentScalingPolicy
 //	  401: empty
 //	  403: empty
-func (r Routing) getMachineDeployment() http.Handler {
+func (r Routing) updateMachineDeploymentScalingPolicy() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.GetMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeGetMachineDeployment,
+		)(machine.UpdateMachineDeploymentScalingPolicy(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeUpdateMachineDeploymentScalingPolicyReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/joiningscript project getMachineDeploymentJoinScript
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy project deleteMachineDeploymentScalingPolicy
 //
-//	Gets a machine deployment joining script for the edge provider.
+//	Removes the scaling policy and the scaling history of a machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: JoiningScript
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) getMachineDeploymentJoinScript() http.Handler {
+func (r Routing) deleteMachineDeploymentScalingPolicy() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.GetMachineDeploymentJoiningScript(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(machine.DeleteMachineDeploymentScalingPolicy(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes project listMachineDeploymentNodes
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/scalingpolicy/history project listMachineDeploymentScalingHistory
 //
-//	Lists nodes that belong to the given machine deployment.
+//	Lists the most recent replica changes made by the scaling policy of a machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Node
+//	  200: []MachineDeploymentScalingAction
 //	  401: empty
 //	  403: empty
-func (r Routing) listMachineDeploymentNodes() http.Handler {
+func (r Routing) listMachineDeploymentScalingHistory() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListMachineDeploymentNodes(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListMachineDeploymentNodes,
+		)(machine.ListMachineDeploymentScalingHistory(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/nodes project listNodesForCluster
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout project startMachineDeploymentRollout
 //
-//	This endpoint is used for kubeadm cluster.
+//	Starts a canary or blue/green rollout of a new node spec. A new machine deployment is created next to the
+//	given one, which stays untouched until the rollout is promoted.
+//
+//	Consumes:
+//	- application/json
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []Node
+//	  201: MachineDeploymentRollout
 //	  401: empty
 //	  403: empty
-func (r Routing) listNodesForCluster() http.Handler {
+func (r Routing) startMachineDeploymentRollout() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListNodesForCluster(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListNodesForCluster,
-		handler.EncodeJSON,
+		)(machine.StartMachineDeploymentRollout(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider, r.backgroundOperationProvider)),
+		machine.DecodeStartMachineDeploymentRolloutReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/nodes/metrics metric listMachineDeploymentMetrics
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout project getMachineDeploymentRollout
 //
-//	Lists metrics that belong to the given machine deployment.
+//	Gets the current or last rollout of a machine deployment.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []NodeMetric
+//	  200: MachineDeploymentRollout
 //	  401: empty
 //	  403: empty
-func (r Routing) listMachineDeploymentMetrics() http.Handler {
+func (r Routing) getMachineDeploymentRollout() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.ListMachineDeploymentMetrics(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		machine.DecodeListMachineDeploymentMetrics,
+		)(machine.GetMachineDeploymentRollout(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project patchMachineDeployment
-//
-//	    Patches a machine deployment that is assigned to the given cluster. Please note that at the moment only
-//		   node deployment's spec can be updated by a patch, no other fields can be changed using this endpoint.
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout/promote project promoteMachineDeploymentRollout
 //
-//	    Consumes:
-//	    - application/json
+//	Promotes a ready rollout. The new machine deployment is scaled to the replicas of the original one, which is
+//	then scaled down and deleted.
 //
-//	    Produces:
-//	    - application/json
+//	Produces:
+//	- application/json
 //
-//	    Responses:
-//	      default: errorResponse
-//	      200: NodeDeployment
-//	      401: empty
-//	      403: empty
-func (r Routing) patchMachineDeployment() http.Handler {
+//	Responses:
+//	  default: errorResponse
+//	  200: MachineDeploymentRollout
+//	  401: empty
+//	  403: empty
+func (r Routing) promoteMachineDeploymentRollout() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.PatchMachineDeployment(r.sshKeyProvider, r.projectProvider, r.privilegedProjectProvider, r.seedsGetter, r.userInfoGetter, r.settingsProvider)),
-		machine.DecodePatchMachineDeployment,
+		)(machine.PromoteMachineDeploymentRollout(r.projectProvider, r.privilegedProjectProvider, r.backgroundOperationProvider, r.userInfoGetter)),
+		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id} project restartMachineDeployment
-//
-//	Schedules rolling restart of a machine deployment that is assigned to the given cluster.
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/machinedeployments/{machinedeployment_id}/rollout/rollback project rollbackMachineDeploymentRollout
 //
-//	Consumes:
-//	- application/json
+//	Rolls back a rollout by deleting the new machine deployment and restoring the replicas of the original one.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: NodeDeployment
+//	  200: MachineDeploymentRollout
 //	  401: empty
 //	  403: empty
-func (r Routing) restartMachineDeployment() http.Handler {
+func (r Routing) rollbackMachineDeploymentRollout() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(machine.RestartMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(machine.RollbackMachineDeploymentRollout(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		machine.DecodeGetMachineDeployment,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -3624,6 +4952,7 @@ func (r Routing) listMachineDeploymentNodesEvents() http.Handler {
 //	   200: empty
 //	   401: empty
 //	   403: empty
+//	   409: errorResponse
 func (r Routing) deleteMachineDeployment() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
@@ -3631,6 +4960,8 @@ func (r Routing) deleteMachineDeployment() http.Handler {
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.MachineDeploymentDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(machine.DeleteMachineDeployment(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		machine.DecodeDeleteMachineDeployment,
 		handler.EncodeJSON,
@@ -3988,26 +5319,113 @@ func (r Routing) listAddons() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.ListAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		addon.DecodeListAddons,
+		)(addon.ListAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		addon.DecodeListAddons,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon getAddonV2
+//
+//	Gets an addon that is assigned to the given cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Addon
+//	  401: empty
+//	  403: empty
+func (r Routing) getAddon() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		)(addon.GetAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		addon.DecodeGetAddon,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon patchAddonV2
+//
+//	Patches an addon that is assigned to the given cluster.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: Addon
+//	  401: empty
+//	  403: empty
+func (r Routing) patchAddon() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		)(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		addon.DecodePatchAddon,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon deleteAddonV2
+//
+//	Deletes the given addon that belongs to the cluster.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) deleteAddon() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
+		)(addon.DeleteAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		addon.DecodeGetAddon,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon getAddonV2
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/revisions addon listAddonRevisionsV2
 //
-//	Gets an addon that is assigned to the given cluster.
+//	Lists the revisions of the variables of an addon, newest first.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: Addon
+//	  200: []AddonRevision
 //	  401: empty
 //	  403: empty
-func (r Routing) getAddon() http.Handler {
+func (r Routing) listAddonRevisions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
@@ -4016,16 +5434,16 @@ func (r Routing) getAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.GetAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(addon.ListAddonRevisionsEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		addon.DecodeGetAddon,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PATCH /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon patchAddonV2
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/revert addon revertAddonV2
 //
-//	Patches an addon that is assigned to the given cluster.
+//	Reverts the variables of an addon to an earlier revision.
 //
 //	Consumes:
 //	- application/json
@@ -4038,7 +5456,7 @@ func (r Routing) getAddon() http.Handler {
 //	  200: Addon
 //	  401: empty
 //	  403: empty
-func (r Routing) patchAddon() http.Handler {
+func (r Routing) revertAddon() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
@@ -4047,26 +5465,26 @@ func (r Routing) patchAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.PatchAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
-		addon.DecodePatchAddon,
+		)(addon.RevertAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		addon.DecodeRevertAddon,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id} addon deleteAddonV2
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/addons/{addon_id}/drift addon getAddonDriftV2
 //
-//	Deletes the given addon that belongs to the cluster.
+//	Reports the objects of an addon that were changed in the user cluster since the addon controller applied them.
 //
-//	 Produces:
-//	 - application/json
+//	Produces:
+//	- application/json
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: empty
-//	   401: empty
-//	   403: empty
-func (r Routing) deleteAddon() http.Handler {
+//	Responses:
+//	  default: errorResponse
+//	  200: AddonDrift
+//	  401: empty
+//	  403: empty
+func (r Routing) getAddonDrift() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
@@ -4075,7 +5493,7 @@ func (r Routing) deleteAddon() http.Handler {
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.Addons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
 			middleware.PrivilegedAddons(r.clusterProviderGetter, r.addonProviderGetter, r.seedsGetter),
-		)(addon.DeleteAddonEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
+		)(addon.GetAddonDriftEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter)),
 		addon.DecodeGetAddon,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -5980,6 +7398,69 @@ func (r Routing) resetAlertmanager() http.Handler {
 	)
 }
 
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/alertmanager/config/routes/test project testAlertmanagerRoutes
+//
+//	Shows the routes and receivers an alert with the given labels is sent to. The given configuration is tested,
+//	or the current configuration of the cluster if none is given.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: AlertmanagerRouteTestResult
+//	  401: empty
+//	  403: empty
+func (r Routing) testAlertmanagerRoutes() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Alertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAlertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
+		)(alertmanager.RoutesTestEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		alertmanager.DecodeRoutesTestReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/alertmanager/test project sendAlertmanagerTestAlert
+//
+//	Sends a test alert to the alertmanager of the specified cluster. The alert resolves on its own after five minutes.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: AlertmanagerTestAlert
+//	  401: empty
+//	  403: empty
+func (r Routing) sendAlertmanagerTestAlert() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.Alertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
+			middleware.PrivilegedAlertmanagers(r.clusterProviderGetter, r.alertmanagerProviderGetter, r.seedsGetter),
+		)(alertmanager.SendTestAlertEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		alertmanager.DecodeSendTestAlertReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/projects/{project_id}/providers/gke/images gke listProjectGKEImages
 //
 // Lists GKE image types.
@@ -7593,7 +9074,7 @@ func (r Routing) getClusterTemplate() http.Handler {
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(clustertemplate.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
+		)(clustertemplate.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider, r.seedsGetter, r.priceCatalog)),
 		clustertemplate.DecodeGetReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
@@ -7640,11 +9121,14 @@ func (r Routing) exportClusterTemplate() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
+//	  409: errorResponse
 func (r Routing) deleteClusterTemplate() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
+			middleware.ClusterTemplateDeletionProtection(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.clusterTemplateProvider),
+			middleware.RecentAuthentication(r.settingsProvider),
 		)(clustertemplate.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.clusterTemplateProvider)),
 		clustertemplate.DecodeGetReq,
 		handler.EncodeJSON,
@@ -8725,38 +10209,201 @@ func (r Routing) deleteEtcdRestore() http.Handler {
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/etcdrestores etcdrestore listProjectEtcdRestore
+// swagger:route GET /api/v2/projects/{project_id}/etcdrestores etcdrestore listProjectEtcdRestore
+//
+//	List etcd backup restores for a given project
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []EtcdRestore
+//	  401: empty
+//	  403: empty
+func (r Routing) listProjectEtcdRestore() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.EtcdRestoreProject(r.etcdRestoreProjectProviderGetter, r.seedsGetter),
+			middleware.PrivilegedEtcdRestoreProject(r.etcdRestoreProjectProviderGetter, r.seedsGetter),
+		)(etcdrestore.ProjectListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
+		etcdrestore.DecodeListProjectEtcdRestoreReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/seeds/{seed_name}/backupcredentials backupcredentials createOrUpdateBackupCredentials
+//
+//	Creates or updates backup credentials for a given seed
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) createOrUpdateBackupCredentials() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.BackupCredentials(r.backupCredentialsProviderGetter, r.seedsGetter),
+		)(backupcredentials.CreateOrUpdateEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
+		backupcredentials.DecodeBackupCredentialsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting getMLAAdminSetting
+//
+//	Gets MLA Admin settings for the given cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: MLAAdminSetting
+//	  401: empty
+//	  403: empty
+func (r Routing) getMLAAdminSetting() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
+		)(mlaadminsetting.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		mlaadminsetting.DecodeGetReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting createMLAAdminSetting
+//
+//	Creates MLA admin setting that will belong to the given cluster
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: MLAAdminSetting
+//	  401: empty
+//	  403: empty
+func (r Routing) createMLAAdminSetting() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
+		)(mlaadminsetting.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		mlaadminsetting.DecodeCreateReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting updateMLAAdminSetting
+//
+//	Updates the MLA admin setting for the given cluster.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: MLAAdminSetting
+//	  401: empty
+//	  403: empty
+func (r Routing) updateMLAAdminSetting() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
+		)(mlaadminsetting.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		mlaadminsetting.DecodeUpdateReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting deleteMLAAdminSetting
+//
+//	Deletes the MLA admin setting that belongs to the cluster.
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) deleteMLAAdminSetting() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
+		)(mlaadminsetting.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		mlaadminsetting.DecodeDeleteReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/users user listUser
 //
-//	List etcd backup restores for a given project
+//	List users
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []EtcdRestore
+//	  200: []User
 //	  401: empty
 //	  403: empty
-func (r Routing) listProjectEtcdRestore() http.Handler {
+func (r Routing) listUser() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.EtcdRestoreProject(r.etcdRestoreProjectProviderGetter, r.seedsGetter),
-			middleware.PrivilegedEtcdRestoreProject(r.etcdRestoreProjectProviderGetter, r.seedsGetter),
-		)(etcdrestore.ProjectListEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.settingsProvider)),
-		etcdrestore.DecodeListProjectEtcdRestoreReq,
+		)(user.ListEndpoint(r.userInfoGetter, r.userProvider)),
+		common.DecodeEmptyReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/seeds/{seed_name}/backupcredentials backupcredentials createOrUpdateBackupCredentials
+// swagger:route DELETE /api/v2/users/{user_id}/sessions user revokeUserSessions
 //
-//	Creates or updates backup credentials for a given seed
-//
-//	Consumes:
-//	- application/json
+//	Revokes all login sessions of the user. Only available for admins.
 //
 //	Produces:
 //	- application/json
@@ -8766,152 +10413,136 @@ func (r Routing) listProjectEtcdRestore() http.Handler {
 //	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) createOrUpdateBackupCredentials() http.Handler {
+func (r Routing) revokeUserSessions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.BackupCredentials(r.backupCredentialsProviderGetter, r.seedsGetter),
-		)(backupcredentials.CreateOrUpdateEndpoint(r.userInfoGetter, r.seedsGetter, r.seedProvider)),
-		backupcredentials.DecodeBackupCredentialsReq,
+		)(session.RevokeUserSessionsEndpoint(r.userInfoGetter, r.userProvider, r.sessionProvider)),
+		session.DecodeUserSessionsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting getMLAAdminSetting
+// swagger:route GET /api/v2/me/sessions user listSessions
 //
-//	Gets MLA Admin settings for the given cluster.
+//	Lists the active login sessions of the user.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: MLAAdminSetting
+//	  200: []Session
 //	  401: empty
 //	  403: empty
-func (r Routing) getMLAAdminSetting() http.Handler {
+func (r Routing) listSessions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
-		)(mlaadminsetting.GetEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		mlaadminsetting.DecodeGetReq,
+		)(session.ListEndpoint(r.userInfoGetter, r.sessionProvider)),
+		session.DecodeListSessionsReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting createMLAAdminSetting
-//
-//	Creates MLA admin setting that will belong to the given cluster
+// swagger:route DELETE /api/v2/me/sessions/{session_id} user revokeSession
 //
-//	Consumes:
-//	- application/json
+//	Revokes a login session of the user.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  201: MLAAdminSetting
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) createMLAAdminSetting() http.Handler {
+func (r Routing) revokeSession() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
-		)(mlaadminsetting.CreateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		mlaadminsetting.DecodeCreateReq,
-		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		)(session.RevokeEndpoint(r.userInfoGetter, r.sessionProvider)),
+		session.DecodeSessionReq,
+		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting updateMLAAdminSetting
-//
-//	Updates the MLA admin setting for the given cluster.
+// swagger:route GET /api/v2/me/tokens user listPersonalAccessTokens
 //
-//	Consumes:
-//	- application/json
+//	Lists the personal access tokens of the user.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: MLAAdminSetting
+//	  200: []PersonalAccessToken
 //	  401: empty
 //	  403: empty
-func (r Routing) updateMLAAdminSetting() http.Handler {
+func (r Routing) listPersonalAccessTokens() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
-		)(mlaadminsetting.UpdateEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		mlaadminsetting.DecodeUpdateReq,
+		)(personalaccesstoken.ListEndpoint(r.userInfoGetter, r.personalAccessTokenProvider)),
+		common.DecodeEmptyReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/mlaadminsetting mlaadminsetting deleteMLAAdminSetting
+// swagger:route POST /api/v2/me/tokens user createPersonalAccessToken
 //
-//	Deletes the MLA admin setting that belongs to the cluster.
+//	Creates a personal access token which acts with the identity of the user. The token is only returned once.
 //
-//	 Produces:
-//	 - application/json
+//	Consumes:
+//	- application/json
 //
-//	 Responses:
-//	   default: errorResponse
-//	   200: empty
-//	   401: empty
-//	   403: empty
-func (r Routing) deleteMLAAdminSetting() http.Handler {
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: PersonalAccessToken
+//	  401: empty
+//	  403: empty
+func (r Routing) createPersonalAccessToken() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-			middleware.PrivilegedMLAAdminSetting(r.clusterProviderGetter, r.privilegedMLAAdminSettingProviderGetter, r.seedsGetter),
-		)(mlaadminsetting.DeleteEndpoint(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		mlaadminsetting.DecodeDeleteReq,
-		handler.EncodeJSON,
+		)(personalaccesstoken.CreateEndpoint(r.userInfoGetter, r.settingsProvider, r.personalAccessTokenProvider)),
+		personalaccesstoken.DecodeCreateTokenReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/users user listUser
+// swagger:route DELETE /api/v2/me/tokens/{token_id} user revokePersonalAccessToken
 //
-//	List users
+//	Revokes a personal access token of the user.
 //
 //	Produces:
 //	- application/json
 //
 //	Responses:
 //	  default: errorResponse
-//	  200: []User
+//	  200: empty
 //	  401: empty
 //	  403: empty
-func (r Routing) listUser() http.Handler {
+func (r Routing) revokePersonalAccessToken() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
-		)(user.ListEndpoint(r.userInfoGetter, r.userProvider)),
-		common.DecodeEmptyReq,
+		)(personalaccesstoken.RevokeEndpoint(r.userInfoGetter, r.personalAccessTokenProvider)),
+		personalaccesstoken.DecodeTokenReq,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -10355,6 +11986,30 @@ func (r Routing) calculateProjectResourceQuotaUpdate() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/projects/{project_id}/quotahistory project getProjectQuotaUsageHistory
+//
+//	Returns the recorded resource quota usage of a given project together with a forecast of when the quota will be exhausted.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ResourceQuotaUsageHistory
+//	  401: empty
+//	  403: empty
+func (r Routing) getProjectQuotaUsageHistory() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(resourcequota.GetUsageHistoryForProjectEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.resourceQuotaProvider, r.resourceQuotaUsageHistoryProvider)),
+		common.DecodeGetProject,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/quotas/{quota_name} resourceQuota admin getResourceQuota
 //
 //	Gets a specific Resource Quota.
@@ -10475,6 +12130,81 @@ func (r Routing) deleteResourceQuota() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/quotas/{quota_name}/notifications resourceQuota admin getResourceQuotaNotifications
+//
+//	Gets the usage thresholds and receivers of notifications for a Resource Quota.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ResourceQuotaNotificationConfig
+//	  401: empty
+//	  403: empty
+func (r Routing) getResourceQuotaNotifications() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(resourcequota.GetNotificationsEndpoint(r.userInfoGetter, r.resourceQuotaProvider, r.resourceQuotaNotificationProvider)),
+		resourcequota.DecodeResourceQuotasReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/quotas/{quota_name}/notifications resourceQuota admin putResourceQuotaNotifications
+//
+//	Sets the usage thresholds and receivers of notifications for a Resource Quota.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) putResourceQuotaNotifications() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(resourcequota.PutNotificationsEndpoint(r.userInfoGetter, r.resourceQuotaNotificationProvider)),
+		resourcequota.DecodePutResourceQuotaNotificationsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/quotas/{quota_name}/notifications/deliveries resourceQuota admin listResourceQuotaNotificationDeliveries
+//
+//	Lists the most recent notification deliveries of a Resource Quota.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ResourceQuotaNotificationDelivery
+//	  401: empty
+//	  403: empty
+func (r Routing) listResourceQuotaNotificationDeliveries() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(resourcequota.ListNotificationDeliveriesEndpoint(r.userInfoGetter, r.resourceQuotaProvider, r.resourceQuotaNotificationProvider)),
+		resourcequota.DecodeResourceQuotasReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route get /api/v2/projects/{project_id}/groupbindings project listGroupProjectBinding
 //
 //	Lists project's group bindings.
@@ -10675,36 +12405,124 @@ func (r Routing) createApplicationInstallation() http.Handler {
 	)
 }
 
-// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name} applications deleteApplicationInstallation
+// swagger:route DELETE /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name} applications deleteApplicationInstallation
+//
+//	Deletes the given ApplicationInstallation
+//
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: empty
+//	   401: empty
+//	   403: empty
+func (r Routing) deleteApplicationInstallation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(applicationinstallation.DeleteApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		applicationinstallation.DecodeDeleteApplicationInstallation,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name} applications getApplicationInstallation
+//
+//	Gets the given ApplicationInstallation
+//
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ApplicationInstallation
+//	   401: empty
+//	   403: empty
+func (r Routing) getApplicationInstallation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(applicationinstallation.GetApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		applicationinstallation.DecodeGetApplicationInstallation,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name} applications updateApplicationInstallation
+//
+//	Updates the given ApplicationInstallation
+//
+//
+//	 Consumes:
+//	 - application/json
+//
+//	 Produces:
+//	 - application/json
+//
+//	 Responses:
+//	   default: errorResponse
+//	   200: ApplicationInstallation
+//	   401: empty
+//	   403: empty
+func (r Routing) updateApplicationInstallation() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
+		)(applicationinstallation.UpdateApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
+		applicationinstallation.DecodeUpdateApplicationInstallation,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/preview applications previewApplicationInstallation
+//
+//	Previews an update of the given ApplicationInstallation: the diff of its values, the violations of the values
+//	schema of the chart and the objects the update would create, update or delete.
 //
-//	Deletes the given ApplicationInstallation
 //
+//	 Consumes:
+//	 - application/json
 //
 //	 Produces:
 //	 - application/json
 //
 //	 Responses:
 //	   default: errorResponse
-//	   200: empty
+//	   200: ApplicationInstallationPreview
 //	   401: empty
 //	   403: empty
-func (r Routing) deleteApplicationInstallation() http.Handler {
+func (r Routing) previewApplicationInstallation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(applicationinstallation.DeleteApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		applicationinstallation.DecodeDeleteApplicationInstallation,
+		)(applicationinstallation.PreviewApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.applicationDefinitionProvider)),
+		applicationinstallation.DecodePreviewApplicationInstallation,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name} applications getApplicationInstallation
+// swagger:route GET /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/revisions applications listApplicationInstallationRevisions
 //
-//	Gets the given ApplicationInstallation
+//	Lists the revisions of the Helm release of the given ApplicationInstallation, newest first
 //
 //
 //	 Produces:
@@ -10712,26 +12530,26 @@ func (r Routing) deleteApplicationInstallation() http.Handler {
 //
 //	 Responses:
 //	   default: errorResponse
-//	   200: ApplicationInstallation
+//	   200: []ApplicationInstallationRevision
 //	   401: empty
 //	   403: empty
-func (r Routing) getApplicationInstallation() http.Handler {
+func (r Routing) listApplicationInstallationRevisions() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(applicationinstallation.GetApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		applicationinstallation.DecodeGetApplicationInstallation,
+		)(applicationinstallation.ListApplicationInstallationRevisions(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.applicationDefinitionProvider)),
+		applicationinstallation.DecodeListApplicationInstallationRevisions,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
 }
 
-// swagger:route PUT /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name} applications updateApplicationInstallation
+// swagger:route POST /api/v2/projects/{project_id}/clusters/{cluster_id}/applicationinstallations/{namespace}/{appinstall_name}/rollback applications rollbackApplicationInstallation
 //
-//	Updates the given ApplicationInstallation
+//	Rolls the given ApplicationInstallation back to the version and values of an earlier revision
 //
 //
 //	 Consumes:
@@ -10745,15 +12563,15 @@ func (r Routing) getApplicationInstallation() http.Handler {
 //	   200: ApplicationInstallation
 //	   401: empty
 //	   403: empty
-func (r Routing) updateApplicationInstallation() http.Handler {
+func (r Routing) rollbackApplicationInstallation() http.Handler {
 	return httptransport.NewServer(
 		endpoint.Chain(
 			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
 			middleware.UserSaver(r.userProvider),
 			middleware.SetClusterProvider(r.clusterProviderGetter, r.seedsGetter),
 			middleware.SetPrivilegedClusterProvider(r.clusterProviderGetter, r.seedsGetter),
-		)(applicationinstallation.UpdateApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider)),
-		applicationinstallation.DecodeUpdateApplicationInstallation,
+		)(applicationinstallation.RollbackApplicationInstallation(r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider, r.applicationDefinitionProvider)),
+		applicationinstallation.DecodeRollbackApplicationInstallation,
 		handler.EncodeJSON,
 		r.defaultServerOptions()...,
 	)
@@ -10914,6 +12732,307 @@ func (r Routing) deleteApplicationDefinition() http.Handler {
 	)
 }
 
+// swagger:route GET /api/v2/applicationcatalogsources applications listApplicationCatalogSources
+//
+//	Lists the Helm chart repositories and OCI registries which ApplicationDefinitions are synced from.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ApplicationCatalogSource
+//	  401: empty
+//	  403: empty
+func (r Routing) listApplicationCatalogSources() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationcatalogsource.ListEndpoint(r.userInfoGetter, r.applicationCatalogSourceProvider)),
+		common.DecodeEmptyReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/applicationcatalogsources applications createApplicationCatalogSource
+//
+//	Creates a catalog source. Its charts are synced into ApplicationDefinitions periodically.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ApplicationCatalogSource
+//	  401: empty
+//	  403: empty
+func (r Routing) createApplicationCatalogSource() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationcatalogsource.CreateEndpoint(r.userInfoGetter, r.applicationCatalogSourceProvider)),
+		applicationcatalogsource.DecodeCreateSourceReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/applicationcatalogsources/{source_name} applications getApplicationCatalogSource
+//
+//	Gets the given catalog source and the status of its last sync.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ApplicationCatalogSource
+//	  401: empty
+//	  403: empty
+func (r Routing) getApplicationCatalogSource() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationcatalogsource.GetEndpoint(r.userInfoGetter, r.applicationCatalogSourceProvider)),
+		applicationcatalogsource.DecodeSourceReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/applicationcatalogsources/{source_name} applications updateApplicationCatalogSource
+//
+//	Updates the given catalog source.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ApplicationCatalogSource
+//	  401: empty
+//	  403: empty
+func (r Routing) updateApplicationCatalogSource() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationcatalogsource.UpdateEndpoint(r.userInfoGetter, r.applicationCatalogSourceProvider)),
+		applicationcatalogsource.DecodeUpdateSourceReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/applicationcatalogsources/{source_name} applications deleteApplicationCatalogSource
+//
+//	Deletes the given catalog source. The ApplicationDefinitions synced from it are kept.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteApplicationCatalogSource() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationcatalogsource.DeleteEndpoint(r.userInfoGetter, r.applicationCatalogSourceProvider)),
+		applicationcatalogsource.DecodeSourceReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/applicationcatalogsources/{source_name}/sync applications syncApplicationCatalogSource
+//
+//	Syncs the given catalog source right away.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ApplicationCatalogSource
+//	  401: empty
+//	  403: empty
+func (r Routing) syncApplicationCatalogSource() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationcatalogsource.SyncEndpoint(r.userInfoGetter, r.applicationCatalogSourceProvider, r.applicationDefinitionProvider)),
+		applicationcatalogsource.DecodeSourceReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/applicationrollouts applications listApplicationRollouts
+//
+//	Lists the application rollouts of the project.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: []ApplicationRollout
+//	  401: empty
+//	  403: empty
+func (r Routing) listApplicationRollouts() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationrollout.ListEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.applicationRolloutProvider)),
+		applicationrollout.DecodeListRolloutsReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/applicationrollouts applications createApplicationRollout
+//
+//	Creates a rollout which installs or upgrades an application on all clusters of the project that match
+//	its cluster selector, a few clusters at a time. Clusters which match the selector later are picked up.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  201: ApplicationRollout
+//	  401: empty
+//	  403: empty
+func (r Routing) createApplicationRollout() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationrollout.CreateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.applicationRolloutProvider, r.applicationDefinitionProvider)),
+		applicationrollout.DecodeCreateRolloutReq,
+		handler.SetStatusCreatedHeader(handler.EncodeJSON),
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route GET /api/v2/projects/{project_id}/applicationrollouts/{rollout_name} applications getApplicationRollout
+//
+//	Gets the application rollout with its progress per cluster.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ApplicationRollout
+//	  401: empty
+//	  403: empty
+func (r Routing) getApplicationRollout() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationrollout.GetEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.applicationRolloutProvider)),
+		applicationrollout.DecodeRolloutReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route PUT /api/v2/projects/{project_id}/applicationrollouts/{rollout_name} applications updateApplicationRollout
+//
+//	Updates the application rollout. A changed spec is rolled out to all selected clusters again.
+//
+//	Consumes:
+//	- application/json
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ApplicationRollout
+//	  401: empty
+//	  403: empty
+func (r Routing) updateApplicationRollout() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationrollout.UpdateEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.applicationRolloutProvider, r.applicationDefinitionProvider)),
+		applicationrollout.DecodeUpdateRolloutReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route DELETE /api/v2/projects/{project_id}/applicationrollouts/{rollout_name} applications deleteApplicationRollout
+//
+//	Deletes the application rollout. The applications it installed are kept.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: empty
+//	  401: empty
+//	  403: empty
+func (r Routing) deleteApplicationRollout() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationrollout.DeleteEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.applicationRolloutProvider)),
+		applicationrollout.DecodeRolloutReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
+// swagger:route POST /api/v2/projects/{project_id}/applicationrollouts/{rollout_name}/retry applications retryApplicationRollout
+//
+//	Retries the clusters the application failed on, which resumes a failed rollout.
+//
+//	Produces:
+//	- application/json
+//
+//	Responses:
+//	  default: errorResponse
+//	  200: ApplicationRollout
+//	  401: empty
+//	  403: empty
+func (r Routing) retryApplicationRollout() http.Handler {
+	return httptransport.NewServer(
+		endpoint.Chain(
+			middleware.TokenVerifier(r.tokenVerifiers, r.userProvider),
+			middleware.UserSaver(r.userProvider),
+		)(applicationrollout.RetryEndpoint(r.projectProvider, r.privilegedProjectProvider, r.userInfoGetter, r.applicationRolloutProvider)),
+		applicationrollout.DecodeRolloutReq,
+		handler.EncodeJSON,
+		r.defaultServerOptions()...,
+	)
+}
+
 // swagger:route GET /api/v2/applicationsettings applications getApplicationSettings
 //
 //	Get application settings
@@ -28,7 +28,10 @@ import (
 	"go.uber.org/zap"
 
 	"k8c.io/dashboard/v2/pkg/handler"
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
 	"k8c.io/dashboard/v2/pkg/handler/middleware"
+	"k8c.io/dashboard/v2/pkg/pricing"
 	"k8c.io/dashboard/v2/pkg/provider"
 	authtypes "k8c.io/dashboard/v2/pkg/provider/auth/types"
 	"k8c.io/dashboard/v2/pkg/serviceaccount"
@@ -36,6 +39,8 @@ import (
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/features"
 	"k8c.io/kubermatic/v2/pkg/version/kubermatic"
+
+	clientcmdapi "k8s.io/client-go/tools/clientcmd/api"
 )
 
 // Routing represents an object which binds endpoints to http handlers.
@@ -96,15 +101,27 @@ type Routing struct {
 	privilegedMLAAdminSettingProviderGetter        provider.PrivilegedMLAAdminSettingProviderGetter
 	seedProvider                                   provider.SeedProvider
 	resourceQuotaProvider                          provider.ResourceQuotaProvider
+	resourceQuotaUsageHistoryProvider              provider.ResourceQuotaUsageHistoryProvider
+	clusterAgentTokenProvider                      provider.ClusterAgentTokenProvider
+	sessionProvider                                provider.SessionProvider
+	deviceAuthorizationProvider                    provider.DeviceAuthorizationProvider
+	personalAccessTokenProvider                    provider.PersonalAccessTokenProvider
+	resourceQuotaNotificationProvider              provider.ResourceQuotaNotificationProvider
 	groupProjectBindingProvider                    provider.GroupProjectBindingProvider
 	privilegedIPAMPoolProviderGetter               provider.PrivilegedIPAMPoolProviderGetter
 	applicationDefinitionProvider                  provider.ApplicationDefinitionProvider
+	applicationCatalogSourceProvider               provider.ApplicationCatalogSourceProvider
+	applicationRolloutProvider                     provider.ApplicationRolloutProvider
+	backgroundOperationProvider                    provider.BackgroundOperationProvider
 	privilegedOperatingSystemProfileProviderGetter provider.PrivilegedOperatingSystemProfileProviderGetter
 	oidcIssuerVerifierProviderGetter               provider.OIDCIssuerVerifierGetter
 	oidcIssuerVerifier                             authtypes.OIDCIssuerVerifier
+	additionalOIDCIssuerVerifiers                  []authtypes.OIDCIssuerVerifier
+	trustedProxies                                 handlerauth.TrustedProxies
 	versions                                       kubermatic.Versions
 	caBundle                                       *x509.CertPool
 	features                                       features.FeatureGate
+	priceCatalog                                   pricing.Catalog
 }
 
 // NewV2Routing creates a new Routing.
@@ -166,15 +183,27 @@ func NewV2Routing(routingParams handler.RoutingParams) Routing {
 		privilegedMLAAdminSettingProviderGetter:        routingParams.PrivilegedMLAAdminSettingProviderGetter,
 		seedProvider:                                   routingParams.SeedProvider,
 		resourceQuotaProvider:                          routingParams.ResourceQuotaProvider,
+		resourceQuotaUsageHistoryProvider:              routingParams.ResourceQuotaUsageHistoryProvider,
+		clusterAgentTokenProvider:                      routingParams.ClusterAgentTokenProvider,
+		sessionProvider:                                routingParams.SessionProvider,
+		deviceAuthorizationProvider:                    routingParams.DeviceAuthorizationProvider,
+		personalAccessTokenProvider:                    routingParams.PersonalAccessTokenProvider,
+		resourceQuotaNotificationProvider:              routingParams.ResourceQuotaNotificationProvider,
 		groupProjectBindingProvider:                    routingParams.GroupProjectBindingProvider,
 		privilegedIPAMPoolProviderGetter:               routingParams.PrivilegedIPAMPoolProviderGetter,
 		applicationDefinitionProvider:                  routingParams.ApplicationDefinitionProvider,
+		applicationCatalogSourceProvider:               routingParams.ApplicationCatalogSourceProvider,
+		applicationRolloutProvider:                     routingParams.ApplicationRolloutProvider,
+		backgroundOperationProvider:                    routingParams.BackgroundOperationProvider,
 		privilegedOperatingSystemProfileProviderGetter: routingParams.PrivilegedOperatingSystemProfileProviderGetter,
 		oidcIssuerVerifierProviderGetter:               routingParams.OIDCIssuerVerifierProviderGetter,
 		oidcIssuerVerifier:                             routingParams.OIDCIssuerVerifier,
+		additionalOIDCIssuerVerifiers:                  routingParams.AdditionalOIDCIssuerVerifiers,
+		trustedProxies:                                 routingParams.TrustedProxies,
 		versions:                                       routingParams.Versions,
 		caBundle:                                       routingParams.CABundle,
 		features:                                       routingParams.Features,
+		priceCatalog:                                   routingParams.PriceCatalog,
 	}
 }
 
@@ -198,3 +227,9 @@ func (r Routing) defaultServerOptions() []httptransport.ServerOption {
 		httptransport.ServerBefore(middleware.SetSeedsGetter(r.seedsGetter)),
 	}
 }
+
+// deviceOIDCKubeconfig creates the OIDC kubeconfigs devices receive with their tokens after the user approved them.
+func (r Routing) deviceOIDCKubeconfig(ctx context.Context, userEmail, projectID, clusterID string, oidcTokens authtypes.OIDCToken) (*clientcmdapi.Config, error) {
+	return handlercommon.CreateDeviceOIDCKubeconfig(ctx, r.userProvider, r.userInfoGetter, r.projectProvider, r.privilegedProjectProvider,
+		r.seedsGetter, r.clusterProviderGetter, r.oidcIssuerVerifier.OIDCConfig(), userEmail, projectID, clusterID, oidcTokens)
+}
@@ -0,0 +1,107 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package session
+
+import (
+	"context"
+	"fmt"
+	"net/http"
+
+	"github.com/go-kit/kit/endpoint"
+	"github.com/gorilla/mux"
+
+	handlerauth "k8c.io/dashboard/v2/pkg/handler/auth"
+	handlercommon "k8c.io/dashboard/v2/pkg/handler/common"
+	"k8c.io/dashboard/v2/pkg/provider"
+	utilerrors "k8c.io/kubermatic/v2/pkg/util/errors"
+)
+
+func ListEndpoint(userInfoGetter provider.UserInfoGetter, sessionProvider provider.SessionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(listSessionsReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, listSessionsReq{})
+		}
+		return handlercommon.ListSessionsEndpoint(ctx, userInfoGetter, sessionProvider, req.currentSessionKey)
+	}
+}
+
+func RevokeEndpoint(userInfoGetter provider.UserInfoGetter, sessionProvider provider.SessionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(sessionReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, sessionReq{})
+		}
+		return nil, handlercommon.RevokeSessionEndpoint(ctx, userInfoGetter, sessionProvider, req.SessionID)
+	}
+}
+
+func RevokeUserSessionsEndpoint(userInfoGetter provider.UserInfoGetter, userProvider provider.UserProvider, sessionProvider provider.SessionProvider) endpoint.Endpoint {
+	return func(ctx context.Context, request interface{}) (interface{}, error) {
+		req, ok := request.(userSessionsReq)
+		if !ok {
+			return nil, utilerrors.NewWrongMethod(request, userSessionsReq{})
+		}
+		return nil, handlercommon.RevokeUserSessionsEndpoint(ctx, userInfoGetter, userProvider, sessionProvider, req.UserID)
+	}
+}
+
+// listSessionsReq defines HTTP request for listSessions
+type listSessionsReq struct {
+	// currentSessionKey is the key of the session the request was sent with, if any.
+	currentSessionKey string
+}
+
+func DecodeListSessionsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	req := listSessionsReq{}
+	if cookie, err := r.Cookie(handlerauth.SessionCookieName); err == nil {
+		req.currentSessionKey = cookie.Value
+	}
+	return req, nil
+}
+
+// sessionReq defines HTTP request for revokeSession
+// swagger:parameters revokeSession
+type sessionReq struct {
+	// in: path
+	// required: true
+	SessionID string `json:"session_id"`
+}
+
+func DecodeSessionReq(_ context.Context, r *http.Request) (interface{}, error) {
+	sessionID := mux.Vars(r)["session_id"]
+	if sessionID == "" {
+		return nil, fmt.Errorf("'session_id' parameter is required but was not provided")
+	}
+	return sessionReq{SessionID: sessionID}, nil
+}
+
+// userSessionsReq defines HTTP request for revokeUserSessions
+// swagger:parameters revokeUserSessions
+type userSessionsReq struct {
+	// in: path
+	// required: true
+	UserID string `json:"user_id"`
+}
+
+func DecodeUserSessionsReq(_ context.Context, r *http.Request) (interface{}, error) {
+	userID := mux.Vars(r)["user_id"]
+	if userID == "" {
+		return nil, fmt.Errorf("'user_id' parameter is required but was not provided")
+	}
+	return userSessionsReq{UserID: userID}, nil
+}
@@ -37,7 +37,7 @@ func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websock
 		return
 	}
 
-	initialResponse, err := json.Marshal(admin.ConvertCRDSettingsToAPISettingsSpec(&initialSettings.Spec))
+	initialResponse, err := json.Marshal(admin.ConvertCRDSettingsToAPISettings(initialSettings))
 	if err != nil {
 		log.Logger.Debug(err)
 		return
@@ -54,7 +54,7 @@ func WriteSettings(ctx context.Context, providers watcher.Providers, ws *websock
 			var externalSettings apiv2.GlobalSettings
 			internalSettings, ok := settings.(*kubermaticv1.KubermaticSetting)
 			if ok {
-				externalSettings = admin.ConvertCRDSettingsToAPISettingsSpec(&internalSettings.Spec)
+				externalSettings = admin.ConvertCRDSettingsToAPISettings(internalSettings)
 			} else {
 				log.Logger.Debug("cannot convert settings: %v", settings)
 			}
@@ -0,0 +1,171 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package pricing provides price catalogs which are used to estimate the
+// monthly cost of node deployments, clusters and projects.
+package pricing
+
+import (
+	"errors"
+	"fmt"
+	"os"
+
+	"sigs.k8s.io/yaml"
+)
+
+const (
+	// HoursPerMonth is the number of hours used to turn hourly prices into monthly ones.
+	HoursPerMonth = 730
+
+	// AnyRegion is the region key that matches every region of a provider
+	// which has no dedicated entry in the price sheet.
+	AnyRegion = "*"
+)
+
+// Catalog is the interface for price lookups. All returned prices are per hour
+// and in the catalog's currency.
+type Catalog interface {
+	// Currency returns the ISO 4217 currency code of all prices in the catalog.
+	Currency() string
+	// InstancePrice returns the hourly price of a named instance type, e.g. an AWS
+	// instance type or an OpenStack flavor.
+	InstancePrice(provider, region, instanceType string) (float64, bool)
+	// ResourcePrice returns the hourly price of a node that is sized by its
+	// resources rather than by a named instance type, e.g. on vSphere.
+	ResourcePrice(provider, region string, cpus int64, memoryMiB int64) (float64, bool)
+}
+
+// PriceSheet is the on-disk format of a price catalog. It can be written in
+// either JSON or YAML.
+type PriceSheet struct {
+	// Currency is the ISO 4217 currency code of all prices, e.g. "EUR".
+	Currency string `json:"currency"`
+	// Providers maps a cloud provider name (as used in datacenter specs, e.g.
+	// "aws" or "vsphere") to its regions. The region "*" is used as a fallback
+	// for regions without their own entry.
+	Providers map[string]map[string]RegionPrices `json:"providers"`
+}
+
+// RegionPrices contains the hourly prices of a single provider region.
+type RegionPrices struct {
+	// InstanceTypes maps instance type names to their hourly price.
+	InstanceTypes map[string]float64 `json:"instanceTypes,omitempty"`
+	// CPU is the hourly price per vCPU for resource based providers.
+	CPU *float64 `json:"cpu,omitempty"`
+	// MemoryGiB is the hourly price per GiB of memory for resource based providers.
+	MemoryGiB *float64 `json:"memoryGiB,omitempty"`
+}
+
+type sheetCatalog struct {
+	sheet PriceSheet
+}
+
+var _ Catalog = &sheetCatalog{}
+
+// NewCatalog returns a Catalog backed by the given price sheet.
+func NewCatalog(sheet PriceSheet) (Catalog, error) {
+	if err := validate(sheet); err != nil {
+		return nil, err
+	}
+
+	return &sheetCatalog{sheet: sheet}, nil
+}
+
+// NewCatalogFromFile loads a JSON or YAML price sheet from disk.
+func NewCatalogFromFile(filename string) (Catalog, error) {
+	data, err := os.ReadFile(filename)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read file: %w", err)
+	}
+
+	sheet := PriceSheet{}
+	if err := yaml.UnmarshalStrict(data, &sheet); err != nil {
+		return nil, fmt.Errorf("failed to parse price sheet: %w", err)
+	}
+
+	return NewCatalog(sheet)
+}
+
+func validate(sheet PriceSheet) error {
+	if sheet.Currency == "" {
+		return errors.New("currency must be set")
+	}
+
+	for providerName, regions := range sheet.Providers {
+		for region, prices := range regions {
+			for instanceType, price := range prices.InstanceTypes {
+				if price < 0 {
+					return fmt.Errorf("%s/%s: price of instance type %q must not be negative", providerName, region, instanceType)
+				}
+			}
+			if prices.CPU != nil && *prices.CPU < 0 {
+				return fmt.Errorf("%s/%s: CPU price must not be negative", providerName, region)
+			}
+			if prices.MemoryGiB != nil && *prices.MemoryGiB < 0 {
+				return fmt.Errorf("%s/%s: memory price must not be negative", providerName, region)
+			}
+		}
+	}
+
+	return nil
+}
+
+func (c *sheetCatalog) Currency() string {
+	return c.sheet.Currency
+}
+
+func (c *sheetCatalog) InstancePrice(provider, region, instanceType string) (float64, bool) {
+	for _, prices := range c.regionPrices(provider, region) {
+		if price, ok := prices.InstanceTypes[instanceType]; ok {
+			return price, true
+		}
+	}
+
+	return 0, false
+}
+
+func (c *sheetCatalog) ResourcePrice(provider, region string, cpus int64, memoryMiB int64) (float64, bool) {
+	for _, prices := range c.regionPrices(provider, region) {
+		if prices.CPU == nil || prices.MemoryGiB == nil {
+			continue
+		}
+
+		memoryGiB := float64(memoryMiB) / 1024
+
+		return float64(cpus)*(*prices.CPU) + memoryGiB*(*prices.MemoryGiB), true
+	}
+
+	return 0, false
+}
+
+// regionPrices returns the price entries to consult for the given region,
+// the dedicated region entry first, followed by the fallback entry.
+func (c *sheetCatalog) regionPrices(provider, region string) []RegionPrices {
+	regions, ok := c.sheet.Providers[provider]
+	if !ok {
+		return nil
+	}
+
+	var result []RegionPrices
+	if prices, ok := regions[region]; ok && region != AnyRegion {
+		result = append(result, prices)
+	}
+	if prices, ok := regions[AnyRegion]; ok {
+		result = append(result, prices)
+	}
+
+	return result
+}
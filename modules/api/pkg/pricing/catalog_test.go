@@ -0,0 +1,137 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package pricing
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+)
+
+const testSheet = `
+currency: EUR
+providers:
+  aws:
+    eu-central-1:
+      instanceTypes:
+        t3.medium: 0.048
+    "*":
+      instanceTypes:
+        t3.medium: 0.05
+        t3.large: 0.1
+  vsphere:
+    "*":
+      cpu: 0.01
+      memoryGiB: 0.005
+`
+
+func TestCatalogFromFile(t *testing.T) {
+	filename := filepath.Join(t.TempDir(), "prices.yaml")
+	require.NoError(t, os.WriteFile(filename, []byte(testSheet), 0600))
+
+	catalog, err := NewCatalogFromFile(filename)
+	require.NoError(t, err)
+	require.Equal(t, "EUR", catalog.Currency())
+
+	testCases := []struct {
+		name          string
+		provider      string
+		region        string
+		instanceType  string
+		cpus          int64
+		memoryMiB     int64
+		expectedPrice float64
+		expectedFound bool
+	}{
+		{
+			name:          "dedicated region entry wins",
+			provider:      "aws",
+			region:        "eu-central-1",
+			instanceType:  "t3.medium",
+			expectedPrice: 0.048,
+			expectedFound: true,
+		},
+		{
+			name:          "fallback region for instance type missing in region",
+			provider:      "aws",
+			region:        "eu-central-1",
+			instanceType:  "t3.large",
+			expectedPrice: 0.1,
+			expectedFound: true,
+		},
+		{
+			name:          "fallback region for unknown region",
+			provider:      "aws",
+			region:        "us-east-1",
+			instanceType:  "t3.medium",
+			expectedPrice: 0.05,
+			expectedFound: true,
+		},
+		{
+			name:         "unknown instance type",
+			provider:     "aws",
+			region:       "us-east-1",
+			instanceType: "m5.xlarge",
+		},
+		{
+			name:         "unknown provider",
+			provider:     "gcp",
+			region:       "europe-west3",
+			instanceType: "n1-standard-2",
+		},
+		{
+			name:          "resource based pricing",
+			provider:      "vsphere",
+			region:        "dc-1",
+			cpus:          4,
+			memoryMiB:     8192,
+			expectedPrice: 4*0.01 + 8*0.005,
+			expectedFound: true,
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			var (
+				price float64
+				found bool
+			)
+			if tc.instanceType != "" {
+				price, found = catalog.InstancePrice(tc.provider, tc.region, tc.instanceType)
+			} else {
+				price, found = catalog.ResourcePrice(tc.provider, tc.region, tc.cpus, tc.memoryMiB)
+			}
+			require.Equal(t, tc.expectedFound, found)
+			require.InDelta(t, tc.expectedPrice, price, 1e-9)
+		})
+	}
+}
+
+func TestCatalogValidation(t *testing.T) {
+	_, err := NewCatalog(PriceSheet{})
+	require.Error(t, err, "a sheet without currency must be rejected")
+
+	_, err = NewCatalog(PriceSheet{
+		Currency: "USD",
+		Providers: map[string]map[string]RegionPrices{
+			"aws": {AnyRegion: {InstanceTypes: map[string]float64{"t3.medium": -1}}},
+		},
+	})
+	require.Error(t, err, "negative prices must be rejected")
+}
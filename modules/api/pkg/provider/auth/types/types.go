@@ -67,6 +67,13 @@ type TokenVerifier interface {
 	Verify(ctx context.Context, token string) (TokenClaims, error)
 }
 
+// IssuerTokenVerifier is a TokenVerifier that only accepts the tokens of a single issuer.
+type IssuerTokenVerifier interface {
+	TokenVerifier
+	// Issuer returns the issuer identifier, the iss claim of the tokens accepted by the verifier.
+	Issuer() string
+}
+
 // TokenExtractorVerifier combines TokenVerifier and TokenExtractor interfaces.
 type TokenExtractorVerifier interface {
 	TokenVerifier
@@ -113,6 +120,10 @@ type TokenClaims struct {
 	Groups  []string
 	Nonce   string
 	Expiry  apiv1.Time
+	// ReadOnly is set for tokens which must not be used to change anything.
+	ReadOnly bool
+	// AuthTime is the time the user last authenticated at the issuer, zero if the token does not tell.
+	AuthTime apiv1.Time
 }
 
 // OIDCConfiguration is a struct that holds
@@ -134,4 +145,18 @@ type OIDCConfiguration struct {
 	OfflineAccessAsScope bool
 	// SkipTLSVerify skip TLS verification for the token issuer
 	SkipTLSVerify bool
+	// Name selects the issuer in the login flow, it is empty for the issuer configured with --oidc-url.
+	Name string
+	// DisplayName is the name of the issuer shown to users.
+	DisplayName string
+	// EmailClaim and GroupsClaim are the claims holding the email and the groups of the user, they default to
+	// "email" and "groups".
+	EmailClaim  string
+	GroupsClaim string
+	// GroupMapping renames groups of the issuer to KKP groups. Groups that are not mapped get the GroupsPrefix.
+	GroupMapping map[string]string
+	GroupsPrefix string
+	// AllowedEmailDomains restricts the users of the issuer to these email domains. All domains are allowed if empty,
+	// which is only accepted for the issuer configured with --oidc-url.
+	AllowedEmailDomains []string
 }
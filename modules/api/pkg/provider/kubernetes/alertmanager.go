@@ -18,9 +18,13 @@ package kubernetes
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"net/http"
+	"strings"
+	"time"
 
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
 	"k8c.io/kubermatic/v2/pkg/resources"
@@ -30,9 +34,21 @@ import (
 	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes"
+	"k8s.io/client-go/rest"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
+const (
+	// cortexAlertmanagerNamespace and cortexAlertmanagerService locate the Cortex Alertmanager of the MLA stack in the seed,
+	// which serves the Alertmanagers of all user clusters. Every cluster is a tenant of Cortex, identified by the name
+	// of the cluster in the cortexTenantHeader.
+	cortexAlertmanagerNamespace = "mla"
+	cortexAlertmanagerService   = "cortex-alertmanager:8080"
+	cortexAlertmanagerAlertsAPI = "api/prom/alertmanager/api/v2/alerts"
+	cortexTenantHeader          = "X-Scope-OrgID"
+)
+
 // AlertmanagerProvider struct that holds required components in order to manage alertmanager objects.
 type AlertmanagerProvider struct {
 	// createSeedImpersonatedClient is used as a ground for impersonation
@@ -41,16 +57,20 @@ type AlertmanagerProvider struct {
 
 	// privilegedClient is used for admins to interact with alertmanager configuration.
 	privilegedClient ctrlruntimeclient.Client
+
+	// seedRESTClient is used to reach the Cortex Alertmanager through the service proxy of the Seed API server.
+	seedRESTClient rest.Interface
 }
 
 var _ provider.AlertmanagerProvider = &AlertmanagerProvider{}
 var _ provider.PrivilegedAlertmanagerProvider = &AlertmanagerProvider{}
 
 // NewAlertmanagerProvider returns an alertmanager provider.
-func NewAlertmanagerProvider(createSeedImpersonatedClient ImpersonationClient, privilegedClient ctrlruntimeclient.Client) *AlertmanagerProvider {
+func NewAlertmanagerProvider(createSeedImpersonatedClient ImpersonationClient, privilegedClient ctrlruntimeclient.Client, seedRESTClient rest.Interface) *AlertmanagerProvider {
 	return &AlertmanagerProvider{
 		createSeedImpersonatedClient: createSeedImpersonatedClient,
 		privilegedClient:             privilegedClient,
+		seedRESTClient:               seedRESTClient,
 	}
 }
 
@@ -65,9 +85,14 @@ func AlertmanagerProviderFactory(mapper meta.RESTMapper, seedKubeconfigGetter pr
 		if err != nil {
 			return nil, err
 		}
+		seedClientset, err := kubernetes.NewForConfig(cfg)
+		if err != nil {
+			return nil, err
+		}
 		return NewAlertmanagerProvider(
 			defaultImpersonationClientForSeed.CreateImpersonatedClient,
 			privilegedClient,
+			seedClientset.CoreV1().RESTClient(),
 		), nil
 	}
 }
@@ -116,6 +141,51 @@ func (p *AlertmanagerProvider) ResetUnsecured(ctx context.Context, cluster *kube
 	return reset(ctx, p.privilegedClient, cluster)
 }
 
+// postableAlert is an alert in the format of the Alertmanager API.
+type postableAlert struct {
+	Labels      map[string]string `json:"labels"`
+	Annotations map[string]string `json:"annotations,omitempty"`
+	StartsAt    time.Time         `json:"startsAt"`
+	EndsAt      time.Time         `json:"endsAt"`
+}
+
+// SendTestAlertUnsecured sends an alert to the Alertmanager of the cluster by using a privileged client. The alert
+// is posted to the Cortex Alertmanager through the service proxy of the Seed API server.
+func (p *AlertmanagerProvider) SendTestAlertUnsecured(ctx context.Context, cluster *kubermaticv1.Cluster, alert *apiv2.AlertmanagerTestAlert) error {
+	if p.seedRESTClient == nil {
+		return utilerrors.New(http.StatusInternalServerError, "no client for the seed API server is configured")
+	}
+
+	body, err := json.Marshal([]postableAlert{{
+		Labels:      alert.Labels,
+		Annotations: alert.Annotations,
+		StartsAt:    alert.StartsAt.Time,
+		EndsAt:      alert.EndsAt.Time,
+	}})
+	if err != nil {
+		return err
+	}
+
+	response, err := p.seedRESTClient.Post().
+		Namespace(cortexAlertmanagerNamespace).
+		Resource("services").
+		Name(cortexAlertmanagerService).
+		SubResource("proxy").
+		Suffix(cortexAlertmanagerAlertsAPI).
+		SetHeader(cortexTenantHeader, cluster.Name).
+		SetHeader("Content-Type", "application/json").
+		Body(body).
+		DoRaw(ctx)
+	if err != nil {
+		// the alertmanager explains in plain text why it rejected the alert
+		if len(response) > 0 {
+			return utilerrors.New(http.StatusInternalServerError, fmt.Sprintf("failed to send alert to the alertmanager: %s", strings.TrimSpace(string(response))))
+		}
+		return utilerrors.New(http.StatusInternalServerError, fmt.Errorf("failed to send alert to the alertmanager: %w", err).Error())
+	}
+	return nil
+}
+
 func get(ctx context.Context, client ctrlruntimeclient.Client, cluster *kubermaticv1.Cluster) (*kubermaticv1.Alertmanager, *corev1.Secret, error) {
 	alertmanager := &kubermaticv1.Alertmanager{}
 	if err := client.Get(ctx, types.NamespacedName{
@@ -18,11 +18,18 @@ package kubernetes_test
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
+	"io"
+	"net/http"
+	"strings"
 	"testing"
+	"time"
 
 	"github.com/stretchr/testify/assert"
 
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
 	"k8c.io/dashboard/v2/pkg/provider"
 	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -33,7 +40,9 @@ import (
 	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/kubernetes/scheme"
 	restclient "k8s.io/client-go/rest"
+	fakerest "k8s.io/client-go/rest/fake"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
 
@@ -87,7 +96,7 @@ func TestGetAlertmanager(t *testing.T) {
 				return client, nil
 			}
 
-			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, client)
+			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, client, nil)
 
 			alertmanager, configSecret, err := alertmanagerProvider.Get(context.Background(), tc.cluster, genUserInfo())
 			if len(tc.expectedError) == 0 {
@@ -173,7 +182,7 @@ func TestUpdateAlertmanager(t *testing.T) {
 				return client, nil
 			}
 
-			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, client)
+			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, client, nil)
 
 			alertmanager, configSecret, err := alertmanagerProvider.Update(context.Background(), tc.expectedAlertmanager,
 				tc.expectedConfigSecret, genUserInfo())
@@ -250,7 +259,7 @@ func TestResetAlertmanager(t *testing.T) {
 				return client, nil
 			}
 
-			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, client)
+			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(fakeImpersonationClient, client, nil)
 
 			err := alertmanagerProvider.Reset(context.Background(), tc.cluster, genUserInfo())
 			if len(tc.expectedError) == 0 {
@@ -283,6 +292,71 @@ func TestResetAlertmanager(t *testing.T) {
 	}
 }
 
+func TestSendTestAlert(t *testing.T) {
+	t.Parallel()
+	testCases := []struct {
+		name          string
+		statusCode    int
+		response      string
+		expectedError string
+	}{
+		{
+			name:       "scenario 1, send alert to the cortex alertmanager of the seed",
+			statusCode: http.StatusOK,
+		},
+		{
+			name:          "scenario 2, alertmanager rejects the alert",
+			statusCode:    http.StatusBadRequest,
+			response:      "start time must be before end time\n",
+			expectedError: "failed to send alert to the alertmanager: start time must be before end time",
+		},
+	}
+
+	for _, tc := range testCases {
+		t.Run(tc.name, func(t *testing.T) {
+			seedRESTClient := &fakerest.RESTClient{
+				NegotiatedSerializer: scheme.Codecs.WithoutConversion(),
+				VersionedAPIPath:     "/api/v1",
+				Client: fakerest.CreateHTTPClient(func(req *http.Request) (*http.Response, error) {
+					return &http.Response{StatusCode: tc.statusCode, Body: io.NopCloser(strings.NewReader(tc.response))}, nil
+				}),
+			}
+			alertmanagerProvider := kubernetes.NewAlertmanagerProvider(nil, nil, seedRESTClient)
+
+			now := time.Now()
+			err := alertmanagerProvider.SendTestAlertUnsecured(context.Background(),
+				genCluster(testAlertmanagerClusterName, "kubernetes", "my-first-project-ID", "test-alertmanager", "john@acme.com"),
+				&apiv2.AlertmanagerTestAlert{
+					Labels:   map[string]string{"alertname": "Test"},
+					StartsAt: apiv1.NewTime(now),
+					EndsAt:   apiv1.NewTime(now.Add(time.Minute)),
+				})
+			if len(tc.expectedError) > 0 {
+				if err == nil {
+					t.Fatalf("expected error message")
+				}
+				assert.Equal(t, tc.expectedError, err.Error())
+				return
+			}
+			if err != nil {
+				t.Fatal(err)
+			}
+
+			req := seedRESTClient.Req
+			assert.Equal(t, http.MethodPost, req.Method)
+			assert.Equal(t, "/api/v1/namespaces/mla/services/cortex-alertmanager:8080/proxy/api/prom/alertmanager/api/v2/alerts", req.URL.Path)
+			assert.Equal(t, testAlertmanagerClusterName, req.Header.Get("X-Scope-OrgID"))
+
+			alerts := []map[string]interface{}{}
+			if err := json.NewDecoder(req.Body).Decode(&alerts); err != nil {
+				t.Fatal(err)
+			}
+			assert.Len(t, alerts, 1)
+			assert.Equal(t, map[string]interface{}{"alertname": "Test"}, alerts[0]["labels"])
+		})
+	}
+}
+
 func generateAlertmanager(namespace, configSecretName, resourceVersion string) *kubermaticv1.Alertmanager {
 	return &kubermaticv1.Alertmanager{
 		ObjectMeta: metav1.ObjectMeta{
@@ -0,0 +1,294 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	applicationCatalogSourcePrefix      = "application-catalog-source-"
+	applicationCatalogCredentialsPrefix = "application-catalog-credentials-"
+	applicationCatalogSourceLabel       = "dashboard.k8c.io/application-catalog-source"
+	applicationCatalogSourceSpecKey     = "spec"
+	applicationCatalogSourceStatusKey   = "status"
+	applicationCatalogSourceUsernameKey = "username"
+	applicationCatalogSourcePasswordKey = "password"
+
+	// applicationSecretTypeAnnotation makes KKP sync the credentials to the seeds, where the Helm charts of the
+	// ApplicationDefinitions are installed from.
+	applicationSecretTypeAnnotation = "apps.kubermatic.k8c.io/secret-type"
+)
+
+// ApplicationCatalogSourceProvider stores the catalog sources as secrets in the Kubermatic namespace. The
+// credentials of a source are kept in a secret of their own, which the ApplicationDefinitions synced from the
+// source reference.
+type ApplicationCatalogSourceProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+}
+
+var _ provider.ApplicationCatalogSourceProvider = &ApplicationCatalogSourceProvider{}
+
+// NewApplicationCatalogSourceProvider returns an application catalog source provider.
+func NewApplicationCatalogSourceProvider(client ctrlruntimeclient.Client) *ApplicationCatalogSourceProvider {
+	return &ApplicationCatalogSourceProvider{
+		clientPrivileged: client,
+	}
+}
+
+func (p *ApplicationCatalogSourceProvider) ListUnsecured(ctx context.Context) ([]apiv2.ApplicationCatalogSource, error) {
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{applicationCatalogSourceLabel: "true"},
+	); err != nil {
+		return nil, err
+	}
+
+	sources := make([]apiv2.ApplicationCatalogSource, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		source, err := convertApplicationCatalogSourceSecret(&secrets.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		sources = append(sources, *source)
+	}
+	sort.Slice(sources, func(i, j int) bool {
+		return sources[i].Name < sources[j].Name
+	})
+	return sources, nil
+}
+
+func (p *ApplicationCatalogSourceProvider) GetUnsecured(ctx context.Context, name string) (*apiv2.ApplicationCatalogSource, error) {
+	secret, err := p.get(ctx, name)
+	if err != nil {
+		return nil, err
+	}
+	return convertApplicationCatalogSourceSecret(secret)
+}
+
+func (p *ApplicationCatalogSourceProvider) GetCredentialsUnsecured(ctx context.Context, name string) (*provider.ApplicationCatalogSourceCredentials, error) {
+	secret := &corev1.Secret{}
+	secretName := applicationCatalogSourceCredentialsName(name)
+	if err := p.clientPrivileged.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: secretName}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, nil
+		}
+		return nil, err
+	}
+
+	return &provider.ApplicationCatalogSourceCredentials{
+		Username: string(secret.Data[applicationCatalogSourceUsernameKey]),
+		Password: string(secret.Data[applicationCatalogSourcePasswordKey]),
+		HelmCredentials: &appskubermaticv1.HelmCredentials{
+			Username: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  applicationCatalogSourceUsernameKey,
+			},
+			Password: &corev1.SecretKeySelector{
+				LocalObjectReference: corev1.LocalObjectReference{Name: secretName},
+				Key:                  applicationCatalogSourcePasswordKey,
+			},
+		},
+	}, nil
+}
+
+func (p *ApplicationCatalogSourceProvider) CreateUnsecured(ctx context.Context, source *apiv2.ApplicationCatalogSource, password string) (*apiv2.ApplicationCatalogSource, error) {
+	spec, err := json.Marshal(source.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      applicationCatalogSourcePrefix + source.Name,
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				applicationCatalogSourceLabel: "true",
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			applicationCatalogSourceSpecKey: spec,
+		},
+	}
+	if err := p.clientPrivileged.Create(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	if err := p.reconcileCredentials(ctx, source.Name, source.Spec.Username, password); err != nil {
+		return nil, err
+	}
+
+	return convertApplicationCatalogSourceSecret(secret)
+}
+
+func (p *ApplicationCatalogSourceProvider) UpdateUnsecured(ctx context.Context, source *apiv2.ApplicationCatalogSource, password string) (*apiv2.ApplicationCatalogSource, error) {
+	secret, err := p.get(ctx, source.Name)
+	if err != nil {
+		return nil, err
+	}
+
+	if password == "" && source.Spec.Username != "" {
+		credentials, err := p.GetCredentialsUnsecured(ctx, source.Name)
+		if err != nil {
+			return nil, err
+		}
+		if credentials != nil && credentials.Username == source.Spec.Username {
+			password = credentials.Password
+		}
+	}
+
+	spec, err := json.Marshal(source.Spec)
+	if err != nil {
+		return nil, err
+	}
+	oldSecret := secret.DeepCopy()
+	secret.Data[applicationCatalogSourceSpecKey] = spec
+	if err := p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+		return nil, err
+	}
+
+	if err := p.reconcileCredentials(ctx, source.Name, source.Spec.Username, password); err != nil {
+		return nil, err
+	}
+
+	return convertApplicationCatalogSourceSecret(secret)
+}
+
+func (p *ApplicationCatalogSourceProvider) UpdateStatusUnsecured(ctx context.Context, name string, status *apiv2.ApplicationCatalogSourceStatus) error {
+	secret, err := p.get(ctx, name)
+	if err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(status)
+	if err != nil {
+		return err
+	}
+	oldSecret := secret.DeepCopy()
+	secret.Data[applicationCatalogSourceStatusKey] = data
+	return p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret))
+}
+
+func (p *ApplicationCatalogSourceProvider) DeleteUnsecured(ctx context.Context, name string) error {
+	secret, err := p.get(ctx, name)
+	if err != nil {
+		return err
+	}
+	if err := p.clientPrivileged.Delete(ctx, secret); err != nil {
+		return err
+	}
+	return p.reconcileCredentials(ctx, name, "", "")
+}
+
+// reconcileCredentials stores the given credentials, or removes the stored ones if there is no username.
+func (p *ApplicationCatalogSourceProvider) reconcileCredentials(ctx context.Context, name, username, password string) error {
+	secret := &corev1.Secret{}
+	key := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: applicationCatalogSourceCredentialsName(name)}
+	err := p.clientPrivileged.Get(ctx, key, secret)
+	if err != nil && !apierrors.IsNotFound(err) {
+		return err
+	}
+	exists := err == nil
+
+	if username == "" {
+		if !exists {
+			return nil
+		}
+		return ctrlruntimeclient.IgnoreNotFound(p.clientPrivileged.Delete(ctx, secret))
+	}
+
+	data := map[string][]byte{
+		applicationCatalogSourceUsernameKey: []byte(username),
+		applicationCatalogSourcePasswordKey: []byte(password),
+	}
+	if !exists {
+		secret = &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      key.Name,
+				Namespace: key.Namespace,
+				Annotations: map[string]string{
+					applicationSecretTypeAnnotation: "helm",
+				},
+			},
+			Type: corev1.SecretTypeOpaque,
+			Data: data,
+		}
+		return p.clientPrivileged.Create(ctx, secret)
+	}
+
+	oldSecret := secret.DeepCopy()
+	secret.Data = data
+	return p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret))
+}
+
+func (p *ApplicationCatalogSourceProvider) get(ctx context.Context, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := p.clientPrivileged.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: applicationCatalogSourcePrefix + name}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, newApplicationCatalogSourceNotFoundError(name)
+		}
+		return nil, err
+	}
+	if secret.Labels[applicationCatalogSourceLabel] != "true" {
+		return nil, newApplicationCatalogSourceNotFoundError(name)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	return secret, nil
+}
+
+func applicationCatalogSourceCredentialsName(name string) string {
+	return applicationCatalogCredentialsPrefix + name
+}
+
+func newApplicationCatalogSourceNotFoundError(name string) error {
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "applicationcatalogsource"}, name)
+}
+
+func convertApplicationCatalogSourceSecret(secret *corev1.Secret) (*apiv2.ApplicationCatalogSource, error) {
+	source := &apiv2.ApplicationCatalogSource{
+		Name: strings.TrimPrefix(secret.Name, applicationCatalogSourcePrefix),
+	}
+	if err := json.Unmarshal(secret.Data[applicationCatalogSourceSpecKey], &source.Spec); err != nil {
+		return nil, fmt.Errorf("failed to decode the spec of application catalog source %s: %w", source.Name, err)
+	}
+	if status, ok := secret.Data[applicationCatalogSourceStatusKey]; ok {
+		if err := json.Unmarshal(status, &source.Status); err != nil {
+			return nil, fmt.Errorf("failed to decode the status of application catalog source %s: %w", source.Name, err)
+		}
+	}
+	return source, nil
+}
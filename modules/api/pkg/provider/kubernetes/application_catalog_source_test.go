@@ -0,0 +1,95 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestApplicationCatalogSourceProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	target := kubernetes.NewApplicationCatalogSourceProvider(client)
+
+	source := &apiv2.ApplicationCatalogSource{
+		Name: "internal",
+		Spec: apiv2.ApplicationCatalogSourceSpec{
+			Type:     apiv2.ApplicationCatalogSourceTypeHelm,
+			URL:      "https://charts.example.com",
+			Username: "robot",
+		},
+	}
+	_, err := target.CreateUnsecured(ctx, source, "secret")
+	require.NoError(t, err)
+
+	credentials, err := target.GetCredentialsUnsecured(ctx, "internal")
+	require.NoError(t, err)
+	require.Equal(t, "robot", credentials.Username)
+	require.Equal(t, "secret", credentials.Password)
+
+	// KKP only syncs annotated credentials to the seeds
+	secret := &corev1.Secret{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: credentials.HelmCredentials.Password.Name}, secret))
+	require.Equal(t, "helm", secret.Annotations["apps.kubermatic.k8c.io/secret-type"])
+
+	// the password is kept as long as the username does not change
+	source.Spec.URL = "https://charts.example.com/stable"
+	_, err = target.UpdateUnsecured(ctx, source, "")
+	require.NoError(t, err)
+	credentials, err = target.GetCredentialsUnsecured(ctx, "internal")
+	require.NoError(t, err)
+	require.Equal(t, "secret", credentials.Password)
+
+	source.Spec.Username = "bot"
+	_, err = target.UpdateUnsecured(ctx, source, "")
+	require.NoError(t, err)
+	credentials, err = target.GetCredentialsUnsecured(ctx, "internal")
+	require.NoError(t, err)
+	require.Equal(t, "bot", credentials.Username)
+	require.Empty(t, credentials.Password)
+
+	now := apiv1.Now()
+	require.NoError(t, target.UpdateStatusUnsecured(ctx, "internal", &apiv2.ApplicationCatalogSourceStatus{LastSyncTime: &now, Error: "unreachable"}))
+	sources, err := target.ListUnsecured(ctx)
+	require.NoError(t, err)
+	require.Len(t, sources, 1)
+	require.Equal(t, "https://charts.example.com/stable", sources[0].Spec.URL)
+	require.Equal(t, "unreachable", sources[0].Status.Error)
+
+	// the credentials are removed with the source
+	require.NoError(t, target.DeleteUnsecured(ctx, "internal"))
+	_, err = target.GetUnsecured(ctx, "internal")
+	require.True(t, apierrors.IsNotFound(err))
+	credentials, err = target.GetCredentialsUnsecured(ctx, "internal")
+	require.NoError(t, err)
+	require.Nil(t, credentials)
+}
@@ -18,10 +18,13 @@ package kubernetes
 
 import (
 	"context"
+	"fmt"
 
 	"k8c.io/dashboard/v2/pkg/provider"
 	appskubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/apps.kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
 
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/types"
 	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
 )
@@ -91,3 +94,39 @@ func (p *ApplicationDefinitionProvider) DeleteUnsecured(ctx context.Context, app
 func (p *ApplicationDefinitionProvider) PatchUnsecured(ctx context.Context, oldAppDef, newAppDef *appskubermaticv1.ApplicationDefinition) error {
 	return p.privilegedClient.Patch(ctx, newAppDef, ctrlruntimeclient.MergeFrom(oldAppDef))
 }
+
+func (p *ApplicationDefinitionProvider) GetHelmCredentialsUnsecured(ctx context.Context, credentials *appskubermaticv1.HelmCredentials) (*provider.HelmSourceCredentials, error) {
+	username, err := p.getSecretKey(ctx, credentials.Username)
+	if err != nil {
+		return nil, err
+	}
+	password, err := p.getSecretKey(ctx, credentials.Password)
+	if err != nil {
+		return nil, err
+	}
+	registryConfigFile, err := p.getSecretKey(ctx, credentials.RegistryConfigFile)
+	if err != nil {
+		return nil, err
+	}
+
+	return &provider.HelmSourceCredentials{
+		Username:           string(username),
+		Password:           string(password),
+		RegistryConfigFile: registryConfigFile,
+	}, nil
+}
+
+func (p *ApplicationDefinitionProvider) getSecretKey(ctx context.Context, selector *corev1.SecretKeySelector) ([]byte, error) {
+	if selector == nil {
+		return nil, nil
+	}
+	secret := &corev1.Secret{}
+	if err := p.privilegedClient.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: selector.Name}, secret); err != nil {
+		return nil, err
+	}
+	value, ok := secret.Data[selector.Key]
+	if !ok {
+		return nil, fmt.Errorf("secret %s has no key %s", selector.Name, selector.Key)
+	}
+	return value, nil
+}
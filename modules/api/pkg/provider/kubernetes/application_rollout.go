@@ -0,0 +1,239 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strconv"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	applicationRolloutPrefix        = "application-rollout-"
+	applicationRolloutLabel         = "dashboard.k8c.io/application-rollout"
+	applicationRolloutSpecKey       = "spec"
+	applicationRolloutStatusKey     = "status"
+	applicationRolloutGenerationKey = "generation"
+)
+
+// ApplicationRolloutProvider stores the application rollouts as secrets in the Kubermatic namespace, as their values
+// may contain credentials.
+type ApplicationRolloutProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+}
+
+var _ provider.ApplicationRolloutProvider = &ApplicationRolloutProvider{}
+
+// NewApplicationRolloutProvider returns an application rollout provider.
+func NewApplicationRolloutProvider(client ctrlruntimeclient.Client) *ApplicationRolloutProvider {
+	return &ApplicationRolloutProvider{
+		clientPrivileged: client,
+	}
+}
+
+func (p *ApplicationRolloutProvider) ListUnsecured(ctx context.Context, projectID string) ([]apiv2.ApplicationRollout, error) {
+	selector := ctrlruntimeclient.MatchingLabels{applicationRolloutLabel: "true"}
+	if projectID != "" {
+		selector[kubermaticv1.ProjectIDLabelKey] = projectID
+	}
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets, ctrlruntimeclient.InNamespace(resources.KubermaticNamespace), selector); err != nil {
+		return nil, err
+	}
+
+	rollouts := make([]apiv2.ApplicationRollout, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		rollout, err := convertApplicationRolloutSecret(&secrets.Items[i])
+		if err != nil {
+			return nil, err
+		}
+		rollouts = append(rollouts, *rollout)
+	}
+	sort.Slice(rollouts, func(i, j int) bool {
+		if rollouts[i].ProjectID != rollouts[j].ProjectID {
+			return rollouts[i].ProjectID < rollouts[j].ProjectID
+		}
+		return rollouts[i].Name < rollouts[j].Name
+	})
+	return rollouts, nil
+}
+
+func (p *ApplicationRolloutProvider) GetUnsecured(ctx context.Context, projectID, name string) (*apiv2.ApplicationRollout, error) {
+	secret, err := p.get(ctx, projectID, name)
+	if err != nil {
+		return nil, err
+	}
+	return convertApplicationRolloutSecret(secret)
+}
+
+func (p *ApplicationRolloutProvider) CreateUnsecured(ctx context.Context, project *kubermaticv1.Project, rollout *apiv2.ApplicationRollout) (*apiv2.ApplicationRollout, error) {
+	spec, err := json.Marshal(rollout.Spec)
+	if err != nil {
+		return nil, err
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      applicationRolloutSecretName(project.Name, rollout.Name),
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: project.Name,
+				applicationRolloutLabel:        "true",
+			},
+			Annotations: map[string]string{
+				applicationRolloutLabel: rollout.Name,
+			},
+			// the garbage collector deletes the rollouts of deleted projects
+			OwnerReferences: []metav1.OwnerReference{
+				{
+					APIVersion: kubermaticv1.SchemeGroupVersion.String(),
+					Kind:       kubermaticv1.ProjectKindName,
+					UID:        project.GetUID(),
+					Name:       project.Name,
+				},
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			applicationRolloutSpecKey:       spec,
+			applicationRolloutGenerationKey: []byte("1"),
+		},
+	}
+	if err := p.clientPrivileged.Create(ctx, secret); err != nil {
+		return nil, err
+	}
+	return convertApplicationRolloutSecret(secret)
+}
+
+func (p *ApplicationRolloutProvider) UpdateUnsecured(ctx context.Context, rollout *apiv2.ApplicationRollout) (*apiv2.ApplicationRollout, error) {
+	secret, err := p.get(ctx, rollout.ProjectID, rollout.Name)
+	if err != nil {
+		return nil, err
+	}
+	current, err := convertApplicationRolloutSecret(secret)
+	if err != nil {
+		return nil, err
+	}
+	if equality.Semantic.DeepEqual(current.Spec, rollout.Spec) {
+		return current, nil
+	}
+
+	spec, err := json.Marshal(rollout.Spec)
+	if err != nil {
+		return nil, err
+	}
+	oldSecret := secret.DeepCopy()
+	secret.Data[applicationRolloutSpecKey] = spec
+	secret.Data[applicationRolloutGenerationKey] = []byte(strconv.FormatInt(current.Generation+1, 10))
+	if err := p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+		return nil, err
+	}
+	return convertApplicationRolloutSecret(secret)
+}
+
+func (p *ApplicationRolloutProvider) UpdateStatusUnsecured(ctx context.Context, rollout *apiv2.ApplicationRollout) error {
+	secret, err := p.get(ctx, rollout.ProjectID, rollout.Name)
+	if err != nil {
+		return err
+	}
+	if secret.ResourceVersion != rollout.ResourceVersion {
+		return apierrors.NewConflict(applicationRolloutResource, rollout.Name, fmt.Errorf("the rollout was changed since it was read"))
+	}
+
+	status, err := json.Marshal(rollout.Status)
+	if err != nil {
+		return err
+	}
+	oldSecret := secret.DeepCopy()
+	secret.Data[applicationRolloutStatusKey] = status
+	if err := p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFromWithOptions(oldSecret, ctrlruntimeclient.MergeFromWithOptimisticLock{})); err != nil {
+		return err
+	}
+	rollout.ResourceVersion = secret.ResourceVersion
+	return nil
+}
+
+func (p *ApplicationRolloutProvider) DeleteUnsecured(ctx context.Context, projectID, name string) error {
+	secret, err := p.get(ctx, projectID, name)
+	if err != nil {
+		return err
+	}
+	return p.clientPrivileged.Delete(ctx, secret)
+}
+
+func (p *ApplicationRolloutProvider) get(ctx context.Context, projectID, name string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := p.clientPrivileged.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: applicationRolloutSecretName(projectID, name)}, secret); err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, apierrors.NewNotFound(applicationRolloutResource, name)
+		}
+		return nil, err
+	}
+	// the secret name does not separate the project ID from the rollout name unambiguously
+	if secret.Labels[applicationRolloutLabel] != "true" || secret.Labels[kubermaticv1.ProjectIDLabelKey] != projectID || secret.Annotations[applicationRolloutLabel] != name {
+		return nil, apierrors.NewNotFound(applicationRolloutResource, name)
+	}
+	if secret.Data == nil {
+		secret.Data = map[string][]byte{}
+	}
+	return secret, nil
+}
+
+var applicationRolloutResource = schema.GroupResource{Resource: "applicationrollout"}
+
+func applicationRolloutSecretName(projectID, name string) string {
+	return applicationRolloutPrefix + projectID + "-" + name
+}
+
+func convertApplicationRolloutSecret(secret *corev1.Secret) (*apiv2.ApplicationRollout, error) {
+	rollout := &apiv2.ApplicationRollout{
+		Name:              secret.Annotations[applicationRolloutLabel],
+		ProjectID:         secret.Labels[kubermaticv1.ProjectIDLabelKey],
+		CreationTimestamp: apiv1.NewTime(secret.CreationTimestamp.Time),
+		ResourceVersion:   secret.ResourceVersion,
+	}
+	if err := json.Unmarshal(secret.Data[applicationRolloutSpecKey], &rollout.Spec); err != nil {
+		return nil, fmt.Errorf("failed to decode the spec of application rollout %s: %w", rollout.Name, err)
+	}
+	if status, ok := secret.Data[applicationRolloutStatusKey]; ok {
+		if err := json.Unmarshal(status, &rollout.Status); err != nil {
+			return nil, fmt.Errorf("failed to decode the status of application rollout %s: %w", rollout.Name, err)
+		}
+	}
+	generation, err := strconv.ParseInt(string(secret.Data[applicationRolloutGenerationKey]), 10, 64)
+	if err != nil {
+		return nil, fmt.Errorf("failed to decode the generation of application rollout %s: %w", rollout.Name, err)
+	}
+	rollout.Generation = generation
+	return rollout, nil
+}
@@ -0,0 +1,102 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+
+	"github.com/stretchr/testify/require"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+)
+
+func TestApplicationRolloutProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	target := kubernetes.NewApplicationRolloutProvider(client)
+
+	project := &kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "abc", UID: "abc-uid"}}
+	rollout := &apiv2.ApplicationRollout{
+		Name:      "ingress",
+		ProjectID: "abc",
+		Spec: apiv2.ApplicationRolloutSpec{
+			Namespace:      "ingress",
+			ApplicationRef: apiv1.ApplicationRef{Name: "ingress-nginx", Version: "4.10.0"},
+		},
+	}
+	created, err := target.CreateUnsecured(ctx, project, rollout)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), created.Generation)
+	require.Equal(t, "abc", created.ProjectID)
+
+	// the rollout is garbage collected with the project
+	secret := &corev1.Secret{}
+	require.NoError(t, client.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: "application-rollout-abc-ingress"}, secret))
+	require.Len(t, secret.OwnerReferences, 1)
+	require.Equal(t, kubermaticv1.ProjectKindName, secret.OwnerReferences[0].Kind)
+	require.Equal(t, project.UID, secret.OwnerReferences[0].UID)
+
+	// rollouts of other projects are not visible
+	_, err = target.CreateUnsecured(ctx, &kubermaticv1.Project{ObjectMeta: metav1.ObjectMeta{Name: "ab"}}, &apiv2.ApplicationRollout{Name: "c-ingress", Spec: rollout.Spec})
+	require.NoError(t, err)
+	_, err = target.GetUnsecured(ctx, "ab", "ingress")
+	require.True(t, apierrors.IsNotFound(err))
+	rollouts, err := target.ListUnsecured(ctx, "abc")
+	require.NoError(t, err)
+	require.Len(t, rollouts, 1)
+	rollouts, err = target.ListUnsecured(ctx, "")
+	require.NoError(t, err)
+	require.Len(t, rollouts, 2)
+
+	// only a change of the spec increments the generation
+	updated, err := target.UpdateUnsecured(ctx, rollout)
+	require.NoError(t, err)
+	require.Equal(t, int64(1), updated.Generation)
+	rollout.Spec.ApplicationRef.Version = "4.11.0"
+	updated, err = target.UpdateUnsecured(ctx, rollout)
+	require.NoError(t, err)
+	require.Equal(t, int64(2), updated.Generation)
+
+	// the status of a rollout that changed since it was read is not overwritten
+	created.Status.Phase = apiv2.ApplicationRolloutProgressing
+	require.True(t, apierrors.IsConflict(target.UpdateStatusUnsecured(ctx, created)))
+	updated.Status.Phase = apiv2.ApplicationRolloutSucceeded
+	require.NoError(t, target.UpdateStatusUnsecured(ctx, updated))
+	require.NoError(t, target.UpdateStatusUnsecured(ctx, updated))
+
+	current, err := target.GetUnsecured(ctx, "abc", "ingress")
+	require.NoError(t, err)
+	require.Equal(t, "4.11.0", current.Spec.ApplicationRef.Version)
+	require.Equal(t, apiv2.ApplicationRolloutSucceeded, current.Status.Phase)
+
+	require.NoError(t, target.DeleteUnsecured(ctx, "abc", "ingress"))
+	_, err = target.GetUnsecured(ctx, "abc", "ingress")
+	require.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,520 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"errors"
+	"os"
+	"strconv"
+	"sync"
+	"sync/atomic"
+	"time"
+
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	backgroundOperationPrefix              = "dashboard-background-operation-"
+	backgroundOperationLabel               = "dashboard.k8c.io/background-operation"
+	backgroundOperationHolderAnnotation    = "dashboard.k8c.io/background-operation-holder"
+	backgroundOperationRenewTimeAnnotation = "dashboard.k8c.io/background-operation-renew-time"
+	// backgroundOperationRequestedAnnotation is set when the operation is started again while it is driven,
+	// the holder then runs it once more instead of unregistering it.
+	backgroundOperationRequestedAnnotation = "dashboard.k8c.io/background-operation-requested"
+	// backgroundOperationAttemptsAnnotation counts the failed runs of the operation in a row, it is not resumed
+	// before backgroundOperationNextAttemptAnnotation.
+	backgroundOperationAttemptsAnnotation    = "dashboard.k8c.io/background-operation-attempts"
+	backgroundOperationNextAttemptAnnotation = "dashboard.k8c.io/background-operation-next-attempt"
+	// backgroundOperationFailedAnnotation holds the error of an operation which has been given up. The operation
+	// is not resumed anymore, only its failure is reported until that succeeded.
+	backgroundOperationFailedAnnotation = "dashboard.k8c.io/background-operation-failed"
+	backgroundOperationKey              = "operation"
+
+	backgroundOperationLeaseDuration  = time.Minute
+	backgroundOperationRenewInterval  = 20 * time.Second
+	backgroundOperationResyncInterval = 30 * time.Second
+)
+
+var errBackgroundOperationLeaseLost = errors.New("the lease of the background operation was lost")
+
+// defaultBackgroundOperationBackoff resumes a failed operation four times, after 1m, 2m, 4m and 8m, and gives it
+// up after the fifth failure. The operations are resumed by the resyncs, so the delays are rounded up to the
+// resync interval.
+var defaultBackgroundOperationBackoff = wait.Backoff{
+	Duration: time.Minute,
+	Factor:   2,
+	Steps:    5,
+	Cap:      30 * time.Minute,
+}
+
+// BackgroundOperationProvider registers the background operations as config maps in the Kubermatic namespace.
+// The config map of an operation doubles as its lease: the replica which holds it drives the operation and
+// renews it, all replicas take over operations whose lease expired, e.g. because the holder is gone.
+type BackgroundOperationProvider struct {
+	// ctx bounds all runs, they outlive the requests that start them.
+	ctx              context.Context
+	clientPrivileged ctrlruntimeclient.Client
+	// apiReader reads the leases, they must not be taken based on a stale cache.
+	apiReader ctrlruntimeclient.Reader
+	identity  string
+	log       *zap.SugaredLogger
+	backoff   wait.Backoff
+
+	// lock serializes taking and releasing leases with the bookkeeping of the operations this replica drives.
+	lock            sync.Mutex
+	running         sets.Set[string]
+	resumers        map[string]provider.BackgroundOperationResumer
+	failureHandlers map[string]provider.BackgroundOperationFailureHandler
+}
+
+var _ provider.BackgroundOperationProvider = &BackgroundOperationProvider{}
+
+// NewBackgroundOperationProvider returns a background operation provider whose runs are cancelled once the
+// given context is done.
+func NewBackgroundOperationProvider(ctx context.Context, client ctrlruntimeclient.Client, apiReader ctrlruntimeclient.Reader, log *zap.SugaredLogger) *BackgroundOperationProvider {
+	hostname, _ := os.Hostname()
+
+	return &BackgroundOperationProvider{
+		ctx:              ctx,
+		clientPrivileged: client,
+		apiReader:        apiReader,
+		identity:         hostname + "-" + rand.String(5),
+		log:              log,
+		backoff:          defaultBackgroundOperationBackoff,
+		running:          sets.New[string](),
+		resumers:         map[string]provider.BackgroundOperationResumer{},
+		failureHandlers:  map[string]provider.BackgroundOperationFailureHandler{},
+	}
+}
+
+// RegisterResumer sets the resumer for the operations of the given kind. Resumers must be registered before Run.
+func (p *BackgroundOperationProvider) RegisterResumer(kind string, resumer provider.BackgroundOperationResumer) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.resumers[kind] = resumer
+}
+
+// RegisterFailureHandler sets the handler which reports the failure of the given kind of operations once they are
+// given up. Handlers must be registered before Run, operations without handler are given up silently.
+func (p *BackgroundOperationProvider) RegisterFailureHandler(kind string, handler provider.BackgroundOperationFailureHandler) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	p.failureHandlers[kind] = handler
+}
+
+// Run resumes the registered operations which are not driven by any replica, right away and then periodically,
+// until the context of the provider is done.
+func (p *BackgroundOperationProvider) Run() {
+	wait.UntilWithContext(p.ctx, func(ctx context.Context) {
+		if err := p.resync(ctx); err != nil {
+			p.log.Warnw("Failed to resume background operations", zap.Error(err))
+		}
+	}, backgroundOperationResyncInterval)
+}
+
+func (p *BackgroundOperationProvider) Start(ctx context.Context, op provider.BackgroundOperation, run provider.BackgroundOperationRun) error {
+	data, err := json.Marshal(op)
+	if err != nil {
+		return err
+	}
+	name := backgroundOperationName(op)
+
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	acquired := false
+	err = retry.OnError(retry.DefaultRetry, func(err error) bool {
+		return apierrors.IsConflict(err) || apierrors.IsAlreadyExists(err)
+	}, func() error {
+		acquired = false
+
+		cm := &corev1.ConfigMap{}
+		err := p.apiReader.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: name}, cm)
+		if apierrors.IsNotFound(err) {
+			cm = &corev1.ConfigMap{
+				ObjectMeta: metav1.ObjectMeta{
+					Name:      name,
+					Namespace: resources.KubermaticNamespace,
+					Labels: map[string]string{
+						backgroundOperationLabel: "true",
+					},
+					Annotations: map[string]string{
+						backgroundOperationHolderAnnotation:    p.identity,
+						backgroundOperationRenewTimeAnnotation: time.Now().UTC().Format(time.RFC3339),
+					},
+				},
+				Data: map[string]string{
+					backgroundOperationKey: string(data),
+				},
+			}
+			if err := p.clientPrivileged.Create(ctx, cm); err != nil {
+				return err
+			}
+			acquired = true
+			return nil
+		}
+		if err != nil {
+			return err
+		}
+
+		if p.leaseFree(cm, time.Now()) {
+			// starting the operation again gives it a fresh set of attempts
+			resetBackgroundOperationAttempts(cm)
+			if err := p.takeLease(ctx, cm); err != nil {
+				return err
+			}
+			acquired = true
+			return nil
+		}
+
+		cm.Annotations[backgroundOperationRequestedAnnotation] = "true"
+		return p.clientPrivileged.Update(ctx, cm)
+	})
+	if err != nil {
+		return err
+	}
+
+	if acquired {
+		p.running.Insert(name)
+		go p.drive(name, op, run)
+	}
+
+	return nil
+}
+
+// resync takes the leases of the operations nobody drives and resumes them.
+func (p *BackgroundOperationProvider) resync(ctx context.Context) error {
+	configMaps := &corev1.ConfigMapList{}
+	if err := p.apiReader.List(ctx, configMaps,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{backgroundOperationLabel: "true"},
+	); err != nil {
+		return err
+	}
+
+	for i := range configMaps.Items {
+		cm := &configMaps.Items[i]
+
+		op := provider.BackgroundOperation{}
+		if err := json.Unmarshal([]byte(cm.Data[backgroundOperationKey]), &op); err != nil {
+			p.log.Warnw("Failed to decode background operation", "configmap", cm.Name, zap.Error(err))
+			continue
+		}
+		log := p.log.With("kind", op.Kind, "cluster", op.Cluster, "name", op.Name)
+		failure := cm.Annotations[backgroundOperationFailedAnnotation]
+
+		resumer, failureHandler, acquired, err := p.acquire(ctx, cm, op.Kind)
+		if err != nil {
+			log.Warnw("Failed to take over background operation", zap.Error(err))
+			continue
+		}
+		if !acquired {
+			continue
+		}
+
+		if failure != "" {
+			log.Info("Reporting the failure of background operation")
+			go p.drive(cm.Name, op, backgroundOperationFailureRun(failureHandler, op, failure))
+			continue
+		}
+
+		run, err := resumer(p.ctx, op)
+		if err != nil {
+			log.Warnw("Failed to resume background operation", zap.Error(err))
+			run = func(context.Context) error { return err }
+		}
+		if run == nil {
+			run = func(context.Context) error { return nil }
+		}
+
+		log.Info("Resuming background operation")
+		go p.drive(cm.Name, op, run)
+	}
+
+	return nil
+}
+
+// acquire takes the lease of the operation if it is free, its next attempt is due and this replica knows how to
+// resume the operation.
+func (p *BackgroundOperationProvider) acquire(ctx context.Context, cm *corev1.ConfigMap, kind string) (provider.BackgroundOperationResumer, provider.BackgroundOperationFailureHandler, bool, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	resumer, ok := p.resumers[kind]
+	if !ok || !p.leaseFree(cm, now) || !backgroundOperationDue(cm, now) {
+		return nil, nil, false, nil
+	}
+
+	if err := p.takeLease(ctx, cm); err != nil {
+		if apierrors.IsConflict(err) || apierrors.IsNotFound(err) {
+			// another replica took it or it is done
+			return nil, nil, false, nil
+		}
+		return nil, nil, false, err
+	}
+	p.running.Insert(cm.Name)
+
+	return resumer, p.failureHandlers[kind], true, nil
+}
+
+// backgroundOperationDue returns true if the backoff of a failed operation is over.
+func backgroundOperationDue(cm *corev1.ConfigMap, now time.Time) bool {
+	nextAttempt, err := time.Parse(time.RFC3339, cm.Annotations[backgroundOperationNextAttemptAnnotation])
+	return err != nil || !now.Before(nextAttempt)
+}
+
+// resetBackgroundOperationAttempts forgets the failed runs of the operation.
+func resetBackgroundOperationAttempts(cm *corev1.ConfigMap) {
+	delete(cm.Annotations, backgroundOperationAttemptsAnnotation)
+	delete(cm.Annotations, backgroundOperationNextAttemptAnnotation)
+	delete(cm.Annotations, backgroundOperationFailedAnnotation)
+}
+
+// leaseFree returns true if no replica drives the operation. The caller must hold the lock.
+func (p *BackgroundOperationProvider) leaseFree(cm *corev1.ConfigMap, now time.Time) bool {
+	switch holder := cm.Annotations[backgroundOperationHolderAnnotation]; holder {
+	case "":
+		return true
+	case p.identity:
+		return !p.running.Has(cm.Name)
+	}
+
+	renewTime, err := time.Parse(time.RFC3339, cm.Annotations[backgroundOperationRenewTimeAnnotation])
+	if err != nil {
+		return true
+	}
+	return now.Sub(renewTime) > backgroundOperationLeaseDuration
+}
+
+// takeLease makes this replica the holder. It fails with a conflict if the config map changed since it was read.
+func (p *BackgroundOperationProvider) takeLease(ctx context.Context, cm *corev1.ConfigMap) error {
+	if cm.Annotations == nil {
+		cm.Annotations = map[string]string{}
+	}
+	cm.Annotations[backgroundOperationHolderAnnotation] = p.identity
+	cm.Annotations[backgroundOperationRenewTimeAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	delete(cm.Annotations, backgroundOperationRequestedAnnotation)
+
+	return p.clientPrivileged.Update(ctx, cm)
+}
+
+// drive runs the operation until it is done, runs it again if it was started again meanwhile or reports its
+// failure if it is given up, and then unregisters it.
+func (p *BackgroundOperationProvider) drive(name string, op provider.BackgroundOperation, run provider.BackgroundOperationRun) {
+	log := p.log.With("kind", op.Kind, "cluster", op.Cluster, "name", op.Name)
+
+	for {
+		runErr := p.runWithLease(name, run)
+		if runErr != nil && p.ctx.Err() == nil {
+			log.Warnw("Background operation failed", zap.Error(runErr))
+		}
+
+		p.lock.Lock()
+		next, err := p.finish(name, op, run, runErr)
+		if next == nil {
+			p.running.Delete(name)
+		}
+		p.lock.Unlock()
+
+		if err != nil {
+			log.Warnw("Failed to release background operation", zap.Error(err))
+		}
+		if next == nil {
+			return
+		}
+		run = next
+	}
+}
+
+// runWithLease runs the operation while renewing its lease. The run is cancelled once the lease cannot be
+// renewed anymore, before another replica may take it over.
+func (p *BackgroundOperationProvider) runWithLease(name string, run provider.BackgroundOperationRun) error {
+	ctx, cancel := context.WithCancel(p.ctx)
+	defer cancel()
+
+	var lost atomic.Bool
+	go func() {
+		lastRenewal := time.Now()
+		ticker := time.NewTicker(backgroundOperationRenewInterval)
+		defer ticker.Stop()
+
+		for {
+			select {
+			case <-ctx.Done():
+				return
+			case <-ticker.C:
+			}
+
+			err := p.renew(ctx, name)
+			switch {
+			case err == nil:
+				lastRenewal = time.Now()
+			case errors.Is(err, errBackgroundOperationLeaseLost),
+				time.Since(lastRenewal) >= backgroundOperationLeaseDuration-backgroundOperationRenewInterval:
+				lost.Store(true)
+				cancel()
+				return
+			}
+		}
+	}()
+
+	err := run(ctx)
+	if lost.Load() {
+		return errBackgroundOperationLeaseLost
+	}
+	return err
+}
+
+func (p *BackgroundOperationProvider) renew(ctx context.Context, name string) error {
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		cm := &corev1.ConfigMap{}
+		if err := p.apiReader.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: name}, cm); err != nil {
+			if apierrors.IsNotFound(err) {
+				return errBackgroundOperationLeaseLost
+			}
+			return err
+		}
+		if cm.Annotations[backgroundOperationHolderAnnotation] != p.identity {
+			return errBackgroundOperationLeaseLost
+		}
+
+		cm.Annotations[backgroundOperationRenewTimeAnnotation] = time.Now().UTC().Format(time.RFC3339)
+		return p.clientPrivileged.Update(ctx, cm)
+	})
+}
+
+// finish unregisters the operation after its run returned. It returns the run to drive the operation with next,
+// if any. Failed operations stay registered without holder, so that they are resumed by a resync once their
+// backoff is over. Operations which failed too often are given up, the returned run reports their failure.
+// The caller must hold the lock.
+func (p *BackgroundOperationProvider) finish(name string, op provider.BackgroundOperation, run provider.BackgroundOperationRun, runErr error) (provider.BackgroundOperationRun, error) {
+	if p.ctx.Err() != nil || errors.Is(runErr, errBackgroundOperationLeaseLost) {
+		// the lease expires and another replica resumes the operation
+		return nil, nil
+	}
+
+	// the context of the provider is still alive, the operation is released even if the run was cancelled
+	ctx := p.ctx
+	var next provider.BackgroundOperationRun
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		next = nil
+
+		cm := &corev1.ConfigMap{}
+		if err := p.apiReader.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: name}, cm); err != nil {
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+		if cm.Annotations[backgroundOperationHolderAnnotation] != p.identity {
+			return nil
+		}
+
+		now := time.Now()
+		failed := cm.Annotations[backgroundOperationFailedAnnotation] != ""
+		requested := cm.Annotations[backgroundOperationRequestedAnnotation] != ""
+
+		switch {
+		case runErr != nil:
+			attempts, _ := strconv.Atoi(cm.Annotations[backgroundOperationAttemptsAnnotation])
+			attempts++
+
+			if !failed && attempts >= p.backoff.Steps {
+				resetBackgroundOperationAttempts(cm)
+				cm.Annotations[backgroundOperationFailedAnnotation] = runErr.Error()
+				cm.Annotations[backgroundOperationRenewTimeAnnotation] = now.UTC().Format(time.RFC3339)
+				if err := p.clientPrivileged.Update(ctx, cm); err != nil {
+					return err
+				}
+				p.log.Warnw("Giving up background operation", "kind", op.Kind, "cluster", op.Cluster, "name", op.Name, "attempts", attempts)
+				next = backgroundOperationFailureRun(p.failureHandlers[op.Kind], op, runErr.Error())
+				return nil
+			}
+
+			cm.Annotations[backgroundOperationAttemptsAnnotation] = strconv.Itoa(attempts)
+			cm.Annotations[backgroundOperationNextAttemptAnnotation] = now.Add(p.retryDelay(attempts)).UTC().Format(time.RFC3339)
+			delete(cm.Annotations, backgroundOperationHolderAnnotation)
+			return p.clientPrivileged.Update(ctx, cm)
+		case failed && requested:
+			// the operation was started again while its failure was reported, the next resync resumes it
+			resetBackgroundOperationAttempts(cm)
+			delete(cm.Annotations, backgroundOperationRequestedAnnotation)
+			delete(cm.Annotations, backgroundOperationHolderAnnotation)
+			return p.clientPrivileged.Update(ctx, cm)
+		case requested:
+			resetBackgroundOperationAttempts(cm)
+			delete(cm.Annotations, backgroundOperationRequestedAnnotation)
+			cm.Annotations[backgroundOperationRenewTimeAnnotation] = now.UTC().Format(time.RFC3339)
+			if err := p.clientPrivileged.Update(ctx, cm); err != nil {
+				return err
+			}
+			next = run
+			return nil
+		default:
+			err := p.clientPrivileged.Delete(ctx, cm, ctrlruntimeclient.Preconditions{ResourceVersion: &cm.ResourceVersion})
+			return ctrlruntimeclient.IgnoreNotFound(err)
+		}
+	})
+
+	return next, err
+}
+
+// retryDelay returns how long to wait after the given number of failed runs in a row.
+func (p *BackgroundOperationProvider) retryDelay(attempts int) time.Duration {
+	delay := p.backoff.Duration
+	for i := 1; i < attempts; i++ {
+		delay = time.Duration(float64(delay) * p.backoff.Factor)
+		if p.backoff.Cap > 0 && delay > p.backoff.Cap {
+			return p.backoff.Cap
+		}
+	}
+	return delay
+}
+
+// backgroundOperationFailureRun reports the failure of an operation which has been given up.
+func backgroundOperationFailureRun(handler provider.BackgroundOperationFailureHandler, op provider.BackgroundOperation, failure string) provider.BackgroundOperationRun {
+	return func(ctx context.Context) error {
+		if handler == nil {
+			return nil
+		}
+		return handler(ctx, op, errors.New(failure))
+	}
+}
+
+// backgroundOperationName returns the name of the config map of the operation, operations are identified by their
+// kind, cluster and name.
+func backgroundOperationName(op provider.BackgroundOperation) string {
+	sum := sha256.Sum256([]byte(op.Kind + "/" + op.Cluster + "/" + op.Name))
+	return backgroundOperationPrefix + hex.EncodeToString(sum[:])[:16]
+}
@@ -0,0 +1,186 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+	"go.uber.org/zap"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+)
+
+func TestBackgroundOperationProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	log := zap.NewNop().Sugar()
+	op := provider.BackgroundOperation{Kind: "test", Cluster: "my-cluster", Name: "my-operation"}
+
+	registered := func() []corev1.ConfigMap {
+		configMaps := &corev1.ConfigMapList{}
+		require.NoError(t, client.List(ctx, configMaps))
+		return configMaps.Items
+	}
+
+	// the first replica drives the operation, the second one only asks it to run once more
+	firstCtx, stopFirst := context.WithCancel(ctx)
+	defer stopFirst()
+	first := kubernetes.NewBackgroundOperationProvider(firstCtx, client, client, log)
+	secondCtx, stopSecond := context.WithCancel(ctx)
+	defer stopSecond()
+	second := kubernetes.NewBackgroundOperationProvider(secondCtx, client, client, log)
+
+	var runs atomic.Int32
+	release := make(chan struct{})
+	run := func(ctx context.Context) error {
+		runs.Add(1)
+		select {
+		case <-release:
+		case <-ctx.Done():
+			return ctx.Err()
+		}
+		return nil
+	}
+
+	require.NoError(t, first.Start(ctx, op, run))
+	require.Eventually(t, func() bool { return runs.Load() == 1 }, 5*time.Second, 10*time.Millisecond)
+	require.NoError(t, second.Start(ctx, op, func(context.Context) error {
+		t.Error("the operation must not be driven by two replicas")
+		return nil
+	}))
+	require.Len(t, registered(), 1)
+
+	release <- struct{}{}
+	require.Eventually(t, func() bool { return runs.Load() == 2 }, 5*time.Second, 10*time.Millisecond)
+	close(release)
+	require.Eventually(t, func() bool { return len(registered()) == 0 }, 5*time.Second, 10*time.Millisecond)
+
+	// an operation whose replica is gone is resumed by another replica
+	require.NoError(t, first.Start(ctx, op, func(ctx context.Context) error {
+		<-ctx.Done()
+		return ctx.Err()
+	}))
+	stopFirst()
+
+	require.Eventually(t, func() bool {
+		configMaps := registered()
+		if len(configMaps) != 1 {
+			return false
+		}
+		cm := &configMaps[0]
+		cm.Annotations["dashboard.k8c.io/background-operation-renew-time"] = time.Now().Add(-time.Hour).UTC().Format(time.RFC3339)
+		return client.Update(ctx, cm) == nil
+	}, 5*time.Second, 10*time.Millisecond)
+
+	resumed := make(chan provider.BackgroundOperation, 1)
+	second.RegisterResumer("test", func(_ context.Context, op provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+		resumed <- op
+		return nil, nil
+	})
+	go second.Run()
+
+	select {
+	case got := <-resumed:
+		require.Equal(t, op, got)
+	case <-time.After(5 * time.Second):
+		t.Fatal("the operation was not resumed")
+	}
+	require.Eventually(t, func() bool { return len(registered()) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
+
+func TestBackgroundOperationProviderFailure(t *testing.T) {
+	t.Parallel()
+
+	ctx, cancel := context.WithCancel(context.Background())
+	defer cancel()
+	client := fake.NewClientBuilder().Build()
+	log := zap.NewNop().Sugar()
+	op := provider.BackgroundOperation{Kind: "test", Cluster: "my-cluster", Name: "my-operation"}
+
+	registered := func() []corev1.ConfigMap {
+		configMaps := &corev1.ConfigMapList{}
+		require.NoError(t, client.List(ctx, configMaps))
+		return configMaps.Items
+	}
+
+	resumed := make(chan struct{}, 10)
+	failed := make(chan error, 1)
+	newProvider := func() *kubernetes.BackgroundOperationProvider {
+		p := kubernetes.NewBackgroundOperationProvider(ctx, client, client, log)
+		p.RegisterResumer("test", func(context.Context, provider.BackgroundOperation) (provider.BackgroundOperationRun, error) {
+			resumed <- struct{}{}
+			return func(context.Context) error { return errors.New("still broken") }, nil
+		})
+		p.RegisterFailureHandler("test", func(_ context.Context, got provider.BackgroundOperation, err error) error {
+			require.Equal(t, op, got)
+			failed <- err
+			return nil
+		})
+		return p
+	}
+
+	// a failed operation is released and resumed after a backoff
+	first := newProvider()
+	require.NoError(t, first.Start(ctx, op, func(context.Context) error { return errors.New("broken") }))
+
+	var cm corev1.ConfigMap
+	require.Eventually(t, func() bool {
+		configMaps := registered()
+		if len(configMaps) != 1 {
+			return false
+		}
+		cm = configMaps[0]
+		return cm.Annotations["dashboard.k8c.io/background-operation-attempts"] == "1"
+	}, 5*time.Second, 10*time.Millisecond)
+	require.Empty(t, cm.Annotations["dashboard.k8c.io/background-operation-holder"])
+	nextAttempt, err := time.Parse(time.RFC3339, cm.Annotations["dashboard.k8c.io/background-operation-next-attempt"])
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now().Add(time.Minute), nextAttempt, 5*time.Second)
+
+	go first.Run()
+	select {
+	case <-resumed:
+		t.Fatal("the operation was resumed before its backoff was over")
+	case <-time.After(200 * time.Millisecond):
+	}
+
+	// the operation is given up after its last attempt failed, its failure is reported and it is dropped
+	cm.Annotations["dashboard.k8c.io/background-operation-attempts"] = "4"
+	cm.Annotations["dashboard.k8c.io/background-operation-next-attempt"] = time.Now().Add(-time.Second).UTC().Format(time.RFC3339)
+	require.NoError(t, client.Update(ctx, &cm))
+	go newProvider().Run()
+
+	select {
+	case err := <-failed:
+		require.EqualError(t, err, "still broken")
+	case <-time.After(5 * time.Second):
+		t.Fatal("the failure of the operation was not reported")
+	}
+	require.Len(t, resumed, 1)
+	require.Eventually(t, func() bool { return len(registered()) == 0 }, 5*time.Second, 10*time.Millisecond)
+}
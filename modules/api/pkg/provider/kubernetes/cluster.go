@@ -455,6 +455,14 @@ func (p *ClusterProvider) GetClientForUserCluster(ctx context.Context, userInfo
 	return p.userClusterConnProvider.GetClient(ctx, c, p.withImpersonation(userInfo))
 }
 
+// GetK8sClientForUserCluster returns a k8s go client to interact with all resources in the given cluster
+//
+// Note that the client doesn't use admin account instead it authn/authz as userInfo(email, group)
+// This implies that you have to make sure the user has the appropriate permissions inside the user cluster.
+func (p *ClusterProvider) GetK8sClientForUserCluster(ctx context.Context, userInfo *provider.UserInfo, c *kubermaticv1.Cluster) (kubernetes.Interface, error) {
+	return p.userClusterConnProvider.GetK8sClient(ctx, c, p.withImpersonation(userInfo))
+}
+
 func (p *ClusterProvider) GetTokenForUserCluster(ctx context.Context, userInfo *provider.UserInfo, cluster *kubermaticv1.Cluster) (string, error) {
 	if userInfo.Roles.Has("viewers") && userInfo.Roles.Len() == 1 {
 		s := &corev1.Secret{}
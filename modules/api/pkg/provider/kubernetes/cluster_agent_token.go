@@ -0,0 +1,225 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/rand"
+	"crypto/sha256"
+	"crypto/subtle"
+	"encoding/hex"
+	"errors"
+	"sort"
+	"strings"
+	"time"
+
+	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
+	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/clusteragent"
+	"k8c.io/dashboard/v2/pkg/provider"
+	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	utilrand "k8s.io/apimachinery/pkg/util/rand"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	clusterAgentTokenPrefix           = "cluster-agent-token-"
+	clusterAgentTokenLabel            = "dashboard.k8c.io/cluster-agent-token"
+	clusterAgentTokenNameAnnotation   = "dashboard.k8c.io/cluster-agent-token-name"
+	clusterAgentTokenExpiryAnnotation = "dashboard.k8c.io/cluster-agent-token-expiry"
+	// clusterAgentTokenAgentAnnotation holds the ID of the agent that used the token first.
+	clusterAgentTokenAgentAnnotation = "dashboard.k8c.io/cluster-agent-id"
+	clusterAgentTokenHashKey         = "hash"
+)
+
+// ClusterAgentTokenProvider manages the registration tokens of cluster agents. The tokens are
+// stored as secrets in the Kubermatic namespace, only a hash of the token value is kept.
+type ClusterAgentTokenProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+}
+
+var _ provider.ClusterAgentTokenProvider = &ClusterAgentTokenProvider{}
+
+// NewClusterAgentTokenProvider returns a cluster agent token provider.
+func NewClusterAgentTokenProvider(client ctrlruntimeclient.Client) *ClusterAgentTokenProvider {
+	return &ClusterAgentTokenProvider{
+		clientPrivileged: client,
+	}
+}
+
+func (p *ClusterAgentTokenProvider) CreateUnsecured(ctx context.Context, projectID, name string, expiry *time.Time) (*apiv2.ClusterAgentToken, error) {
+	id := utilrand.String(10)
+	value := make([]byte, 32)
+	if _, err := rand.Read(value); err != nil {
+		return nil, err
+	}
+	secretValue := hex.EncodeToString(value)
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      clusterAgentTokenPrefix + id,
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				kubermaticv1.ProjectIDLabelKey: projectID,
+				clusterAgentTokenLabel:         "true",
+			},
+			Annotations: map[string]string{
+				clusterAgentTokenNameAnnotation: name,
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			clusterAgentTokenHashKey: []byte(hashClusterAgentToken(secretValue)),
+		},
+	}
+	if expiry != nil {
+		secret.Annotations[clusterAgentTokenExpiryAnnotation] = expiry.UTC().Format(time.RFC3339)
+	}
+
+	if err := p.clientPrivileged.Create(ctx, secret); err != nil {
+		return nil, err
+	}
+
+	token := convertClusterAgentToken(secret)
+	token.Token = id + "." + secretValue
+	return &token, nil
+}
+
+func (p *ClusterAgentTokenProvider) ListUnsecured(ctx context.Context, projectID string) ([]apiv2.ClusterAgentToken, error) {
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{kubermaticv1.ProjectIDLabelKey: projectID, clusterAgentTokenLabel: "true"},
+	); err != nil {
+		return nil, err
+	}
+
+	tokens := make([]apiv2.ClusterAgentToken, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		tokens = append(tokens, convertClusterAgentToken(&secrets.Items[i]))
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreationTimestamp.Before(tokens[j].CreationTimestamp)
+	})
+	return tokens, nil
+}
+
+func (p *ClusterAgentTokenProvider) DeleteUnsecured(ctx context.Context, projectID, tokenID string) error {
+	secret, err := p.get(ctx, tokenID)
+	if err != nil {
+		return err
+	}
+	if secret.Labels[kubermaticv1.ProjectIDLabelKey] != projectID {
+		return apierrors.NewNotFound(corev1.Resource("secret"), tokenID)
+	}
+	if err := p.clientPrivileged.Delete(ctx, secret); err != nil {
+		return err
+	}
+
+	// agents connected to other API replicas are disconnected once they revalidate their token
+	clusters := &kubermaticv1.ExternalClusterList{}
+	if err := p.clientPrivileged.List(ctx, clusters, ctrlruntimeclient.MatchingLabels{clusteragent.AgentTokenLabel: tokenID}); err != nil {
+		return err
+	}
+	for _, cluster := range clusters.Items {
+		clusteragent.Disconnect(cluster.Name)
+	}
+	return nil
+}
+
+func (p *ClusterAgentTokenProvider) Authenticate(ctx context.Context, token, agentID string) (string, string, error) {
+	unauthorized := apierrors.NewUnauthorized("invalid cluster agent token")
+
+	id, secretValue, ok := strings.Cut(token, ".")
+	if !ok || id == "" || secretValue == "" {
+		return "", "", unauthorized
+	}
+
+	var projectID string
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := p.get(ctx, id)
+		if err != nil {
+			if apierrors.IsNotFound(err) {
+				return unauthorized
+			}
+			return err
+		}
+
+		hash := []byte(hashClusterAgentToken(secretValue))
+		if subtle.ConstantTimeCompare(hash, secret.Data[clusterAgentTokenHashKey]) != 1 {
+			return unauthorized
+		}
+		if expiry := convertClusterAgentToken(secret).Expiry; expiry != nil && !expiry.After(time.Now()) {
+			return apierrors.NewUnauthorized("cluster agent token has expired")
+		}
+		projectID = secret.Labels[kubermaticv1.ProjectIDLabelKey]
+
+		switch secret.Annotations[clusterAgentTokenAgentAnnotation] {
+		case agentID:
+			return nil
+		case "":
+			// the first agent that uses the token owns it
+			if secret.Annotations == nil {
+				secret.Annotations = map[string]string{}
+			}
+			secret.Annotations[clusterAgentTokenAgentAnnotation] = agentID
+			return p.clientPrivileged.Update(ctx, secret)
+		default:
+			return apierrors.NewForbidden(corev1.Resource("secret"), id, errors.New("the cluster agent token is used by another cluster"))
+		}
+	})
+	if err != nil {
+		return "", "", err
+	}
+
+	return projectID, id, nil
+}
+
+func (p *ClusterAgentTokenProvider) get(ctx context.Context, tokenID string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	if err := p.clientPrivileged.Get(ctx, types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: clusterAgentTokenPrefix + tokenID}, secret); err != nil {
+		return nil, err
+	}
+	if secret.Labels[clusterAgentTokenLabel] != "true" {
+		return nil, apierrors.NewNotFound(corev1.Resource("secret"), tokenID)
+	}
+	return secret, nil
+}
+
+func hashClusterAgentToken(value string) string {
+	sum := sha256.Sum256([]byte(value))
+	return hex.EncodeToString(sum[:])
+}
+
+func convertClusterAgentToken(secret *corev1.Secret) apiv2.ClusterAgentToken {
+	token := apiv2.ClusterAgentToken{
+		ID:                strings.TrimPrefix(secret.Name, clusterAgentTokenPrefix),
+		Name:              secret.Annotations[clusterAgentTokenNameAnnotation],
+		CreationTimestamp: apiv1.NewTime(secret.CreationTimestamp.Time),
+	}
+	if expiry, err := time.Parse(time.RFC3339, secret.Annotations[clusterAgentTokenExpiryAnnotation]); err == nil {
+		token.Expiry = &apiv1.Time{Time: expiry}
+	}
+	return token
+}
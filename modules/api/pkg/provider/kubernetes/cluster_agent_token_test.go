@@ -0,0 +1,81 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/utils/ptr"
+)
+
+func TestClusterAgentTokenProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	target := kubernetes.NewClusterAgentTokenProvider(fake.NewClientBuilder().Build())
+
+	token, err := target.CreateUnsecured(ctx, "project-a", "on-prem", nil)
+	require.NoError(t, err)
+	require.NotEmpty(t, token.Token)
+	require.Equal(t, "on-prem", token.Name)
+
+	projectID, tokenID, err := target.Authenticate(ctx, token.Token, "3f0a")
+	require.NoError(t, err)
+	require.Equal(t, "project-a", projectID)
+	require.Equal(t, token.ID, tokenID)
+
+	// the token is bound to the first agent
+	_, _, err = target.Authenticate(ctx, token.Token, "3f0a")
+	require.NoError(t, err)
+	_, _, err = target.Authenticate(ctx, token.Token, "9b21")
+	require.True(t, apierrors.IsForbidden(err), "expected another agent to be rejected, got %v", err)
+
+	// the token value is never returned again
+	tokens, err := target.ListUnsecured(ctx, "project-a")
+	require.NoError(t, err)
+	require.Len(t, tokens, 1)
+	require.Equal(t, token.ID, tokens[0].ID)
+	require.Empty(t, tokens[0].Token)
+
+	tokens, err = target.ListUnsecured(ctx, "project-b")
+	require.NoError(t, err)
+	require.Empty(t, tokens)
+
+	for _, invalid := range []string{"", token.ID, token.ID + ".invalid", "unknown." + token.Token} {
+		_, _, err = target.Authenticate(ctx, invalid, "3f0a")
+		require.True(t, apierrors.IsUnauthorized(err), "expected %q to be rejected, got %v", invalid, err)
+	}
+
+	expired, err := target.CreateUnsecured(ctx, "project-a", "expired", ptr.To(time.Now().Add(-time.Minute)))
+	require.NoError(t, err)
+	_, _, err = target.Authenticate(ctx, expired.Token, "3f0a")
+	require.ErrorContains(t, err, "expired")
+
+	// tokens can only be revoked through the project they belong to
+	require.True(t, apierrors.IsNotFound(target.DeleteUnsecured(ctx, "project-b", token.ID)))
+	require.NoError(t, target.DeleteUnsecured(ctx, "project-a", token.ID))
+	_, _, err = target.Authenticate(ctx, token.Token, "3f0a")
+	require.True(t, apierrors.IsUnauthorized(err))
+}
@@ -0,0 +1,265 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"strings"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/retry"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	deviceAuthorizationPrefix             = "dashboard-device-"
+	deviceAuthorizationLabel              = "dashboard.k8c.io/device-authorization"
+	deviceAuthorizationUserCodeLabel      = "dashboard.k8c.io/device-user-code"
+	deviceAuthorizationProjectAnnotation  = "dashboard.k8c.io/device-project"
+	deviceAuthorizationClusterAnnotation  = "dashboard.k8c.io/device-cluster"
+	deviceAuthorizationStatusAnnotation   = "dashboard.k8c.io/device-status"
+	deviceAuthorizationUserAnnotation     = "dashboard.k8c.io/device-user"
+	deviceAuthorizationLastPollAnnotation = "dashboard.k8c.io/device-last-poll"
+	deviceAuthorizationExpiryAnnotation   = "dashboard.k8c.io/device-expiry"
+	deviceAuthorizationIDTokenKey         = "id-token"
+	deviceAuthorizationRefreshTokenKey    = "refresh-token"
+)
+
+// DeviceAuthorizationProvider stores the device authorizations as secrets in the Kubermatic namespace.
+// The secrets are named after the authorization ID, a hash of the device code, so the device code
+// itself is never stored.
+type DeviceAuthorizationProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+	// apiReader reads authorizations which have not reached the cache of the client yet, the user
+	// enters the user code right after the device asked for it.
+	apiReader ctrlruntimeclient.Reader
+}
+
+var _ provider.DeviceAuthorizationProvider = &DeviceAuthorizationProvider{}
+
+// NewDeviceAuthorizationProvider returns a device authorization provider.
+func NewDeviceAuthorizationProvider(client ctrlruntimeclient.Client, apiReader ctrlruntimeclient.Reader) *DeviceAuthorizationProvider {
+	return &DeviceAuthorizationProvider{
+		clientPrivileged: client,
+		apiReader:        apiReader,
+	}
+}
+
+func (p *DeviceAuthorizationProvider) Create(ctx context.Context, deviceCode string, authorization *provider.DeviceAuthorization) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      deviceAuthorizationPrefix + provider.DeviceAuthorizationID(deviceCode),
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				deviceAuthorizationLabel:         "true",
+				deviceAuthorizationUserCodeLabel: authorization.UserCode,
+			},
+			Annotations: map[string]string{
+				deviceAuthorizationProjectAnnotation:  authorization.ProjectID,
+				deviceAuthorizationClusterAnnotation:  authorization.ClusterID,
+				deviceAuthorizationExpiryAnnotation:   authorization.Expiry.UTC().Format(time.RFC3339),
+				deviceAuthorizationLastPollAnnotation: authorization.LastPoll.UTC().Format(time.RFC3339),
+			},
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+	setDeviceAuthorizationSecret(secret, authorization)
+
+	return p.clientPrivileged.Create(ctx, secret)
+}
+
+func (p *DeviceAuthorizationProvider) Poll(ctx context.Context, deviceCode string) (*provider.DeviceAuthorization, error) {
+	secret, err := p.get(ctx, provider.DeviceAuthorizationID(deviceCode))
+	if err != nil {
+		return nil, err
+	}
+	authorization := convertDeviceAuthorizationSecret(secret)
+
+	// Only the poll time is patched, so a poll never overwrites the decision of the user.
+	oldSecret := secret.DeepCopy()
+	secret.Annotations[deviceAuthorizationLastPollAnnotation] = time.Now().UTC().Format(time.RFC3339)
+	if err := p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret)); err != nil {
+		return nil, err
+	}
+	return authorization, nil
+}
+
+func (p *DeviceAuthorizationProvider) GetByUserCode(ctx context.Context, userCode string) (*provider.DeviceAuthorization, error) {
+	secrets := &corev1.SecretList{}
+	listOpts := []ctrlruntimeclient.ListOption{
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{deviceAuthorizationLabel: "true", deviceAuthorizationUserCodeLabel: userCode},
+	}
+	if err := p.clientPrivileged.List(ctx, secrets, listOpts...); err != nil {
+		return nil, err
+	}
+	if len(secrets.Items) == 0 && p.apiReader != nil {
+		if err := p.apiReader.List(ctx, secrets, listOpts...); err != nil {
+			return nil, err
+		}
+	}
+
+	now := time.Now()
+	for i := range secrets.Items {
+		authorization := convertDeviceAuthorizationSecret(&secrets.Items[i])
+		if authorization.UserCode == userCode && authorization.Expiry.After(now) {
+			return authorization, nil
+		}
+	}
+	return nil, newDeviceAuthorizationNotFoundError(userCode)
+}
+
+func (p *DeviceAuthorizationProvider) Update(ctx context.Context, authorization *provider.DeviceAuthorization) error {
+	// The device keeps polling while the user decides, retry if a poll came in between.
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		secret, err := p.get(ctx, authorization.ID)
+		if err != nil {
+			return err
+		}
+		setDeviceAuthorizationSecret(secret, authorization)
+		return p.clientPrivileged.Update(ctx, secret)
+	})
+}
+
+func (p *DeviceAuthorizationProvider) Delete(ctx context.Context, id string) error {
+	secret, err := p.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	return p.clientPrivileged.Delete(ctx, secret)
+}
+
+// Count reads the authorizations from the cache of the client, so devices asking for codes do not
+// cause requests to the API server.
+func (p *DeviceAuthorizationProvider) Count(ctx context.Context) (int, error) {
+	secrets, err := p.list(ctx)
+	if err != nil {
+		return 0, err
+	}
+
+	count := 0
+	now := time.Now()
+	for i := range secrets.Items {
+		if convertDeviceAuthorizationSecret(&secrets.Items[i]).Expiry.After(now) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (p *DeviceAuthorizationProvider) DeleteExpired(ctx context.Context) error {
+	secrets, err := p.list(ctx)
+	if err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if convertDeviceAuthorizationSecret(secret).Expiry.After(now) {
+			continue
+		}
+		if err := p.clientPrivileged.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *DeviceAuthorizationProvider) list(ctx context.Context) (*corev1.SecretList, error) {
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{deviceAuthorizationLabel: "true"},
+	); err != nil {
+		return nil, err
+	}
+	return secrets, nil
+}
+
+// get returns the secret of the authorization with the given ID. Expired authorizations are not found.
+func (p *DeviceAuthorizationProvider) get(ctx context.Context, id string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: deviceAuthorizationPrefix + id}
+	err := p.clientPrivileged.Get(ctx, name, secret)
+	if apierrors.IsNotFound(err) && p.apiReader != nil {
+		err = p.apiReader.Get(ctx, name, secret)
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, newDeviceAuthorizationNotFoundError(id)
+		}
+		return nil, err
+	}
+	if secret.Labels[deviceAuthorizationLabel] != "true" || !convertDeviceAuthorizationSecret(secret).Expiry.After(time.Now()) {
+		return nil, newDeviceAuthorizationNotFoundError(id)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	return secret, nil
+}
+
+func newDeviceAuthorizationNotFoundError(name string) error {
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "deviceauthorization"}, name)
+}
+
+// setDeviceAuthorizationSecret stores the decision of the user in the secret.
+func setDeviceAuthorizationSecret(secret *corev1.Secret, authorization *provider.DeviceAuthorization) {
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	status := authorization.Status
+	if status == "" {
+		status = provider.DeviceAuthorizationPending
+	}
+	secret.Annotations[deviceAuthorizationStatusAnnotation] = string(status)
+	secret.Annotations[deviceAuthorizationUserAnnotation] = authorization.UserEmail
+
+	secret.Data = map[string][]byte{
+		deviceAuthorizationIDTokenKey:      []byte(authorization.IDToken),
+		deviceAuthorizationRefreshTokenKey: []byte(authorization.RefreshToken),
+	}
+}
+
+func convertDeviceAuthorizationSecret(secret *corev1.Secret) *provider.DeviceAuthorization {
+	authorization := &provider.DeviceAuthorization{
+		ID:           strings.TrimPrefix(secret.Name, deviceAuthorizationPrefix),
+		UserCode:     secret.Labels[deviceAuthorizationUserCodeLabel],
+		ProjectID:    secret.Annotations[deviceAuthorizationProjectAnnotation],
+		ClusterID:    secret.Annotations[deviceAuthorizationClusterAnnotation],
+		Status:       provider.DeviceAuthorizationStatus(secret.Annotations[deviceAuthorizationStatusAnnotation]),
+		UserEmail:    secret.Annotations[deviceAuthorizationUserAnnotation],
+		IDToken:      string(secret.Data[deviceAuthorizationIDTokenKey]),
+		RefreshToken: string(secret.Data[deviceAuthorizationRefreshTokenKey]),
+	}
+	if lastPoll, err := time.Parse(time.RFC3339, secret.Annotations[deviceAuthorizationLastPollAnnotation]); err == nil {
+		authorization.LastPoll = lastPoll
+	}
+	if expiry, err := time.Parse(time.RFC3339, secret.Annotations[deviceAuthorizationExpiryAnnotation]); err == nil {
+		authorization.Expiry = expiry
+	}
+	return authorization
+}
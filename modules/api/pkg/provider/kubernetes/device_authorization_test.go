@@ -0,0 +1,94 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestDeviceAuthorizationProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	target := kubernetes.NewDeviceAuthorizationProvider(client, client)
+
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, target.Create(ctx, "expired-device-code", &provider.DeviceAuthorization{UserCode: "XXXXXXXX", Expiry: now.Add(-time.Minute)}))
+	require.NoError(t, target.Create(ctx, "device-code", &provider.DeviceAuthorization{
+		UserCode:  "BCDFGHJK",
+		ProjectID: "my-project",
+		ClusterID: "my-cluster",
+		Expiry:    now.Add(10 * time.Minute),
+	}))
+
+	// expired authorizations are not counted until they are removed, the device code itself is not stored
+	count, err := target.Count(ctx)
+	require.NoError(t, err)
+	require.Equal(t, 1, count)
+	require.NoError(t, target.DeleteExpired(ctx))
+	secrets := &corev1.SecretList{}
+	require.NoError(t, client.List(ctx, secrets))
+	require.Len(t, secrets.Items, 1)
+	require.NotContains(t, secrets.Items[0].Name, "device-code")
+
+	authorization, err := target.GetByUserCode(ctx, "BCDFGHJK")
+	require.NoError(t, err)
+	require.Equal(t, provider.DeviceAuthorizationID("device-code"), authorization.ID)
+	require.Equal(t, provider.DeviceAuthorizationPending, authorization.Status)
+	require.Equal(t, "my-project", authorization.ProjectID)
+	require.Equal(t, "my-cluster", authorization.ClusterID)
+
+	_, err = target.GetByUserCode(ctx, "XXXXXXXX")
+	require.True(t, apierrors.IsNotFound(err))
+
+	// a poll returns the time of the previous poll
+	polled, err := target.Poll(ctx, "device-code")
+	require.NoError(t, err)
+	require.Equal(t, provider.DeviceAuthorizationPending, polled.Status)
+	polled, err = target.Poll(ctx, "device-code")
+	require.NoError(t, err)
+	require.WithinDuration(t, time.Now(), polled.LastPoll, 2*time.Second)
+
+	authorization.Status = provider.DeviceAuthorizationApproved
+	authorization.UserEmail = "john@acme.com"
+	authorization.IDToken = "id-token"
+	authorization.RefreshToken = "refresh-token"
+	require.NoError(t, target.Update(ctx, authorization))
+
+	polled, err = target.Poll(ctx, "device-code")
+	require.NoError(t, err)
+	require.Equal(t, provider.DeviceAuthorizationApproved, polled.Status)
+	require.Equal(t, "john@acme.com", polled.UserEmail)
+	require.Equal(t, "id-token", polled.IDToken)
+	require.Equal(t, "refresh-token", polled.RefreshToken)
+
+	require.NoError(t, target.Delete(ctx, polled.ID))
+	_, err = target.Poll(ctx, "device-code")
+	require.True(t, apierrors.IsNotFound(err))
+}
@@ -24,6 +24,7 @@ import (
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
+	"k8c.io/dashboard/v2/pkg/clusteragent"
 	"k8c.io/dashboard/v2/pkg/handler/v1/common"
 	"k8c.io/dashboard/v2/pkg/provider"
 	kubermaticv1 "k8c.io/kubermatic/sdk/v2/apis/kubermatic/v1"
@@ -212,19 +213,18 @@ func (p *ExternalClusterProvider) GenerateClient(cfg *clientcmdapi.Config) (ctrl
 }
 
 func (p *ExternalClusterProvider) GetClient(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (ctrlruntimeclient.Client, error) {
-	secretKeyGetter := provider.SecretKeySelectorValueFuncFactory(ctx, masterClient)
-	rawKubeconfig, err := secretKeyGetter(cluster.Spec.KubeconfigReference, resources.KubeconfigSecretKey)
+	clientConfig, err := p.GetRestConfig(ctx, masterClient, cluster)
 	if err != nil {
 		return nil, err
 	}
-	cfg, err := clientcmd.Load([]byte(rawKubeconfig))
-	if err != nil {
-		return nil, err
-	}
-	return p.GenerateClient(cfg)
+	return p.restMapperCache.Client(clientConfig)
 }
 
-func (p *ExternalClusterProvider) GetVersion(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*ksemver.Semver, error) {
+func (p *ExternalClusterProvider) GetRestConfig(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*rest.Config, error) {
+	if clusteragent.UsesAgent(cluster) {
+		return clusteragent.RestConfig(cluster.Name), nil
+	}
+
 	secretKeyGetter := provider.SecretKeySelectorValueFuncFactory(ctx, masterClient)
 	rawKubeconfig, err := secretKeyGetter(cluster.Spec.KubeconfigReference, resources.KubeconfigSecretKey)
 	if err != nil {
@@ -234,7 +234,11 @@ func (p *ExternalClusterProvider) GetVersion(ctx context.Context, masterClient c
 	if err != nil {
 		return nil, err
 	}
-	clientConfig, err := getRestConfig(cfg)
+	return getRestConfig(cfg)
+}
+
+func (p *ExternalClusterProvider) GetVersion(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*ksemver.Semver, error) {
+	clientConfig, err := p.GetRestConfig(ctx, masterClient, cluster)
 	if err != nil {
 		return nil, err
 	}
@@ -0,0 +1,207 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	personalAccessTokenPrefix             = "dashboard-pat-"
+	personalAccessTokenLabel              = "dashboard.k8c.io/personal-access-token"
+	personalAccessTokenUserLabel          = "dashboard.k8c.io/personal-access-token-user"
+	personalAccessTokenUserAnnotation     = "dashboard.k8c.io/personal-access-token-user"
+	personalAccessTokenNameAnnotation     = "dashboard.k8c.io/personal-access-token-name"
+	personalAccessTokenReadOnlyAnnotation = "dashboard.k8c.io/personal-access-token-read-only"
+	personalAccessTokenLastUsedAnnotation = "dashboard.k8c.io/personal-access-token-last-used"
+	personalAccessTokenExpiryAnnotation   = "dashboard.k8c.io/personal-access-token-expiry"
+	personalAccessTokenSecretHashKey      = "secret-hash"
+)
+
+// PersonalAccessTokenProvider stores the personal access tokens as secrets in the Kubermatic namespace.
+type PersonalAccessTokenProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+	// apiReader reads tokens which have not reached the cache of the client yet, automation uses a
+	// token right after it has been created.
+	apiReader ctrlruntimeclient.Reader
+}
+
+var _ provider.PersonalAccessTokenProvider = &PersonalAccessTokenProvider{}
+
+// NewPersonalAccessTokenProvider returns a personal access token provider.
+func NewPersonalAccessTokenProvider(client ctrlruntimeclient.Client, apiReader ctrlruntimeclient.Reader) *PersonalAccessTokenProvider {
+	return &PersonalAccessTokenProvider{
+		clientPrivileged: client,
+		apiReader:        apiReader,
+	}
+}
+
+func (p *PersonalAccessTokenProvider) Create(ctx context.Context, token *provider.PersonalAccessToken) error {
+	annotations := map[string]string{
+		personalAccessTokenUserAnnotation:     token.UserEmail,
+		personalAccessTokenNameAnnotation:     token.Name,
+		personalAccessTokenReadOnlyAnnotation: strconv.FormatBool(token.ReadOnly),
+	}
+	if !token.Expiry.IsZero() {
+		annotations[personalAccessTokenExpiryAnnotation] = token.Expiry.UTC().Format(time.RFC3339)
+	}
+
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      personalAccessTokenPrefix + token.ID,
+			Namespace: resources.KubermaticNamespace,
+			Labels: map[string]string{
+				personalAccessTokenLabel:     "true",
+				personalAccessTokenUserLabel: sessionUserLabelValue(token.UserEmail),
+			},
+			Annotations: annotations,
+		},
+		Type: corev1.SecretTypeOpaque,
+		Data: map[string][]byte{
+			personalAccessTokenSecretHashKey: []byte(token.SecretHash),
+		},
+	}
+	return p.clientPrivileged.Create(ctx, secret)
+}
+
+func (p *PersonalAccessTokenProvider) Get(ctx context.Context, id string) (*provider.PersonalAccessToken, error) {
+	secret, err := p.get(ctx, id)
+	if err != nil {
+		return nil, err
+	}
+	return convertPersonalAccessTokenSecret(secret), nil
+}
+
+func (p *PersonalAccessTokenProvider) List(ctx context.Context, userEmail string) ([]provider.PersonalAccessToken, error) {
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{personalAccessTokenLabel: "true", personalAccessTokenUserLabel: sessionUserLabelValue(userEmail)},
+	); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	tokens := make([]provider.PersonalAccessToken, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		token := convertPersonalAccessTokenSecret(secret)
+		if !strings.EqualFold(token.UserEmail, userEmail) {
+			continue
+		}
+		// expired tokens are removed lazily, nobody can use them anymore
+		if token.Expired(now) {
+			if err := p.clientPrivileged.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+				return nil, err
+			}
+			continue
+		}
+		tokens = append(tokens, *token)
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreationTimestamp.After(tokens[j].CreationTimestamp)
+	})
+	return tokens, nil
+}
+
+func (p *PersonalAccessTokenProvider) UpdateLastUsed(ctx context.Context, id string, lastUsed time.Time) error {
+	secret, err := p.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	oldSecret := secret.DeepCopy()
+	secret.Annotations[personalAccessTokenLastUsedAnnotation] = lastUsed.UTC().Format(time.RFC3339)
+	return p.clientPrivileged.Patch(ctx, secret, ctrlruntimeclient.MergeFrom(oldSecret))
+}
+
+func (p *PersonalAccessTokenProvider) Revoke(ctx context.Context, userEmail, id string) error {
+	secret, err := p.get(ctx, id)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(secret.Annotations[personalAccessTokenUserAnnotation], userEmail) {
+		return newPersonalAccessTokenNotFoundError(id)
+	}
+	return p.clientPrivileged.Delete(ctx, secret)
+}
+
+// get returns the secret of the token with the given ID. Expired tokens are not found.
+func (p *PersonalAccessTokenProvider) get(ctx context.Context, id string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: personalAccessTokenPrefix + id}
+	err := p.clientPrivileged.Get(ctx, name, secret)
+	if apierrors.IsNotFound(err) && p.apiReader != nil {
+		err = p.apiReader.Get(ctx, name, secret)
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, newPersonalAccessTokenNotFoundError(id)
+		}
+		return nil, err
+	}
+	if secret.Labels[personalAccessTokenLabel] != "true" || convertPersonalAccessTokenSecret(secret).Expired(time.Now()) {
+		return nil, newPersonalAccessTokenNotFoundError(id)
+	}
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	return secret, nil
+}
+
+func newPersonalAccessTokenNotFoundError(id string) error {
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "personalaccesstoken"}, id)
+}
+
+func convertPersonalAccessTokenSecret(secret *corev1.Secret) *provider.PersonalAccessToken {
+	token := &provider.PersonalAccessToken{
+		ID:                strings.TrimPrefix(secret.Name, personalAccessTokenPrefix),
+		Name:              secret.Annotations[personalAccessTokenNameAnnotation],
+		UserEmail:         secret.Annotations[personalAccessTokenUserAnnotation],
+		SecretHash:        string(secret.Data[personalAccessTokenSecretHashKey]),
+		CreationTimestamp: secret.CreationTimestamp.Time,
+	}
+	// tokens are read-only unless they say otherwise, a damaged annotation must not grant more rights
+	if readOnly, err := strconv.ParseBool(secret.Annotations[personalAccessTokenReadOnlyAnnotation]); err != nil || readOnly {
+		token.ReadOnly = true
+	}
+	if lastUsed, err := time.Parse(time.RFC3339, secret.Annotations[personalAccessTokenLastUsedAnnotation]); err == nil {
+		token.LastUsed = lastUsed
+	}
+	if expiry, ok := secret.Annotations[personalAccessTokenExpiryAnnotation]; ok {
+		parsed, err := time.Parse(time.RFC3339, expiry)
+		if err != nil {
+			// a token with a damaged expiry has expired
+			parsed = time.Unix(0, 0)
+		}
+		token.Expiry = parsed
+	}
+	return token
+}
@@ -0,0 +1,98 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestPersonalAccessTokenProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	target := kubernetes.NewPersonalAccessTokenProvider(client, client)
+
+	now := time.Now().Truncate(time.Second)
+	require.NoError(t, target.Create(ctx, &provider.PersonalAccessToken{
+		ID:         "ci",
+		Name:       "CI pipeline",
+		UserEmail:  "john@acme.com",
+		SecretHash: provider.PersonalAccessTokenSecretHash("secret"),
+		Expiry:     now.Add(time.Hour),
+	}))
+	require.NoError(t, target.Create(ctx, &provider.PersonalAccessToken{
+		ID:        "monitoring",
+		Name:      "monitoring",
+		UserEmail: "john@acme.com",
+		ReadOnly:  true,
+	}))
+	require.NoError(t, target.Create(ctx, &provider.PersonalAccessToken{ID: "expired", UserEmail: "john@acme.com", Expiry: now.Add(-time.Minute)}))
+	require.NoError(t, target.Create(ctx, &provider.PersonalAccessToken{ID: "bob", UserEmail: "bob@acme.com"}))
+
+	// only the hash of the secret is stored
+	secrets := &corev1.SecretList{}
+	require.NoError(t, client.List(ctx, secrets))
+	require.Len(t, secrets.Items, 4)
+	for _, secret := range secrets.Items {
+		for _, value := range secret.Data {
+			require.NotEqual(t, "secret", string(value))
+		}
+	}
+
+	token, err := target.Get(ctx, "ci")
+	require.NoError(t, err)
+	require.Equal(t, "CI pipeline", token.Name)
+	require.Equal(t, "john@acme.com", token.UserEmail)
+	require.False(t, token.ReadOnly)
+	require.Equal(t, provider.PersonalAccessTokenSecretHash("secret"), token.SecretHash)
+	require.True(t, token.Expiry.Equal(now.Add(time.Hour)))
+
+	_, err = target.Get(ctx, "expired")
+	require.True(t, apierrors.IsNotFound(err))
+
+	// expired tokens are removed while listing, tokens of other users are not listed
+	tokens, err := target.List(ctx, "john@acme.com")
+	require.NoError(t, err)
+	require.Len(t, tokens, 2)
+	require.NoError(t, client.List(ctx, secrets))
+	require.Len(t, secrets.Items, 3)
+
+	require.NoError(t, target.UpdateLastUsed(ctx, "monitoring", now))
+	token, err = target.Get(ctx, "monitoring")
+	require.NoError(t, err)
+	require.True(t, token.ReadOnly)
+	require.True(t, token.Expiry.IsZero())
+	require.True(t, token.LastUsed.Equal(now))
+
+	// users can only revoke their own tokens
+	require.True(t, apierrors.IsNotFound(target.Revoke(ctx, "bob@acme.com", "ci")))
+	require.NoError(t, target.Revoke(ctx, "john@acme.com", "ci"))
+	_, err = target.Get(ctx, "ci")
+	require.True(t, apierrors.IsNotFound(err))
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes
+
+import (
+	"context"
+	"crypto/hmac"
+	"crypto/rand"
+	"crypto/sha256"
+	"encoding/base64"
+	"encoding/hex"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/kubermatic/v2/pkg/resources"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/util/flowcontrol"
+	ctrlruntimeclient "sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	sessionPrefix               = "dashboard-session-"
+	sessionLabel                = "dashboard.k8c.io/session"
+	sessionUserLabel            = "dashboard.k8c.io/session-user"
+	sessionUserAnnotation       = "dashboard.k8c.io/session-user"
+	sessionIssuerAnnotation     = "dashboard.k8c.io/session-issuer"
+	sessionUserAgentAnnotation  = "dashboard.k8c.io/session-user-agent"
+	sessionIPAnnotation         = "dashboard.k8c.io/session-ip"
+	sessionLastSeenAnnotation   = "dashboard.k8c.io/session-last-seen"
+	sessionExpiryAnnotation     = "dashboard.k8c.io/session-expiry"
+	sessionIDTokenKey           = "id-token"
+	sessionRefreshTokenKey      = "refresh-token"
+	sessionMaxUserAgentLength   = 512
+	sessionUserLabelValueLength = 32
+
+	// sessionKeyFallbackWindow is how long after the creation of its key a session which is not in
+	// the cache yet is read with the API reader. Session keys are sent by anyone, only keys signed by
+	// NewKey are passed on to the API server, made up keys are not.
+	sessionKeyFallbackWindow = time.Minute
+
+	// sessionAPIReaderQPS and sessionAPIReaderBurst cap the reads of sessions which are not in the
+	// cache, e.g. for the keys of sessions which were deleted right after the login.
+	sessionAPIReaderQPS   = 5
+	sessionAPIReaderBurst = 20
+)
+
+// SessionProvider stores the login sessions of the dashboard as secrets in the Kubermatic
+// namespace. The secrets are named after the session ID, a hash of the session key, so the
+// key itself is never stored.
+type SessionProvider struct {
+	clientPrivileged ctrlruntimeclient.Client
+	// apiReader reads sessions which have not reached the cache of the client yet, the browser uses
+	// its session right after the login. The reads are rate limited by apiReaderLimiter.
+	apiReader        ctrlruntimeclient.Reader
+	apiReaderLimiter flowcontrol.RateLimiter
+	// keySigningKey signs the session keys, see NewKey.
+	keySigningKey []byte
+}
+
+var _ provider.SessionProvider = &SessionProvider{}
+
+// NewSessionProvider returns a session provider which signs the session keys with the given key.
+func NewSessionProvider(client ctrlruntimeclient.Client, apiReader ctrlruntimeclient.Reader, keySigningKey []byte) *SessionProvider {
+	return &SessionProvider{
+		clientPrivileged: client,
+		apiReader:        apiReader,
+		apiReaderLimiter: flowcontrol.NewTokenBucketRateLimiter(sessionAPIReaderQPS, sessionAPIReaderBurst),
+		keySigningKey:    keySigningKey,
+	}
+}
+
+// NewKey returns a random session key which records its creation time and is signed, so that new
+// sessions can be told apart from made up keys before they are looked up.
+func (p *SessionProvider) NewKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	payload := base64.RawURLEncoding.EncodeToString(b) + "." + strconv.FormatInt(time.Now().Unix(), 10)
+	return payload + "." + p.keySignature(payload), nil
+}
+
+// isNewKey tells whether the key was returned by NewKey within the sessionKeyFallbackWindow.
+func (p *SessionProvider) isNewKey(key string, now time.Time) bool {
+	i := strings.LastIndexByte(key, '.')
+	if i < 0 {
+		return false
+	}
+	payload, signature := key[:i], key[i+1:]
+	if !hmac.Equal([]byte(signature), []byte(p.keySignature(payload))) {
+		return false
+	}
+
+	created, err := strconv.ParseInt(payload[strings.LastIndexByte(payload, '.')+1:], 10, 64)
+	if err != nil {
+		return false
+	}
+	age := now.Sub(time.Unix(created, 0))
+	return age >= -sessionKeyFallbackWindow && age <= sessionKeyFallbackWindow
+}
+
+func (p *SessionProvider) keySignature(payload string) string {
+	mac := hmac.New(sha256.New, p.keySigningKey)
+	mac.Write([]byte(payload))
+	return base64.RawURLEncoding.EncodeToString(mac.Sum(nil))
+}
+
+func (p *SessionProvider) Create(ctx context.Context, key string, session *provider.Session) error {
+	secret := &corev1.Secret{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      sessionPrefix + provider.SessionID(key),
+			Namespace: resources.KubermaticNamespace,
+		},
+		Type: corev1.SecretTypeOpaque,
+	}
+	setSessionSecret(secret, session)
+
+	return p.clientPrivileged.Create(ctx, secret)
+}
+
+func (p *SessionProvider) Get(ctx context.Context, key string) (*provider.Session, error) {
+	secret, err := p.get(ctx, provider.SessionID(key), key)
+	if err != nil {
+		return nil, err
+	}
+	return convertSessionSecret(secret), nil
+}
+
+func (p *SessionProvider) Update(ctx context.Context, key string, session *provider.Session) error {
+	secret, err := p.get(ctx, provider.SessionID(key), key)
+	if err != nil {
+		return err
+	}
+	setSessionSecret(secret, session)
+
+	return p.clientPrivileged.Update(ctx, secret)
+}
+
+func (p *SessionProvider) Delete(ctx context.Context, key string) error {
+	secret, err := p.get(ctx, provider.SessionID(key), key)
+	if err != nil {
+		return err
+	}
+	return p.clientPrivileged.Delete(ctx, secret)
+}
+
+func (p *SessionProvider) List(ctx context.Context, userEmail string) ([]provider.Session, error) {
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{sessionLabel: "true", sessionUserLabel: sessionUserLabelValue(userEmail)},
+	); err != nil {
+		return nil, err
+	}
+
+	now := time.Now()
+	sessions := make([]provider.Session, 0, len(secrets.Items))
+	for i := range secrets.Items {
+		session := convertSessionSecret(&secrets.Items[i])
+		if !strings.EqualFold(session.UserEmail, userEmail) || !session.Expiry.After(now) {
+			continue
+		}
+		sessions = append(sessions, *session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	return sessions, nil
+}
+
+func (p *SessionProvider) Revoke(ctx context.Context, userEmail, sessionID string) error {
+	secret, err := p.get(ctx, sessionID, "")
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(secret.Annotations[sessionUserAnnotation], userEmail) {
+		return newSessionNotFoundError(sessionID)
+	}
+	return p.clientPrivileged.Delete(ctx, secret)
+}
+
+func (p *SessionProvider) RevokeAll(ctx context.Context, userEmail string) error {
+	sessions, err := p.List(ctx, userEmail)
+	if err != nil {
+		return err
+	}
+	for _, session := range sessions {
+		if err := p.Revoke(ctx, userEmail, session.ID); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func (p *SessionProvider) DeleteExpired(ctx context.Context) error {
+	secrets := &corev1.SecretList{}
+	if err := p.clientPrivileged.List(ctx, secrets,
+		ctrlruntimeclient.InNamespace(resources.KubermaticNamespace),
+		ctrlruntimeclient.MatchingLabels{sessionLabel: "true"},
+	); err != nil {
+		return err
+	}
+
+	now := time.Now()
+	for i := range secrets.Items {
+		secret := &secrets.Items[i]
+		if convertSessionSecret(secret).Expiry.After(now) {
+			continue
+		}
+		if err := p.clientPrivileged.Delete(ctx, secret); ctrlruntimeclient.IgnoreNotFound(err) != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// get returns the secret of the session with the given ID. Expired sessions are not found. Sessions
+// which are not in the cache yet are only found for the given key if it is new, see isNewKey.
+func (p *SessionProvider) get(ctx context.Context, sessionID, key string) (*corev1.Secret, error) {
+	secret := &corev1.Secret{}
+	name := types.NamespacedName{Namespace: resources.KubermaticNamespace, Name: sessionPrefix + sessionID}
+	err := p.clientPrivileged.Get(ctx, name, secret)
+	if apierrors.IsNotFound(err) && p.apiReader != nil && p.isNewKey(key, time.Now()) && p.apiReaderLimiter.TryAccept() {
+		err = p.apiReader.Get(ctx, name, secret)
+	}
+	if err != nil {
+		if apierrors.IsNotFound(err) {
+			return nil, newSessionNotFoundError(sessionID)
+		}
+		return nil, err
+	}
+	if secret.Labels[sessionLabel] != "true" || !convertSessionSecret(secret).Expiry.After(time.Now()) {
+		return nil, newSessionNotFoundError(sessionID)
+	}
+	return secret, nil
+}
+
+func newSessionNotFoundError(sessionID string) error {
+	return apierrors.NewNotFound(schema.GroupResource{Resource: "session"}, sessionID)
+}
+
+// sessionUserLabelValue returns the label value which selects the sessions of the given user. Email
+// addresses are not valid label values, so a hash is used instead.
+func sessionUserLabelValue(userEmail string) string {
+	sum := sha256.Sum256([]byte(strings.ToLower(userEmail)))
+	return hex.EncodeToString(sum[:])[:sessionUserLabelValueLength]
+}
+
+func setSessionSecret(secret *corev1.Secret, session *provider.Session) {
+	userAgent := session.UserAgent
+	if len(userAgent) > sessionMaxUserAgentLength {
+		userAgent = userAgent[:sessionMaxUserAgentLength]
+	}
+
+	if secret.Labels == nil {
+		secret.Labels = map[string]string{}
+	}
+	secret.Labels[sessionLabel] = "true"
+	secret.Labels[sessionUserLabel] = sessionUserLabelValue(session.UserEmail)
+
+	if secret.Annotations == nil {
+		secret.Annotations = map[string]string{}
+	}
+	secret.Annotations[sessionUserAnnotation] = session.UserEmail
+	secret.Annotations[sessionIssuerAnnotation] = session.Issuer
+	secret.Annotations[sessionUserAgentAnnotation] = userAgent
+	secret.Annotations[sessionIPAnnotation] = session.IP
+	secret.Annotations[sessionLastSeenAnnotation] = session.LastSeen.UTC().Format(time.RFC3339)
+	secret.Annotations[sessionExpiryAnnotation] = session.Expiry.UTC().Format(time.RFC3339)
+
+	secret.Data = map[string][]byte{
+		sessionIDTokenKey:      []byte(session.IDToken),
+		sessionRefreshTokenKey: []byte(session.RefreshToken),
+	}
+}
+
+func convertSessionSecret(secret *corev1.Secret) *provider.Session {
+	session := &provider.Session{
+		ID:                strings.TrimPrefix(secret.Name, sessionPrefix),
+		UserEmail:         secret.Annotations[sessionUserAnnotation],
+		Issuer:            secret.Annotations[sessionIssuerAnnotation],
+		IDToken:           string(secret.Data[sessionIDTokenKey]),
+		RefreshToken:      string(secret.Data[sessionRefreshTokenKey]),
+		UserAgent:         secret.Annotations[sessionUserAgentAnnotation],
+		IP:                secret.Annotations[sessionIPAnnotation],
+		CreationTimestamp: secret.CreationTimestamp.Time,
+	}
+	if lastSeen, err := time.Parse(time.RFC3339, secret.Annotations[sessionLastSeenAnnotation]); err == nil {
+		session.LastSeen = lastSeen
+	}
+	if expiry, err := time.Parse(time.RFC3339, secret.Annotations[sessionExpiryAnnotation]); err == nil {
+		session.Expiry = expiry
+	}
+	return session
+}
@@ -0,0 +1,143 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package kubernetes_test
+
+import (
+	"context"
+	"testing"
+	"time"
+
+	"github.com/stretchr/testify/require"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+	"k8c.io/dashboard/v2/pkg/provider/kubernetes"
+	"k8c.io/kubermatic/v2/pkg/test/fake"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+)
+
+func TestSessionProvider(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	client := fake.NewClientBuilder().Build()
+	target := kubernetes.NewSessionProvider(client, client, []byte("signing-key"))
+
+	now := time.Now().Truncate(time.Second)
+	session := &provider.Session{
+		UserEmail:    "John@acme.com",
+		IDToken:      "id-token",
+		RefreshToken: "refresh-token",
+		UserAgent:    "Firefox",
+		IP:           "192.0.2.1",
+		LastSeen:     now,
+		Expiry:       now.Add(time.Hour),
+	}
+	require.NoError(t, target.Create(ctx, "key-a", session))
+	require.NoError(t, target.Create(ctx, "key-b", &provider.Session{UserEmail: "john@acme.com", LastSeen: now.Add(time.Minute), Expiry: now.Add(time.Hour)}))
+	require.NoError(t, target.Create(ctx, "key-other", &provider.Session{UserEmail: "jane@acme.com", Expiry: now.Add(time.Hour)}))
+	require.NoError(t, target.Create(ctx, "key-expired", &provider.Session{UserEmail: "john@acme.com", Expiry: now.Add(-time.Minute)}))
+
+	stored, err := target.Get(ctx, "key-a")
+	require.NoError(t, err)
+	require.Equal(t, provider.SessionID("key-a"), stored.ID)
+	require.Equal(t, "id-token", stored.IDToken)
+	require.Equal(t, "refresh-token", stored.RefreshToken)
+	require.Equal(t, "192.0.2.1", stored.IP)
+	require.True(t, now.Equal(stored.LastSeen))
+
+	// the session key itself is not stored
+	secrets := &corev1.SecretList{}
+	require.NoError(t, client.List(ctx, secrets))
+	for _, secret := range secrets.Items {
+		require.NotContains(t, secret.Name, "key-a")
+	}
+
+	_, err = target.Get(ctx, "key-expired")
+	require.True(t, apierrors.IsNotFound(err))
+
+	stored.IDToken = "refreshed-id-token"
+	require.NoError(t, target.Update(ctx, "key-a", stored))
+	stored, err = target.Get(ctx, "key-a")
+	require.NoError(t, err)
+	require.Equal(t, "refreshed-id-token", stored.IDToken)
+
+	// users are matched case-insensitively, the most recently used session comes first
+	sessions, err := target.List(ctx, "john@acme.com")
+	require.NoError(t, err)
+	require.Len(t, sessions, 2)
+	require.Equal(t, provider.SessionID("key-b"), sessions[0].ID)
+	require.Equal(t, provider.SessionID("key-a"), sessions[1].ID)
+
+	// sessions can only be revoked by their user
+	require.True(t, apierrors.IsNotFound(target.Revoke(ctx, "jane@acme.com", sessions[0].ID)))
+	require.NoError(t, target.Revoke(ctx, "john@acme.com", sessions[0].ID))
+	_, err = target.Get(ctx, "key-b")
+	require.True(t, apierrors.IsNotFound(err))
+
+	require.NoError(t, target.RevokeAll(ctx, "john@acme.com"))
+	sessions, err = target.List(ctx, "john@acme.com")
+	require.NoError(t, err)
+	require.Empty(t, sessions)
+
+	require.NoError(t, target.Delete(ctx, "key-other"))
+	_, err = target.Get(ctx, "key-other")
+	require.True(t, apierrors.IsNotFound(err))
+
+	// expired sessions are kept until the cleanup removes them
+	require.NoError(t, client.List(ctx, secrets))
+	require.Len(t, secrets.Items, 1)
+	require.NoError(t, target.DeleteExpired(ctx))
+	require.NoError(t, client.List(ctx, secrets))
+	require.Empty(t, secrets.Items)
+}
+
+func TestSessionProviderAPIReader(t *testing.T) {
+	t.Parallel()
+
+	ctx := context.Background()
+	// the sessions are only known to the API server, the cache of the client has not seen them yet
+	cache := fake.NewClientBuilder().Build()
+	apiServer := fake.NewClientBuilder().Build()
+	target := kubernetes.NewSessionProvider(cache, apiServer, []byte("signing-key"))
+	creator := kubernetes.NewSessionProvider(apiServer, nil, []byte("signing-key"))
+	session := &provider.Session{UserEmail: "john@acme.com", Expiry: time.Now().Add(time.Hour)}
+
+	// new keys are looked up with the API reader
+	key, err := target.NewKey()
+	require.NoError(t, err)
+	require.NoError(t, creator.Create(ctx, key, session))
+	stored, err := target.Get(ctx, key)
+	require.NoError(t, err)
+	require.Equal(t, provider.SessionID(key), stored.ID)
+
+	// made up keys and keys signed by another key are not
+	for _, key := range []string{"made-up-key", key + "x", mustNewSessionKey(t, kubernetes.NewSessionProvider(cache, apiServer, []byte("another-key")))} {
+		require.NoError(t, creator.Create(ctx, key, session))
+		_, err := target.Get(ctx, key)
+		require.True(t, apierrors.IsNotFound(err), key)
+	}
+}
+
+func mustNewSessionKey(t *testing.T, p *kubernetes.SessionProvider) string {
+	t.Helper()
+
+	key, err := p.NewKey()
+	require.NoError(t, err)
+	return key
+}
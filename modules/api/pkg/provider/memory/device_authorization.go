@@ -0,0 +1,158 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"sync"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// DeviceAuthorizationProvider keeps the device authorizations in memory.
+type DeviceAuthorizationProvider struct {
+	lock sync.Mutex
+	// authorizations are indexed by the authorization ID.
+	authorizations map[string]provider.DeviceAuthorization
+}
+
+var _ provider.DeviceAuthorizationProvider = &DeviceAuthorizationProvider{}
+
+// NewDeviceAuthorizationProvider returns an empty device authorization provider.
+func NewDeviceAuthorizationProvider() *DeviceAuthorizationProvider {
+	return &DeviceAuthorizationProvider{
+		authorizations: map[string]provider.DeviceAuthorization{},
+	}
+}
+
+func (p *DeviceAuthorizationProvider) Create(_ context.Context, deviceCode string, authorization *provider.DeviceAuthorization) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	id := provider.DeviceAuthorizationID(deviceCode)
+	if _, exists := p.authorizations[id]; exists {
+		return apierrors.NewAlreadyExists(deviceAuthorizationResource, id)
+	}
+	stored := *authorization
+	stored.ID = id
+	if stored.Status == "" {
+		stored.Status = provider.DeviceAuthorizationPending
+	}
+	p.authorizations[id] = stored
+	return nil
+}
+
+func (p *DeviceAuthorizationProvider) Poll(_ context.Context, deviceCode string) (*provider.DeviceAuthorization, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	id := provider.DeviceAuthorizationID(deviceCode)
+	authorization, err := p.get(id)
+	if err != nil {
+		return nil, err
+	}
+	polled := authorization
+	polled.LastPoll = time.Now()
+	p.authorizations[id] = polled
+	return &authorization, nil
+}
+
+func (p *DeviceAuthorizationProvider) GetByUserCode(_ context.Context, userCode string) (*provider.DeviceAuthorization, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for id := range p.authorizations {
+		authorization, err := p.get(id)
+		if err == nil && authorization.UserCode == userCode {
+			return &authorization, nil
+		}
+	}
+	return nil, apierrors.NewNotFound(deviceAuthorizationResource, userCode)
+}
+
+func (p *DeviceAuthorizationProvider) Update(_ context.Context, authorization *provider.DeviceAuthorization) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	stored, err := p.get(authorization.ID)
+	if err != nil {
+		return err
+	}
+	stored.Status = authorization.Status
+	stored.UserEmail = authorization.UserEmail
+	stored.IDToken = authorization.IDToken
+	stored.RefreshToken = authorization.RefreshToken
+	p.authorizations[authorization.ID] = stored
+	return nil
+}
+
+func (p *DeviceAuthorizationProvider) Delete(_ context.Context, id string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, err := p.get(id); err != nil {
+		return err
+	}
+	delete(p.authorizations, id)
+	return nil
+}
+
+func (p *DeviceAuthorizationProvider) Count(_ context.Context) (int, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	count := 0
+	now := time.Now()
+	for _, authorization := range p.authorizations {
+		if authorization.Expiry.After(now) {
+			count++
+		}
+	}
+	return count, nil
+}
+
+func (p *DeviceAuthorizationProvider) DeleteExpired(_ context.Context) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	for id, authorization := range p.authorizations {
+		if !authorization.Expiry.After(now) {
+			delete(p.authorizations, id)
+		}
+	}
+	return nil
+}
+
+var deviceAuthorizationResource = schema.GroupResource{Resource: "deviceauthorization"}
+
+// get returns the authorization with the given ID, expired authorizations are removed. The lock must be held.
+func (p *DeviceAuthorizationProvider) get(id string) (provider.DeviceAuthorization, error) {
+	authorization, ok := p.authorizations[id]
+	if !ok {
+		return provider.DeviceAuthorization{}, apierrors.NewNotFound(deviceAuthorizationResource, id)
+	}
+	if !authorization.Expiry.After(time.Now()) {
+		delete(p.authorizations, id)
+		return provider.DeviceAuthorization{}, apierrors.NewNotFound(deviceAuthorizationResource, id)
+	}
+	return authorization, nil
+}
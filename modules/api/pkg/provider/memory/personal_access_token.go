@@ -0,0 +1,132 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package memory
+
+import (
+	"context"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// PersonalAccessTokenProvider keeps the personal access tokens in memory.
+type PersonalAccessTokenProvider struct {
+	lock sync.Mutex
+	// tokens are indexed by the token ID.
+	tokens map[string]provider.PersonalAccessToken
+}
+
+var _ provider.PersonalAccessTokenProvider = &PersonalAccessTokenProvider{}
+
+// NewPersonalAccessTokenProvider returns an empty personal access token provider.
+func NewPersonalAccessTokenProvider() *PersonalAccessTokenProvider {
+	return &PersonalAccessTokenProvider{
+		tokens: map[string]provider.PersonalAccessToken{},
+	}
+}
+
+func (p *PersonalAccessTokenProvider) Create(_ context.Context, token *provider.PersonalAccessToken) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	if _, exists := p.tokens[token.ID]; exists {
+		return apierrors.NewAlreadyExists(personalAccessTokenResource, token.ID)
+	}
+	stored := *token
+	if stored.CreationTimestamp.IsZero() {
+		stored.CreationTimestamp = time.Now()
+	}
+	p.tokens[token.ID] = stored
+	return nil
+}
+
+func (p *PersonalAccessTokenProvider) Get(_ context.Context, id string) (*provider.PersonalAccessToken, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	token, err := p.get(id)
+	if err != nil {
+		return nil, err
+	}
+	return &token, nil
+}
+
+func (p *PersonalAccessTokenProvider) List(_ context.Context, userEmail string) ([]provider.PersonalAccessToken, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	tokens := []provider.PersonalAccessToken{}
+	for id := range p.tokens {
+		token, err := p.get(id)
+		if err == nil && strings.EqualFold(token.UserEmail, userEmail) {
+			tokens = append(tokens, token)
+		}
+	}
+	sort.Slice(tokens, func(i, j int) bool {
+		return tokens[i].CreationTimestamp.After(tokens[j].CreationTimestamp)
+	})
+	return tokens, nil
+}
+
+func (p *PersonalAccessTokenProvider) UpdateLastUsed(_ context.Context, id string, lastUsed time.Time) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	token, err := p.get(id)
+	if err != nil {
+		return err
+	}
+	token.LastUsed = lastUsed
+	p.tokens[id] = token
+	return nil
+}
+
+func (p *PersonalAccessTokenProvider) Revoke(_ context.Context, userEmail, id string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	token, err := p.get(id)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(token.UserEmail, userEmail) {
+		return apierrors.NewNotFound(personalAccessTokenResource, id)
+	}
+	delete(p.tokens, id)
+	return nil
+}
+
+var personalAccessTokenResource = schema.GroupResource{Resource: "personalaccesstoken"}
+
+// get returns the token with the given ID, expired tokens are removed. The lock must be held.
+func (p *PersonalAccessTokenProvider) get(id string) (provider.PersonalAccessToken, error) {
+	token, ok := p.tokens[id]
+	if !ok {
+		return provider.PersonalAccessToken{}, apierrors.NewNotFound(personalAccessTokenResource, id)
+	}
+	if token.Expired(time.Now()) {
+		delete(p.tokens, id)
+		return provider.PersonalAccessToken{}, apierrors.NewNotFound(personalAccessTokenResource, id)
+	}
+	return token, nil
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2026 The Kubermatic Kubernetes Platform contributors.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package memory contains providers which keep their state in the memory of the process. They
+// are meant for tests and single replica setups, the state is lost when the process exits.
+package memory
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"k8c.io/dashboard/v2/pkg/provider"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+)
+
+// SessionProvider keeps the login sessions of the dashboard in memory.
+type SessionProvider struct {
+	lock sync.Mutex
+	// sessions are indexed by the session ID.
+	sessions map[string]provider.Session
+}
+
+var _ provider.SessionProvider = &SessionProvider{}
+
+// NewSessionProvider returns an empty session provider.
+func NewSessionProvider() *SessionProvider {
+	return &SessionProvider{
+		sessions: map[string]provider.Session{},
+	}
+}
+
+func (p *SessionProvider) NewKey() (string, error) {
+	b := make([]byte, 32)
+	if _, err := rand.Read(b); err != nil {
+		return "", err
+	}
+	return base64.RawURLEncoding.EncodeToString(b), nil
+}
+
+func (p *SessionProvider) Create(_ context.Context, key string, session *provider.Session) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	id := provider.SessionID(key)
+	if _, exists := p.sessions[id]; exists {
+		return apierrors.NewAlreadyExists(sessionResource, id)
+	}
+	p.store(id, session)
+	return nil
+}
+
+func (p *SessionProvider) Get(_ context.Context, key string) (*provider.Session, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	session, err := p.get(provider.SessionID(key))
+	if err != nil {
+		return nil, err
+	}
+	return &session, nil
+}
+
+func (p *SessionProvider) Update(_ context.Context, key string, session *provider.Session) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	id := provider.SessionID(key)
+	stored, err := p.get(id)
+	if err != nil {
+		return err
+	}
+	updated := *session
+	updated.CreationTimestamp = stored.CreationTimestamp
+	p.store(id, &updated)
+	return nil
+}
+
+func (p *SessionProvider) Delete(_ context.Context, key string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	id := provider.SessionID(key)
+	if _, err := p.get(id); err != nil {
+		return err
+	}
+	delete(p.sessions, id)
+	return nil
+}
+
+func (p *SessionProvider) List(_ context.Context, userEmail string) ([]provider.Session, error) {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	sessions := []provider.Session{}
+	for id := range p.sessions {
+		session, err := p.get(id)
+		if err != nil || !strings.EqualFold(session.UserEmail, userEmail) {
+			continue
+		}
+		sessions = append(sessions, session)
+	}
+	sort.Slice(sessions, func(i, j int) bool {
+		return sessions[i].LastSeen.After(sessions[j].LastSeen)
+	})
+	return sessions, nil
+}
+
+func (p *SessionProvider) Revoke(_ context.Context, userEmail, sessionID string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	session, err := p.get(sessionID)
+	if err != nil {
+		return err
+	}
+	if !strings.EqualFold(session.UserEmail, userEmail) {
+		return apierrors.NewNotFound(sessionResource, sessionID)
+	}
+	delete(p.sessions, sessionID)
+	return nil
+}
+
+func (p *SessionProvider) RevokeAll(_ context.Context, userEmail string) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	for id, session := range p.sessions {
+		if strings.EqualFold(session.UserEmail, userEmail) {
+			delete(p.sessions, id)
+		}
+	}
+	return nil
+}
+
+func (p *SessionProvider) DeleteExpired(_ context.Context) error {
+	p.lock.Lock()
+	defer p.lock.Unlock()
+
+	now := time.Now()
+	for id, session := range p.sessions {
+		if !session.Expiry.After(now) {
+			delete(p.sessions, id)
+		}
+	}
+	return nil
+}
+
+var sessionResource = schema.GroupResource{Resource: "session"}
+
+// get returns the session with the given ID, expired sessions are removed. The lock must be held.
+func (p *SessionProvider) get(id string) (provider.Session, error) {
+	session, ok := p.sessions[id]
+	if !ok {
+		return provider.Session{}, apierrors.NewNotFound(sessionResource, id)
+	}
+	if !session.Expiry.After(time.Now()) {
+		delete(p.sessions, id)
+		return provider.Session{}, apierrors.NewNotFound(sessionResource, id)
+	}
+	return session, nil
+}
+
+// store saves a copy of the session under the given ID. The lock must be held.
+func (p *SessionProvider) store(id string, session *provider.Session) {
+	stored := *session
+	stored.ID = id
+	if stored.CreationTimestamp.IsZero() {
+		stored.CreationTimestamp = time.Now()
+	}
+	p.sessions[id] = stored
+}
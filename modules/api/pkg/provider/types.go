@@ -18,8 +18,14 @@ package provider
 
 import (
 	"context"
+	"crypto/sha256"
+	"encoding/hex"
 	"errors"
 	"fmt"
+	"strings"
+	"time"
+
+	"go.uber.org/zap"
 
 	apiv1 "k8c.io/dashboard/v2/pkg/api/v1"
 	apiv2 "k8c.io/dashboard/v2/pkg/api/v2"
@@ -252,6 +258,11 @@ type ClusterProvider interface {
 	// Note that the client doesn't use admin account instead it authn/authz as userInfo(email, group)
 	GetClientForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (ctrlruntimeclient.Client, error)
 
+	// GetK8sClientForUserCluster returns a k8s go client to interact with all resources in the given cluster
+	//
+	// Note that the client doesn't use admin account instead it authn/authz as userInfo(email, group)
+	GetK8sClientForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (kubernetes.Interface, error)
+
 	// GetTokenForUserCluster returns a token for the given cluster with permissions granted to group that
 	// user belongs to.
 	GetTokenForUserCluster(context.Context, *UserInfo, *kubermaticv1.Cluster) (string, error)
@@ -734,6 +745,10 @@ type ExternalClusterProvider interface {
 
 	GetUserBasedMasterClient(ctx context.Context, projectName string, userInfoGetter func(ctx context.Context, projectID string) (*UserInfo, error)) (ctrlruntimeclient.Client, error)
 
+	// GetRestConfig returns the rest config of the kubeconfig the cluster was imported with, or a config
+	// routed through the tunnel of the cluster agent for clusters registered by an agent.
+	GetRestConfig(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*rest.Config, error)
+
 	GetVersion(ctx context.Context, masterClient ctrlruntimeclient.Client, cluster *kubermaticv1.ExternalCluster) (*ksemver.Semver, error)
 
 	VersionsEndpoint(ctx context.Context, configGetter KubermaticConfigurationGetter, providerType kubermaticv1.ExternalClusterProviderType) ([]apiv1.MasterVersion, error)
@@ -904,6 +919,12 @@ type PrivilegedAlertmanagerProvider interface {
 	// Note that this function:
 	// is unsafe in a sense that it uses privileged account to reset the resource
 	ResetUnsecured(ctx context.Context, cluster *kubermaticv1.Cluster) error
+
+	// SendTestAlertUnsecured sends the given alert to the alertmanager of the cluster using a privileged client
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to reach the alertmanager
+	SendTestAlertUnsecured(ctx context.Context, cluster *kubermaticv1.Cluster, alert *apiv2.AlertmanagerTestAlert) error
 }
 
 // ClusterTemplateProvider declares the set of method for interacting with cluster templates.
@@ -1275,6 +1296,114 @@ type ResourceQuotaProvider interface {
 	DeleteUnsecured(ctx context.Context, name string) error
 }
 
+// ResourceQuotaUsageSnapshot is the global usage of a resource quota at a point in time.
+type ResourceQuotaUsageSnapshot struct {
+	Timestamp time.Time                    `json:"timestamp"`
+	Usage     kubermaticv1.ResourceDetails `json:"usage"`
+}
+
+// ResourceQuotaUsageHistoryProvider declares the set of methods for reading the periodically
+// recorded usage of resource quotas.
+type ResourceQuotaUsageHistoryProvider interface {
+	// ListUnsecured returns the recorded usage snapshots of a resource quota, oldest first.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	ListUnsecured(ctx context.Context, quotaName string) ([]ResourceQuotaUsageSnapshot, error)
+
+	// Run records the usage of all resource quotas periodically until the context is cancelled.
+	Run(ctx context.Context, log *zap.SugaredLogger)
+}
+
+// ResourceQuotaNotificationProvider declares the set of methods for managing the threshold
+// notifications of resource quotas.
+type ResourceQuotaNotificationProvider interface {
+	// GetConfigUnsecured returns the notification config of a resource quota. An empty config is
+	// returned if none has been set.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	GetConfigUnsecured(ctx context.Context, quotaName string) (*apiv2.ResourceQuotaNotificationConfig, error)
+
+	// UpdateConfigUnsecured sets the notification config of a resource quota.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to update the resource
+	UpdateConfigUnsecured(ctx context.Context, quotaName string, config *apiv2.ResourceQuotaNotificationConfig) error
+
+	// ListDeliveriesUnsecured returns the most recent notification deliveries of a resource quota, oldest first.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	ListDeliveriesUnsecured(ctx context.Context, quotaName string) ([]apiv2.ResourceQuotaNotificationDelivery, error)
+
+	// Run checks the usage of all resource quotas periodically and sends the due notifications until
+	// the context is cancelled.
+	Run(ctx context.Context, log *zap.SugaredLogger)
+}
+
+// ClusterAgentTokenProvider declares the set of methods for managing the tokens cluster agents
+// use to register the cluster they run in with a project.
+type ClusterAgentTokenProvider interface {
+	// CreateUnsecured creates a token for the given project. Only a hash of the token is stored,
+	// the returned token is the only time its value is known.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to create the resource
+	CreateUnsecured(ctx context.Context, projectID, name string, expiry *time.Time) (*apiv2.ClusterAgentToken, error)
+
+	// ListUnsecured returns the tokens of the given project.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resources
+	ListUnsecured(ctx context.Context, projectID string) ([]apiv2.ClusterAgentToken, error)
+
+	// DeleteUnsecured revokes a token of the given project and closes the tunnels of the
+	// clusters registered with it.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to delete the resource
+	DeleteUnsecured(ctx context.Context, projectID, tokenID string) error
+
+	// Authenticate returns the IDs of the project and of the given token. The token is bound to the
+	// agent it is used by first, a forbidden error is returned for other agents. An unauthorized
+	// error is returned if the token is unknown, revoked or expired.
+	Authenticate(ctx context.Context, token, agentID string) (projectID, tokenID string, err error)
+}
+
+// BackgroundOperation identifies a long running operation which is driven by the API in the background,
+// e.g. a cluster upgrade or a node drain.
+type BackgroundOperation struct {
+	// Kind selects the BackgroundOperationResumer of the operation.
+	Kind string `json:"kind"`
+	// Cluster is the name of the (external) cluster the operation belongs to.
+	Cluster string `json:"cluster"`
+	// Name distinguishes several operations of the same kind in one cluster, e.g. the node which is drained.
+	Name string `json:"name,omitempty"`
+}
+
+// BackgroundOperationRun drives an operation until it does not need to be driven anymore, in which case it
+// returns nil. The context is cancelled when the API replica loses the lease of the operation or shuts down.
+// A returned error keeps the operation registered, so that it is resumed later with a backoff. The operation
+// is given up after it failed a few times in a row.
+type BackgroundOperationRun func(ctx context.Context) error
+
+// BackgroundOperationFailureHandler records in the status of an operation that it has been given up with the
+// given error of its last run. A returned error makes the failure be reported again later.
+type BackgroundOperationFailureHandler func(ctx context.Context, op BackgroundOperation, err error) error
+
+// BackgroundOperationResumer returns the run of an operation which was started earlier, e.g. by an API replica
+// which is gone. A nil run means that nothing is left to do.
+type BackgroundOperationResumer func(ctx context.Context, op BackgroundOperation) (BackgroundOperationRun, error)
+
+// BackgroundOperationProvider drives background operations so that every operation is driven by exactly one
+// API replica and survives restarts of the API.
+type BackgroundOperationProvider interface {
+	// Start registers the operation and drives it with the given run. If the operation is already driven,
+	// by this or another replica, the driving replica runs it once more after it is done instead.
+	Start(ctx context.Context, op BackgroundOperation, run BackgroundOperationRun) error
+}
+
 type GroupProjectBindingProvider interface {
 	// List returns a list of GroupProjectBindings for a given project.
 	List(ctx context.Context, userInfo *UserInfo, projectID string) ([]kubermaticv1.GroupProjectBinding, error)
@@ -1357,6 +1486,119 @@ type ApplicationDefinitionProvider interface {
 	// Note that this function:
 	// is unsafe in a sense that it uses privileged account to get the resources
 	DeleteUnsecured(ctx context.Context, appDefName string) error
+
+	// GetHelmCredentialsUnsecured reads the credentials of the Helm source of an ApplicationDefinition from the
+	// Kubermatic namespace.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resources
+	GetHelmCredentialsUnsecured(ctx context.Context, credentials *appskubermaticv1.HelmCredentials) (*HelmSourceCredentials, error)
+}
+
+// HelmSourceCredentials are the credentials of the Helm source of an ApplicationDefinition.
+type HelmSourceCredentials struct {
+	Username string
+	Password string
+	// RegistryConfigFile is a Docker config JSON with the credentials of OCI registries.
+	RegistryConfigFile []byte
+}
+
+// ApplicationCatalogSourceCredentials are the credentials of a catalog source.
+type ApplicationCatalogSourceCredentials struct {
+	Username string
+	Password string
+	// HelmCredentials references the stored credentials, for the ApplicationDefinitions synced from the source.
+	HelmCredentials *appskubermaticv1.HelmCredentials
+}
+
+// ApplicationCatalogSourceProvider declares the set of methods for managing the Helm chart repositories and
+// OCI registries whose charts are synced into ApplicationDefinitions.
+type ApplicationCatalogSourceProvider interface {
+	// ListUnsecured returns all catalog sources, sorted by name.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resources
+	ListUnsecured(ctx context.Context) ([]apiv2.ApplicationCatalogSource, error)
+
+	// GetUnsecured returns the catalog source with the given name.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	GetUnsecured(ctx context.Context, name string) (*apiv2.ApplicationCatalogSource, error)
+
+	// GetCredentialsUnsecured returns the credentials of the catalog source with the given name, nil if it has none.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	GetCredentialsUnsecured(ctx context.Context, name string) (*ApplicationCatalogSourceCredentials, error)
+
+	// CreateUnsecured creates a catalog source with the password of its username.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to create the resource
+	CreateUnsecured(ctx context.Context, source *apiv2.ApplicationCatalogSource, password string) (*apiv2.ApplicationCatalogSource, error)
+
+	// UpdateUnsecured updates the spec of a catalog source. An empty password keeps the stored one, unless the
+	// username changed.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to update the resource
+	UpdateUnsecured(ctx context.Context, source *apiv2.ApplicationCatalogSource, password string) (*apiv2.ApplicationCatalogSource, error)
+
+	// UpdateStatusUnsecured records the result of a sync of the catalog source with the given name.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to update the resource
+	UpdateStatusUnsecured(ctx context.Context, name string, status *apiv2.ApplicationCatalogSourceStatus) error
+
+	// DeleteUnsecured deletes a catalog source. The ApplicationDefinitions synced from it are kept.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to delete the resource
+	DeleteUnsecured(ctx context.Context, name string) error
+}
+
+// ApplicationRolloutProvider declares the set of methods for managing the rollouts of applications to the clusters
+// of a project.
+type ApplicationRolloutProvider interface {
+	// ListUnsecured returns the rollouts of the given project sorted by name, or of all projects if the project ID
+	// is empty.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resources
+	ListUnsecured(ctx context.Context, projectID string) ([]apiv2.ApplicationRollout, error)
+
+	// GetUnsecured returns the rollout with the given name.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to get the resource
+	GetUnsecured(ctx context.Context, projectID, name string) (*apiv2.ApplicationRollout, error)
+
+	// CreateUnsecured creates a rollout with the name and spec of the given one in the project. The rollout is
+	// deleted together with the project.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to create the resource
+	CreateUnsecured(ctx context.Context, project *kubermaticv1.Project, rollout *apiv2.ApplicationRollout) (*apiv2.ApplicationRollout, error)
+
+	// UpdateUnsecured replaces the spec of a rollout. The generation is incremented if the spec changed.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to update the resource
+	UpdateUnsecured(ctx context.Context, rollout *apiv2.ApplicationRollout) (*apiv2.ApplicationRollout, error)
+
+	// UpdateStatusUnsecured stores the status of the given rollout. It fails with a conflict if the rollout was
+	// changed since it was read, and sets the new resource version on success.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to update the resource
+	UpdateStatusUnsecured(ctx context.Context, rollout *apiv2.ApplicationRollout) error
+
+	// DeleteUnsecured deletes a rollout. The applications it installed are kept.
+	//
+	// Note that this function:
+	// is unsafe in a sense that it uses privileged account to delete the resource
+	DeleteUnsecured(ctx context.Context, projectID, name string) error
 }
 
 type PrivilegedOperatingSystemProfileProvider interface {
@@ -1412,3 +1654,197 @@ type PolicyTemplateProvider interface {
 	// is unsafe in a sense that it uses privileged account to get the resources
 	DeleteUnsecured(ctx context.Context, name string, projectID string, userInfo *UserInfo) error
 }
+
+// Session is a login session of the dashboard. The tokens of the session are only kept server-side,
+// the browser only holds the key of the session.
+type Session struct {
+	// ID identifies the session without revealing its key.
+	ID        string
+	UserEmail string
+	// Issuer is the name of the OIDC issuer the user logged in with, empty for the default issuer.
+	Issuer            string
+	IDToken           string
+	RefreshToken      string
+	UserAgent         string
+	IP                string
+	CreationTimestamp time.Time
+	LastSeen          time.Time
+	// Expiry is the time after which the session cannot be used anymore.
+	Expiry time.Time
+}
+
+// SessionProvider stores the login sessions of the dashboard. The browser addresses its session by
+// the session key, the sessions of a user are listed and revoked by their ID.
+type SessionProvider interface {
+	// NewKey returns a random key for a new session.
+	NewKey() (string, error)
+
+	// Create stores a new session under the given key.
+	Create(ctx context.Context, key string, session *Session) error
+
+	// Get returns the session of the given key. A not found error is returned if the session is
+	// unknown, revoked or expired.
+	Get(ctx context.Context, key string) (*Session, error)
+
+	// Update replaces the session stored under the given key.
+	Update(ctx context.Context, key string, session *Session) error
+
+	// Delete removes the session of the given key.
+	Delete(ctx context.Context, key string) error
+
+	// List returns the active sessions of the given user, the most recently used session first.
+	List(ctx context.Context, userEmail string) ([]Session, error)
+
+	// Revoke removes the session of the given user with the given ID.
+	Revoke(ctx context.Context, userEmail, sessionID string) error
+
+	// RevokeAll removes all sessions of the given user.
+	RevokeAll(ctx context.Context, userEmail string) error
+
+	// DeleteExpired removes the sessions which have expired. Expired sessions can not be used
+	// anymore, this only frees their storage.
+	DeleteExpired(ctx context.Context) error
+}
+
+// SessionID returns the ID of the session with the given key.
+func SessionID(key string) string {
+	sum := sha256.Sum256([]byte(key))
+	return hex.EncodeToString(sum[:])
+}
+
+// DeviceAuthorizationStatus is the state of a device authorization.
+type DeviceAuthorizationStatus string
+
+const (
+	// DeviceAuthorizationPending is the state of an authorization the user has not decided on yet.
+	DeviceAuthorizationPending DeviceAuthorizationStatus = "pending"
+	// DeviceAuthorizationApproved is the state of an authorization which holds the tokens for the device.
+	DeviceAuthorizationApproved DeviceAuthorizationStatus = "approved"
+	// DeviceAuthorizationDenied is the state of an authorization the user has denied.
+	DeviceAuthorizationDenied DeviceAuthorizationStatus = "denied"
+)
+
+// DeviceAuthorization is a login of a device, like a CLI on a headless host, with the OAuth 2.0 device
+// authorization grant (RFC 8628). The device polls the authorization by its device code, the user
+// approves it in the dashboard by its user code.
+type DeviceAuthorization struct {
+	// ID identifies the authorization without revealing its device code.
+	ID       string
+	UserCode string
+	// ProjectID and ClusterID select the cluster the device asked a kubeconfig for, they are empty otherwise.
+	ProjectID string
+	ClusterID string
+	Status    DeviceAuthorizationStatus
+	// UserEmail is the user who approved or denied the authorization.
+	UserEmail    string
+	IDToken      string
+	RefreshToken string
+	// LastPoll is the time the device last asked for its tokens.
+	LastPoll time.Time
+	// Expiry is the time after which the device codes cannot be used anymore.
+	Expiry time.Time
+}
+
+// DeviceAuthorizationProvider stores the pending device authorizations until the device received its tokens.
+type DeviceAuthorizationProvider interface {
+	// Create stores a new authorization under the given device code.
+	Create(ctx context.Context, deviceCode string, authorization *DeviceAuthorization) error
+
+	// Poll returns the authorization of the given device code and records the time of the poll, the
+	// returned authorization holds the time of the previous poll. A not found error is returned if the
+	// authorization is unknown or expired.
+	Poll(ctx context.Context, deviceCode string) (*DeviceAuthorization, error)
+
+	// GetByUserCode returns the authorization of the given user code. A not found error is returned if
+	// the authorization is unknown or expired.
+	GetByUserCode(ctx context.Context, userCode string) (*DeviceAuthorization, error)
+
+	// Update stores the status, user and tokens of the given authorization.
+	Update(ctx context.Context, authorization *DeviceAuthorization) error
+
+	// Delete removes the authorization with the given ID.
+	Delete(ctx context.Context, id string) error
+
+	// Count returns the number of authorizations which have not expired.
+	Count(ctx context.Context) (int, error)
+
+	// DeleteExpired removes the authorizations which have expired. Devices give up on expired
+	// authorizations, this only frees their storage.
+	DeleteExpired(ctx context.Context) error
+}
+
+// DeviceAuthorizationID returns the ID of the device authorization with the given device code.
+func DeviceAuthorizationID(deviceCode string) string {
+	sum := sha256.Sum256([]byte(deviceCode))
+	return hex.EncodeToString(sum[:])
+}
+
+// PersonalAccessTokenPrefix is the prefix of personal access tokens. It tells them apart from the JWTs
+// of the other authentication methods.
+const PersonalAccessTokenPrefix = "kkp_pat_"
+
+// PersonalAccessToken is a token with which a user authenticates automation with their own identity. Only
+// a hash of the secret of the token is kept.
+type PersonalAccessToken struct {
+	ID        string
+	Name      string
+	UserEmail string
+	// ReadOnly tokens can only be used for requests which do not change anything.
+	ReadOnly bool
+	// SecretHash is the hash of the secret of the token, see PersonalAccessTokenSecretHash.
+	SecretHash        string
+	CreationTimestamp time.Time
+	LastUsed          time.Time
+	// Expiry is the time after which the token cannot be used anymore, zero for tokens which do not expire.
+	Expiry time.Time
+}
+
+// Expired reports whether the token cannot be used anymore.
+func (t *PersonalAccessToken) Expired(now time.Time) bool {
+	return !t.Expiry.IsZero() && !t.Expiry.After(now)
+}
+
+// PersonalAccessTokenProvider stores the personal access tokens of users.
+type PersonalAccessTokenProvider interface {
+	// Create stores a new token.
+	Create(ctx context.Context, token *PersonalAccessToken) error
+
+	// Get returns the token with the given ID. A not found error is returned if the token is unknown,
+	// revoked or expired.
+	Get(ctx context.Context, id string) (*PersonalAccessToken, error)
+
+	// List returns the tokens of the given user which have not expired, the most recently created first.
+	List(ctx context.Context, userEmail string) ([]PersonalAccessToken, error)
+
+	// UpdateLastUsed records the time the token with the given ID was last used.
+	UpdateLastUsed(ctx context.Context, id string, lastUsed time.Time) error
+
+	// Revoke removes the token of the given user with the given ID.
+	Revoke(ctx context.Context, userEmail, id string) error
+}
+
+// NewPersonalAccessTokenValue returns the value users authenticate with for the token with the given ID and secret.
+func NewPersonalAccessTokenValue(id, secret string) string {
+	return PersonalAccessTokenPrefix + id + "_" + secret
+}
+
+// ParsePersonalAccessTokenValue returns the ID and secret of a personal access token. The ID never contains
+// an underscore, the secret may.
+func ParsePersonalAccessTokenValue(value string) (id, secret string, ok bool) {
+	rest, ok := strings.CutPrefix(value, PersonalAccessTokenPrefix)
+	if !ok {
+		return "", "", false
+	}
+	id, secret, ok = strings.Cut(rest, "_")
+	if !ok || id == "" || secret == "" {
+		return "", "", false
+	}
+	return id, secret, true
+}
+
+// PersonalAccessTokenSecretHash returns the hash of the secret of a personal access token which is stored
+// instead of the secret.
+func PersonalAccessTokenSecretHash(secret string) string {
+	sum := sha256.Sum256([]byte(secret))
+	return hex.EncodeToString(sum[:])
+}